@@ -86,7 +86,9 @@ type GetFriendRequestsRequest struct {
 	// Page is the page number for pagination
 	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	// Limit is the number of requests per page
-	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Direction selects incoming, outgoing or all requests (defaults to incoming)
+	Direction     string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -149,6 +151,13 @@ func (x *GetFriendRequestsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetFriendRequestsRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
 // AcceptFriendRequestRequest is the request for accepting a friend request
 type AcceptFriendRequestRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -259,33 +268,29 @@ func (x *RejectFriendRequestRequest) GetUserId() string {
 	return ""
 }
 
-// GetFriendsRequest is the request for retrieving friends
-type GetFriendsRequest struct {
+// AcceptFriendRequestsRequest is the request for accepting several friend requests
+type AcceptFriendRequestsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Page is the page number for pagination
-	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	// Limit is the number of friends per page
-	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// RequestIds are the IDs of the friend requests
+	RequestIds    []string `protobuf:"bytes,1,rep,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFriendsRequest) Reset() {
-	*x = GetFriendsRequest{}
+func (x *AcceptFriendRequestsRequest) Reset() {
+	*x = AcceptFriendRequestsRequest{}
 	mi := &file_friends_friends_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendsRequest) String() string {
+func (x *AcceptFriendRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendsRequest) ProtoMessage() {}
+func (*AcceptFriendRequestsRequest) ProtoMessage() {}
 
-func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -297,57 +302,41 @@ func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendsRequest.ProtoReflect.Descriptor instead.
-func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetFriendsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetFriendsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetFriendsRequest) GetLimit() int32 {
+func (x *AcceptFriendRequestsRequest) GetRequestIds() []string {
 	if x != nil {
-		return x.Limit
+		return x.RequestIds
 	}
-	return 0
+	return nil
 }
 
-// RemoveFriendRequest is the request for removing a friend
-type RemoveFriendRequest struct {
+// RejectFriendRequestsRequest is the request for rejecting several friend requests
+type RejectFriendRequestsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// FriendId is the ID of the friend to remove
-	FriendId      string `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	// RequestIds are the IDs of the friend requests
+	RequestIds    []string `protobuf:"bytes,1,rep,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFriendRequest) Reset() {
-	*x = RemoveFriendRequest{}
+func (x *RejectFriendRequestsRequest) Reset() {
+	*x = RejectFriendRequestsRequest{}
 	mi := &file_friends_friends_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFriendRequest) String() string {
+func (x *RejectFriendRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFriendRequest) ProtoMessage() {}
+func (*RejectFriendRequestsRequest) ProtoMessage() {}
 
-func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+func (x *RejectFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -359,50 +348,39 @@ func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*RejectFriendRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RemoveFriendRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *RemoveFriendRequest) GetFriendId() string {
+func (x *RejectFriendRequestsRequest) GetRequestIds() []string {
 	if x != nil {
-		return x.FriendId
+		return x.RequestIds
 	}
-	return ""
+	return nil
 }
 
-// BlockUserRequest is the request for blocking a user
-type BlockUserRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// BlockedUserId is the ID of the user to block
-	BlockedUserId string `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+// AcceptAllFriendRequestsRequest is the request for accepting every pending friend request
+type AcceptAllFriendRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockUserRequest) Reset() {
-	*x = BlockUserRequest{}
+func (x *AcceptAllFriendRequestsRequest) Reset() {
+	*x = AcceptAllFriendRequestsRequest{}
 	mi := &file_friends_friends_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockUserRequest) String() string {
+func (x *AcceptAllFriendRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserRequest) ProtoMessage() {}
+func (*AcceptAllFriendRequestsRequest) ProtoMessage() {}
 
-func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptAllFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,50 +392,40 @@ func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
-func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptAllFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*AcceptAllFriendRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BlockUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *BlockUserRequest) GetBlockedUserId() string {
-	if x != nil {
-		return x.BlockedUserId
-	}
-	return ""
-}
-
-// UnblockUserRequest is the request for unblocking a user
-type UnblockUserRequest struct {
+// FriendRequestResult is the outcome of acting on one friend request of a batch
+type FriendRequestResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// BlockedUserId is the ID of the user to unblock
-	BlockedUserId string `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	// RequestId is the ID of the friend request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Success indicates if the action succeeded
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Error is why the action failed; empty on success
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Request is the updated friend request; only set on success
+	Request       *FriendRequestResponse `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnblockUserRequest) Reset() {
-	*x = UnblockUserRequest{}
+func (x *FriendRequestResult) Reset() {
+	*x = FriendRequestResult{}
 	mi := &file_friends_friends_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnblockUserRequest) String() string {
+func (x *FriendRequestResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserRequest) ProtoMessage() {}
+func (*FriendRequestResult) ProtoMessage() {}
 
-func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+func (x *FriendRequestResult) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -469,52 +437,66 @@ func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
-func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FriendRequestResult.ProtoReflect.Descriptor instead.
+func (*FriendRequestResult) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UnblockUserRequest) GetUserId() string {
+func (x *FriendRequestResult) GetRequestId() string {
 	if x != nil {
-		return x.UserId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *UnblockUserRequest) GetBlockedUserId() string {
+func (x *FriendRequestResult) GetSuccess() bool {
 	if x != nil {
-		return x.BlockedUserId
+		return x.Success
+	}
+	return false
+}
+
+func (x *FriendRequestResult) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-// GetBlockedUsersRequest is the request for retrieving blocked users
-type GetBlockedUsersRequest struct {
+func (x *FriendRequestResult) GetRequest() *FriendRequestResponse {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// BatchFriendRequestsResponse is the response containing the outcome for each friend request of a batch
+type BatchFriendRequestsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Page is the page number for pagination
-	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	// Limit is the number of blocked users per page
-	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Results are the outcomes, in the order the requests were given
+	Results []*FriendRequestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// SucceededCount is the number of requests the action succeeded for
+	SucceededCount int32 `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	// FailedCount is the number of requests the action failed for
+	FailedCount   int32 `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlockedUsersRequest) Reset() {
-	*x = GetBlockedUsersRequest{}
+func (x *BatchFriendRequestsResponse) Reset() {
+	*x = BatchFriendRequestsResponse{}
 	mi := &file_friends_friends_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlockedUsersRequest) String() string {
+func (x *BatchFriendRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockedUsersRequest) ProtoMessage() {}
+func (*BatchFriendRequestsResponse) ProtoMessage() {}
 
-func (x *GetBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchFriendRequestsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,57 +508,57 @@ func (x *GetBlockedUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockedUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockedUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchFriendRequestsResponse.ProtoReflect.Descriptor instead.
+func (*BatchFriendRequestsResponse) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetBlockedUsersRequest) GetUserId() string {
+func (x *BatchFriendRequestsResponse) GetResults() []*FriendRequestResult {
 	if x != nil {
-		return x.UserId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *GetBlockedUsersRequest) GetPage() int32 {
+func (x *BatchFriendRequestsResponse) GetSucceededCount() int32 {
 	if x != nil {
-		return x.Page
+		return x.SucceededCount
 	}
 	return 0
 }
 
-func (x *GetBlockedUsersRequest) GetLimit() int32 {
+func (x *BatchFriendRequestsResponse) GetFailedCount() int32 {
 	if x != nil {
-		return x.Limit
+		return x.FailedCount
 	}
 	return 0
 }
 
-// CheckFriendshipRequest is the request for checking if two users are friends
-type CheckFriendshipRequest struct {
+// CancelFriendRequestRequest is the request for canceling a sent friend request
+type CancelFriendRequestRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the first user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// FriendId is the ID of the second user
-	FriendId      string `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	// RequestId is the ID of the friend request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// UserId is the ID of the user who sent the request
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckFriendshipRequest) Reset() {
-	*x = CheckFriendshipRequest{}
+func (x *CancelFriendRequestRequest) Reset() {
+	*x = CancelFriendRequestRequest{}
 	mi := &file_friends_friends_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckFriendshipRequest) String() string {
+func (x *CancelFriendRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckFriendshipRequest) ProtoMessage() {}
+func (*CancelFriendRequestRequest) ProtoMessage() {}
 
-func (x *CheckFriendshipRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelFriendRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -588,66 +570,54 @@ func (x *CheckFriendshipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckFriendshipRequest.ProtoReflect.Descriptor instead.
-func (*CheckFriendshipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFriendRequestRequest) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CheckFriendshipRequest) GetUserId() string {
+func (x *CancelFriendRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.UserId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CheckFriendshipRequest) GetFriendId() string {
+func (x *CancelFriendRequestRequest) GetUserId() string {
 	if x != nil {
-		return x.FriendId
+		return x.UserId
 	}
 	return ""
 }
 
-// FriendRequestResponse is the response containing a friend request
-type FriendRequestResponse struct {
+// GetFriendsRequest is the request for retrieving friends
+type GetFriendsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// RequestId is the ID of the friend request
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// SenderId is the ID of the user who sent the request
-	SenderId string `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// SenderName is the name of the user who sent the request
-	SenderName string `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	// SenderAvatar is the avatar URL of the user who sent the request
-	SenderAvatar string `protobuf:"bytes,4,opt,name=sender_avatar,json=senderAvatar,proto3" json:"sender_avatar,omitempty"`
-	// ReceiverId is the ID of the user who received the request
-	ReceiverId string `protobuf:"bytes,5,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
-	// ReceiverName is the name of the user who received the request
-	ReceiverName string `protobuf:"bytes,6,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
-	// ReceiverAvatar is the avatar URL of the user who received the request
-	ReceiverAvatar string `protobuf:"bytes,7,opt,name=receiver_avatar,json=receiverAvatar,proto3" json:"receiver_avatar,omitempty"`
-	// Status is the status of the request (pending, accepted, rejected)
-	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	// CreatedAt is the timestamp when the request was created
-	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// UpdatedAt is the timestamp when the request was last updated
-	UpdatedAt     string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of friends per page
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Sort is the order of the friends: recent, oldest or name_asc (optional, storage order by default)
+	Sort          string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FriendRequestResponse) Reset() {
-	*x = FriendRequestResponse{}
+func (x *GetFriendsRequest) Reset() {
+	*x = GetFriendsRequest{}
 	mi := &file_friends_friends_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FriendRequestResponse) String() string {
+func (x *GetFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FriendRequestResponse) ProtoMessage() {}
+func (*GetFriendsRequest) ProtoMessage() {}
 
-func (x *FriendRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_friends_friends_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -659,111 +629,1398 @@ func (x *FriendRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FriendRequestResponse.ProtoReflect.Descriptor instead.
-func (*FriendRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_friends_friends_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FriendRequestResponse) GetRequestId() string {
+func (x *GetFriendsRequest) GetUserId() string {
 	if x != nil {
-		return x.RequestId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *FriendRequestResponse) GetSenderId() string {
+func (x *GetFriendsRequest) GetPage() int32 {
 	if x != nil {
-		return x.SenderId
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *FriendRequestResponse) GetSenderName() string {
+func (x *GetFriendsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.SenderName
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *FriendRequestResponse) GetSenderAvatar() string {
+func (x *GetFriendsRequest) GetSort() string {
 	if x != nil {
-		return x.SenderAvatar
+		return x.Sort
 	}
 	return ""
 }
 
-func (x *FriendRequestResponse) GetReceiverId() string {
-	if x != nil {
-		return x.ReceiverId
-	}
-	return ""
+// StreamFriendsRequest is the request for streaming all friends of a user
+type StreamFriendsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// PageSize is the number of friends per message (optional, 100 by default, at most 500)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Sort is the order of the friends: recent, oldest or name_asc (optional, storage order by default)
+	Sort          string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FriendRequestResponse) GetReceiverName() string {
-	if x != nil {
-		return x.ReceiverName
-	}
-	return ""
+func (x *StreamFriendsRequest) Reset() {
+	*x = StreamFriendsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FriendRequestResponse) GetReceiverAvatar() string {
-	if x != nil {
-		return x.ReceiverAvatar
-	}
-	return ""
+func (x *StreamFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FriendRequestResponse) GetStatus() string {
+func (*StreamFriendsRequest) ProtoMessage() {}
+
+func (x *StreamFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[11]
 	if x != nil {
-		return x.Status
-	}
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFriendsRequest.ProtoReflect.Descriptor instead.
+func (*StreamFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamFriendsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamFriendsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StreamFriendsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// GetFriendIDsRequest is the request for retrieving the IDs of the authenticated user's friends
+type GetFriendIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendIDsRequest) Reset() {
+	*x = GetFriendIDsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendIDsRequest) ProtoMessage() {}
+
+func (x *GetFriendIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendIDsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{12}
+}
+
+// GetMutualFriendsRequest is the request for retrieving friends in common with another user
+type GetMutualFriendsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// OtherUserId is the ID of the user to compare friends with
+	OtherUserId string `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of friends per page
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFriendsRequest) Reset() {
+	*x = GetMutualFriendsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFriendsRequest) ProtoMessage() {}
+
+func (x *GetMutualFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetMutualFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMutualFriendsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMutualFriendsRequest) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+func (x *GetMutualFriendsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMutualFriendsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// RemoveFriendRequest is the request for removing a friend
+type RemoveFriendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// FriendId is the ID of the friend to remove
+	FriendId      string `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	mi := &file_friends_friends_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequest) ProtoMessage() {}
+
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveFriendRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFriendRequest) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+// BlockUserRequest is the request for blocking a user
+type BlockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// BlockedUserId is the ID of the user to block
+	BlockedUserId string `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_friends_friends_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+// UnblockUserRequest is the request for unblocking a user
+type UnblockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// BlockedUserId is the ID of the user to unblock
+	BlockedUserId string `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_friends_friends_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnblockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+// GetBlockedUsersRequest is the request for retrieving blocked users
+type GetBlockedUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of blocked users per page
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedUsersRequest) Reset() {
+	*x = GetBlockedUsersRequest{}
+	mi := &file_friends_friends_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedUsersRequest) ProtoMessage() {}
+
+func (x *GetBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBlockedUsersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBlockedUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBlockedUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// CheckFriendshipRequest is the request for checking if two users are friends
+type CheckFriendshipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the first user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// FriendId is the ID of the second user
+	FriendId      string `protobuf:"bytes,2,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendshipRequest) Reset() {
+	*x = CheckFriendshipRequest{}
+	mi := &file_friends_friends_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendshipRequest) ProtoMessage() {}
+
+func (x *CheckFriendshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendshipRequest.ProtoReflect.Descriptor instead.
+func (*CheckFriendshipRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckFriendshipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckFriendshipRequest) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+// CheckFriendshipsRequest is the request for checking the relationship between a user and several others
+type CheckFriendshipsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// OtherUserIds are the IDs of the other users (at most 100)
+	OtherUserIds  []string `protobuf:"bytes,2,rep,name=other_user_ids,json=otherUserIds,proto3" json:"other_user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendshipsRequest) Reset() {
+	*x = CheckFriendshipsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendshipsRequest) ProtoMessage() {}
+
+func (x *CheckFriendshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendshipsRequest.ProtoReflect.Descriptor instead.
+func (*CheckFriendshipsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckFriendshipsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckFriendshipsRequest) GetOtherUserIds() []string {
+	if x != nil {
+		return x.OtherUserIds
+	}
+	return nil
+}
+
+// FriendRequestResponse is the response containing a friend request
+type FriendRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RequestId is the ID of the friend request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// SenderId is the ID of the user who sent the request
+	SenderId string `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// SenderName is the name of the user who sent the request
+	SenderName string `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	// SenderAvatar is the avatar URL of the user who sent the request
+	SenderAvatar string `protobuf:"bytes,4,opt,name=sender_avatar,json=senderAvatar,proto3" json:"sender_avatar,omitempty"`
+	// ReceiverId is the ID of the user who received the request
+	ReceiverId string `protobuf:"bytes,5,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	// ReceiverName is the name of the user who received the request
+	ReceiverName string `protobuf:"bytes,6,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
+	// ReceiverAvatar is the avatar URL of the user who received the request
+	ReceiverAvatar string `protobuf:"bytes,7,opt,name=receiver_avatar,json=receiverAvatar,proto3" json:"receiver_avatar,omitempty"`
+	// Status is the status of the request (pending, accepted, rejected)
+	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// CreatedAt is the timestamp when the request was created
+	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// UpdatedAt is the timestamp when the request was last updated
+	UpdatedAt string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Direction is incoming if the user received the request, outgoing if they sent it
+	Direction     string `protobuf:"bytes,11,opt,name=direction,proto3" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequestResponse) Reset() {
+	*x = FriendRequestResponse{}
+	mi := &file_friends_friends_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestResponse) ProtoMessage() {}
+
+func (x *FriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*FriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FriendRequestResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetSenderAvatar() string {
+	if x != nil {
+		return x.SenderAvatar
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetReceiverName() string {
+	if x != nil {
+		return x.ReceiverName
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetReceiverAvatar() string {
+	if x != nil {
+		return x.ReceiverAvatar
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *FriendRequestResponse) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+// GetFriendRequestsResponse is the response containing friend requests
+type GetFriendRequestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requests is an array of friend requests
+	Requests []*FriendRequestResponse `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// TotalCount is the total number of friend requests
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendRequestsResponse) Reset() {
+	*x = GetFriendRequestsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRequestsResponse) ProtoMessage() {}
+
+func (x *GetFriendRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetFriendRequestsResponse) GetRequests() []*FriendRequestResponse {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GetFriendRequestsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetFriendRequestsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFriendRequestsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// FriendResponse is the response containing a friend
+type FriendResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the friend
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Name is the name of the friend
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Avatar is the avatar URL of the friend
+	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// Email is the email of the friend
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// FriendsSince is the timestamp when the friendship was created
+	FriendsSince string `protobuf:"bytes,5,opt,name=friends_since,json=friendsSince,proto3" json:"friends_since,omitempty"`
+	// LastSeenAt is the timestamp when the friend was last active; empty if they never were
+	LastSeenAt string `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// IsOnline indicates if the friend is online
+	IsOnline      bool `protobuf:"varint,7,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendResponse) Reset() {
+	*x = FriendResponse{}
+	mi := &file_friends_friends_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendResponse) ProtoMessage() {}
+
+func (x *FriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendResponse.ProtoReflect.Descriptor instead.
+func (*FriendResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FriendResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FriendResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *FriendResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *FriendResponse) GetFriendsSince() string {
+	if x != nil {
+		return x.FriendsSince
+	}
+	return ""
+}
+
+func (x *FriendResponse) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+func (x *FriendResponse) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+// GetFriendsResponse is the response containing friends
+type GetFriendsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Friends is an array of friends
+	Friends []*FriendResponse `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	// TotalCount is the total number of friends
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendsResponse) Reset() {
+	*x = GetFriendsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendsResponse) ProtoMessage() {}
+
+func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendsResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetFriendsResponse) GetFriends() []*FriendResponse {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *GetFriendsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetFriendsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFriendsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// GetFriendIDsResponse is the response containing the IDs of a user's friends
+type GetFriendIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// FriendIds is an array of friend user IDs
+	FriendIds     []string `protobuf:"bytes,1,rep,name=friend_ids,json=friendIds,proto3" json:"friend_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendIDsResponse) Reset() {
+	*x = GetFriendIDsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendIDsResponse) ProtoMessage() {}
+
+func (x *GetFriendIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendIDsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFriendIDsResponse) GetFriendIds() []string {
+	if x != nil {
+		return x.FriendIds
+	}
+	return nil
+}
+
+// RemoveFriendResponse is the response for removing a friend
+type RemoveFriendResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the friend was successfully removed
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendResponse) Reset() {
+	*x = RemoveFriendResponse{}
+	mi := &file_friends_friends_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendResponse) ProtoMessage() {}
+
+func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveFriendResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// CancelFriendRequestResponse is the response for canceling a friend request
+type CancelFriendRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the friend request was successfully canceled
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFriendRequestResponse) Reset() {
+	*x = CancelFriendRequestResponse{}
+	mi := &file_friends_friends_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFriendRequestResponse) ProtoMessage() {}
+
+func (x *CancelFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelFriendRequestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// BlockUserResponse is the response for blocking a user
+type BlockUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the user was successfully blocked
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_friends_friends_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// UnblockUserResponse is the response for unblocking a user
+type UnblockUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the user was successfully unblocked
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_friends_friends_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnblockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// BlockedUserResponse is the response containing a blocked user
+type BlockedUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the blocked user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Name is the name of the blocked user
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Avatar is the avatar URL of the blocked user
+	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// BlockedAt is the timestamp when the user was blocked
+	BlockedAt     string `protobuf:"bytes,4,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUserResponse) Reset() {
+	*x = BlockedUserResponse{}
+	mi := &file_friends_friends_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUserResponse) ProtoMessage() {}
+
+func (x *BlockedUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockedUserResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BlockedUserResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUserResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlockedUserResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *BlockedUserResponse) GetBlockedAt() string {
+	if x != nil {
+		return x.BlockedAt
+	}
 	return ""
 }
 
-func (x *FriendRequestResponse) GetCreatedAt() string {
+// GetBlockedUsersResponse is the response containing blocked users
+type GetBlockedUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BlockedUsers is an array of blocked users
+	BlockedUsers []*BlockedUserResponse `protobuf:"bytes,1,rep,name=blocked_users,json=blockedUsers,proto3" json:"blocked_users,omitempty"`
+	// TotalCount is the total number of blocked users
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedUsersResponse) Reset() {
+	*x = GetBlockedUsersResponse{}
+	mi := &file_friends_friends_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedUsersResponse) ProtoMessage() {}
+
+func (x *GetBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetBlockedUsersResponse) GetBlockedUsers() []*BlockedUserResponse {
+	if x != nil {
+		return x.BlockedUsers
+	}
+	return nil
+}
+
+func (x *GetBlockedUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetBlockedUsersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBlockedUsersResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// CheckFriendshipResponse is the response for checking if two users are friends
+type CheckFriendshipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// AreFriends indicates if the users are friends
+	AreFriends bool `protobuf:"varint,1,opt,name=are_friends,json=areFriends,proto3" json:"are_friends,omitempty"`
+	// Status is the status of the friendship (none, pending, friends, blocked)
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// RequestId is the ID of the friend request if status is pending
+	RequestId     string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendshipResponse) Reset() {
+	*x = CheckFriendshipResponse{}
+	mi := &file_friends_friends_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendshipResponse) ProtoMessage() {}
+
+func (x *CheckFriendshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[31]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendshipResponse.ProtoReflect.Descriptor instead.
+func (*CheckFriendshipResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheckFriendshipResponse) GetAreFriends() bool {
+	if x != nil {
+		return x.AreFriends
+	}
+	return false
+}
+
+func (x *CheckFriendshipResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *FriendRequestResponse) GetUpdatedAt() string {
+func (x *CheckFriendshipResponse) GetRequestId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RequestId
 	}
 	return ""
 }
 
-// GetFriendRequestsResponse is the response containing friend requests
-type GetFriendRequestsResponse struct {
+// CheckFriendshipsResponse is the response for checking the relationship with several users
+type CheckFriendshipsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Requests is an array of friend requests
-	Requests []*FriendRequestResponse `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
-	// TotalCount is the total number of friend requests
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Page is the current page number
-	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	// TotalPages is the total number of pages
-	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Statuses maps each other user's ID to the status of the relationship (none, pending, friends, blocked or self)
+	Statuses      map[string]string `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFriendRequestsResponse) Reset() {
-	*x = GetFriendRequestsResponse{}
-	mi := &file_friends_friends_proto_msgTypes[11]
+func (x *CheckFriendshipsResponse) Reset() {
+	*x = CheckFriendshipsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendRequestsResponse) String() string {
+func (x *CheckFriendshipsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendRequestsResponse) ProtoMessage() {}
+func (*CheckFriendshipsResponse) ProtoMessage() {}
 
-func (x *GetFriendRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[11]
+func (x *CheckFriendshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,71 +2031,360 @@ func (x *GetFriendRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendRequestsResponse.ProtoReflect.Descriptor instead.
-func (*GetFriendRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CheckFriendshipsResponse.ProtoReflect.Descriptor instead.
+func (*CheckFriendshipsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetFriendRequestsResponse) GetRequests() []*FriendRequestResponse {
+func (x *CheckFriendshipsResponse) GetStatuses() map[string]string {
 	if x != nil {
-		return x.Requests
+		return x.Statuses
 	}
 	return nil
 }
 
-func (x *GetFriendRequestsResponse) GetTotalCount() int32 {
+// FollowRequest is the request for following a user
+type FollowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user to follow
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	mi := &file_friends_friends_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FollowRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// FollowResponse is the response for following a user
+type FollowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the user is now followed
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowResponse) Reset() {
+	*x = FollowResponse{}
+	mi := &file_friends_friends_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResponse) ProtoMessage() {}
+
+func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FollowResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// UnfollowRequest is the request for unfollowing a user
+type UnfollowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user to unfollow
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
+	mi := &file_friends_friends_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowRequest) ProtoMessage() {}
+
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UnfollowRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// UnfollowResponse is the response for unfollowing a user
+type UnfollowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the user is no longer followed
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowResponse) Reset() {
+	*x = UnfollowResponse{}
+	mi := &file_friends_friends_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowResponse) ProtoMessage() {}
+
+func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UnfollowResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetFollowersRequest is the request for retrieving a user's followers
+type GetFollowersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user whose followers to retrieve; the authenticated user when empty
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of followers per page
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersRequest) Reset() {
+	*x = GetFollowersRequest{}
+	mi := &file_friends_friends_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersRequest) ProtoMessage() {}
+
+func (x *GetFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetFollowersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetFollowersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFollowersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetFollowingRequest is the request for retrieving the users a user follows
+type GetFollowingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user whose followed users to retrieve; the authenticated user when empty
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of followed users per page
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingRequest) Reset() {
+	*x = GetFollowingRequest{}
+	mi := &file_friends_friends_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingRequest) ProtoMessage() {}
+
+func (x *GetFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetFollowingRequest) GetUserId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFriendRequestsResponse) GetPage() int32 {
+func (x *GetFollowingRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetFriendRequestsResponse) GetTotalPages() int32 {
+func (x *GetFollowingRequest) GetLimit() int32 {
 	if x != nil {
-		return x.TotalPages
+		return x.Limit
 	}
 	return 0
 }
 
-// FriendResponse is the response containing a friend
-type FriendResponse struct {
+// FollowUserResponse is a user on the other side of a follow
+type FollowUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the friend
+	// UserId is the ID of the user
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Name is the name of the friend
+	// Name is the name of the user
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Avatar is the avatar URL of the friend
+	// Avatar is the avatar URL of the user
 	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	// Email is the email of the friend
-	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	// FriendsSince is the timestamp when the friendship was created
-	FriendsSince  string `protobuf:"bytes,5,opt,name=friends_since,json=friendsSince,proto3" json:"friends_since,omitempty"`
+	// FollowedAt is the timestamp when the follow started
+	FollowedAt    string `protobuf:"bytes,4,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FriendResponse) Reset() {
-	*x = FriendResponse{}
-	mi := &file_friends_friends_proto_msgTypes[12]
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	mi := &file_friends_friends_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FriendResponse) String() string {
+func (x *FollowUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FriendResponse) ProtoMessage() {}
+func (*FollowUserResponse) ProtoMessage() {}
 
-func (x *FriendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[12]
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,52 +2395,45 @@ func (x *FriendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FriendResponse.ProtoReflect.Descriptor instead.
-func (*FriendResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FriendResponse) GetUserId() string {
+func (x *FollowUserResponse) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *FriendResponse) GetName() string {
+func (x *FollowUserResponse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *FriendResponse) GetAvatar() string {
+func (x *FollowUserResponse) GetAvatar() string {
 	if x != nil {
 		return x.Avatar
 	}
 	return ""
 }
 
-func (x *FriendResponse) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *FriendResponse) GetFriendsSince() string {
+func (x *FollowUserResponse) GetFollowedAt() string {
 	if x != nil {
-		return x.FriendsSince
+		return x.FollowedAt
 	}
 	return ""
 }
 
-// GetFriendsResponse is the response containing friends
-type GetFriendsResponse struct {
+// GetFollowsResponse is the response containing followers or followed users
+type GetFollowsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Friends is an array of friends
-	Friends []*FriendResponse `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
-	// TotalCount is the total number of friends
+	// Users is an array of followers or followed users, most recent first
+	Users []*FollowUserResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// TotalCount is the total number of users
 	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// Page is the current page number
 	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
@@ -904,21 +2443,21 @@ type GetFriendsResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFriendsResponse) Reset() {
-	*x = GetFriendsResponse{}
-	mi := &file_friends_friends_proto_msgTypes[13]
+func (x *GetFollowsResponse) Reset() {
+	*x = GetFollowsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendsResponse) String() string {
+func (x *GetFollowsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendsResponse) ProtoMessage() {}
+func (*GetFollowsResponse) ProtoMessage() {}
 
-func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[13]
+func (x *GetFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,63 +2468,63 @@ func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendsResponse.ProtoReflect.Descriptor instead.
-func (*GetFriendsResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetFollowsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetFriendsResponse) GetFriends() []*FriendResponse {
+func (x *GetFollowsResponse) GetUsers() []*FollowUserResponse {
 	if x != nil {
-		return x.Friends
+		return x.Users
 	}
 	return nil
 }
 
-func (x *GetFriendsResponse) GetTotalCount() int32 {
+func (x *GetFollowsResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *GetFriendsResponse) GetPage() int32 {
+func (x *GetFollowsResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetFriendsResponse) GetTotalPages() int32 {
+func (x *GetFollowsResponse) GetTotalPages() int32 {
 	if x != nil {
 		return x.TotalPages
 	}
 	return 0
 }
 
-// RemoveFriendResponse is the response for removing a friend
-type RemoveFriendResponse struct {
+// GetBlockedIDsRequest is the request for retrieving the IDs of the users blocked in either direction
+type GetBlockedIDsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the friend was successfully removed
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// UserId is the ID of the user
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFriendResponse) Reset() {
-	*x = RemoveFriendResponse{}
-	mi := &file_friends_friends_proto_msgTypes[14]
+func (x *GetBlockedIDsRequest) Reset() {
+	*x = GetBlockedIDsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFriendResponse) String() string {
+func (x *GetBlockedIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFriendResponse) ProtoMessage() {}
+func (*GetBlockedIDsRequest) ProtoMessage() {}
 
-func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[14]
+func (x *GetBlockedIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,42 +2535,42 @@ func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFriendResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetBlockedIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockedIDsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RemoveFriendResponse) GetSuccess() bool {
+func (x *GetBlockedIDsRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-// BlockUserResponse is the response for blocking a user
-type BlockUserResponse struct {
+// GetBlockedIDsResponse is the response containing the IDs of the users blocked in either direction
+type GetBlockedIDsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the user was successfully blocked
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// UserIds are the IDs of the users the user has blocked or been blocked by
+	UserIds       []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockUserResponse) Reset() {
-	*x = BlockUserResponse{}
-	mi := &file_friends_friends_proto_msgTypes[15]
+func (x *GetBlockedIDsResponse) Reset() {
+	*x = GetBlockedIDsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockUserResponse) String() string {
+func (x *GetBlockedIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserResponse) ProtoMessage() {}
+func (*GetBlockedIDsResponse) ProtoMessage() {}
 
-func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[15]
+func (x *GetBlockedIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,42 +2581,42 @@ func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
-func (*BlockUserResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetBlockedIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockedIDsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BlockUserResponse) GetSuccess() bool {
+func (x *GetBlockedIDsResponse) GetUserIds() []string {
 	if x != nil {
-		return x.Success
+		return x.UserIds
 	}
-	return false
+	return nil
 }
 
-// UnblockUserResponse is the response for unblocking a user
-type UnblockUserResponse struct {
+// GetFollowingIDsRequest is the request for retrieving the IDs of the users a user follows
+type GetFollowingIDsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the user was successfully unblocked
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// UserId is the ID of the follower
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnblockUserResponse) Reset() {
-	*x = UnblockUserResponse{}
-	mi := &file_friends_friends_proto_msgTypes[16]
+func (x *GetFollowingIDsRequest) Reset() {
+	*x = GetFollowingIDsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnblockUserResponse) String() string {
+func (x *GetFollowingIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserResponse) ProtoMessage() {}
+func (*GetFollowingIDsRequest) ProtoMessage() {}
 
-func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[16]
+func (x *GetFollowingIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,48 +2627,42 @@ func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
-func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetFollowingIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingIDsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UnblockUserResponse) GetSuccess() bool {
+func (x *GetFollowingIDsRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-// BlockedUserResponse is the response containing a blocked user
-type BlockedUserResponse struct {
+// GetFollowingIDsResponse is the response containing the IDs of the users a user follows
+type GetFollowingIDsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UserId is the ID of the blocked user
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Name is the name of the blocked user
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Avatar is the avatar URL of the blocked user
-	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	// BlockedAt is the timestamp when the user was blocked
-	BlockedAt     string `protobuf:"bytes,4,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	// UserIds are the IDs of the followed users
+	UserIds       []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockedUserResponse) Reset() {
-	*x = BlockedUserResponse{}
-	mi := &file_friends_friends_proto_msgTypes[17]
+func (x *GetFollowingIDsResponse) Reset() {
+	*x = GetFollowingIDsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockedUserResponse) String() string {
+func (x *GetFollowingIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockedUserResponse) ProtoMessage() {}
+func (*GetFollowingIDsResponse) ProtoMessage() {}
 
-func (x *BlockedUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[17]
+func (x *GetFollowingIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,69 +2673,44 @@ func (x *BlockedUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockedUserResponse.ProtoReflect.Descriptor instead.
-func (*BlockedUserResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *BlockedUserResponse) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *BlockedUserResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BlockedUserResponse) GetAvatar() string {
-	if x != nil {
-		return x.Avatar
-	}
-	return ""
+// Deprecated: Use GetFollowingIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowingIDsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *BlockedUserResponse) GetBlockedAt() string {
+func (x *GetFollowingIDsResponse) GetUserIds() []string {
 	if x != nil {
-		return x.BlockedAt
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-// GetBlockedUsersResponse is the response containing blocked users
-type GetBlockedUsersResponse struct {
+// GetFollowCountsRequest is the request for counting a user's follows
+type GetFollowCountsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// BlockedUsers is an array of blocked users
-	BlockedUsers []*BlockedUserResponse `protobuf:"bytes,1,rep,name=blocked_users,json=blockedUsers,proto3" json:"blocked_users,omitempty"`
-	// TotalCount is the total number of blocked users
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Page is the current page number
-	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	// TotalPages is the total number of pages
-	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// UserId is the ID of the user whose follows to count
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// ViewerId is the ID of the user looking at the counts, to report whether they follow the user (optional)
+	ViewerId      string `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlockedUsersResponse) Reset() {
-	*x = GetBlockedUsersResponse{}
-	mi := &file_friends_friends_proto_msgTypes[18]
+func (x *GetFollowCountsRequest) Reset() {
+	*x = GetFollowCountsRequest{}
+	mi := &file_friends_friends_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlockedUsersResponse) String() string {
+func (x *GetFollowCountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockedUsersResponse) ProtoMessage() {}
+func (*GetFollowCountsRequest) ProtoMessage() {}
 
-func (x *GetBlockedUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[18]
+func (x *GetFollowCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,67 +2721,53 @@ func (x *GetBlockedUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockedUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockedUsersResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GetBlockedUsersResponse) GetBlockedUsers() []*BlockedUserResponse {
-	if x != nil {
-		return x.BlockedUsers
-	}
-	return nil
-}
-
-func (x *GetBlockedUsersResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+// Deprecated: Use GetFollowCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowCountsRequest) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetBlockedUsersResponse) GetPage() int32 {
+func (x *GetFollowCountsRequest) GetUserId() string {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetBlockedUsersResponse) GetTotalPages() int32 {
+func (x *GetFollowCountsRequest) GetViewerId() string {
 	if x != nil {
-		return x.TotalPages
+		return x.ViewerId
 	}
-	return 0
+	return ""
 }
 
-// CheckFriendshipResponse is the response for checking if two users are friends
-type CheckFriendshipResponse struct {
+// GetFollowCountsResponse is the response containing a user's follow counts
+type GetFollowCountsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// AreFriends indicates if the users are friends
-	AreFriends bool `protobuf:"varint,1,opt,name=are_friends,json=areFriends,proto3" json:"are_friends,omitempty"`
-	// Status is the status of the friendship (none, pending, friends, blocked)
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// RequestId is the ID of the friend request if status is pending
-	RequestId     string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// FollowersCount is the number of users who follow the user
+	FollowersCount int32 `protobuf:"varint,1,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	// FollowingCount is the number of users the user follows
+	FollowingCount int32 `protobuf:"varint,2,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	// IsFollowing indicates if the viewer follows the user
+	IsFollowing   bool `protobuf:"varint,3,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckFriendshipResponse) Reset() {
-	*x = CheckFriendshipResponse{}
-	mi := &file_friends_friends_proto_msgTypes[19]
+func (x *GetFollowCountsResponse) Reset() {
+	*x = GetFollowCountsResponse{}
+	mi := &file_friends_friends_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckFriendshipResponse) String() string {
+func (x *GetFollowCountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckFriendshipResponse) ProtoMessage() {}
+func (*GetFollowCountsResponse) ProtoMessage() {}
 
-func (x *CheckFriendshipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_friends_friends_proto_msgTypes[19]
+func (x *GetFollowCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_friends_friends_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,30 +2778,30 @@ func (x *CheckFriendshipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckFriendshipResponse.ProtoReflect.Descriptor instead.
-func (*CheckFriendshipResponse) Descriptor() ([]byte, []int) {
-	return file_friends_friends_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetFollowCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowCountsResponse) Descriptor() ([]byte, []int) {
+	return file_friends_friends_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CheckFriendshipResponse) GetAreFriends() bool {
+func (x *GetFollowCountsResponse) GetFollowersCount() int32 {
 	if x != nil {
-		return x.AreFriends
+		return x.FollowersCount
 	}
-	return false
+	return 0
 }
 
-func (x *CheckFriendshipResponse) GetStatus() string {
+func (x *GetFollowCountsResponse) GetFollowingCount() int32 {
 	if x != nil {
-		return x.Status
+		return x.FollowingCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckFriendshipResponse) GetRequestId() string {
+func (x *GetFollowCountsResponse) GetIsFollowing() bool {
 	if x != nil {
-		return x.RequestId
+		return x.IsFollowing
 	}
-	return ""
+	return false
 }
 
 var File_friends_friends_proto protoreflect.FileDescriptor
@@ -1317,12 +2811,13 @@ const file_friends_friends_proto_rawDesc = "" +
 	"\x15friends/friends.proto\x12\afriends\"P\n" +
 	"\x18SendFriendRequestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"u\n" +
+	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"\x93\x01\n" +
 	"\x18GetFriendRequestsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"T\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\"T\n" +
 	"\x1aAcceptFriendRequestRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
@@ -1330,11 +2825,43 @@ const file_friends_friends_proto_rawDesc = "" +
 	"\x1aRejectFriendRequestRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"V\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x1bAcceptFriendRequestsRequest\x12\x1f\n" +
+	"\vrequest_ids\x18\x01 \x03(\tR\n" +
+	"requestIds\">\n" +
+	"\x1bRejectFriendRequestsRequest\x12\x1f\n" +
+	"\vrequest_ids\x18\x01 \x03(\tR\n" +
+	"requestIds\" \n" +
+	"\x1eAcceptAllFriendRequestsRequest\"\x9e\x01\n" +
+	"\x13FriendRequestResult\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x128\n" +
+	"\arequest\x18\x04 \x01(\v2\x1e.friends.FriendRequestResponseR\arequest\"\xa1\x01\n" +
+	"\x1bBatchFriendRequestsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.friends.FriendRequestResultR\aresults\x12'\n" +
+	"\x0fsucceeded_count\x18\x02 \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"T\n" +
+	"\x1aCancelFriendRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"j\n" +
 	"\x11GetFriendsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"K\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\"`\n" +
+	"\x14StreamFriendsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\"\x15\n" +
+	"\x13GetFriendIDsRequest\"\x80\x01\n" +
+	"\x17GetMutualFriendsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\rother_user_id\x18\x02 \x01(\tR\votherUserId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"K\n" +
 	"\x13RemoveFriendRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"S\n" +
@@ -1350,7 +2877,10 @@ const file_friends_friends_proto_rawDesc = "" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\"N\n" +
 	"\x16CheckFriendshipRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"\xde\x02\n" +
+	"\tfriend_id\x18\x02 \x01(\tR\bfriendId\"X\n" +
+	"\x17CheckFriendshipsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0eother_user_ids\x18\x02 \x03(\tR\fotherUserIds\"\xfc\x02\n" +
 	"\x15FriendRequestResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
@@ -1367,28 +2897,37 @@ const file_friends_friends_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"\xad\x01\n" +
+	" \x01(\tR\tupdatedAt\x12\x1c\n" +
+	"\tdirection\x18\v \x01(\tR\tdirection\"\xad\x01\n" +
 	"\x19GetFriendRequestsResponse\x12:\n" +
 	"\brequests\x18\x01 \x03(\v2\x1e.friends.FriendRequestResponseR\brequests\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\x90\x01\n" +
+	"totalPages\"\xcf\x01\n" +
 	"\x0eFriendResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12#\n" +
-	"\rfriends_since\x18\x05 \x01(\tR\ffriendsSince\"\x9d\x01\n" +
+	"\rfriends_since\x18\x05 \x01(\tR\ffriendsSince\x12 \n" +
+	"\flast_seen_at\x18\x06 \x01(\tR\n" +
+	"lastSeenAt\x12\x1b\n" +
+	"\tis_online\x18\a \x01(\bR\bisOnline\"\x9d\x01\n" +
 	"\x12GetFriendsResponse\x121\n" +
 	"\afriends\x18\x01 \x03(\v2\x17.friends.FriendResponseR\afriends\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"0\n" +
+	"totalPages\"5\n" +
+	"\x14GetFriendIDsResponse\x12\x1d\n" +
+	"\n" +
+	"friend_ids\x18\x01 \x03(\tR\tfriendIds\"0\n" +
 	"\x14RemoveFriendResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x1bCancelFriendRequestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
 	"\x11BlockUserResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
@@ -1412,19 +2951,83 @@ const file_friends_friends_proto_rawDesc = "" +
 	"areFriends\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x03 \x01(\tR\trequestId2\xc9\x06\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\xa4\x01\n" +
+	"\x18CheckFriendshipsResponse\x12K\n" +
+	"\bstatuses\x18\x01 \x03(\v2/.friends.CheckFriendshipsResponse.StatusesEntryR\bstatuses\x1a;\n" +
+	"\rStatusesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"(\n" +
+	"\rFollowRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"*\n" +
+	"\x0eFollowResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
+	"\x0fUnfollowRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x10UnfollowResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"X\n" +
+	"\x13GetFollowersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"X\n" +
+	"\x13GetFollowingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"z\n" +
+	"\x12FollowUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x1f\n" +
+	"\vfollowed_at\x18\x04 \x01(\tR\n" +
+	"followedAt\"\x9d\x01\n" +
+	"\x12GetFollowsResponse\x121\n" +
+	"\x05users\x18\x01 \x03(\v2\x1b.friends.FollowUserResponseR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"/\n" +
+	"\x14GetBlockedIDsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"2\n" +
+	"\x15GetBlockedIDsResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"1\n" +
+	"\x16GetFollowingIDsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x17GetFollowingIDsResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"N\n" +
+	"\x16GetFollowCountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"\x8e\x01\n" +
+	"\x17GetFollowCountsResponse\x12'\n" +
+	"\x0ffollowers_count\x18\x01 \x01(\x05R\x0efollowersCount\x12'\n" +
+	"\x0ffollowing_count\x18\x02 \x01(\x05R\x0efollowingCount\x12!\n" +
+	"\fis_following\x18\x03 \x01(\bR\visFollowing2\xb3\x10\n" +
 	"\rFriendService\x12V\n" +
 	"\x11SendFriendRequest\x12!.friends.SendFriendRequestRequest\x1a\x1e.friends.FriendRequestResponse\x12Z\n" +
 	"\x11GetFriendRequests\x12!.friends.GetFriendRequestsRequest\x1a\".friends.GetFriendRequestsResponse\x12Z\n" +
 	"\x13AcceptFriendRequest\x12#.friends.AcceptFriendRequestRequest\x1a\x1e.friends.FriendRequestResponse\x12Z\n" +
-	"\x13RejectFriendRequest\x12#.friends.RejectFriendRequestRequest\x1a\x1e.friends.FriendRequestResponse\x12E\n" +
+	"\x13RejectFriendRequest\x12#.friends.RejectFriendRequestRequest\x1a\x1e.friends.FriendRequestResponse\x12b\n" +
+	"\x14AcceptFriendRequests\x12$.friends.AcceptFriendRequestsRequest\x1a$.friends.BatchFriendRequestsResponse\x12b\n" +
+	"\x14RejectFriendRequests\x12$.friends.RejectFriendRequestsRequest\x1a$.friends.BatchFriendRequestsResponse\x12h\n" +
+	"\x17AcceptAllFriendRequests\x12'.friends.AcceptAllFriendRequestsRequest\x1a$.friends.BatchFriendRequestsResponse\x12`\n" +
+	"\x13CancelFriendRequest\x12#.friends.CancelFriendRequestRequest\x1a$.friends.CancelFriendRequestResponse\x12E\n" +
 	"\n" +
-	"GetFriends\x12\x1a.friends.GetFriendsRequest\x1a\x1b.friends.GetFriendsResponse\x12K\n" +
+	"GetFriends\x12\x1a.friends.GetFriendsRequest\x1a\x1b.friends.GetFriendsResponse\x12M\n" +
+	"\rStreamFriends\x12\x1d.friends.StreamFriendsRequest\x1a\x1b.friends.GetFriendsResponse0\x01\x12K\n" +
+	"\fGetFriendIDs\x12\x1c.friends.GetFriendIDsRequest\x1a\x1d.friends.GetFriendIDsResponse\x12Q\n" +
+	"\x10GetMutualFriends\x12 .friends.GetMutualFriendsRequest\x1a\x1b.friends.GetFriendsResponse\x12K\n" +
 	"\fRemoveFriend\x12\x1c.friends.RemoveFriendRequest\x1a\x1d.friends.RemoveFriendResponse\x12B\n" +
 	"\tBlockUser\x12\x19.friends.BlockUserRequest\x1a\x1a.friends.BlockUserResponse\x12H\n" +
 	"\vUnblockUser\x12\x1b.friends.UnblockUserRequest\x1a\x1c.friends.UnblockUserResponse\x12T\n" +
-	"\x0fGetBlockedUsers\x12\x1f.friends.GetBlockedUsersRequest\x1a .friends.GetBlockedUsersResponse\x12T\n" +
-	"\x0fCheckFriendship\x12\x1f.friends.CheckFriendshipRequest\x1a .friends.CheckFriendshipResponseB\x16Z\x14common/proto/friendsb\x06proto3"
+	"\x0fGetBlockedUsers\x12\x1f.friends.GetBlockedUsersRequest\x1a .friends.GetBlockedUsersResponse\x12N\n" +
+	"\rGetBlockedIDs\x12\x1d.friends.GetBlockedIDsRequest\x1a\x1e.friends.GetBlockedIDsResponse\x12T\n" +
+	"\x0fCheckFriendship\x12\x1f.friends.CheckFriendshipRequest\x1a .friends.CheckFriendshipResponse\x12W\n" +
+	"\x10CheckFriendships\x12 .friends.CheckFriendshipsRequest\x1a!.friends.CheckFriendshipsResponse\x129\n" +
+	"\x06Follow\x12\x16.friends.FollowRequest\x1a\x17.friends.FollowResponse\x12?\n" +
+	"\bUnfollow\x12\x18.friends.UnfollowRequest\x1a\x19.friends.UnfollowResponse\x12I\n" +
+	"\fGetFollowers\x12\x1c.friends.GetFollowersRequest\x1a\x1b.friends.GetFollowsResponse\x12I\n" +
+	"\fGetFollowing\x12\x1c.friends.GetFollowingRequest\x1a\x1b.friends.GetFollowsResponse\x12T\n" +
+	"\x0fGetFollowingIDs\x12\x1f.friends.GetFollowingIDsRequest\x1a .friends.GetFollowingIDsResponse\x12T\n" +
+	"\x0fGetFollowCounts\x12\x1f.friends.GetFollowCountsRequest\x1a .friends.GetFollowCountsResponseB\x16Z\x14common/proto/friendsb\x06proto3"
 
 var (
 	file_friends_friends_proto_rawDescOnce sync.Once
@@ -1438,58 +3041,120 @@ func file_friends_friends_proto_rawDescGZIP() []byte {
 	return file_friends_friends_proto_rawDescData
 }
 
-var file_friends_friends_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_friends_friends_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_friends_friends_proto_goTypes = []any{
-	(*SendFriendRequestRequest)(nil),   // 0: friends.SendFriendRequestRequest
-	(*GetFriendRequestsRequest)(nil),   // 1: friends.GetFriendRequestsRequest
-	(*AcceptFriendRequestRequest)(nil), // 2: friends.AcceptFriendRequestRequest
-	(*RejectFriendRequestRequest)(nil), // 3: friends.RejectFriendRequestRequest
-	(*GetFriendsRequest)(nil),          // 4: friends.GetFriendsRequest
-	(*RemoveFriendRequest)(nil),        // 5: friends.RemoveFriendRequest
-	(*BlockUserRequest)(nil),           // 6: friends.BlockUserRequest
-	(*UnblockUserRequest)(nil),         // 7: friends.UnblockUserRequest
-	(*GetBlockedUsersRequest)(nil),     // 8: friends.GetBlockedUsersRequest
-	(*CheckFriendshipRequest)(nil),     // 9: friends.CheckFriendshipRequest
-	(*FriendRequestResponse)(nil),      // 10: friends.FriendRequestResponse
-	(*GetFriendRequestsResponse)(nil),  // 11: friends.GetFriendRequestsResponse
-	(*FriendResponse)(nil),             // 12: friends.FriendResponse
-	(*GetFriendsResponse)(nil),         // 13: friends.GetFriendsResponse
-	(*RemoveFriendResponse)(nil),       // 14: friends.RemoveFriendResponse
-	(*BlockUserResponse)(nil),          // 15: friends.BlockUserResponse
-	(*UnblockUserResponse)(nil),        // 16: friends.UnblockUserResponse
-	(*BlockedUserResponse)(nil),        // 17: friends.BlockedUserResponse
-	(*GetBlockedUsersResponse)(nil),    // 18: friends.GetBlockedUsersResponse
-	(*CheckFriendshipResponse)(nil),    // 19: friends.CheckFriendshipResponse
+	(*SendFriendRequestRequest)(nil),       // 0: friends.SendFriendRequestRequest
+	(*GetFriendRequestsRequest)(nil),       // 1: friends.GetFriendRequestsRequest
+	(*AcceptFriendRequestRequest)(nil),     // 2: friends.AcceptFriendRequestRequest
+	(*RejectFriendRequestRequest)(nil),     // 3: friends.RejectFriendRequestRequest
+	(*AcceptFriendRequestsRequest)(nil),    // 4: friends.AcceptFriendRequestsRequest
+	(*RejectFriendRequestsRequest)(nil),    // 5: friends.RejectFriendRequestsRequest
+	(*AcceptAllFriendRequestsRequest)(nil), // 6: friends.AcceptAllFriendRequestsRequest
+	(*FriendRequestResult)(nil),            // 7: friends.FriendRequestResult
+	(*BatchFriendRequestsResponse)(nil),    // 8: friends.BatchFriendRequestsResponse
+	(*CancelFriendRequestRequest)(nil),     // 9: friends.CancelFriendRequestRequest
+	(*GetFriendsRequest)(nil),              // 10: friends.GetFriendsRequest
+	(*StreamFriendsRequest)(nil),           // 11: friends.StreamFriendsRequest
+	(*GetFriendIDsRequest)(nil),            // 12: friends.GetFriendIDsRequest
+	(*GetMutualFriendsRequest)(nil),        // 13: friends.GetMutualFriendsRequest
+	(*RemoveFriendRequest)(nil),            // 14: friends.RemoveFriendRequest
+	(*BlockUserRequest)(nil),               // 15: friends.BlockUserRequest
+	(*UnblockUserRequest)(nil),             // 16: friends.UnblockUserRequest
+	(*GetBlockedUsersRequest)(nil),         // 17: friends.GetBlockedUsersRequest
+	(*CheckFriendshipRequest)(nil),         // 18: friends.CheckFriendshipRequest
+	(*CheckFriendshipsRequest)(nil),        // 19: friends.CheckFriendshipsRequest
+	(*FriendRequestResponse)(nil),          // 20: friends.FriendRequestResponse
+	(*GetFriendRequestsResponse)(nil),      // 21: friends.GetFriendRequestsResponse
+	(*FriendResponse)(nil),                 // 22: friends.FriendResponse
+	(*GetFriendsResponse)(nil),             // 23: friends.GetFriendsResponse
+	(*GetFriendIDsResponse)(nil),           // 24: friends.GetFriendIDsResponse
+	(*RemoveFriendResponse)(nil),           // 25: friends.RemoveFriendResponse
+	(*CancelFriendRequestResponse)(nil),    // 26: friends.CancelFriendRequestResponse
+	(*BlockUserResponse)(nil),              // 27: friends.BlockUserResponse
+	(*UnblockUserResponse)(nil),            // 28: friends.UnblockUserResponse
+	(*BlockedUserResponse)(nil),            // 29: friends.BlockedUserResponse
+	(*GetBlockedUsersResponse)(nil),        // 30: friends.GetBlockedUsersResponse
+	(*CheckFriendshipResponse)(nil),        // 31: friends.CheckFriendshipResponse
+	(*CheckFriendshipsResponse)(nil),       // 32: friends.CheckFriendshipsResponse
+	(*FollowRequest)(nil),                  // 33: friends.FollowRequest
+	(*FollowResponse)(nil),                 // 34: friends.FollowResponse
+	(*UnfollowRequest)(nil),                // 35: friends.UnfollowRequest
+	(*UnfollowResponse)(nil),               // 36: friends.UnfollowResponse
+	(*GetFollowersRequest)(nil),            // 37: friends.GetFollowersRequest
+	(*GetFollowingRequest)(nil),            // 38: friends.GetFollowingRequest
+	(*FollowUserResponse)(nil),             // 39: friends.FollowUserResponse
+	(*GetFollowsResponse)(nil),             // 40: friends.GetFollowsResponse
+	(*GetBlockedIDsRequest)(nil),           // 41: friends.GetBlockedIDsRequest
+	(*GetBlockedIDsResponse)(nil),          // 42: friends.GetBlockedIDsResponse
+	(*GetFollowingIDsRequest)(nil),         // 43: friends.GetFollowingIDsRequest
+	(*GetFollowingIDsResponse)(nil),        // 44: friends.GetFollowingIDsResponse
+	(*GetFollowCountsRequest)(nil),         // 45: friends.GetFollowCountsRequest
+	(*GetFollowCountsResponse)(nil),        // 46: friends.GetFollowCountsResponse
+	nil,                                    // 47: friends.CheckFriendshipsResponse.StatusesEntry
 }
 var file_friends_friends_proto_depIdxs = []int32{
-	10, // 0: friends.GetFriendRequestsResponse.requests:type_name -> friends.FriendRequestResponse
-	12, // 1: friends.GetFriendsResponse.friends:type_name -> friends.FriendResponse
-	17, // 2: friends.GetBlockedUsersResponse.blocked_users:type_name -> friends.BlockedUserResponse
-	0,  // 3: friends.FriendService.SendFriendRequest:input_type -> friends.SendFriendRequestRequest
-	1,  // 4: friends.FriendService.GetFriendRequests:input_type -> friends.GetFriendRequestsRequest
-	2,  // 5: friends.FriendService.AcceptFriendRequest:input_type -> friends.AcceptFriendRequestRequest
-	3,  // 6: friends.FriendService.RejectFriendRequest:input_type -> friends.RejectFriendRequestRequest
-	4,  // 7: friends.FriendService.GetFriends:input_type -> friends.GetFriendsRequest
-	5,  // 8: friends.FriendService.RemoveFriend:input_type -> friends.RemoveFriendRequest
-	6,  // 9: friends.FriendService.BlockUser:input_type -> friends.BlockUserRequest
-	7,  // 10: friends.FriendService.UnblockUser:input_type -> friends.UnblockUserRequest
-	8,  // 11: friends.FriendService.GetBlockedUsers:input_type -> friends.GetBlockedUsersRequest
-	9,  // 12: friends.FriendService.CheckFriendship:input_type -> friends.CheckFriendshipRequest
-	10, // 13: friends.FriendService.SendFriendRequest:output_type -> friends.FriendRequestResponse
-	11, // 14: friends.FriendService.GetFriendRequests:output_type -> friends.GetFriendRequestsResponse
-	10, // 15: friends.FriendService.AcceptFriendRequest:output_type -> friends.FriendRequestResponse
-	10, // 16: friends.FriendService.RejectFriendRequest:output_type -> friends.FriendRequestResponse
-	13, // 17: friends.FriendService.GetFriends:output_type -> friends.GetFriendsResponse
-	14, // 18: friends.FriendService.RemoveFriend:output_type -> friends.RemoveFriendResponse
-	15, // 19: friends.FriendService.BlockUser:output_type -> friends.BlockUserResponse
-	16, // 20: friends.FriendService.UnblockUser:output_type -> friends.UnblockUserResponse
-	18, // 21: friends.FriendService.GetBlockedUsers:output_type -> friends.GetBlockedUsersResponse
-	19, // 22: friends.FriendService.CheckFriendship:output_type -> friends.CheckFriendshipResponse
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	20, // 0: friends.FriendRequestResult.request:type_name -> friends.FriendRequestResponse
+	7,  // 1: friends.BatchFriendRequestsResponse.results:type_name -> friends.FriendRequestResult
+	20, // 2: friends.GetFriendRequestsResponse.requests:type_name -> friends.FriendRequestResponse
+	22, // 3: friends.GetFriendsResponse.friends:type_name -> friends.FriendResponse
+	29, // 4: friends.GetBlockedUsersResponse.blocked_users:type_name -> friends.BlockedUserResponse
+	47, // 5: friends.CheckFriendshipsResponse.statuses:type_name -> friends.CheckFriendshipsResponse.StatusesEntry
+	39, // 6: friends.GetFollowsResponse.users:type_name -> friends.FollowUserResponse
+	0,  // 7: friends.FriendService.SendFriendRequest:input_type -> friends.SendFriendRequestRequest
+	1,  // 8: friends.FriendService.GetFriendRequests:input_type -> friends.GetFriendRequestsRequest
+	2,  // 9: friends.FriendService.AcceptFriendRequest:input_type -> friends.AcceptFriendRequestRequest
+	3,  // 10: friends.FriendService.RejectFriendRequest:input_type -> friends.RejectFriendRequestRequest
+	4,  // 11: friends.FriendService.AcceptFriendRequests:input_type -> friends.AcceptFriendRequestsRequest
+	5,  // 12: friends.FriendService.RejectFriendRequests:input_type -> friends.RejectFriendRequestsRequest
+	6,  // 13: friends.FriendService.AcceptAllFriendRequests:input_type -> friends.AcceptAllFriendRequestsRequest
+	9,  // 14: friends.FriendService.CancelFriendRequest:input_type -> friends.CancelFriendRequestRequest
+	10, // 15: friends.FriendService.GetFriends:input_type -> friends.GetFriendsRequest
+	11, // 16: friends.FriendService.StreamFriends:input_type -> friends.StreamFriendsRequest
+	12, // 17: friends.FriendService.GetFriendIDs:input_type -> friends.GetFriendIDsRequest
+	13, // 18: friends.FriendService.GetMutualFriends:input_type -> friends.GetMutualFriendsRequest
+	14, // 19: friends.FriendService.RemoveFriend:input_type -> friends.RemoveFriendRequest
+	15, // 20: friends.FriendService.BlockUser:input_type -> friends.BlockUserRequest
+	16, // 21: friends.FriendService.UnblockUser:input_type -> friends.UnblockUserRequest
+	17, // 22: friends.FriendService.GetBlockedUsers:input_type -> friends.GetBlockedUsersRequest
+	41, // 23: friends.FriendService.GetBlockedIDs:input_type -> friends.GetBlockedIDsRequest
+	18, // 24: friends.FriendService.CheckFriendship:input_type -> friends.CheckFriendshipRequest
+	19, // 25: friends.FriendService.CheckFriendships:input_type -> friends.CheckFriendshipsRequest
+	33, // 26: friends.FriendService.Follow:input_type -> friends.FollowRequest
+	35, // 27: friends.FriendService.Unfollow:input_type -> friends.UnfollowRequest
+	37, // 28: friends.FriendService.GetFollowers:input_type -> friends.GetFollowersRequest
+	38, // 29: friends.FriendService.GetFollowing:input_type -> friends.GetFollowingRequest
+	43, // 30: friends.FriendService.GetFollowingIDs:input_type -> friends.GetFollowingIDsRequest
+	45, // 31: friends.FriendService.GetFollowCounts:input_type -> friends.GetFollowCountsRequest
+	20, // 32: friends.FriendService.SendFriendRequest:output_type -> friends.FriendRequestResponse
+	21, // 33: friends.FriendService.GetFriendRequests:output_type -> friends.GetFriendRequestsResponse
+	20, // 34: friends.FriendService.AcceptFriendRequest:output_type -> friends.FriendRequestResponse
+	20, // 35: friends.FriendService.RejectFriendRequest:output_type -> friends.FriendRequestResponse
+	8,  // 36: friends.FriendService.AcceptFriendRequests:output_type -> friends.BatchFriendRequestsResponse
+	8,  // 37: friends.FriendService.RejectFriendRequests:output_type -> friends.BatchFriendRequestsResponse
+	8,  // 38: friends.FriendService.AcceptAllFriendRequests:output_type -> friends.BatchFriendRequestsResponse
+	26, // 39: friends.FriendService.CancelFriendRequest:output_type -> friends.CancelFriendRequestResponse
+	23, // 40: friends.FriendService.GetFriends:output_type -> friends.GetFriendsResponse
+	23, // 41: friends.FriendService.StreamFriends:output_type -> friends.GetFriendsResponse
+	24, // 42: friends.FriendService.GetFriendIDs:output_type -> friends.GetFriendIDsResponse
+	23, // 43: friends.FriendService.GetMutualFriends:output_type -> friends.GetFriendsResponse
+	25, // 44: friends.FriendService.RemoveFriend:output_type -> friends.RemoveFriendResponse
+	27, // 45: friends.FriendService.BlockUser:output_type -> friends.BlockUserResponse
+	28, // 46: friends.FriendService.UnblockUser:output_type -> friends.UnblockUserResponse
+	30, // 47: friends.FriendService.GetBlockedUsers:output_type -> friends.GetBlockedUsersResponse
+	42, // 48: friends.FriendService.GetBlockedIDs:output_type -> friends.GetBlockedIDsResponse
+	31, // 49: friends.FriendService.CheckFriendship:output_type -> friends.CheckFriendshipResponse
+	32, // 50: friends.FriendService.CheckFriendships:output_type -> friends.CheckFriendshipsResponse
+	34, // 51: friends.FriendService.Follow:output_type -> friends.FollowResponse
+	36, // 52: friends.FriendService.Unfollow:output_type -> friends.UnfollowResponse
+	40, // 53: friends.FriendService.GetFollowers:output_type -> friends.GetFollowsResponse
+	40, // 54: friends.FriendService.GetFollowing:output_type -> friends.GetFollowsResponse
+	44, // 55: friends.FriendService.GetFollowingIDs:output_type -> friends.GetFollowingIDsResponse
+	46, // 56: friends.FriendService.GetFollowCounts:output_type -> friends.GetFollowCountsResponse
+	32, // [32:57] is the sub-list for method output_type
+	7,  // [7:32] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_friends_friends_proto_init() }
@@ -1503,7 +3168,7 @@ func file_friends_friends_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_friends_friends_proto_rawDesc), len(file_friends_friends_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
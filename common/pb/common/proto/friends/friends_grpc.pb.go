@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FriendService_SendFriendRequest_FullMethodName   = "/friends.FriendService/SendFriendRequest"
-	FriendService_GetFriendRequests_FullMethodName   = "/friends.FriendService/GetFriendRequests"
-	FriendService_AcceptFriendRequest_FullMethodName = "/friends.FriendService/AcceptFriendRequest"
-	FriendService_RejectFriendRequest_FullMethodName = "/friends.FriendService/RejectFriendRequest"
-	FriendService_GetFriends_FullMethodName          = "/friends.FriendService/GetFriends"
-	FriendService_RemoveFriend_FullMethodName        = "/friends.FriendService/RemoveFriend"
-	FriendService_BlockUser_FullMethodName           = "/friends.FriendService/BlockUser"
-	FriendService_UnblockUser_FullMethodName         = "/friends.FriendService/UnblockUser"
-	FriendService_GetBlockedUsers_FullMethodName     = "/friends.FriendService/GetBlockedUsers"
-	FriendService_CheckFriendship_FullMethodName     = "/friends.FriendService/CheckFriendship"
+	FriendService_SendFriendRequest_FullMethodName       = "/friends.FriendService/SendFriendRequest"
+	FriendService_GetFriendRequests_FullMethodName       = "/friends.FriendService/GetFriendRequests"
+	FriendService_AcceptFriendRequest_FullMethodName     = "/friends.FriendService/AcceptFriendRequest"
+	FriendService_RejectFriendRequest_FullMethodName     = "/friends.FriendService/RejectFriendRequest"
+	FriendService_AcceptFriendRequests_FullMethodName    = "/friends.FriendService/AcceptFriendRequests"
+	FriendService_RejectFriendRequests_FullMethodName    = "/friends.FriendService/RejectFriendRequests"
+	FriendService_AcceptAllFriendRequests_FullMethodName = "/friends.FriendService/AcceptAllFriendRequests"
+	FriendService_CancelFriendRequest_FullMethodName     = "/friends.FriendService/CancelFriendRequest"
+	FriendService_GetFriends_FullMethodName              = "/friends.FriendService/GetFriends"
+	FriendService_StreamFriends_FullMethodName           = "/friends.FriendService/StreamFriends"
+	FriendService_GetFriendIDs_FullMethodName            = "/friends.FriendService/GetFriendIDs"
+	FriendService_GetMutualFriends_FullMethodName        = "/friends.FriendService/GetMutualFriends"
+	FriendService_RemoveFriend_FullMethodName            = "/friends.FriendService/RemoveFriend"
+	FriendService_BlockUser_FullMethodName               = "/friends.FriendService/BlockUser"
+	FriendService_UnblockUser_FullMethodName             = "/friends.FriendService/UnblockUser"
+	FriendService_GetBlockedUsers_FullMethodName         = "/friends.FriendService/GetBlockedUsers"
+	FriendService_GetBlockedIDs_FullMethodName           = "/friends.FriendService/GetBlockedIDs"
+	FriendService_CheckFriendship_FullMethodName         = "/friends.FriendService/CheckFriendship"
+	FriendService_CheckFriendships_FullMethodName        = "/friends.FriendService/CheckFriendships"
+	FriendService_Follow_FullMethodName                  = "/friends.FriendService/Follow"
+	FriendService_Unfollow_FullMethodName                = "/friends.FriendService/Unfollow"
+	FriendService_GetFollowers_FullMethodName            = "/friends.FriendService/GetFollowers"
+	FriendService_GetFollowing_FullMethodName            = "/friends.FriendService/GetFollowing"
+	FriendService_GetFollowingIDs_FullMethodName         = "/friends.FriendService/GetFollowingIDs"
+	FriendService_GetFollowCounts_FullMethodName         = "/friends.FriendService/GetFollowCounts"
 )
 
 // FriendServiceClient is the client API for FriendService service.
@@ -45,8 +60,22 @@ type FriendServiceClient interface {
 	AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error)
 	// RejectFriendRequest rejects a friend request
 	RejectFriendRequest(ctx context.Context, in *RejectFriendRequestRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error)
+	// AcceptFriendRequests accepts several friend requests received by the user, each on its own
+	AcceptFriendRequests(ctx context.Context, in *AcceptFriendRequestsRequest, opts ...grpc.CallOption) (*BatchFriendRequestsResponse, error)
+	// RejectFriendRequests rejects several friend requests received by the user, each on its own
+	RejectFriendRequests(ctx context.Context, in *RejectFriendRequestsRequest, opts ...grpc.CallOption) (*BatchFriendRequestsResponse, error)
+	// AcceptAllFriendRequests accepts every pending friend request received by the user
+	AcceptAllFriendRequests(ctx context.Context, in *AcceptAllFriendRequestsRequest, opts ...grpc.CallOption) (*BatchFriendRequestsResponse, error)
+	// CancelFriendRequest withdraws a pending friend request sent by the user
+	CancelFriendRequest(ctx context.Context, in *CancelFriendRequestRequest, opts ...grpc.CallOption) (*CancelFriendRequestResponse, error)
 	// GetFriends retrieves friends for a user
 	GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error)
+	// StreamFriends streams all friends of a user, one page per message, for exports
+	StreamFriends(ctx context.Context, in *StreamFriendsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFriendsResponse], error)
+	// GetFriendIDs retrieves the IDs of all of the user's friends
+	GetFriendIDs(ctx context.Context, in *GetFriendIDsRequest, opts ...grpc.CallOption) (*GetFriendIDsResponse, error)
+	// GetMutualFriends retrieves the friends two users have in common
+	GetMutualFriends(ctx context.Context, in *GetMutualFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error)
 	// RemoveFriend removes a friend
 	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error)
 	// BlockUser blocks a user
@@ -55,8 +84,24 @@ type FriendServiceClient interface {
 	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
 	// GetBlockedUsers retrieves blocked users for a user
 	GetBlockedUsers(ctx context.Context, in *GetBlockedUsersRequest, opts ...grpc.CallOption) (*GetBlockedUsersResponse, error)
+	// GetBlockedIDs retrieves the IDs of the users a user has blocked or been blocked by
+	GetBlockedIDs(ctx context.Context, in *GetBlockedIDsRequest, opts ...grpc.CallOption) (*GetBlockedIDsResponse, error)
 	// CheckFriendship checks if two users are friends
 	CheckFriendship(ctx context.Context, in *CheckFriendshipRequest, opts ...grpc.CallOption) (*CheckFriendshipResponse, error)
+	// CheckFriendships checks the relationship between a user and several other users at once
+	CheckFriendships(ctx context.Context, in *CheckFriendshipsRequest, opts ...grpc.CallOption) (*CheckFriendshipsResponse, error)
+	// Follow makes the user follow another user; unlike a friendship it needs no acceptance
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	// Unfollow stops the user following another user
+	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)
+	// GetFollowers retrieves the users who follow a user
+	GetFollowers(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowsResponse, error)
+	// GetFollowing retrieves the users a user follows
+	GetFollowing(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*GetFollowsResponse, error)
+	// GetFollowingIDs retrieves the IDs of all the users a user follows
+	GetFollowingIDs(ctx context.Context, in *GetFollowingIDsRequest, opts ...grpc.CallOption) (*GetFollowingIDsResponse, error)
+	// GetFollowCounts counts a user's followers and followed users
+	GetFollowCounts(ctx context.Context, in *GetFollowCountsRequest, opts ...grpc.CallOption) (*GetFollowCountsResponse, error)
 }
 
 type friendServiceClient struct {
@@ -107,6 +152,46 @@ func (c *friendServiceClient) RejectFriendRequest(ctx context.Context, in *Rejec
 	return out, nil
 }
 
+func (c *friendServiceClient) AcceptFriendRequests(ctx context.Context, in *AcceptFriendRequestsRequest, opts ...grpc.CallOption) (*BatchFriendRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, FriendService_AcceptFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) RejectFriendRequests(ctx context.Context, in *RejectFriendRequestsRequest, opts ...grpc.CallOption) (*BatchFriendRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, FriendService_RejectFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) AcceptAllFriendRequests(ctx context.Context, in *AcceptAllFriendRequestsRequest, opts ...grpc.CallOption) (*BatchFriendRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, FriendService_AcceptAllFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) CancelFriendRequest(ctx context.Context, in *CancelFriendRequestRequest, opts ...grpc.CallOption) (*CancelFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFriendRequestResponse)
+	err := c.cc.Invoke(ctx, FriendService_CancelFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *friendServiceClient) GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFriendsResponse)
@@ -117,6 +202,45 @@ func (c *friendServiceClient) GetFriends(ctx context.Context, in *GetFriendsRequ
 	return out, nil
 }
 
+func (c *friendServiceClient) StreamFriends(ctx context.Context, in *StreamFriendsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFriendsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FriendService_ServiceDesc.Streams[0], FriendService_StreamFriends_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamFriendsRequest, GetFriendsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FriendService_StreamFriendsClient = grpc.ServerStreamingClient[GetFriendsResponse]
+
+func (c *friendServiceClient) GetFriendIDs(ctx context.Context, in *GetFriendIDsRequest, opts ...grpc.CallOption) (*GetFriendIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendIDsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFriendIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) GetMutualFriends(ctx context.Context, in *GetMutualFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetMutualFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *friendServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveFriendResponse)
@@ -157,6 +281,16 @@ func (c *friendServiceClient) GetBlockedUsers(ctx context.Context, in *GetBlocke
 	return out, nil
 }
 
+func (c *friendServiceClient) GetBlockedIDs(ctx context.Context, in *GetBlockedIDsRequest, opts ...grpc.CallOption) (*GetBlockedIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockedIDsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetBlockedIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *friendServiceClient) CheckFriendship(ctx context.Context, in *CheckFriendshipRequest, opts ...grpc.CallOption) (*CheckFriendshipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckFriendshipResponse)
@@ -167,6 +301,76 @@ func (c *friendServiceClient) CheckFriendship(ctx context.Context, in *CheckFrie
 	return out, nil
 }
 
+func (c *friendServiceClient) CheckFriendships(ctx context.Context, in *CheckFriendshipsRequest, opts ...grpc.CallOption) (*CheckFriendshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckFriendshipsResponse)
+	err := c.cc.Invoke(ctx, FriendService_CheckFriendships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, FriendService_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowResponse)
+	err := c.cc.Invoke(ctx, FriendService_Unfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) GetFollowers(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) GetFollowing(ctx context.Context, in *GetFollowingRequest, opts ...grpc.CallOption) (*GetFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) GetFollowingIDs(ctx context.Context, in *GetFollowingIDsRequest, opts ...grpc.CallOption) (*GetFollowingIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowingIDsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFollowingIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) GetFollowCounts(ctx context.Context, in *GetFollowCountsRequest, opts ...grpc.CallOption) (*GetFollowCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowCountsResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFollowCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServiceServer is the server API for FriendService service.
 // All implementations must embed UnimplementedFriendServiceServer
 // for forward compatibility.
@@ -181,8 +385,22 @@ type FriendServiceServer interface {
 	AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*FriendRequestResponse, error)
 	// RejectFriendRequest rejects a friend request
 	RejectFriendRequest(context.Context, *RejectFriendRequestRequest) (*FriendRequestResponse, error)
+	// AcceptFriendRequests accepts several friend requests received by the user, each on its own
+	AcceptFriendRequests(context.Context, *AcceptFriendRequestsRequest) (*BatchFriendRequestsResponse, error)
+	// RejectFriendRequests rejects several friend requests received by the user, each on its own
+	RejectFriendRequests(context.Context, *RejectFriendRequestsRequest) (*BatchFriendRequestsResponse, error)
+	// AcceptAllFriendRequests accepts every pending friend request received by the user
+	AcceptAllFriendRequests(context.Context, *AcceptAllFriendRequestsRequest) (*BatchFriendRequestsResponse, error)
+	// CancelFriendRequest withdraws a pending friend request sent by the user
+	CancelFriendRequest(context.Context, *CancelFriendRequestRequest) (*CancelFriendRequestResponse, error)
 	// GetFriends retrieves friends for a user
 	GetFriends(context.Context, *GetFriendsRequest) (*GetFriendsResponse, error)
+	// StreamFriends streams all friends of a user, one page per message, for exports
+	StreamFriends(*StreamFriendsRequest, grpc.ServerStreamingServer[GetFriendsResponse]) error
+	// GetFriendIDs retrieves the IDs of all of the user's friends
+	GetFriendIDs(context.Context, *GetFriendIDsRequest) (*GetFriendIDsResponse, error)
+	// GetMutualFriends retrieves the friends two users have in common
+	GetMutualFriends(context.Context, *GetMutualFriendsRequest) (*GetFriendsResponse, error)
 	// RemoveFriend removes a friend
 	RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error)
 	// BlockUser blocks a user
@@ -191,8 +409,24 @@ type FriendServiceServer interface {
 	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
 	// GetBlockedUsers retrieves blocked users for a user
 	GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error)
+	// GetBlockedIDs retrieves the IDs of the users a user has blocked or been blocked by
+	GetBlockedIDs(context.Context, *GetBlockedIDsRequest) (*GetBlockedIDsResponse, error)
 	// CheckFriendship checks if two users are friends
 	CheckFriendship(context.Context, *CheckFriendshipRequest) (*CheckFriendshipResponse, error)
+	// CheckFriendships checks the relationship between a user and several other users at once
+	CheckFriendships(context.Context, *CheckFriendshipsRequest) (*CheckFriendshipsResponse, error)
+	// Follow makes the user follow another user; unlike a friendship it needs no acceptance
+	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
+	// Unfollow stops the user following another user
+	Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error)
+	// GetFollowers retrieves the users who follow a user
+	GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowsResponse, error)
+	// GetFollowing retrieves the users a user follows
+	GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowsResponse, error)
+	// GetFollowingIDs retrieves the IDs of all the users a user follows
+	GetFollowingIDs(context.Context, *GetFollowingIDsRequest) (*GetFollowingIDsResponse, error)
+	// GetFollowCounts counts a user's followers and followed users
+	GetFollowCounts(context.Context, *GetFollowCountsRequest) (*GetFollowCountsResponse, error)
 	mustEmbedUnimplementedFriendServiceServer()
 }
 
@@ -215,9 +449,30 @@ func (UnimplementedFriendServiceServer) AcceptFriendRequest(context.Context, *Ac
 func (UnimplementedFriendServiceServer) RejectFriendRequest(context.Context, *RejectFriendRequestRequest) (*FriendRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectFriendRequest not implemented")
 }
+func (UnimplementedFriendServiceServer) AcceptFriendRequests(context.Context, *AcceptFriendRequestsRequest) (*BatchFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequests not implemented")
+}
+func (UnimplementedFriendServiceServer) RejectFriendRequests(context.Context, *RejectFriendRequestsRequest) (*BatchFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFriendRequests not implemented")
+}
+func (UnimplementedFriendServiceServer) AcceptAllFriendRequests(context.Context, *AcceptAllFriendRequestsRequest) (*BatchFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAllFriendRequests not implemented")
+}
+func (UnimplementedFriendServiceServer) CancelFriendRequest(context.Context, *CancelFriendRequestRequest) (*CancelFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
 func (UnimplementedFriendServiceServer) GetFriends(context.Context, *GetFriendsRequest) (*GetFriendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriends not implemented")
 }
+func (UnimplementedFriendServiceServer) StreamFriends(*StreamFriendsRequest, grpc.ServerStreamingServer[GetFriendsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFriends not implemented")
+}
+func (UnimplementedFriendServiceServer) GetFriendIDs(context.Context, *GetFriendIDsRequest) (*GetFriendIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendIDs not implemented")
+}
+func (UnimplementedFriendServiceServer) GetMutualFriends(context.Context, *GetMutualFriendsRequest) (*GetFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutualFriends not implemented")
+}
 func (UnimplementedFriendServiceServer) RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
 }
@@ -230,9 +485,33 @@ func (UnimplementedFriendServiceServer) UnblockUser(context.Context, *UnblockUse
 func (UnimplementedFriendServiceServer) GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
 }
+func (UnimplementedFriendServiceServer) GetBlockedIDs(context.Context, *GetBlockedIDsRequest) (*GetBlockedIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedIDs not implemented")
+}
 func (UnimplementedFriendServiceServer) CheckFriendship(context.Context, *CheckFriendshipRequest) (*CheckFriendshipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckFriendship not implemented")
 }
+func (UnimplementedFriendServiceServer) CheckFriendships(context.Context, *CheckFriendshipsRequest) (*CheckFriendshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFriendships not implemented")
+}
+func (UnimplementedFriendServiceServer) Follow(context.Context, *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedFriendServiceServer) Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedFriendServiceServer) GetFollowers(context.Context, *GetFollowersRequest) (*GetFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (UnimplementedFriendServiceServer) GetFollowing(context.Context, *GetFollowingRequest) (*GetFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
+}
+func (UnimplementedFriendServiceServer) GetFollowingIDs(context.Context, *GetFollowingIDsRequest) (*GetFollowingIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingIDs not implemented")
+}
+func (UnimplementedFriendServiceServer) GetFollowCounts(context.Context, *GetFollowCountsRequest) (*GetFollowCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowCounts not implemented")
+}
 func (UnimplementedFriendServiceServer) mustEmbedUnimplementedFriendServiceServer() {}
 func (UnimplementedFriendServiceServer) testEmbeddedByValue()                       {}
 
@@ -326,6 +605,78 @@ func _FriendService_RejectFriendRequest_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_AcceptFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).AcceptFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_AcceptFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).AcceptFriendRequests(ctx, req.(*AcceptFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_RejectFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).RejectFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_RejectFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).RejectFriendRequests(ctx, req.(*RejectFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_AcceptAllFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAllFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).AcceptAllFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_AcceptAllFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).AcceptAllFriendRequests(ctx, req.(*AcceptAllFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_CancelFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).CancelFriendRequest(ctx, req.(*CancelFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FriendService_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFriendsRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +695,53 @@ func _FriendService_GetFriends_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_StreamFriends_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFriendsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FriendServiceServer).StreamFriends(m, &grpc.GenericServerStream[StreamFriendsRequest, GetFriendsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FriendService_StreamFriendsServer = grpc.ServerStreamingServer[GetFriendsResponse]
+
+func _FriendService_GetFriendIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFriendIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFriendIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFriendIDs(ctx, req.(*GetFriendIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_GetMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetMutualFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetMutualFriends(ctx, req.(*GetMutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FriendService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveFriendRequest)
 	if err := dec(in); err != nil {
@@ -416,6 +814,24 @@ func _FriendService_GetBlockedUsers_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_GetBlockedIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetBlockedIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetBlockedIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetBlockedIDs(ctx, req.(*GetBlockedIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FriendService_CheckFriendship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckFriendshipRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +850,132 @@ func _FriendService_CheckFriendship_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_CheckFriendships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFriendshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).CheckFriendships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_CheckFriendships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).CheckFriendships(ctx, req.(*CheckFriendshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).Follow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).Unfollow(ctx, req.(*UnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFollowers(ctx, req.(*GetFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFollowing(ctx, req.(*GetFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_GetFollowingIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFollowingIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFollowingIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFollowingIDs(ctx, req.(*GetFollowingIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_GetFollowCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFollowCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFollowCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFollowCounts(ctx, req.(*GetFollowCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FriendService_ServiceDesc is the grpc.ServiceDesc for FriendService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,10 +999,34 @@ var FriendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectFriendRequest",
 			Handler:    _FriendService_RejectFriendRequest_Handler,
 		},
+		{
+			MethodName: "AcceptFriendRequests",
+			Handler:    _FriendService_AcceptFriendRequests_Handler,
+		},
+		{
+			MethodName: "RejectFriendRequests",
+			Handler:    _FriendService_RejectFriendRequests_Handler,
+		},
+		{
+			MethodName: "AcceptAllFriendRequests",
+			Handler:    _FriendService_AcceptAllFriendRequests_Handler,
+		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _FriendService_CancelFriendRequest_Handler,
+		},
 		{
 			MethodName: "GetFriends",
 			Handler:    _FriendService_GetFriends_Handler,
 		},
+		{
+			MethodName: "GetFriendIDs",
+			Handler:    _FriendService_GetFriendIDs_Handler,
+		},
+		{
+			MethodName: "GetMutualFriends",
+			Handler:    _FriendService_GetMutualFriends_Handler,
+		},
 		{
 			MethodName: "RemoveFriend",
 			Handler:    _FriendService_RemoveFriend_Handler,
@@ -477,11 +1043,49 @@ var FriendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockedUsers",
 			Handler:    _FriendService_GetBlockedUsers_Handler,
 		},
+		{
+			MethodName: "GetBlockedIDs",
+			Handler:    _FriendService_GetBlockedIDs_Handler,
+		},
 		{
 			MethodName: "CheckFriendship",
 			Handler:    _FriendService_CheckFriendship_Handler,
 		},
+		{
+			MethodName: "CheckFriendships",
+			Handler:    _FriendService_CheckFriendships_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _FriendService_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _FriendService_Unfollow_Handler,
+		},
+		{
+			MethodName: "GetFollowers",
+			Handler:    _FriendService_GetFollowers_Handler,
+		},
+		{
+			MethodName: "GetFollowing",
+			Handler:    _FriendService_GetFollowing_Handler,
+		},
+		{
+			MethodName: "GetFollowingIDs",
+			Handler:    _FriendService_GetFollowingIDs_Handler,
+		},
+		{
+			MethodName: "GetFollowCounts",
+			Handler:    _FriendService_GetFollowCounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFriends",
+			Handler:       _FriendService_StreamFriends_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "friends/friends.proto",
 }
@@ -31,7 +31,9 @@ type CreateGroupRequest struct {
 	// Description is the description of the group
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Avatar is the URL to the group's avatar
-	Avatar        string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Avatar string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// Visibility is either "public" or "private"; private groups require approval to join (optional, defaults to public)
+	Visibility    string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -94,6 +96,13 @@ func (x *CreateGroupRequest) GetAvatar() string {
 	return ""
 }
 
+func (x *CreateGroupRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 // GetGroupRequest is the request for retrieving a group
 type GetGroupRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -159,7 +168,9 @@ type GetGroupsRequest struct {
 	// Page is the page number for pagination
 	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	// Limit is the number of groups per page
-	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Sort is the order of the groups: recent, oldest, members_desc or name_asc (optional, storage order by default)
+	Sort          string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +233,68 @@ func (x *GetGroupsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetGroupsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// GetUserGroupsRequest is the request for retrieving the groups of the requesting user
+type GetUserGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of groups per page
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGroupsRequest) Reset() {
+	*x = GetUserGroupsRequest{}
+	mi := &file_groups_groups_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGroupsRequest) ProtoMessage() {}
+
+func (x *GetUserGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserGroupsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserGroupsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 // UpdateGroupRequest is the request for updating a group
 type UpdateGroupRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -234,14 +307,16 @@ type UpdateGroupRequest struct {
 	// Description is the updated description of the group (optional)
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	// Avatar is the updated URL to the group's avatar (optional)
-	Avatar        string `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Avatar string `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// Visibility is the updated visibility of the group, "public" or "private" (optional)
+	Visibility    string `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateGroupRequest) Reset() {
 	*x = UpdateGroupRequest{}
-	mi := &file_groups_groups_proto_msgTypes[3]
+	mi := &file_groups_groups_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -253,7 +328,7 @@ func (x *UpdateGroupRequest) String() string {
 func (*UpdateGroupRequest) ProtoMessage() {}
 
 func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[3]
+	mi := &file_groups_groups_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +341,7 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
 func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{3}
+	return file_groups_groups_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateGroupRequest) GetGroupId() string {
@@ -304,6 +379,13 @@ func (x *UpdateGroupRequest) GetAvatar() string {
 	return ""
 }
 
+func (x *UpdateGroupRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 // DeleteGroupRequest is the request for deleting a group
 type DeleteGroupRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -317,7 +399,7 @@ type DeleteGroupRequest struct {
 
 func (x *DeleteGroupRequest) Reset() {
 	*x = DeleteGroupRequest{}
-	mi := &file_groups_groups_proto_msgTypes[4]
+	mi := &file_groups_groups_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -329,7 +411,7 @@ func (x *DeleteGroupRequest) String() string {
 func (*DeleteGroupRequest) ProtoMessage() {}
 
 func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[4]
+	mi := &file_groups_groups_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +424,7 @@ func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
 func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{4}
+	return file_groups_groups_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteGroupRequest) GetGroupId() string {
@@ -372,7 +454,7 @@ type JoinGroupRequest struct {
 
 func (x *JoinGroupRequest) Reset() {
 	*x = JoinGroupRequest{}
-	mi := &file_groups_groups_proto_msgTypes[5]
+	mi := &file_groups_groups_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -384,7 +466,7 @@ func (x *JoinGroupRequest) String() string {
 func (*JoinGroupRequest) ProtoMessage() {}
 
 func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[5]
+	mi := &file_groups_groups_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +479,7 @@ func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupRequest.ProtoReflect.Descriptor instead.
 func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{5}
+	return file_groups_groups_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *JoinGroupRequest) GetGroupId() string {
@@ -427,7 +509,7 @@ type LeaveGroupRequest struct {
 
 func (x *LeaveGroupRequest) Reset() {
 	*x = LeaveGroupRequest{}
-	mi := &file_groups_groups_proto_msgTypes[6]
+	mi := &file_groups_groups_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +521,7 @@ func (x *LeaveGroupRequest) String() string {
 func (*LeaveGroupRequest) ProtoMessage() {}
 
 func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[6]
+	mi := &file_groups_groups_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +534,7 @@ func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
 func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{6}
+	return file_groups_groups_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LeaveGroupRequest) GetGroupId() string {
@@ -484,7 +566,7 @@ type GetGroupMembersRequest struct {
 
 func (x *GetGroupMembersRequest) Reset() {
 	*x = GetGroupMembersRequest{}
-	mi := &file_groups_groups_proto_msgTypes[7]
+	mi := &file_groups_groups_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +578,7 @@ func (x *GetGroupMembersRequest) String() string {
 func (*GetGroupMembersRequest) ProtoMessage() {}
 
 func (x *GetGroupMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[7]
+	mi := &file_groups_groups_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +591,7 @@ func (x *GetGroupMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupMembersRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{7}
+	return file_groups_groups_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetGroupMembersRequest) GetGroupId() string {
@@ -533,36 +615,32 @@ func (x *GetGroupMembersRequest) GetLimit() int32 {
 	return 0
 }
 
-// CreateGroupPostRequest is the request for creating a post in a group
-type CreateGroupPostRequest struct {
+// StreamGroupMembersRequest is the request for streaming all members of a group
+type StreamGroupMembersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// GroupId is the ID of the group
 	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// UserId is the ID of the user creating the post
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Content is the content of the post
-	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	// Media is an array of media URLs (optional)
-	Media         []string `protobuf:"bytes,4,rep,name=media,proto3" json:"media,omitempty"`
+	// PageSize is the number of members per message (optional, 100 by default, at most 500)
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupPostRequest) Reset() {
-	*x = CreateGroupPostRequest{}
-	mi := &file_groups_groups_proto_msgTypes[8]
+func (x *StreamGroupMembersRequest) Reset() {
+	*x = StreamGroupMembersRequest{}
+	mi := &file_groups_groups_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupPostRequest) String() string {
+func (x *StreamGroupMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupPostRequest) ProtoMessage() {}
+func (*StreamGroupMembersRequest) ProtoMessage() {}
 
-func (x *CreateGroupPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[8]
+func (x *StreamGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,69 +651,108 @@ func (x *CreateGroupPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupPostRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupPostRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use StreamGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*StreamGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateGroupPostRequest) GetGroupId() string {
+func (x *StreamGroupMembersRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *CreateGroupPostRequest) GetUserId() string {
+func (x *StreamGroupMembersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.UserId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGroupPostRequest) GetContent() string {
+// CheckMembershipRequest is the request for checking a user's membership in a group
+type CheckMembershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the user to check (optional; anonymous users are never members)
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckMembershipRequest) Reset() {
+	*x = CheckMembershipRequest{}
+	mi := &file_groups_groups_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMembershipRequest) ProtoMessage() {}
+
+func (x *CheckMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[10]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMembershipRequest.ProtoReflect.Descriptor instead.
+func (*CheckMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckMembershipRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *CreateGroupPostRequest) GetMedia() []string {
+func (x *CheckMembershipRequest) GetUserId() string {
 	if x != nil {
-		return x.Media
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// GetGroupPostsRequest is the request for retrieving posts in a group
-type GetGroupPostsRequest struct {
+// ChangeMemberRoleRequest is the request for changing the role of a group member
+type ChangeMemberRoleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// GroupId is the ID of the group
 	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// UserId is the ID of the user making the request (optional)
+	// UserId is the ID of the user making the change
 	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Page is the page number for pagination
-	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	// Limit is the number of posts per page
-	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// MemberId is the ID of the member whose role is changed
+	MemberId      string `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupPostsRequest) Reset() {
-	*x = GetGroupPostsRequest{}
-	mi := &file_groups_groups_proto_msgTypes[9]
+func (x *ChangeMemberRoleRequest) Reset() {
+	*x = ChangeMemberRoleRequest{}
+	mi := &file_groups_groups_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupPostsRequest) String() string {
+func (x *ChangeMemberRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupPostsRequest) ProtoMessage() {}
+func (*ChangeMemberRoleRequest) ProtoMessage() {}
 
-func (x *GetGroupPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[9]
+func (x *ChangeMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,83 +763,60 @@ func (x *GetGroupPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupPostsRequest) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ChangeMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetGroupPostsRequest) GetGroupId() string {
+func (x *ChangeMemberRoleRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetGroupPostsRequest) GetUserId() string {
+func (x *ChangeMemberRoleRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetGroupPostsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetGroupPostsRequest) GetLimit() int32 {
+func (x *ChangeMemberRoleRequest) GetMemberId() string {
 	if x != nil {
-		return x.Limit
+		return x.MemberId
 	}
-	return 0
+	return ""
 }
 
-// GroupResponse is the response containing a group
-type GroupResponse struct {
+// RemoveMemberRequest is the request for removing a member from a group
+type RemoveMemberRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// GroupId is the ID of the group
 	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// Name is the name of the group
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is the description of the group
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Avatar is the URL to the group's avatar
-	Avatar string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	// CreatorId is the ID of the user who created the group
-	CreatorId string `protobuf:"bytes,5,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	// CreatorName is the name of the user who created the group
-	CreatorName string `protobuf:"bytes,6,opt,name=creator_name,json=creatorName,proto3" json:"creator_name,omitempty"`
-	// MembersCount is the number of members in the group
-	MembersCount int32 `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
-	// PostsCount is the number of posts in the group
-	PostsCount int32 `protobuf:"varint,8,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
-	// IsMember indicates if the requesting user is a member of the group
-	IsMember bool `protobuf:"varint,9,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
-	// CreatedAt is the timestamp when the group was created
-	CreatedAt string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// UpdatedAt is the timestamp when the group was last updated
-	UpdatedAt     string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// UserId is the ID of the creator or admin removing the member
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// MemberId is the ID of the member to remove
+	MemberId      string `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupResponse) Reset() {
-	*x = GroupResponse{}
-	mi := &file_groups_groups_proto_msgTypes[10]
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_groups_groups_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupResponse) String() string {
+func (x *RemoveMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupResponse) ProtoMessage() {}
+func (*RemoveMemberRequest) ProtoMessage() {}
 
-func (x *GroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[10]
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,54 +827,850 @@ func (x *GroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupResponse.ProtoReflect.Descriptor instead.
-func (*GroupResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GroupResponse) GetGroupId() string {
+func (x *RemoveMemberRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GroupResponse) GetName() string {
+func (x *RemoveMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GroupResponse) GetDescription() string {
+func (x *RemoveMemberRequest) GetMemberId() string {
 	if x != nil {
-		return x.Description
+		return x.MemberId
 	}
 	return ""
 }
 
-func (x *GroupResponse) GetAvatar() string {
-	if x != nil {
-		return x.Avatar
-	}
-	return ""
+// TransferOwnershipRequest is the request for transferring the ownership of a group
+type TransferOwnershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the current creator of the group
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// NewCreatorId is the ID of the member who becomes the creator
+	NewCreatorId  string `protobuf:"bytes,3,opt,name=new_creator_id,json=newCreatorId,proto3" json:"new_creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupResponse) GetCreatorId() string {
-	if x != nil {
-		return x.CreatorId
-	}
-	return ""
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_groups_groups_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupResponse) GetCreatorName() string {
-	if x != nil {
-		return x.CreatorName
-	}
-	return ""
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GroupResponse) GetMembersCount() int32 {
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransferOwnershipRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetNewCreatorId() string {
+	if x != nil {
+		return x.NewCreatorId
+	}
+	return ""
+}
+
+// GetJoinRequestsRequest is the request for retrieving pending join requests
+type GetJoinRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the creator or admin making the request
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of requests per page
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinRequestsRequest) Reset() {
+	*x = GetJoinRequestsRequest{}
+	mi := &file_groups_groups_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinRequestsRequest) ProtoMessage() {}
+
+func (x *GetJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetJoinRequestsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetJoinRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetJoinRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetJoinRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ReviewJoinRequestRequest is the request for approving or rejecting a join request
+type ReviewJoinRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the creator or admin reviewing the request
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// RequestId is the ID of the join request
+	RequestId     string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewJoinRequestRequest) Reset() {
+	*x = ReviewJoinRequestRequest{}
+	mi := &file_groups_groups_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewJoinRequestRequest) ProtoMessage() {}
+
+func (x *ReviewJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReviewJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReviewJoinRequestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ReviewJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// CreateGroupPostRequest is the request for creating a post in a group
+type CreateGroupPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the user creating the post
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Content is the content of the post
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Media is an array of media URLs (optional)
+	Media         []string `protobuf:"bytes,4,rep,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupPostRequest) Reset() {
+	*x = CreateGroupPostRequest{}
+	mi := &file_groups_groups_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupPostRequest) ProtoMessage() {}
+
+func (x *CreateGroupPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupPostRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupPostRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateGroupPostRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateGroupPostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateGroupPostRequest) GetMedia() []string {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// GetGroupPostsRequest is the request for retrieving posts in a group
+type GetGroupPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the user making the request (optional)
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of posts per page
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPostsRequest) Reset() {
+	*x = GetGroupPostsRequest{}
+	mi := &file_groups_groups_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPostsRequest) ProtoMessage() {}
+
+func (x *GetGroupPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupPostsRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGroupPostsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupPostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGroupPostsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGroupPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// UpdateGroupPostRequest is the request for editing a post in a group
+type UpdateGroupPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the author or group admin editing the post
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Content is the new content of the post
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Media is the new array of media URLs (optional, the current media is kept if empty)
+	Media         []string `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupPostRequest) Reset() {
+	*x = UpdateGroupPostRequest{}
+	mi := &file_groups_groups_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupPostRequest) ProtoMessage() {}
+
+func (x *UpdateGroupPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupPostRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupPostRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateGroupPostRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *UpdateGroupPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateGroupPostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateGroupPostRequest) GetMedia() []string {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// DeleteGroupPostRequest is the request for deleting a post in a group
+type DeleteGroupPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the author or group admin deleting the post
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupPostRequest) Reset() {
+	*x = DeleteGroupPostRequest{}
+	mi := &file_groups_groups_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupPostRequest) ProtoMessage() {}
+
+func (x *DeleteGroupPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupPostRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupPostRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteGroupPostRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteGroupPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DeleteGroupPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GroupPostLikeRequest is the request for liking or unliking a post in a group
+type GroupPostLikeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user liking or unliking the post
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupPostLikeRequest) Reset() {
+	*x = GroupPostLikeRequest{}
+	mi := &file_groups_groups_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPostLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPostLikeRequest) ProtoMessage() {}
+
+func (x *GroupPostLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPostLikeRequest.ProtoReflect.Descriptor instead.
+func (*GroupPostLikeRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GroupPostLikeRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupPostLikeRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GroupPostLikeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AddGroupPostCommentRequest is the request for commenting on a post in a group
+type AddGroupPostCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user adding the comment
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Content is the content of the comment
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupPostCommentRequest) Reset() {
+	*x = AddGroupPostCommentRequest{}
+	mi := &file_groups_groups_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupPostCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupPostCommentRequest) ProtoMessage() {}
+
+func (x *AddGroupPostCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupPostCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupPostCommentRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddGroupPostCommentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddGroupPostCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *AddGroupPostCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddGroupPostCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// GetGroupPostCommentsRequest is the request for retrieving the comments on a post in a group
+type GetGroupPostCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user making the request (optional)
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of comments per page
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPostCommentsRequest) Reset() {
+	*x = GetGroupPostCommentsRequest{}
+	mi := &file_groups_groups_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPostCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPostCommentsRequest) ProtoMessage() {}
+
+func (x *GetGroupPostCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupPostCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupPostCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetGroupPostCommentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupPostCommentsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetGroupPostCommentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGroupPostCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGroupPostCommentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GroupResponse is the response containing a group
+type GroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Name is the name of the group
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is the description of the group
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Avatar is the URL to the group's avatar
+	Avatar string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// CreatorId is the ID of the user who created the group
+	CreatorId string `protobuf:"bytes,5,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// CreatorName is the name of the user who created the group
+	CreatorName string `protobuf:"bytes,6,opt,name=creator_name,json=creatorName,proto3" json:"creator_name,omitempty"`
+	// MembersCount is the number of members in the group
+	MembersCount int32 `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	// PostsCount is the number of posts in the group
+	PostsCount int32 `protobuf:"varint,8,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
+	// IsMember indicates if the requesting user is a member of the group
+	IsMember bool `protobuf:"varint,9,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	// CreatedAt is the timestamp when the group was created
+	CreatedAt string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// UpdatedAt is the timestamp when the group was last updated
+	UpdatedAt string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Visibility is either "public" or "private"
+	Visibility string `protobuf:"bytes,12,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Role is the requesting user's role in the group (creator, admin or member), only set by GetUserGroups
+	Role          string `protobuf:"bytes,13,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupResponse) Reset() {
+	*x = GroupResponse{}
+	mi := &file_groups_groups_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupResponse) ProtoMessage() {}
+
+func (x *GroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupResponse.ProtoReflect.Descriptor instead.
+func (*GroupResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GroupResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetMembersCount() int32 {
 	if x != nil {
 		return x.MembersCount
 	}
@@ -815,6 +1705,20 @@ func (x *GroupResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *GroupResponse) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *GroupResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 // GetGroupsResponse is the response containing groups
 type GetGroupsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -832,7 +1736,7 @@ type GetGroupsResponse struct {
 
 func (x *GetGroupsResponse) Reset() {
 	*x = GetGroupsResponse{}
-	mi := &file_groups_groups_proto_msgTypes[11]
+	mi := &file_groups_groups_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1748,7 @@ func (x *GetGroupsResponse) String() string {
 func (*GetGroupsResponse) ProtoMessage() {}
 
 func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[11]
+	mi := &file_groups_groups_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1761,7 @@ func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{11}
+	return file_groups_groups_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetGroupsResponse) GetGroups() []*GroupResponse {
@@ -899,7 +1803,7 @@ type DeleteGroupResponse struct {
 
 func (x *DeleteGroupResponse) Reset() {
 	*x = DeleteGroupResponse{}
-	mi := &file_groups_groups_proto_msgTypes[12]
+	mi := &file_groups_groups_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -911,7 +1815,7 @@ func (x *DeleteGroupResponse) String() string {
 func (*DeleteGroupResponse) ProtoMessage() {}
 
 func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[12]
+	mi := &file_groups_groups_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1828,7 @@ func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
 func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{12}
+	return file_groups_groups_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DeleteGroupResponse) GetSuccess() bool {
@@ -940,14 +1844,16 @@ type JoinGroupResponse struct {
 	// Success indicates if the user successfully joined the group
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// MembersCount is the updated number of members in the group
-	MembersCount  int32 `protobuf:"varint,2,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	MembersCount int32 `protobuf:"varint,2,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	// Pending indicates that the group is private and a join request awaits approval
+	Pending       bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinGroupResponse) Reset() {
 	*x = JoinGroupResponse{}
-	mi := &file_groups_groups_proto_msgTypes[13]
+	mi := &file_groups_groups_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1865,7 @@ func (x *JoinGroupResponse) String() string {
 func (*JoinGroupResponse) ProtoMessage() {}
 
 func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[13]
+	mi := &file_groups_groups_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1878,7 @@ func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupResponse.ProtoReflect.Descriptor instead.
 func (*JoinGroupResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{13}
+	return file_groups_groups_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *JoinGroupResponse) GetSuccess() bool {
@@ -989,6 +1895,13 @@ func (x *JoinGroupResponse) GetMembersCount() int32 {
 	return 0
 }
 
+func (x *JoinGroupResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
 // LeaveGroupResponse is the response for leaving a group
 type LeaveGroupResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1002,7 +1915,7 @@ type LeaveGroupResponse struct {
 
 func (x *LeaveGroupResponse) Reset() {
 	*x = LeaveGroupResponse{}
-	mi := &file_groups_groups_proto_msgTypes[14]
+	mi := &file_groups_groups_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1014,7 +1927,7 @@ func (x *LeaveGroupResponse) String() string {
 func (*LeaveGroupResponse) ProtoMessage() {}
 
 func (x *LeaveGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[14]
+	mi := &file_groups_groups_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,19 +1940,330 @@ func (x *LeaveGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGroupResponse.ProtoReflect.Descriptor instead.
 func (*LeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{14}
+	return file_groups_groups_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LeaveGroupResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
-	return false
+	return false
+}
+
+func (x *LeaveGroupResponse) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+// CheckMembershipResponse is the response containing a user's membership in a group
+type CheckMembershipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IsMember indicates if the user is a member of the group
+	IsMember bool `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	// Role is the role of the member in the group, empty if not a member
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// GroupName is the name of the group
+	GroupName string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	// GroupVisibility is the visibility of the group, "public" or "private"
+	GroupVisibility string `protobuf:"bytes,4,opt,name=group_visibility,json=groupVisibility,proto3" json:"group_visibility,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckMembershipResponse) Reset() {
+	*x = CheckMembershipResponse{}
+	mi := &file_groups_groups_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMembershipResponse) ProtoMessage() {}
+
+func (x *CheckMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMembershipResponse.ProtoReflect.Descriptor instead.
+func (*CheckMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CheckMembershipResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+func (x *CheckMembershipResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CheckMembershipResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CheckMembershipResponse) GetGroupVisibility() string {
+	if x != nil {
+		return x.GroupVisibility
+	}
+	return ""
+}
+
+// RemoveMemberResponse is the response for removing a member from a group
+type RemoveMemberResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the member was removed
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// MembersCount is the updated number of members in the group
+	MembersCount int32 `protobuf:"varint,2,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	// PostsRetained indicates that the removed member's group posts were kept.
+	// Removing a member never deletes their posts, so this is always true.
+	PostsRetained bool `protobuf:"varint,3,opt,name=posts_retained,json=postsRetained,proto3" json:"posts_retained,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_groups_groups_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveMemberResponse) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *RemoveMemberResponse) GetPostsRetained() bool {
+	if x != nil {
+		return x.PostsRetained
+	}
+	return false
+}
+
+// JoinRequestResponse is the response containing a group join request
+type JoinRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RequestId is the ID of the join request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// GroupId is the ID of the group
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// UserId is the ID of the user asking to join
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Status is the status of the request (pending, approved, rejected)
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// ReviewedBy is the ID of the admin who reviewed the request
+	ReviewedBy string `protobuf:"bytes,5,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	// CreatedAt is the timestamp when the request was made
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ReviewedAt is the timestamp when the request was reviewed
+	ReviewedAt    string `protobuf:"bytes,7,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequestResponse) Reset() {
+	*x = JoinRequestResponse{}
+	mi := &file_groups_groups_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequestResponse) ProtoMessage() {}
+
+func (x *JoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*JoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *JoinRequestResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *JoinRequestResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *JoinRequestResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JoinRequestResponse) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *JoinRequestResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JoinRequestResponse) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+// GetJoinRequestsResponse is the response containing pending join requests
+type GetJoinRequestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requests is an array of join requests
+	Requests []*JoinRequestResponse `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// TotalCount is the total number of pending requests
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinRequestsResponse) Reset() {
+	*x = GetJoinRequestsResponse{}
+	mi := &file_groups_groups_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinRequestsResponse) ProtoMessage() {}
+
+func (x *GetJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetJoinRequestsResponse) GetRequests() []*JoinRequestResponse {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GetJoinRequestsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetJoinRequestsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
 }
 
-func (x *LeaveGroupResponse) GetMembersCount() int32 {
+func (x *GetJoinRequestsResponse) GetTotalPages() int32 {
 	if x != nil {
-		return x.MembersCount
+		return x.TotalPages
 	}
 	return 0
 }
@@ -1063,7 +2287,7 @@ type GroupMemberResponse struct {
 
 func (x *GroupMemberResponse) Reset() {
 	*x = GroupMemberResponse{}
-	mi := &file_groups_groups_proto_msgTypes[15]
+	mi := &file_groups_groups_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1075,7 +2299,7 @@ func (x *GroupMemberResponse) String() string {
 func (*GroupMemberResponse) ProtoMessage() {}
 
 func (x *GroupMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[15]
+	mi := &file_groups_groups_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,7 +2312,7 @@ func (x *GroupMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMemberResponse.ProtoReflect.Descriptor instead.
 func (*GroupMemberResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{15}
+	return file_groups_groups_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GroupMemberResponse) GetUserId() string {
@@ -1143,7 +2367,7 @@ type GetGroupMembersResponse struct {
 
 func (x *GetGroupMembersResponse) Reset() {
 	*x = GetGroupMembersResponse{}
-	mi := &file_groups_groups_proto_msgTypes[16]
+	mi := &file_groups_groups_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1155,7 +2379,7 @@ func (x *GetGroupMembersResponse) String() string {
 func (*GetGroupMembersResponse) ProtoMessage() {}
 
 func (x *GetGroupMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[16]
+	mi := &file_groups_groups_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +2392,7 @@ func (x *GetGroupMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupMembersResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{16}
+	return file_groups_groups_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetGroupMembersResponse) GetMembers() []*GroupMemberResponse {
@@ -1232,7 +2456,7 @@ type GroupPostResponse struct {
 
 func (x *GroupPostResponse) Reset() {
 	*x = GroupPostResponse{}
-	mi := &file_groups_groups_proto_msgTypes[17]
+	mi := &file_groups_groups_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1244,7 +2468,7 @@ func (x *GroupPostResponse) String() string {
 func (*GroupPostResponse) ProtoMessage() {}
 
 func (x *GroupPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[17]
+	mi := &file_groups_groups_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1257,7 +2481,7 @@ func (x *GroupPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupPostResponse.ProtoReflect.Descriptor instead.
 func (*GroupPostResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{17}
+	return file_groups_groups_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GroupPostResponse) GetPostId() string {
@@ -1361,7 +2585,7 @@ type GetGroupPostsResponse struct {
 
 func (x *GetGroupPostsResponse) Reset() {
 	*x = GetGroupPostsResponse{}
-	mi := &file_groups_groups_proto_msgTypes[18]
+	mi := &file_groups_groups_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +2597,7 @@ func (x *GetGroupPostsResponse) String() string {
 func (*GetGroupPostsResponse) ProtoMessage() {}
 
 func (x *GetGroupPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_groups_groups_proto_msgTypes[18]
+	mi := &file_groups_groups_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +2610,7 @@ func (x *GetGroupPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupPostsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupPostsResponse) Descriptor() ([]byte, []int) {
-	return file_groups_groups_proto_rawDescGZIP(), []int{18}
+	return file_groups_groups_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetGroupPostsResponse) GetPosts() []*GroupPostResponse {
@@ -1417,30 +2641,323 @@ func (x *GetGroupPostsResponse) GetTotalPages() int32 {
 	return 0
 }
 
+// DeleteGroupPostResponse is the response for deleting a post in a group
+type DeleteGroupPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the post was successfully deleted
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupPostResponse) Reset() {
+	*x = DeleteGroupPostResponse{}
+	mi := &file_groups_groups_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupPostResponse) ProtoMessage() {}
+
+func (x *DeleteGroupPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupPostResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupPostResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteGroupPostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GroupPostLikeResponse is the response for liking or unliking a post in a group
+type GroupPostLikeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the like was added or removed
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// LikesCount is the updated number of likes on the post
+	LikesCount    int32 `protobuf:"varint,2,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupPostLikeResponse) Reset() {
+	*x = GroupPostLikeResponse{}
+	mi := &file_groups_groups_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPostLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPostLikeResponse) ProtoMessage() {}
+
+func (x *GroupPostLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPostLikeResponse.ProtoReflect.Descriptor instead.
+func (*GroupPostLikeResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GroupPostLikeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GroupPostLikeResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+// GroupPostCommentResponse is the response containing a comment on a group post
+type GroupPostCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CommentId is the ID of the comment
+	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// AuthorId is the ID of the user who wrote the comment
+	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// AuthorName is the name of the user who wrote the comment
+	AuthorName string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// AuthorAvatar is the avatar URL of the user who wrote the comment
+	AuthorAvatar string `protobuf:"bytes,5,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
+	// Content is the content of the comment
+	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	// CreatedAt is the timestamp when the comment was created
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// UpdatedAt is the timestamp when the comment was last updated
+	UpdatedAt     string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupPostCommentResponse) Reset() {
+	*x = GroupPostCommentResponse{}
+	mi := &file_groups_groups_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPostCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPostCommentResponse) ProtoMessage() {}
+
+func (x *GroupPostCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPostCommentResponse.ProtoReflect.Descriptor instead.
+func (*GroupPostCommentResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GroupPostCommentResponse) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetAuthorAvatar() string {
+	if x != nil {
+		return x.AuthorAvatar
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GroupPostCommentResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// GetGroupPostCommentsResponse is the response containing the comments on a group post
+type GetGroupPostCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comments is an array of comments, oldest first
+	Comments []*GroupPostCommentResponse `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// TotalCount is the total number of comments
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPostCommentsResponse) Reset() {
+	*x = GetGroupPostCommentsResponse{}
+	mi := &file_groups_groups_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPostCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPostCommentsResponse) ProtoMessage() {}
+
+func (x *GetGroupPostCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_groups_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupPostCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupPostCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_groups_groups_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetGroupPostCommentsResponse) GetComments() []*GroupPostCommentResponse {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetGroupPostCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetGroupPostCommentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGroupPostCommentsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
 var File_groups_groups_proto protoreflect.FileDescriptor
 
 const file_groups_groups_proto_rawDesc = "" +
 	"\n" +
-	"\x13groups/groups.proto\x12\x06groups\"{\n" +
+	"\x13groups/groups.proto\x12\x06groups\"\x9b\x01\n" +
 	"\x12CreateGroupRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06avatar\x18\x04 \x01(\tR\x06avatar\"E\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\"E\n" +
 	"\x0fGetGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"k\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x7f\n" +
 	"\x10GetGroupsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x96\x01\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\"@\n" +
+	"\x14GetUserGroupsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xb6\x01\n" +
 	"\x12UpdateGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06avatar\x18\x05 \x01(\tR\x06avatar\"H\n" +
+	"\x06avatar\x18\x05 \x01(\tR\x06avatar\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\"H\n" +
 	"\x12DeleteGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"F\n" +
@@ -1453,7 +2970,35 @@ const file_groups_groups_proto_rawDesc = "" +
 	"\x16GetGroupMembersRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"|\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"S\n" +
+	"\x19StreamGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"L\n" +
+	"\x16CheckMembershipRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"j\n" +
+	"\x17ChangeMemberRoleRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tmember_id\x18\x03 \x01(\tR\bmemberId\"f\n" +
+	"\x13RemoveMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tmember_id\x18\x03 \x01(\tR\bmemberId\"t\n" +
+	"\x18TransferOwnershipRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x0enew_creator_id\x18\x03 \x01(\tR\fnewCreatorId\"v\n" +
+	"\x16GetJoinRequestsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"m\n" +
+	"\x18ReviewJoinRequestRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"|\n" +
 	"\x16CreateGroupPostRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
@@ -1463,7 +3008,32 @@ const file_groups_groups_proto_rawDesc = "" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xdb\x02\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x95\x01\n" +
+	"\x16UpdateGroupPostRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x14\n" +
+	"\x05media\x18\x05 \x03(\tR\x05media\"e\n" +
+	"\x16DeleteGroupPostRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"c\n" +
+	"\x14GroupPostLikeRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x83\x01\n" +
+	"\x1aAddGroupPostCommentRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"\x94\x01\n" +
+	"\x1bGetGroupPostCommentsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x8f\x03\n" +
 	"\rGroupResponse\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1480,7 +3050,11 @@ const file_groups_groups_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\tR\tupdatedAt\"\x98\x01\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\f \x01(\tR\n" +
+	"visibility\x12\x12\n" +
+	"\x04role\x18\r \x01(\tR\x04role\"\x98\x01\n" +
 	"\x11GetGroupsResponse\x12-\n" +
 	"\x06groups\x18\x01 \x03(\v2\x15.groups.GroupResponseR\x06groups\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -1489,13 +3063,43 @@ const file_groups_groups_proto_rawDesc = "" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
 	"totalPages\"/\n" +
 	"\x13DeleteGroupResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"l\n" +
 	"\x11JoinGroupResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\"S\n" +
+	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\x12\x18\n" +
+	"\apending\x18\x03 \x01(\bR\apending\"S\n" +
 	"\x12LeaveGroupResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\"\x8b\x01\n" +
+	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\"\x94\x01\n" +
+	"\x17CheckMembershipResponse\x12\x1b\n" +
+	"\tis_member\x18\x01 \x01(\bR\bisMember\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\x12)\n" +
+	"\x10group_visibility\x18\x04 \x01(\tR\x0fgroupVisibility\"|\n" +
+	"\x14RemoveMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\x12%\n" +
+	"\x0eposts_retained\x18\x03 \x01(\bR\rpostsRetained\"\xe1\x01\n" +
+	"\x13JoinRequestResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\x05 \x01(\tR\n" +
+	"reviewedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vreviewed_at\x18\a \x01(\tR\n" +
+	"reviewedAt\"\xa8\x01\n" +
+	"\x17GetJoinRequestsResponse\x127\n" +
+	"\brequests\x18\x01 \x03(\v2\x1b.groups.JoinRequestResponseR\brequests\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"\x8b\x01\n" +
 	"\x13GroupMemberResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -1533,19 +3137,61 @@ const file_groups_groups_proto_rawDesc = "" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages2\xcf\x05\n" +
+	"totalPages\"3\n" +
+	"\x17DeleteGroupPostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\x15GroupPostLikeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vlikes_count\x18\x02 \x01(\x05R\n" +
+	"likesCount\"\x8d\x02\n" +
+	"\x18GroupPostCommentResponse\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12#\n" +
+	"\rauthor_avatar\x18\x05 \x01(\tR\fauthorAvatar\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\xb2\x01\n" +
+	"\x1cGetGroupPostCommentsResponse\x12<\n" +
+	"\bcomments\x18\x01 \x03(\v2 .groups.GroupPostCommentResponseR\bcomments\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages2\x82\x10\n" +
 	"\fGroupService\x12@\n" +
 	"\vCreateGroup\x12\x1a.groups.CreateGroupRequest\x1a\x15.groups.GroupResponse\x12:\n" +
 	"\bGetGroup\x12\x17.groups.GetGroupRequest\x1a\x15.groups.GroupResponse\x12@\n" +
-	"\tGetGroups\x12\x18.groups.GetGroupsRequest\x1a\x19.groups.GetGroupsResponse\x12@\n" +
+	"\tGetGroups\x12\x18.groups.GetGroupsRequest\x1a\x19.groups.GetGroupsResponse\x12H\n" +
+	"\rGetUserGroups\x12\x1c.groups.GetUserGroupsRequest\x1a\x19.groups.GetGroupsResponse\x12@\n" +
 	"\vUpdateGroup\x12\x1a.groups.UpdateGroupRequest\x1a\x15.groups.GroupResponse\x12F\n" +
 	"\vDeleteGroup\x12\x1a.groups.DeleteGroupRequest\x1a\x1b.groups.DeleteGroupResponse\x12@\n" +
 	"\tJoinGroup\x12\x18.groups.JoinGroupRequest\x1a\x19.groups.JoinGroupResponse\x12C\n" +
 	"\n" +
 	"LeaveGroup\x12\x19.groups.LeaveGroupRequest\x1a\x1a.groups.LeaveGroupResponse\x12R\n" +
-	"\x0fGetGroupMembers\x12\x1e.groups.GetGroupMembersRequest\x1a\x1f.groups.GetGroupMembersResponse\x12L\n" +
+	"\x0fGetGroupMembers\x12\x1e.groups.GetGroupMembersRequest\x1a\x1f.groups.GetGroupMembersResponse\x12Z\n" +
+	"\x12StreamGroupMembers\x12!.groups.StreamGroupMembersRequest\x1a\x1f.groups.GetGroupMembersResponse0\x01\x12R\n" +
+	"\x0fCheckMembership\x12\x1e.groups.CheckMembershipRequest\x1a\x1f.groups.CheckMembershipResponse\x12M\n" +
+	"\rPromoteMember\x12\x1f.groups.ChangeMemberRoleRequest\x1a\x1b.groups.GroupMemberResponse\x12L\n" +
+	"\fDemoteMember\x12\x1f.groups.ChangeMemberRoleRequest\x1a\x1b.groups.GroupMemberResponse\x12I\n" +
+	"\fRemoveMember\x12\x1b.groups.RemoveMemberRequest\x1a\x1c.groups.RemoveMemberResponse\x12R\n" +
+	"\x11TransferOwnership\x12 .groups.TransferOwnershipRequest\x1a\x1b.groups.GroupMemberResponse\x12R\n" +
+	"\x0fGetJoinRequests\x12\x1e.groups.GetJoinRequestsRequest\x1a\x1f.groups.GetJoinRequestsResponse\x12S\n" +
+	"\x12ApproveJoinRequest\x12 .groups.ReviewJoinRequestRequest\x1a\x1b.groups.JoinRequestResponse\x12R\n" +
+	"\x11RejectJoinRequest\x12 .groups.ReviewJoinRequestRequest\x1a\x1b.groups.JoinRequestResponse\x12L\n" +
 	"\x0fCreateGroupPost\x12\x1e.groups.CreateGroupPostRequest\x1a\x19.groups.GroupPostResponse\x12L\n" +
-	"\rGetGroupPosts\x12\x1c.groups.GetGroupPostsRequest\x1a\x1d.groups.GetGroupPostsResponseB\x15Z\x13common/proto/groupsb\x06proto3"
+	"\rGetGroupPosts\x12\x1c.groups.GetGroupPostsRequest\x1a\x1d.groups.GetGroupPostsResponse\x12L\n" +
+	"\x0fUpdateGroupPost\x12\x1e.groups.UpdateGroupPostRequest\x1a\x19.groups.GroupPostResponse\x12R\n" +
+	"\x0fDeleteGroupPost\x12\x1e.groups.DeleteGroupPostRequest\x1a\x1f.groups.DeleteGroupPostResponse\x12L\n" +
+	"\rLikeGroupPost\x12\x1c.groups.GroupPostLikeRequest\x1a\x1d.groups.GroupPostLikeResponse\x12N\n" +
+	"\x0fUnlikeGroupPost\x12\x1c.groups.GroupPostLikeRequest\x1a\x1d.groups.GroupPostLikeResponse\x12[\n" +
+	"\x13AddGroupPostComment\x12\".groups.AddGroupPostCommentRequest\x1a .groups.GroupPostCommentResponse\x12a\n" +
+	"\x14GetGroupPostComments\x12#.groups.GetGroupPostCommentsRequest\x1a$.groups.GetGroupPostCommentsResponseB\x15Z\x13common/proto/groupsb\x06proto3"
 
 var (
 	file_groups_groups_proto_rawDescOnce sync.Once
@@ -1559,57 +3205,112 @@ func file_groups_groups_proto_rawDescGZIP() []byte {
 	return file_groups_groups_proto_rawDescData
 }
 
-var file_groups_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_groups_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_groups_groups_proto_goTypes = []any{
-	(*CreateGroupRequest)(nil),      // 0: groups.CreateGroupRequest
-	(*GetGroupRequest)(nil),         // 1: groups.GetGroupRequest
-	(*GetGroupsRequest)(nil),        // 2: groups.GetGroupsRequest
-	(*UpdateGroupRequest)(nil),      // 3: groups.UpdateGroupRequest
-	(*DeleteGroupRequest)(nil),      // 4: groups.DeleteGroupRequest
-	(*JoinGroupRequest)(nil),        // 5: groups.JoinGroupRequest
-	(*LeaveGroupRequest)(nil),       // 6: groups.LeaveGroupRequest
-	(*GetGroupMembersRequest)(nil),  // 7: groups.GetGroupMembersRequest
-	(*CreateGroupPostRequest)(nil),  // 8: groups.CreateGroupPostRequest
-	(*GetGroupPostsRequest)(nil),    // 9: groups.GetGroupPostsRequest
-	(*GroupResponse)(nil),           // 10: groups.GroupResponse
-	(*GetGroupsResponse)(nil),       // 11: groups.GetGroupsResponse
-	(*DeleteGroupResponse)(nil),     // 12: groups.DeleteGroupResponse
-	(*JoinGroupResponse)(nil),       // 13: groups.JoinGroupResponse
-	(*LeaveGroupResponse)(nil),      // 14: groups.LeaveGroupResponse
-	(*GroupMemberResponse)(nil),     // 15: groups.GroupMemberResponse
-	(*GetGroupMembersResponse)(nil), // 16: groups.GetGroupMembersResponse
-	(*GroupPostResponse)(nil),       // 17: groups.GroupPostResponse
-	(*GetGroupPostsResponse)(nil),   // 18: groups.GetGroupPostsResponse
+	(*CreateGroupRequest)(nil),           // 0: groups.CreateGroupRequest
+	(*GetGroupRequest)(nil),              // 1: groups.GetGroupRequest
+	(*GetGroupsRequest)(nil),             // 2: groups.GetGroupsRequest
+	(*GetUserGroupsRequest)(nil),         // 3: groups.GetUserGroupsRequest
+	(*UpdateGroupRequest)(nil),           // 4: groups.UpdateGroupRequest
+	(*DeleteGroupRequest)(nil),           // 5: groups.DeleteGroupRequest
+	(*JoinGroupRequest)(nil),             // 6: groups.JoinGroupRequest
+	(*LeaveGroupRequest)(nil),            // 7: groups.LeaveGroupRequest
+	(*GetGroupMembersRequest)(nil),       // 8: groups.GetGroupMembersRequest
+	(*StreamGroupMembersRequest)(nil),    // 9: groups.StreamGroupMembersRequest
+	(*CheckMembershipRequest)(nil),       // 10: groups.CheckMembershipRequest
+	(*ChangeMemberRoleRequest)(nil),      // 11: groups.ChangeMemberRoleRequest
+	(*RemoveMemberRequest)(nil),          // 12: groups.RemoveMemberRequest
+	(*TransferOwnershipRequest)(nil),     // 13: groups.TransferOwnershipRequest
+	(*GetJoinRequestsRequest)(nil),       // 14: groups.GetJoinRequestsRequest
+	(*ReviewJoinRequestRequest)(nil),     // 15: groups.ReviewJoinRequestRequest
+	(*CreateGroupPostRequest)(nil),       // 16: groups.CreateGroupPostRequest
+	(*GetGroupPostsRequest)(nil),         // 17: groups.GetGroupPostsRequest
+	(*UpdateGroupPostRequest)(nil),       // 18: groups.UpdateGroupPostRequest
+	(*DeleteGroupPostRequest)(nil),       // 19: groups.DeleteGroupPostRequest
+	(*GroupPostLikeRequest)(nil),         // 20: groups.GroupPostLikeRequest
+	(*AddGroupPostCommentRequest)(nil),   // 21: groups.AddGroupPostCommentRequest
+	(*GetGroupPostCommentsRequest)(nil),  // 22: groups.GetGroupPostCommentsRequest
+	(*GroupResponse)(nil),                // 23: groups.GroupResponse
+	(*GetGroupsResponse)(nil),            // 24: groups.GetGroupsResponse
+	(*DeleteGroupResponse)(nil),          // 25: groups.DeleteGroupResponse
+	(*JoinGroupResponse)(nil),            // 26: groups.JoinGroupResponse
+	(*LeaveGroupResponse)(nil),           // 27: groups.LeaveGroupResponse
+	(*CheckMembershipResponse)(nil),      // 28: groups.CheckMembershipResponse
+	(*RemoveMemberResponse)(nil),         // 29: groups.RemoveMemberResponse
+	(*JoinRequestResponse)(nil),          // 30: groups.JoinRequestResponse
+	(*GetJoinRequestsResponse)(nil),      // 31: groups.GetJoinRequestsResponse
+	(*GroupMemberResponse)(nil),          // 32: groups.GroupMemberResponse
+	(*GetGroupMembersResponse)(nil),      // 33: groups.GetGroupMembersResponse
+	(*GroupPostResponse)(nil),            // 34: groups.GroupPostResponse
+	(*GetGroupPostsResponse)(nil),        // 35: groups.GetGroupPostsResponse
+	(*DeleteGroupPostResponse)(nil),      // 36: groups.DeleteGroupPostResponse
+	(*GroupPostLikeResponse)(nil),        // 37: groups.GroupPostLikeResponse
+	(*GroupPostCommentResponse)(nil),     // 38: groups.GroupPostCommentResponse
+	(*GetGroupPostCommentsResponse)(nil), // 39: groups.GetGroupPostCommentsResponse
 }
 var file_groups_groups_proto_depIdxs = []int32{
-	10, // 0: groups.GetGroupsResponse.groups:type_name -> groups.GroupResponse
-	15, // 1: groups.GetGroupMembersResponse.members:type_name -> groups.GroupMemberResponse
-	17, // 2: groups.GetGroupPostsResponse.posts:type_name -> groups.GroupPostResponse
-	0,  // 3: groups.GroupService.CreateGroup:input_type -> groups.CreateGroupRequest
-	1,  // 4: groups.GroupService.GetGroup:input_type -> groups.GetGroupRequest
-	2,  // 5: groups.GroupService.GetGroups:input_type -> groups.GetGroupsRequest
-	3,  // 6: groups.GroupService.UpdateGroup:input_type -> groups.UpdateGroupRequest
-	4,  // 7: groups.GroupService.DeleteGroup:input_type -> groups.DeleteGroupRequest
-	5,  // 8: groups.GroupService.JoinGroup:input_type -> groups.JoinGroupRequest
-	6,  // 9: groups.GroupService.LeaveGroup:input_type -> groups.LeaveGroupRequest
-	7,  // 10: groups.GroupService.GetGroupMembers:input_type -> groups.GetGroupMembersRequest
-	8,  // 11: groups.GroupService.CreateGroupPost:input_type -> groups.CreateGroupPostRequest
-	9,  // 12: groups.GroupService.GetGroupPosts:input_type -> groups.GetGroupPostsRequest
-	10, // 13: groups.GroupService.CreateGroup:output_type -> groups.GroupResponse
-	10, // 14: groups.GroupService.GetGroup:output_type -> groups.GroupResponse
-	11, // 15: groups.GroupService.GetGroups:output_type -> groups.GetGroupsResponse
-	10, // 16: groups.GroupService.UpdateGroup:output_type -> groups.GroupResponse
-	12, // 17: groups.GroupService.DeleteGroup:output_type -> groups.DeleteGroupResponse
-	13, // 18: groups.GroupService.JoinGroup:output_type -> groups.JoinGroupResponse
-	14, // 19: groups.GroupService.LeaveGroup:output_type -> groups.LeaveGroupResponse
-	16, // 20: groups.GroupService.GetGroupMembers:output_type -> groups.GetGroupMembersResponse
-	17, // 21: groups.GroupService.CreateGroupPost:output_type -> groups.GroupPostResponse
-	18, // 22: groups.GroupService.GetGroupPosts:output_type -> groups.GetGroupPostsResponse
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	23, // 0: groups.GetGroupsResponse.groups:type_name -> groups.GroupResponse
+	30, // 1: groups.GetJoinRequestsResponse.requests:type_name -> groups.JoinRequestResponse
+	32, // 2: groups.GetGroupMembersResponse.members:type_name -> groups.GroupMemberResponse
+	34, // 3: groups.GetGroupPostsResponse.posts:type_name -> groups.GroupPostResponse
+	38, // 4: groups.GetGroupPostCommentsResponse.comments:type_name -> groups.GroupPostCommentResponse
+	0,  // 5: groups.GroupService.CreateGroup:input_type -> groups.CreateGroupRequest
+	1,  // 6: groups.GroupService.GetGroup:input_type -> groups.GetGroupRequest
+	2,  // 7: groups.GroupService.GetGroups:input_type -> groups.GetGroupsRequest
+	3,  // 8: groups.GroupService.GetUserGroups:input_type -> groups.GetUserGroupsRequest
+	4,  // 9: groups.GroupService.UpdateGroup:input_type -> groups.UpdateGroupRequest
+	5,  // 10: groups.GroupService.DeleteGroup:input_type -> groups.DeleteGroupRequest
+	6,  // 11: groups.GroupService.JoinGroup:input_type -> groups.JoinGroupRequest
+	7,  // 12: groups.GroupService.LeaveGroup:input_type -> groups.LeaveGroupRequest
+	8,  // 13: groups.GroupService.GetGroupMembers:input_type -> groups.GetGroupMembersRequest
+	9,  // 14: groups.GroupService.StreamGroupMembers:input_type -> groups.StreamGroupMembersRequest
+	10, // 15: groups.GroupService.CheckMembership:input_type -> groups.CheckMembershipRequest
+	11, // 16: groups.GroupService.PromoteMember:input_type -> groups.ChangeMemberRoleRequest
+	11, // 17: groups.GroupService.DemoteMember:input_type -> groups.ChangeMemberRoleRequest
+	12, // 18: groups.GroupService.RemoveMember:input_type -> groups.RemoveMemberRequest
+	13, // 19: groups.GroupService.TransferOwnership:input_type -> groups.TransferOwnershipRequest
+	14, // 20: groups.GroupService.GetJoinRequests:input_type -> groups.GetJoinRequestsRequest
+	15, // 21: groups.GroupService.ApproveJoinRequest:input_type -> groups.ReviewJoinRequestRequest
+	15, // 22: groups.GroupService.RejectJoinRequest:input_type -> groups.ReviewJoinRequestRequest
+	16, // 23: groups.GroupService.CreateGroupPost:input_type -> groups.CreateGroupPostRequest
+	17, // 24: groups.GroupService.GetGroupPosts:input_type -> groups.GetGroupPostsRequest
+	18, // 25: groups.GroupService.UpdateGroupPost:input_type -> groups.UpdateGroupPostRequest
+	19, // 26: groups.GroupService.DeleteGroupPost:input_type -> groups.DeleteGroupPostRequest
+	20, // 27: groups.GroupService.LikeGroupPost:input_type -> groups.GroupPostLikeRequest
+	20, // 28: groups.GroupService.UnlikeGroupPost:input_type -> groups.GroupPostLikeRequest
+	21, // 29: groups.GroupService.AddGroupPostComment:input_type -> groups.AddGroupPostCommentRequest
+	22, // 30: groups.GroupService.GetGroupPostComments:input_type -> groups.GetGroupPostCommentsRequest
+	23, // 31: groups.GroupService.CreateGroup:output_type -> groups.GroupResponse
+	23, // 32: groups.GroupService.GetGroup:output_type -> groups.GroupResponse
+	24, // 33: groups.GroupService.GetGroups:output_type -> groups.GetGroupsResponse
+	24, // 34: groups.GroupService.GetUserGroups:output_type -> groups.GetGroupsResponse
+	23, // 35: groups.GroupService.UpdateGroup:output_type -> groups.GroupResponse
+	25, // 36: groups.GroupService.DeleteGroup:output_type -> groups.DeleteGroupResponse
+	26, // 37: groups.GroupService.JoinGroup:output_type -> groups.JoinGroupResponse
+	27, // 38: groups.GroupService.LeaveGroup:output_type -> groups.LeaveGroupResponse
+	33, // 39: groups.GroupService.GetGroupMembers:output_type -> groups.GetGroupMembersResponse
+	33, // 40: groups.GroupService.StreamGroupMembers:output_type -> groups.GetGroupMembersResponse
+	28, // 41: groups.GroupService.CheckMembership:output_type -> groups.CheckMembershipResponse
+	32, // 42: groups.GroupService.PromoteMember:output_type -> groups.GroupMemberResponse
+	32, // 43: groups.GroupService.DemoteMember:output_type -> groups.GroupMemberResponse
+	29, // 44: groups.GroupService.RemoveMember:output_type -> groups.RemoveMemberResponse
+	32, // 45: groups.GroupService.TransferOwnership:output_type -> groups.GroupMemberResponse
+	31, // 46: groups.GroupService.GetJoinRequests:output_type -> groups.GetJoinRequestsResponse
+	30, // 47: groups.GroupService.ApproveJoinRequest:output_type -> groups.JoinRequestResponse
+	30, // 48: groups.GroupService.RejectJoinRequest:output_type -> groups.JoinRequestResponse
+	34, // 49: groups.GroupService.CreateGroupPost:output_type -> groups.GroupPostResponse
+	35, // 50: groups.GroupService.GetGroupPosts:output_type -> groups.GetGroupPostsResponse
+	34, // 51: groups.GroupService.UpdateGroupPost:output_type -> groups.GroupPostResponse
+	36, // 52: groups.GroupService.DeleteGroupPost:output_type -> groups.DeleteGroupPostResponse
+	37, // 53: groups.GroupService.LikeGroupPost:output_type -> groups.GroupPostLikeResponse
+	37, // 54: groups.GroupService.UnlikeGroupPost:output_type -> groups.GroupPostLikeResponse
+	38, // 55: groups.GroupService.AddGroupPostComment:output_type -> groups.GroupPostCommentResponse
+	39, // 56: groups.GroupService.GetGroupPostComments:output_type -> groups.GetGroupPostCommentsResponse
+	31, // [31:57] is the sub-list for method output_type
+	5,  // [5:31] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_groups_groups_proto_init() }
@@ -1623,7 +3324,7 @@ func file_groups_groups_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_groups_groups_proto_rawDesc), len(file_groups_groups_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
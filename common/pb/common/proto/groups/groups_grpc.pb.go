@@ -19,16 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GroupService_CreateGroup_FullMethodName     = "/groups.GroupService/CreateGroup"
-	GroupService_GetGroup_FullMethodName        = "/groups.GroupService/GetGroup"
-	GroupService_GetGroups_FullMethodName       = "/groups.GroupService/GetGroups"
-	GroupService_UpdateGroup_FullMethodName     = "/groups.GroupService/UpdateGroup"
-	GroupService_DeleteGroup_FullMethodName     = "/groups.GroupService/DeleteGroup"
-	GroupService_JoinGroup_FullMethodName       = "/groups.GroupService/JoinGroup"
-	GroupService_LeaveGroup_FullMethodName      = "/groups.GroupService/LeaveGroup"
-	GroupService_GetGroupMembers_FullMethodName = "/groups.GroupService/GetGroupMembers"
-	GroupService_CreateGroupPost_FullMethodName = "/groups.GroupService/CreateGroupPost"
-	GroupService_GetGroupPosts_FullMethodName   = "/groups.GroupService/GetGroupPosts"
+	GroupService_CreateGroup_FullMethodName          = "/groups.GroupService/CreateGroup"
+	GroupService_GetGroup_FullMethodName             = "/groups.GroupService/GetGroup"
+	GroupService_GetGroups_FullMethodName            = "/groups.GroupService/GetGroups"
+	GroupService_GetUserGroups_FullMethodName        = "/groups.GroupService/GetUserGroups"
+	GroupService_UpdateGroup_FullMethodName          = "/groups.GroupService/UpdateGroup"
+	GroupService_DeleteGroup_FullMethodName          = "/groups.GroupService/DeleteGroup"
+	GroupService_JoinGroup_FullMethodName            = "/groups.GroupService/JoinGroup"
+	GroupService_LeaveGroup_FullMethodName           = "/groups.GroupService/LeaveGroup"
+	GroupService_GetGroupMembers_FullMethodName      = "/groups.GroupService/GetGroupMembers"
+	GroupService_StreamGroupMembers_FullMethodName   = "/groups.GroupService/StreamGroupMembers"
+	GroupService_CheckMembership_FullMethodName      = "/groups.GroupService/CheckMembership"
+	GroupService_PromoteMember_FullMethodName        = "/groups.GroupService/PromoteMember"
+	GroupService_DemoteMember_FullMethodName         = "/groups.GroupService/DemoteMember"
+	GroupService_RemoveMember_FullMethodName         = "/groups.GroupService/RemoveMember"
+	GroupService_TransferOwnership_FullMethodName    = "/groups.GroupService/TransferOwnership"
+	GroupService_GetJoinRequests_FullMethodName      = "/groups.GroupService/GetJoinRequests"
+	GroupService_ApproveJoinRequest_FullMethodName   = "/groups.GroupService/ApproveJoinRequest"
+	GroupService_RejectJoinRequest_FullMethodName    = "/groups.GroupService/RejectJoinRequest"
+	GroupService_CreateGroupPost_FullMethodName      = "/groups.GroupService/CreateGroupPost"
+	GroupService_GetGroupPosts_FullMethodName        = "/groups.GroupService/GetGroupPosts"
+	GroupService_UpdateGroupPost_FullMethodName      = "/groups.GroupService/UpdateGroupPost"
+	GroupService_DeleteGroupPost_FullMethodName      = "/groups.GroupService/DeleteGroupPost"
+	GroupService_LikeGroupPost_FullMethodName        = "/groups.GroupService/LikeGroupPost"
+	GroupService_UnlikeGroupPost_FullMethodName      = "/groups.GroupService/UnlikeGroupPost"
+	GroupService_AddGroupPostComment_FullMethodName  = "/groups.GroupService/AddGroupPostComment"
+	GroupService_GetGroupPostComments_FullMethodName = "/groups.GroupService/GetGroupPostComments"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -43,6 +59,8 @@ type GroupServiceClient interface {
 	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
 	// GetGroups retrieves groups with pagination and filtering
 	GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
+	// GetUserGroups retrieves the groups the requesting user is a member of, with their role in each
+	GetUserGroups(ctx context.Context, in *GetUserGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
 	// UpdateGroup updates a group
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
 	// DeleteGroup deletes a group
@@ -53,10 +71,40 @@ type GroupServiceClient interface {
 	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error)
 	// GetGroupMembers retrieves members of a group
 	GetGroupMembers(ctx context.Context, in *GetGroupMembersRequest, opts ...grpc.CallOption) (*GetGroupMembersResponse, error)
+	// StreamGroupMembers streams all members of a group, one page per message, for exports
+	StreamGroupMembers(ctx context.Context, in *StreamGroupMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGroupMembersResponse], error)
+	// CheckMembership reports whether a user is a member of a group
+	CheckMembership(ctx context.Context, in *CheckMembershipRequest, opts ...grpc.CallOption) (*CheckMembershipResponse, error)
+	// PromoteMember makes a group member an admin
+	PromoteMember(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*GroupMemberResponse, error)
+	// DemoteMember makes a group admin a regular member
+	DemoteMember(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*GroupMemberResponse, error)
+	// RemoveMember removes another member from a group
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// TransferOwnership makes another member the creator of a group, demoting the current creator to admin
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*GroupMemberResponse, error)
+	// GetJoinRequests retrieves the pending join requests of a private group
+	GetJoinRequests(ctx context.Context, in *GetJoinRequestsRequest, opts ...grpc.CallOption) (*GetJoinRequestsResponse, error)
+	// ApproveJoinRequest accepts a join request and adds the requester to the group
+	ApproveJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error)
+	// RejectJoinRequest declines a join request
+	RejectJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error)
 	// CreateGroupPost creates a post in a group
 	CreateGroupPost(ctx context.Context, in *CreateGroupPostRequest, opts ...grpc.CallOption) (*GroupPostResponse, error)
 	// GetGroupPosts retrieves posts in a group
 	GetGroupPosts(ctx context.Context, in *GetGroupPostsRequest, opts ...grpc.CallOption) (*GetGroupPostsResponse, error)
+	// UpdateGroupPost edits a post in a group
+	UpdateGroupPost(ctx context.Context, in *UpdateGroupPostRequest, opts ...grpc.CallOption) (*GroupPostResponse, error)
+	// DeleteGroupPost deletes a post in a group with its media, likes and comments
+	DeleteGroupPost(ctx context.Context, in *DeleteGroupPostRequest, opts ...grpc.CallOption) (*DeleteGroupPostResponse, error)
+	// LikeGroupPost likes a post in a group
+	LikeGroupPost(ctx context.Context, in *GroupPostLikeRequest, opts ...grpc.CallOption) (*GroupPostLikeResponse, error)
+	// UnlikeGroupPost removes the user's like from a post in a group
+	UnlikeGroupPost(ctx context.Context, in *GroupPostLikeRequest, opts ...grpc.CallOption) (*GroupPostLikeResponse, error)
+	// AddGroupPostComment adds a comment to a post in a group
+	AddGroupPostComment(ctx context.Context, in *AddGroupPostCommentRequest, opts ...grpc.CallOption) (*GroupPostCommentResponse, error)
+	// GetGroupPostComments retrieves the comments on a post in a group
+	GetGroupPostComments(ctx context.Context, in *GetGroupPostCommentsRequest, opts ...grpc.CallOption) (*GetGroupPostCommentsResponse, error)
 }
 
 type groupServiceClient struct {
@@ -97,6 +145,16 @@ func (c *groupServiceClient) GetGroups(ctx context.Context, in *GetGroupsRequest
 	return out, nil
 }
 
+func (c *groupServiceClient) GetUserGroups(ctx context.Context, in *GetUserGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetUserGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GroupResponse)
@@ -147,6 +205,105 @@ func (c *groupServiceClient) GetGroupMembers(ctx context.Context, in *GetGroupMe
 	return out, nil
 }
 
+func (c *groupServiceClient) StreamGroupMembers(ctx context.Context, in *StreamGroupMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGroupMembersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GroupService_ServiceDesc.Streams[0], GroupService_StreamGroupMembers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamGroupMembersRequest, GetGroupMembersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_StreamGroupMembersClient = grpc.ServerStreamingClient[GetGroupMembersResponse]
+
+func (c *groupServiceClient) CheckMembership(ctx context.Context, in *CheckMembershipRequest, opts ...grpc.CallOption) (*CheckMembershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckMembershipResponse)
+	err := c.cc.Invoke(ctx, GroupService_CheckMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) PromoteMember(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*GroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMemberResponse)
+	err := c.cc.Invoke(ctx, GroupService_PromoteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) DemoteMember(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*GroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMemberResponse)
+	err := c.cc.Invoke(ctx, GroupService_DemoteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, GroupService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*GroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupMemberResponse)
+	err := c.cc.Invoke(ctx, GroupService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetJoinRequests(ctx context.Context, in *GetJoinRequestsRequest, opts ...grpc.CallOption) (*GetJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ApproveJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestResponse)
+	err := c.cc.Invoke(ctx, GroupService_ApproveJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RejectJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestResponse)
+	err := c.cc.Invoke(ctx, GroupService_RejectJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupServiceClient) CreateGroupPost(ctx context.Context, in *CreateGroupPostRequest, opts ...grpc.CallOption) (*GroupPostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GroupPostResponse)
@@ -167,6 +324,66 @@ func (c *groupServiceClient) GetGroupPosts(ctx context.Context, in *GetGroupPost
 	return out, nil
 }
 
+func (c *groupServiceClient) UpdateGroupPost(ctx context.Context, in *UpdateGroupPostRequest, opts ...grpc.CallOption) (*GroupPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupPostResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateGroupPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) DeleteGroupPost(ctx context.Context, in *DeleteGroupPostRequest, opts ...grpc.CallOption) (*DeleteGroupPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupPostResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteGroupPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) LikeGroupPost(ctx context.Context, in *GroupPostLikeRequest, opts ...grpc.CallOption) (*GroupPostLikeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupPostLikeResponse)
+	err := c.cc.Invoke(ctx, GroupService_LikeGroupPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UnlikeGroupPost(ctx context.Context, in *GroupPostLikeRequest, opts ...grpc.CallOption) (*GroupPostLikeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupPostLikeResponse)
+	err := c.cc.Invoke(ctx, GroupService_UnlikeGroupPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) AddGroupPostComment(ctx context.Context, in *AddGroupPostCommentRequest, opts ...grpc.CallOption) (*GroupPostCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupPostCommentResponse)
+	err := c.cc.Invoke(ctx, GroupService_AddGroupPostComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupPostComments(ctx context.Context, in *GetGroupPostCommentsRequest, opts ...grpc.CallOption) (*GetGroupPostCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupPostCommentsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupPostComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
@@ -179,6 +396,8 @@ type GroupServiceServer interface {
 	GetGroup(context.Context, *GetGroupRequest) (*GroupResponse, error)
 	// GetGroups retrieves groups with pagination and filtering
 	GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error)
+	// GetUserGroups retrieves the groups the requesting user is a member of, with their role in each
+	GetUserGroups(context.Context, *GetUserGroupsRequest) (*GetGroupsResponse, error)
 	// UpdateGroup updates a group
 	UpdateGroup(context.Context, *UpdateGroupRequest) (*GroupResponse, error)
 	// DeleteGroup deletes a group
@@ -189,10 +408,40 @@ type GroupServiceServer interface {
 	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupResponse, error)
 	// GetGroupMembers retrieves members of a group
 	GetGroupMembers(context.Context, *GetGroupMembersRequest) (*GetGroupMembersResponse, error)
+	// StreamGroupMembers streams all members of a group, one page per message, for exports
+	StreamGroupMembers(*StreamGroupMembersRequest, grpc.ServerStreamingServer[GetGroupMembersResponse]) error
+	// CheckMembership reports whether a user is a member of a group
+	CheckMembership(context.Context, *CheckMembershipRequest) (*CheckMembershipResponse, error)
+	// PromoteMember makes a group member an admin
+	PromoteMember(context.Context, *ChangeMemberRoleRequest) (*GroupMemberResponse, error)
+	// DemoteMember makes a group admin a regular member
+	DemoteMember(context.Context, *ChangeMemberRoleRequest) (*GroupMemberResponse, error)
+	// RemoveMember removes another member from a group
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// TransferOwnership makes another member the creator of a group, demoting the current creator to admin
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*GroupMemberResponse, error)
+	// GetJoinRequests retrieves the pending join requests of a private group
+	GetJoinRequests(context.Context, *GetJoinRequestsRequest) (*GetJoinRequestsResponse, error)
+	// ApproveJoinRequest accepts a join request and adds the requester to the group
+	ApproveJoinRequest(context.Context, *ReviewJoinRequestRequest) (*JoinRequestResponse, error)
+	// RejectJoinRequest declines a join request
+	RejectJoinRequest(context.Context, *ReviewJoinRequestRequest) (*JoinRequestResponse, error)
 	// CreateGroupPost creates a post in a group
 	CreateGroupPost(context.Context, *CreateGroupPostRequest) (*GroupPostResponse, error)
 	// GetGroupPosts retrieves posts in a group
 	GetGroupPosts(context.Context, *GetGroupPostsRequest) (*GetGroupPostsResponse, error)
+	// UpdateGroupPost edits a post in a group
+	UpdateGroupPost(context.Context, *UpdateGroupPostRequest) (*GroupPostResponse, error)
+	// DeleteGroupPost deletes a post in a group with its media, likes and comments
+	DeleteGroupPost(context.Context, *DeleteGroupPostRequest) (*DeleteGroupPostResponse, error)
+	// LikeGroupPost likes a post in a group
+	LikeGroupPost(context.Context, *GroupPostLikeRequest) (*GroupPostLikeResponse, error)
+	// UnlikeGroupPost removes the user's like from a post in a group
+	UnlikeGroupPost(context.Context, *GroupPostLikeRequest) (*GroupPostLikeResponse, error)
+	// AddGroupPostComment adds a comment to a post in a group
+	AddGroupPostComment(context.Context, *AddGroupPostCommentRequest) (*GroupPostCommentResponse, error)
+	// GetGroupPostComments retrieves the comments on a post in a group
+	GetGroupPostComments(context.Context, *GetGroupPostCommentsRequest) (*GetGroupPostCommentsResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
 }
 
@@ -212,6 +461,9 @@ func (UnimplementedGroupServiceServer) GetGroup(context.Context, *GetGroupReques
 func (UnimplementedGroupServiceServer) GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
 }
+func (UnimplementedGroupServiceServer) GetUserGroups(context.Context, *GetUserGroupsRequest) (*GetGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserGroups not implemented")
+}
 func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*GroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
 }
@@ -227,12 +479,57 @@ func (UnimplementedGroupServiceServer) LeaveGroup(context.Context, *LeaveGroupRe
 func (UnimplementedGroupServiceServer) GetGroupMembers(context.Context, *GetGroupMembersRequest) (*GetGroupMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMembers not implemented")
 }
+func (UnimplementedGroupServiceServer) StreamGroupMembers(*StreamGroupMembersRequest, grpc.ServerStreamingServer[GetGroupMembersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGroupMembers not implemented")
+}
+func (UnimplementedGroupServiceServer) CheckMembership(context.Context, *CheckMembershipRequest) (*CheckMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMembership not implemented")
+}
+func (UnimplementedGroupServiceServer) PromoteMember(context.Context, *ChangeMemberRoleRequest) (*GroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteMember not implemented")
+}
+func (UnimplementedGroupServiceServer) DemoteMember(context.Context, *ChangeMemberRoleRequest) (*GroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteMember not implemented")
+}
+func (UnimplementedGroupServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedGroupServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*GroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedGroupServiceServer) GetJoinRequests(context.Context, *GetJoinRequestsRequest) (*GetJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinRequests not implemented")
+}
+func (UnimplementedGroupServiceServer) ApproveJoinRequest(context.Context, *ReviewJoinRequestRequest) (*JoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedGroupServiceServer) RejectJoinRequest(context.Context, *ReviewJoinRequestRequest) (*JoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
 func (UnimplementedGroupServiceServer) CreateGroupPost(context.Context, *CreateGroupPostRequest) (*GroupPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupPost not implemented")
 }
 func (UnimplementedGroupServiceServer) GetGroupPosts(context.Context, *GetGroupPostsRequest) (*GetGroupPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupPosts not implemented")
 }
+func (UnimplementedGroupServiceServer) UpdateGroupPost(context.Context, *UpdateGroupPostRequest) (*GroupPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPost not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteGroupPost(context.Context, *DeleteGroupPostRequest) (*DeleteGroupPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupPost not implemented")
+}
+func (UnimplementedGroupServiceServer) LikeGroupPost(context.Context, *GroupPostLikeRequest) (*GroupPostLikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeGroupPost not implemented")
+}
+func (UnimplementedGroupServiceServer) UnlikeGroupPost(context.Context, *GroupPostLikeRequest) (*GroupPostLikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeGroupPost not implemented")
+}
+func (UnimplementedGroupServiceServer) AddGroupPostComment(context.Context, *AddGroupPostCommentRequest) (*GroupPostCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupPostComment not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupPostComments(context.Context, *GetGroupPostCommentsRequest) (*GetGroupPostCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupPostComments not implemented")
+}
 func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
 func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
@@ -308,6 +605,24 @@ func _GroupService_GetGroups_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetUserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetUserGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetUserGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetUserGroups(ctx, req.(*GetUserGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateGroupRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +713,161 @@ func _GroupService_GetGroupMembers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_StreamGroupMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGroupMembersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GroupServiceServer).StreamGroupMembers(m, &grpc.GenericServerStream[StreamGroupMembersRequest, GetGroupMembersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_StreamGroupMembersServer = grpc.ServerStreamingServer[GetGroupMembersResponse]
+
+func _GroupService_CheckMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CheckMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CheckMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CheckMembership(ctx, req.(*CheckMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_PromoteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).PromoteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_PromoteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).PromoteMember(ctx, req.(*ChangeMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DemoteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DemoteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DemoteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DemoteMember(ctx, req.(*ChangeMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetJoinRequests(ctx, req.(*GetJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ApproveJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ApproveJoinRequest(ctx, req.(*ReviewJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RejectJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RejectJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RejectJoinRequest(ctx, req.(*ReviewJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GroupService_CreateGroupPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGroupPostRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +904,114 @@ func _GroupService_GetGroupPosts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_UpdateGroupPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateGroupPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateGroupPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateGroupPost(ctx, req.(*UpdateGroupPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteGroupPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteGroupPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteGroupPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteGroupPost(ctx, req.(*DeleteGroupPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_LikeGroupPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupPostLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).LikeGroupPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_LikeGroupPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).LikeGroupPost(ctx, req.(*GroupPostLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UnlikeGroupPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupPostLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UnlikeGroupPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UnlikeGroupPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UnlikeGroupPost(ctx, req.(*GroupPostLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddGroupPostComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupPostCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddGroupPostComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddGroupPostComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddGroupPostComment(ctx, req.(*AddGroupPostCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupPostComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupPostCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupPostComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupPostComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupPostComments(ctx, req.(*GetGroupPostCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -453,6 +1031,10 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroups",
 			Handler:    _GroupService_GetGroups_Handler,
 		},
+		{
+			MethodName: "GetUserGroups",
+			Handler:    _GroupService_GetUserGroups_Handler,
+		},
 		{
 			MethodName: "UpdateGroup",
 			Handler:    _GroupService_UpdateGroup_Handler,
@@ -473,6 +1055,38 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupMembers",
 			Handler:    _GroupService_GetGroupMembers_Handler,
 		},
+		{
+			MethodName: "CheckMembership",
+			Handler:    _GroupService_CheckMembership_Handler,
+		},
+		{
+			MethodName: "PromoteMember",
+			Handler:    _GroupService_PromoteMember_Handler,
+		},
+		{
+			MethodName: "DemoteMember",
+			Handler:    _GroupService_DemoteMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _GroupService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _GroupService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "GetJoinRequests",
+			Handler:    _GroupService_GetJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _GroupService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _GroupService_RejectJoinRequest_Handler,
+		},
 		{
 			MethodName: "CreateGroupPost",
 			Handler:    _GroupService_CreateGroupPost_Handler,
@@ -481,7 +1095,37 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupPosts",
 			Handler:    _GroupService_GetGroupPosts_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPost",
+			Handler:    _GroupService_UpdateGroupPost_Handler,
+		},
+		{
+			MethodName: "DeleteGroupPost",
+			Handler:    _GroupService_DeleteGroupPost_Handler,
+		},
+		{
+			MethodName: "LikeGroupPost",
+			Handler:    _GroupService_LikeGroupPost_Handler,
+		},
+		{
+			MethodName: "UnlikeGroupPost",
+			Handler:    _GroupService_UnlikeGroupPost_Handler,
+		},
+		{
+			MethodName: "AddGroupPostComment",
+			Handler:    _GroupService_AddGroupPostComment_Handler,
+		},
+		{
+			MethodName: "GetGroupPostComments",
+			Handler:    _GroupService_GetGroupPostComments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGroupMembers",
+			Handler:       _GroupService_StreamGroupMembers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "groups/groups.proto",
 }
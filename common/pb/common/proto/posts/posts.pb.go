@@ -28,14 +28,17 @@ type CreatePostRequest struct {
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Content is the content of the post
 	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	// Visibility is the visibility of the post (public or private)
+	// Visibility is the visibility of the post: public, friends (the author and the author's friends)
+	// or private (the author only)
 	Visibility string `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	// GroupId is the ID of the group if the post is in a group (optional)
 	GroupId string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	// Media is an array of media URLs (optional)
-	Media         []string `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Media []string `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
+	// IdempotencyKey makes retries of the request return the post created first (optional)
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreatePostRequest) Reset() {
@@ -103,6 +106,13 @@ func (x *CreatePostRequest) GetMedia() []string {
 	return nil
 }
 
+func (x *CreatePostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // GetPostRequest is the request for retrieving a post
 type GetPostRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -172,7 +182,13 @@ type GetPostsRequest struct {
 	// Page is the page number for pagination
 	Page int32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
 	// Limit is the number of posts per page
-	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor is the next_cursor of a previous response; when set, page is ignored
+	// and the feed continues after that position (optional, feeds only)
+	Cursor string `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Feed selects the posts of a feed: following for only the posts of users the user
+	// follows; every post visible to the user when empty (optional, feeds only)
+	Feed          string `protobuf:"bytes,8,opt,name=feed,proto3" json:"feed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -249,6 +265,93 @@ func (x *GetPostsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetPostsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetPostsRequest) GetFeed() string {
+	if x != nil {
+		return x.Feed
+	}
+	return ""
+}
+
+// GetPostsByHashtagRequest is the request for retrieving posts tagged with a hashtag
+type GetPostsByHashtagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tag is the hashtag to look up, with or without the leading #
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// UserId is the ID of the user making the request (optional)
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of posts per page
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsByHashtagRequest) Reset() {
+	*x = GetPostsByHashtagRequest{}
+	mi := &file_posts_posts_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsByHashtagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsByHashtagRequest) ProtoMessage() {}
+
+func (x *GetPostsByHashtagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsByHashtagRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsByHashtagRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPostsByHashtagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetPostsByHashtagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPostsByHashtagRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPostsByHashtagRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 // UpdatePostRequest is the request for updating a post
 type UpdatePostRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -258,7 +361,7 @@ type UpdatePostRequest struct {
 	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Content is the updated content of the post
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	// Visibility is the updated visibility of the post (optional)
+	// Visibility is the updated visibility of the post, public, friends or private (optional)
 	Visibility string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	// Media is an array of updated media URLs (optional)
 	Media         []string `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
@@ -268,7 +371,7 @@ type UpdatePostRequest struct {
 
 func (x *UpdatePostRequest) Reset() {
 	*x = UpdatePostRequest{}
-	mi := &file_posts_posts_proto_msgTypes[3]
+	mi := &file_posts_posts_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +383,7 @@ func (x *UpdatePostRequest) String() string {
 func (*UpdatePostRequest) ProtoMessage() {}
 
 func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[3]
+	mi := &file_posts_posts_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +396,7 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{3}
+	return file_posts_posts_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdatePostRequest) GetPostId() string {
@@ -331,6 +434,70 @@ func (x *UpdatePostRequest) GetMedia() []string {
 	return nil
 }
 
+// SharePostRequest is the request for resharing a post
+type SharePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post to share
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user sharing the post
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Comment is the user's own text shown above the shared post (optional)
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharePostRequest) Reset() {
+	*x = SharePostRequest{}
+	mi := &file_posts_posts_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharePostRequest) ProtoMessage() {}
+
+func (x *SharePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharePostRequest.ProtoReflect.Descriptor instead.
+func (*SharePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SharePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *SharePostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SharePostRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
 // DeletePostRequest is the request for deleting a post
 type DeletePostRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -344,7 +511,7 @@ type DeletePostRequest struct {
 
 func (x *DeletePostRequest) Reset() {
 	*x = DeletePostRequest{}
-	mi := &file_posts_posts_proto_msgTypes[4]
+	mi := &file_posts_posts_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -356,7 +523,7 @@ func (x *DeletePostRequest) String() string {
 func (*DeletePostRequest) ProtoMessage() {}
 
 func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[4]
+	mi := &file_posts_posts_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +536,7 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
 func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{4}
+	return file_posts_posts_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeletePostRequest) GetPostId() string {
@@ -386,6 +553,61 @@ func (x *DeletePostRequest) GetUserId() string {
 	return ""
 }
 
+// RestorePostRequest is the request for restoring a deleted post
+type RestorePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user restoring the post
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_posts_posts_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRequest) ProtoMessage() {}
+
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestorePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *RestorePostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 // AddCommentRequest is the request for adding a comment to a post
 type AddCommentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -394,14 +616,18 @@ type AddCommentRequest struct {
 	// UserId is the ID of the user adding the comment
 	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Content is the content of the comment
-	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// ParentCommentId is the ID of the comment being replied to (optional)
+	ParentCommentId string `protobuf:"bytes,4,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	// IdempotencyKey makes retries of the request return the comment created first (optional)
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AddCommentRequest) Reset() {
 	*x = AddCommentRequest{}
-	mi := &file_posts_posts_proto_msgTypes[5]
+	mi := &file_posts_posts_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +639,7 @@ func (x *AddCommentRequest) String() string {
 func (*AddCommentRequest) ProtoMessage() {}
 
 func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[5]
+	mi := &file_posts_posts_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +652,7 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
 func (*AddCommentRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{5}
+	return file_posts_posts_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddCommentRequest) GetPostId() string {
@@ -450,6 +676,20 @@ func (x *AddCommentRequest) GetContent() string {
 	return ""
 }
 
+func (x *AddCommentRequest) GetParentCommentId() string {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // GetCommentsRequest is the request for retrieving comments for a post
 type GetCommentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -458,14 +698,19 @@ type GetCommentsRequest struct {
 	// Page is the page number for pagination
 	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	// Limit is the number of comments per page
-	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Threaded returns top-level comments with their replies nested beneath them
+	Threaded bool `protobuf:"varint,4,opt,name=threaded,proto3" json:"threaded,omitempty"`
+	// UserId is the ID of the user viewing the comments, if signed in; comments by users they
+	// have blocked or been blocked by are left out
+	UserId        string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCommentsRequest) Reset() {
 	*x = GetCommentsRequest{}
-	mi := &file_posts_posts_proto_msgTypes[6]
+	mi := &file_posts_posts_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -477,7 +722,7 @@ func (x *GetCommentsRequest) String() string {
 func (*GetCommentsRequest) ProtoMessage() {}
 
 func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[6]
+	mi := &file_posts_posts_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +735,7 @@ func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentsRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{6}
+	return file_posts_posts_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCommentsRequest) GetPostId() string {
@@ -514,34 +759,50 @@ func (x *GetCommentsRequest) GetLimit() int32 {
 	return 0
 }
 
-// DeleteCommentRequest is the request for deleting a comment
-type DeleteCommentRequest struct {
+func (x *GetCommentsRequest) GetThreaded() bool {
+	if x != nil {
+		return x.Threaded
+	}
+	return false
+}
+
+func (x *GetCommentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// EditCommentRequest is the request for editing a comment
+type EditCommentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// CommentId is the ID of the comment
 	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// UserId is the ID of the user deleting the comment
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// PostId is the ID of the post
-	PostId        string `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user editing the comment
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Content is the updated content of the comment
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
-	mi := &file_posts_posts_proto_msgTypes[7]
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_posts_posts_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *EditCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*EditCommentRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[7]
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,46 +813,119 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteCommentRequest) GetCommentId() string {
+func (x *EditCommentRequest) GetCommentId() string {
 	if x != nil {
 		return x.CommentId
 	}
 	return ""
 }
 
-func (x *DeleteCommentRequest) GetUserId() string {
+func (x *EditCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteCommentRequest) GetPostId() string {
+func (x *EditCommentRequest) GetContent() string {
 	if x != nil {
-		return x.PostId
+		return x.Content
 	}
 	return ""
 }
 
-// LikePostRequest is the request for liking a post
-type LikePostRequest struct {
+// DeleteCommentRequest is the request for deleting a comment
+type DeleteCommentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// CommentId is the ID of the comment
+	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// UserId is the ID of the user deleting the comment
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// PostId is the ID of the post
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	// UserId is the ID of the user liking the post
-	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId        string `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostRequest) Reset() {
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_posts_posts_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// LikePostRequest is the request for liking a post
+type LikePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user liking the post
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// ReactionType is the reaction to set (like, love, laugh, angry or sad), defaults to like
+	ReactionType  string `protobuf:"bytes,3,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikePostRequest) Reset() {
 	*x = LikePostRequest{}
-	mi := &file_posts_posts_proto_msgTypes[8]
+	mi := &file_posts_posts_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +937,7 @@ func (x *LikePostRequest) String() string {
 func (*LikePostRequest) ProtoMessage() {}
 
 func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[8]
+	mi := &file_posts_posts_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +950,7 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
 func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{8}
+	return file_posts_posts_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LikePostRequest) GetPostId() string {
@@ -633,6 +967,13 @@ func (x *LikePostRequest) GetUserId() string {
 	return ""
 }
 
+func (x *LikePostRequest) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
 // UnlikePostRequest is the request for unliking a post
 type UnlikePostRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -646,7 +987,7 @@ type UnlikePostRequest struct {
 
 func (x *UnlikePostRequest) Reset() {
 	*x = UnlikePostRequest{}
-	mi := &file_posts_posts_proto_msgTypes[9]
+	mi := &file_posts_posts_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -658,7 +999,7 @@ func (x *UnlikePostRequest) String() string {
 func (*UnlikePostRequest) ProtoMessage() {}
 
 func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[9]
+	mi := &file_posts_posts_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +1012,7 @@ func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
 func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{9}
+	return file_posts_posts_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UnlikePostRequest) GetPostId() string {
@@ -688,56 +1029,30 @@ func (x *UnlikePostRequest) GetUserId() string {
 	return ""
 }
 
-// PostResponse is the response containing a post
-type PostResponse struct {
+// GetReactionCountsRequest is the request for retrieving reaction counts for a post
+type GetReactionCountsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// PostId is the ID of the post
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	// AuthorId is the ID of the user who created the post
-	AuthorId string `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	// AuthorName is the name of the user who created the post
-	AuthorName string `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	// AuthorAvatar is the avatar URL of the user who created the post
-	AuthorAvatar string `protobuf:"bytes,4,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
-	// Content is the content of the post
-	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	// Visibility is the visibility of the post
-	Visibility string `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	// GroupId is the ID of the group if the post is in a group
-	GroupId string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// GroupName is the name of the group if the post is in a group
-	GroupName string `protobuf:"bytes,8,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	// Media is an array of media URLs
-	Media []string `protobuf:"bytes,9,rep,name=media,proto3" json:"media,omitempty"`
-	// LikesCount is the number of likes on the post
-	LikesCount int32 `protobuf:"varint,10,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
-	// CommentsCount is the number of comments on the post
-	CommentsCount int32 `protobuf:"varint,11,opt,name=comments_count,json=commentsCount,proto3" json:"comments_count,omitempty"`
-	// IsLiked indicates if the requesting user has liked the post
-	IsLiked bool `protobuf:"varint,12,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	// CreatedAt is the timestamp when the post was created
-	CreatedAt string `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// UpdatedAt is the timestamp when the post was last updated
-	UpdatedAt     string `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PostId        string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostResponse) Reset() {
-	*x = PostResponse{}
-	mi := &file_posts_posts_proto_msgTypes[10]
+func (x *GetReactionCountsRequest) Reset() {
+	*x = GetReactionCountsRequest{}
+	mi := &file_posts_posts_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostResponse) String() string {
+func (x *GetReactionCountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostResponse) ProtoMessage() {}
+func (*GetReactionCountsRequest) ProtoMessage() {}
 
-func (x *PostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[10]
+func (x *GetReactionCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,139 +1063,1366 @@ func (x *PostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostResponse.ProtoReflect.Descriptor instead.
-func (*PostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetReactionCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetReactionCountsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PostResponse) GetPostId() string {
+func (x *GetReactionCountsRequest) GetPostId() string {
 	if x != nil {
 		return x.PostId
 	}
 	return ""
 }
 
-func (x *PostResponse) GetAuthorId() string {
-	if x != nil {
-		return x.AuthorId
-	}
-	return ""
+// BookmarkPostRequest is the request for bookmarking a post
+type BookmarkPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user bookmarking the post
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostResponse) GetAuthorName() string {
-	if x != nil {
-		return x.AuthorName
-	}
-	return ""
+func (x *BookmarkPostRequest) Reset() {
+	*x = BookmarkPostRequest{}
+	mi := &file_posts_posts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostResponse) GetAuthorAvatar() string {
-	if x != nil {
-		return x.AuthorAvatar
-	}
-	return ""
+func (x *BookmarkPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PostResponse) GetContent() string {
+func (*BookmarkPostRequest) ProtoMessage() {}
+
+func (x *BookmarkPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[15]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PostResponse) GetVisibility() string {
-	if x != nil {
-		return x.Visibility
-	}
-	return ""
+// Deprecated: Use BookmarkPostRequest.ProtoReflect.Descriptor instead.
+func (*BookmarkPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PostResponse) GetGroupId() string {
+func (x *BookmarkPostRequest) GetPostId() string {
 	if x != nil {
-		return x.GroupId
+		return x.PostId
 	}
 	return ""
 }
 
-func (x *PostResponse) GetGroupName() string {
+func (x *BookmarkPostRequest) GetUserId() string {
 	if x != nil {
-		return x.GroupName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *PostResponse) GetMedia() []string {
-	if x != nil {
-		return x.Media
-	}
-	return nil
+// UnbookmarkPostRequest is the request for removing a bookmark
+type UnbookmarkPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user removing the bookmark
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostResponse) GetLikesCount() int32 {
-	if x != nil {
-		return x.LikesCount
-	}
-	return 0
+func (x *UnbookmarkPostRequest) Reset() {
+	*x = UnbookmarkPostRequest{}
+	mi := &file_posts_posts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostResponse) GetCommentsCount() int32 {
-	if x != nil {
-		return x.CommentsCount
-	}
-	return 0
+func (x *UnbookmarkPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PostResponse) GetIsLiked() bool {
+func (*UnbookmarkPostRequest) ProtoMessage() {}
+
+func (x *UnbookmarkPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[16]
 	if x != nil {
-		return x.IsLiked
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *PostResponse) GetCreatedAt() string {
+// Deprecated: Use UnbookmarkPostRequest.ProtoReflect.Descriptor instead.
+func (*UnbookmarkPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnbookmarkPostRequest) GetPostId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PostId
 	}
 	return ""
 }
 
-func (x *PostResponse) GetUpdatedAt() string {
+func (x *UnbookmarkPostRequest) GetUserId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.UserId
 	}
 	return ""
 }
 
-// GetPostsResponse is the response containing posts
-type GetPostsResponse struct {
+// GetBookmarksRequest is the request for retrieving a user's bookmarked posts
+type GetBookmarksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Posts is an array of posts
-	Posts []*PostResponse `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	// TotalCount is the total number of posts
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Page is the current page number
-	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	// TotalPages is the total number of pages
-	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// UserId is the ID of the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of posts per page
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsResponse) Reset() {
-	*x = GetPostsResponse{}
-	mi := &file_posts_posts_proto_msgTypes[11]
+func (x *GetBookmarksRequest) Reset() {
+	*x = GetBookmarksRequest{}
+	mi := &file_posts_posts_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsResponse) String() string {
+func (x *GetBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarksRequest) ProtoMessage() {}
+
+func (x *GetBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*GetBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBookmarksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBookmarksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBookmarksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ReportPostRequest is the request for reporting a post
+type ReportPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// UserId is the ID of the user reporting the post
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Reason is why the post is reported: spam, harassment, hate_speech, violence, nudity, misinformation or other
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPostRequest) Reset() {
+	*x = ReportPostRequest{}
+	mi := &file_posts_posts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostRequest) ProtoMessage() {}
+
+func (x *ReportPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostRequest.ProtoReflect.Descriptor instead.
+func (*ReportPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReportPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ReportPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportPostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GetReportsRequest is the request for retrieving the moderation queue
+type GetReportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the admin
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Status filters reports by status, open or resolved; open by default
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of reports per page
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportsRequest) Reset() {
+	*x = GetReportsRequest{}
+	mi := &file_posts_posts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportsRequest) ProtoMessage() {}
+
+func (x *GetReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetReportsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetReportsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReportsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetReportsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ResolveReportRequest is the request for resolving a report
+type ResolveReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ReportId is the ID of the report
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// UserId is the ID of the admin resolving the report
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// HidePost hides the reported post from everyone
+	HidePost      bool `protobuf:"varint,3,opt,name=hide_post,json=hidePost,proto3" json:"hide_post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_posts_posts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportRequest) ProtoMessage() {}
+
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResolveReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ResolveReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResolveReportRequest) GetHidePost() bool {
+	if x != nil {
+		return x.HidePost
+	}
+	return false
+}
+
+// PostResponse is the response containing a post
+type PostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// AuthorId is the ID of the user who created the post
+	AuthorId string `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// AuthorName is the name of the user who created the post
+	AuthorName string `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// AuthorAvatar is the avatar URL of the user who created the post
+	AuthorAvatar string `protobuf:"bytes,4,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
+	// Content is the content of the post
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// Visibility is the visibility of the post, public, friends or private
+	Visibility string `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// GroupId is the ID of the group if the post is in a group
+	GroupId string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// GroupName is the name of the group if the post is in a group
+	GroupName string `protobuf:"bytes,8,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	// Media is an array of media URLs
+	Media []string `protobuf:"bytes,9,rep,name=media,proto3" json:"media,omitempty"`
+	// LikesCount is the number of likes on the post
+	LikesCount int32 `protobuf:"varint,10,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	// CommentsCount is the number of comments on the post
+	CommentsCount int32 `protobuf:"varint,11,opt,name=comments_count,json=commentsCount,proto3" json:"comments_count,omitempty"`
+	// IsLiked indicates if the requesting user has liked the post
+	IsLiked bool `protobuf:"varint,12,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	// CreatedAt is the timestamp when the post was created
+	CreatedAt string `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// UpdatedAt is the timestamp when the post was last updated
+	UpdatedAt string `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Mentions are the users mentioned in the content
+	Mentions []*Mention `protobuf:"bytes,15,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// OriginalPostId is the ID of the shared post if this post is a share
+	OriginalPostId string `protobuf:"bytes,16,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"`
+	// SharesCount is the number of times the post has been shared
+	SharesCount int32 `protobuf:"varint,17,opt,name=shares_count,json=sharesCount,proto3" json:"shares_count,omitempty"`
+	// OriginalPost is the shared post; unset when it was deleted or is not visible to the requesting user
+	OriginalPost *PostResponse `protobuf:"bytes,18,opt,name=original_post,json=originalPost,proto3" json:"original_post,omitempty"`
+	// ReportsCount is the number of times the post has been reported; only set in moderation views
+	ReportsCount int32 `protobuf:"varint,19,opt,name=reports_count,json=reportsCount,proto3" json:"reports_count,omitempty"`
+	// Hidden indicates if a moderator has hidden the post; only set in moderation views
+	Hidden        bool `protobuf:"varint,20,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostResponse) Reset() {
+	*x = PostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostResponse) ProtoMessage() {}
+
+func (x *PostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostResponse.ProtoReflect.Descriptor instead.
+func (*PostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PostResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostResponse) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PostResponse) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *PostResponse) GetAuthorAvatar() string {
+	if x != nil {
+		return x.AuthorAvatar
+	}
+	return ""
+}
+
+func (x *PostResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostResponse) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *PostResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PostResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PostResponse) GetMedia() []string {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *PostResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *PostResponse) GetCommentsCount() int32 {
+	if x != nil {
+		return x.CommentsCount
+	}
+	return 0
+}
+
+func (x *PostResponse) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *PostResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PostResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PostResponse) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *PostResponse) GetOriginalPostId() string {
+	if x != nil {
+		return x.OriginalPostId
+	}
+	return ""
+}
+
+func (x *PostResponse) GetSharesCount() int32 {
+	if x != nil {
+		return x.SharesCount
+	}
+	return 0
+}
+
+func (x *PostResponse) GetOriginalPost() *PostResponse {
+	if x != nil {
+		return x.OriginalPost
+	}
+	return nil
+}
+
+func (x *PostResponse) GetReportsCount() int32 {
+	if x != nil {
+		return x.ReportsCount
+	}
+	return 0
+}
+
+func (x *PostResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// Mention is a user mentioned with @username in a post or comment
+type Mention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the mentioned user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Username is the username as it appears in the content, without the leading @
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_posts_posts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Mention) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Mention) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// GetPostsResponse is the response containing posts
+type GetPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Posts is an array of posts
+	Posts []*PostResponse `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// TotalCount is the total number of posts
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// NextCursor continues a feed after the last post returned; empty when there are no more posts.
+	// Totals are not computed when paging by cursor.
+	NextCursor    string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
+	mi := &file_posts_posts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsResponse) ProtoMessage() {}
+
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPostsResponse) GetPosts() []*PostResponse {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetPostsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPostsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetPostsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// CommentResponse is the response containing a comment
+type CommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CommentId is the ID of the comment
+	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// PostId is the ID of the post
+	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// AuthorId is the ID of the user who created the comment
+	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// AuthorName is the name of the user who created the comment
+	AuthorName string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// AuthorAvatar is the avatar URL of the user who created the comment
+	AuthorAvatar string `protobuf:"bytes,5,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
+	// Content is the content of the comment
+	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	// CreatedAt is the timestamp when the comment was created
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ParentCommentId is the ID of the comment this is a reply to, empty for top-level comments
+	ParentCommentId string `protobuf:"bytes,8,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	// Depth is the nesting level of the comment, 0 for top-level comments
+	Depth int32 `protobuf:"varint,9,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Replies are the nested replies to the comment, only populated for threaded requests
+	Replies []*CommentResponse `protobuf:"bytes,10,rep,name=replies,proto3" json:"replies,omitempty"`
+	// UpdatedAt is the timestamp when the comment was last updated
+	UpdatedAt string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Edited indicates if the comment has been edited since it was created
+	Edited bool `protobuf:"varint,12,opt,name=edited,proto3" json:"edited,omitempty"`
+	// Mentions are the users mentioned in the content
+	Mentions      []*Mention `protobuf:"bytes,13,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentResponse) Reset() {
+	*x = CommentResponse{}
+	mi := &file_posts_posts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentResponse) ProtoMessage() {}
+
+func (x *CommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
+func (*CommentResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CommentResponse) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetAuthorAvatar() string {
+	if x != nil {
+		return x.AuthorAvatar
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetParentCommentId() string {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CommentResponse) GetReplies() []*CommentResponse {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *CommentResponse) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+// GetCommentsResponse is the response containing comments
+type GetCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comments is an array of comments
+	Comments []*CommentResponse `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// TotalCount is the total number of comments
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsResponse) Reset() {
+	*x = GetCommentsResponse{}
+	mi := &file_posts_posts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsResponse) ProtoMessage() {}
+
+func (x *GetCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCommentsResponse) GetComments() []*CommentResponse {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetCommentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCommentsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// DeletePostResponse is the response for deleting a post
+type DeletePostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the post was successfully deleted
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeletePostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// DeleteCommentResponse is the response for deleting a comment
+type DeleteCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the comment was successfully deleted
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_posts_posts_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// LikePostResponse is the response for liking a post
+type LikePostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the post was successfully liked
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// LikesCount is the updated number of reactions of any type on the post
+	LikesCount int32 `protobuf:"varint,2,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	// ReactionCounts is the updated number of each reaction type on the post
+	ReactionCounts map[string]int32 `protobuf:"bytes,3,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LikePostResponse) Reset() {
+	*x = LikePostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResponse) ProtoMessage() {}
+
+func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
+func (*LikePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LikePostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LikePostResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *LikePostResponse) GetReactionCounts() map[string]int32 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+// UnlikePostResponse is the response for unliking a post
+type UnlikePostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the post was successfully unliked
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// LikesCount is the updated number of reactions of any type on the post
+	LikesCount int32 `protobuf:"varint,2,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	// ReactionCounts is the updated number of each reaction type on the post
+	ReactionCounts map[string]int32 `protobuf:"bytes,3,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnlikePostResponse) Reset() {
+	*x = UnlikePostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikePostResponse) ProtoMessage() {}
+
+func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikePostResponse.ProtoReflect.Descriptor instead.
+func (*UnlikePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlikePostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnlikePostResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *UnlikePostResponse) GetReactionCounts() map[string]int32 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+// GetReactionCountsResponse is the response containing reaction counts for a post
+type GetReactionCountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// LikesCount is the number of reactions of any type on the post
+	LikesCount int32 `protobuf:"varint,1,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	// ReactionCounts is the number of each reaction type on the post
+	ReactionCounts map[string]int32 `protobuf:"bytes,2,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReactionCountsResponse) Reset() {
+	*x = GetReactionCountsResponse{}
+	mi := &file_posts_posts_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReactionCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReactionCountsResponse) ProtoMessage() {}
+
+func (x *GetReactionCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReactionCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetReactionCountsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetReactionCountsResponse) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *GetReactionCountsResponse) GetReactionCounts() map[string]int32 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+// BookmarkPostResponse is the response for bookmarking a post
+type BookmarkPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the post was successfully bookmarked
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkPostResponse) Reset() {
+	*x = BookmarkPostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkPostResponse) ProtoMessage() {}
+
+func (x *BookmarkPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkPostResponse.ProtoReflect.Descriptor instead.
+func (*BookmarkPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BookmarkPostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// UnbookmarkPostResponse is the response for removing a bookmark
+type UnbookmarkPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the bookmark was successfully removed
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbookmarkPostResponse) Reset() {
+	*x = UnbookmarkPostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbookmarkPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbookmarkPostResponse) ProtoMessage() {}
+
+func (x *UnbookmarkPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbookmarkPostResponse.ProtoReflect.Descriptor instead.
+func (*UnbookmarkPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnbookmarkPostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ReportPostResponse is the response for reporting a post
+type ReportPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the post was successfully reported
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPostResponse) Reset() {
+	*x = ReportPostResponse{}
+	mi := &file_posts_posts_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsResponse) ProtoMessage() {}
+func (*ReportPostResponse) ProtoMessage() {}
 
-func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[11]
+func (x *ReportPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,75 +2433,58 @@ func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetPostsResponse) GetPosts() []*PostResponse {
-	if x != nil {
-		return x.Posts
-	}
-	return nil
-}
-
-func (x *GetPostsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetPostsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use ReportPostResponse.ProtoReflect.Descriptor instead.
+func (*ReportPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetPostsResponse) GetTotalPages() int32 {
+func (x *ReportPostResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TotalPages
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// CommentResponse is the response containing a comment
-type CommentResponse struct {
+// ReportResponse is the response containing a post report
+type ReportResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// CommentId is the ID of the comment
-	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// PostId is the ID of the post
+	// ReportId is the ID of the report
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// PostId is the ID of the reported post
 	PostId string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	// AuthorId is the ID of the user who created the comment
-	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	// AuthorName is the name of the user who created the comment
-	AuthorName string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	// AuthorAvatar is the avatar URL of the user who created the comment
-	AuthorAvatar string `protobuf:"bytes,5,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
-	// Content is the content of the comment
-	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	// CreatedAt is the timestamp when the comment was created
-	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ReporterId is the ID of the user who reported the post
+	ReporterId string `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	// Reason is why the post was reported
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Status is the status of the report, open or resolved
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// ResolvedBy is the ID of the admin who resolved the report; empty while it is open
+	ResolvedBy string `protobuf:"bytes,6,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	// ResolvedAt is the timestamp when the report was resolved; empty while it is open
+	ResolvedAt string `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	// CreatedAt is the timestamp when the report was filed
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Post is the reported post, with its report count; unset when the post was deleted
+	Post          *PostResponse `protobuf:"bytes,9,opt,name=post,proto3" json:"post,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentResponse) Reset() {
-	*x = CommentResponse{}
-	mi := &file_posts_posts_proto_msgTypes[12]
+func (x *ReportResponse) Reset() {
+	*x = ReportResponse{}
+	mi := &file_posts_posts_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentResponse) String() string {
+func (x *ReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentResponse) ProtoMessage() {}
+func (*ReportResponse) ProtoMessage() {}
 
-func (x *CommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[12]
+func (x *ReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,66 +2495,80 @@ func (x *CommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
-func (*CommentResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
+func (*ReportResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CommentResponse) GetCommentId() string {
+func (x *ReportResponse) GetReportId() string {
 	if x != nil {
-		return x.CommentId
+		return x.ReportId
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetPostId() string {
+func (x *ReportResponse) GetPostId() string {
 	if x != nil {
 		return x.PostId
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetAuthorId() string {
+func (x *ReportResponse) GetReporterId() string {
 	if x != nil {
-		return x.AuthorId
+		return x.ReporterId
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetAuthorName() string {
+func (x *ReportResponse) GetReason() string {
 	if x != nil {
-		return x.AuthorName
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetAuthorAvatar() string {
+func (x *ReportResponse) GetStatus() string {
 	if x != nil {
-		return x.AuthorAvatar
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetContent() string {
+func (x *ReportResponse) GetResolvedBy() string {
 	if x != nil {
-		return x.Content
+		return x.ResolvedBy
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetCreatedAt() string {
+func (x *ReportResponse) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *ReportResponse) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-// GetCommentsResponse is the response containing comments
-type GetCommentsResponse struct {
+func (x *ReportResponse) GetPost() *PostResponse {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// GetReportsResponse is the response containing reports
+type GetReportsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Comments is an array of comments
-	Comments []*CommentResponse `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	// TotalCount is the total number of comments
+	// Reports is an array of reports
+	Reports []*ReportResponse `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// TotalCount is the total number of reports
 	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// Page is the current page number
 	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
@@ -1039,21 +2578,21 @@ type GetCommentsResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsResponse) Reset() {
-	*x = GetCommentsResponse{}
-	mi := &file_posts_posts_proto_msgTypes[13]
+func (x *GetReportsResponse) Reset() {
+	*x = GetReportsResponse{}
+	mi := &file_posts_posts_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsResponse) String() string {
+func (x *GetReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsResponse) ProtoMessage() {}
+func (*GetReportsResponse) ProtoMessage() {}
 
-func (x *GetCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[13]
+func (x *GetReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,157 +2603,63 @@ func (x *GetCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetReportsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetCommentsResponse) GetComments() []*CommentResponse {
+func (x *GetReportsResponse) GetReports() []*ReportResponse {
 	if x != nil {
-		return x.Comments
+		return x.Reports
 	}
 	return nil
 }
 
-func (x *GetCommentsResponse) GetTotalCount() int32 {
+func (x *GetReportsResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *GetCommentsResponse) GetPage() int32 {
+func (x *GetReportsResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetCommentsResponse) GetTotalPages() int32 {
+func (x *GetReportsResponse) GetTotalPages() int32 {
 	if x != nil {
 		return x.TotalPages
 	}
 	return 0
 }
 
-// DeletePostResponse is the response for deleting a post
-type DeletePostResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the post was successfully deleted
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_posts_posts_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeletePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeletePostResponse) ProtoMessage() {}
-
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *DeletePostResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-// DeleteCommentResponse is the response for deleting a comment
-type DeleteCommentResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the comment was successfully deleted
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteCommentResponse) Reset() {
-	*x = DeleteCommentResponse{}
-	mi := &file_posts_posts_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteCommentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteCommentResponse) ProtoMessage() {}
-
-func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[15]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *DeleteCommentResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-// LikePostResponse is the response for liking a post
-type LikePostResponse struct {
+// CountPublicPostsRequest is the request for counting an author's public posts
+type CountPublicPostsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the post was successfully liked
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// LikesCount is the updated number of likes on the post
-	LikesCount    int32 `protobuf:"varint,2,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	// AuthorId is the ID of the author whose posts are counted
+	AuthorId      string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostResponse) Reset() {
-	*x = LikePostResponse{}
-	mi := &file_posts_posts_proto_msgTypes[16]
+func (x *CountPublicPostsRequest) Reset() {
+	*x = CountPublicPostsRequest{}
+	mi := &file_posts_posts_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostResponse) String() string {
+func (x *CountPublicPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostResponse) ProtoMessage() {}
+func (*CountPublicPostsRequest) ProtoMessage() {}
 
-func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[16]
+func (x *CountPublicPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,51 +2670,42 @@ func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
-func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *LikePostResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use CountPublicPostsRequest.ProtoReflect.Descriptor instead.
+func (*CountPublicPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *LikePostResponse) GetLikesCount() int32 {
+func (x *CountPublicPostsRequest) GetAuthorId() string {
 	if x != nil {
-		return x.LikesCount
+		return x.AuthorId
 	}
-	return 0
+	return ""
 }
 
-// UnlikePostResponse is the response for unliking a post
-type UnlikePostResponse struct {
+// CountPublicPostsResponse is the response containing the number of an author's public posts
+type CountPublicPostsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success indicates if the post was successfully unliked
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// LikesCount is the updated number of likes on the post
-	LikesCount    int32 `protobuf:"varint,2,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	// Count is the number of public posts outside of groups that are not hidden
+	Count         int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikePostResponse) Reset() {
-	*x = UnlikePostResponse{}
-	mi := &file_posts_posts_proto_msgTypes[17]
+func (x *CountPublicPostsResponse) Reset() {
+	*x = CountPublicPostsResponse{}
+	mi := &file_posts_posts_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikePostResponse) String() string {
+func (x *CountPublicPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikePostResponse) ProtoMessage() {}
+func (*CountPublicPostsResponse) ProtoMessage() {}
 
-func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_posts_proto_msgTypes[17]
+func (x *CountPublicPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_posts_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,21 +2716,14 @@ func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikePostResponse.ProtoReflect.Descriptor instead.
-func (*UnlikePostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_posts_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *UnlikePostResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use CountPublicPostsResponse.ProtoReflect.Descriptor instead.
+func (*CountPublicPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_posts_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UnlikePostResponse) GetLikesCount() int32 {
+func (x *CountPublicPostsResponse) GetCount() int32 {
 	if x != nil {
-		return x.LikesCount
+		return x.Count
 	}
 	return 0
 }
@@ -1303,7 +2732,7 @@ var File_posts_posts_proto protoreflect.FileDescriptor
 
 const file_posts_posts_proto_rawDesc = "" +
 	"\n" +
-	"\x11posts/posts.proto\x12\x05posts\"\x97\x01\n" +
+	"\x11posts/posts.proto\x12\x05posts\"\xc0\x01\n" +
 	"\x11CreatePostRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1e\n" +
@@ -1311,10 +2740,11 @@ const file_posts_posts_proto_rawDesc = "" +
 	"visibility\x18\x03 \x01(\tR\n" +
 	"visibility\x12\x19\n" +
 	"\bgroup_id\x18\x04 \x01(\tR\agroupId\x12\x14\n" +
-	"\x05media\x18\x05 \x03(\tR\x05media\"B\n" +
+	"\x05media\x18\x05 \x03(\tR\x05media\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"B\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xac\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xd8\x01\n" +
 	"\x0fGetPostsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x19\n" +
@@ -1323,7 +2753,14 @@ const file_posts_posts_proto_rawDesc = "" +
 	"visibility\x18\x04 \x01(\tR\n" +
 	"visibility\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x95\x01\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04feed\x18\b \x01(\tR\x04feed\"o\n" +
+	"\x18GetPostsByHashtagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x95\x01\n" +
 	"\x11UpdatePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
@@ -1331,29 +2768,72 @@ const file_posts_posts_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\x04 \x01(\tR\n" +
 	"visibility\x12\x14\n" +
-	"\x05media\x18\x05 \x03(\tR\x05media\"E\n" +
+	"\x05media\x18\x05 \x03(\tR\x05media\"^\n" +
+	"\x10SharePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"E\n" +
 	"\x11DeletePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"_\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"F\n" +
+	"\x12RestorePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xb4\x01\n" +
 	"\x11AddCommentRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"W\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12*\n" +
+	"\x11parent_comment_id\x18\x04 \x01(\tR\x0fparentCommentId\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\x8c\x01\n" +
 	"\x12GetCommentsRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"g\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bthreaded\x18\x04 \x01(\bR\bthreaded\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\"\x7f\n" +
+	"\x12EditCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"g\n" +
 	"\x14DeleteCommentRequest\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
-	"\apost_id\x18\x03 \x01(\tR\x06postId\"C\n" +
+	"\apost_id\x18\x03 \x01(\tR\x06postId\"h\n" +
 	"\x0fLikePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"E\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\rreaction_type\x18\x03 \x01(\tR\freactionType\"E\n" +
 	"\x11UnlikePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xb5\x03\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\x18GetReactionCountsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"G\n" +
+	"\x13BookmarkPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"I\n" +
+	"\x15UnbookmarkPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"X\n" +
+	"\x13GetBookmarksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"]\n" +
+	"\x11ReportPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"n\n" +
+	"\x11GetReportsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"i\n" +
+	"\x14ResolveReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\thide_post\x18\x03 \x01(\bR\bhidePost\"\xa5\x05\n" +
 	"\fPostResponse\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
@@ -1376,14 +2856,25 @@ const file_posts_posts_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"\x93\x01\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12*\n" +
+	"\bmentions\x18\x0f \x03(\v2\x0e.posts.MentionR\bmentions\x12(\n" +
+	"\x10original_post_id\x18\x10 \x01(\tR\x0eoriginalPostId\x12!\n" +
+	"\fshares_count\x18\x11 \x01(\x05R\vsharesCount\x128\n" +
+	"\roriginal_post\x18\x12 \x01(\v2\x13.posts.PostResponseR\foriginalPost\x12#\n" +
+	"\rreports_count\x18\x13 \x01(\x05R\freportsCount\x12\x16\n" +
+	"\x06hidden\x18\x14 \x01(\bR\x06hidden\">\n" +
+	"\aMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\xb4\x01\n" +
 	"\x10GetPostsResponse\x12)\n" +
 	"\x05posts\x18\x01 \x03(\v2\x13.posts.PostResponseR\x05posts\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\xe5\x01\n" +
+	"totalPages\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"\xbc\x03\n" +
 	"\x0fCommentResponse\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
@@ -1394,7 +2885,15 @@ const file_posts_posts_proto_rawDesc = "" +
 	"\rauthor_avatar\x18\x05 \x01(\tR\fauthorAvatar\x12\x18\n" +
 	"\acontent\x18\x06 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"\x9f\x01\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12*\n" +
+	"\x11parent_comment_id\x18\b \x01(\tR\x0fparentCommentId\x12\x14\n" +
+	"\x05depth\x18\t \x01(\x05R\x05depth\x120\n" +
+	"\areplies\x18\n" +
+	" \x03(\v2\x16.posts.CommentResponseR\areplies\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06edited\x18\f \x01(\bR\x06edited\x12*\n" +
+	"\bmentions\x18\r \x03(\v2\x0e.posts.MentionR\bmentions\"\x9f\x01\n" +
 	"\x13GetCommentsResponse\x122\n" +
 	"\bcomments\x18\x01 \x03(\v2\x16.posts.CommentResponseR\bcomments\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -1405,31 +2904,91 @@ const file_posts_posts_proto_rawDesc = "" +
 	"\x12DeletePostResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
 	"\x15DeleteCommentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe6\x01\n" +
 	"\x10LikePostResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
 	"\vlikes_count\x18\x02 \x01(\x05R\n" +
-	"likesCount\"O\n" +
+	"likesCount\x12T\n" +
+	"\x0freaction_counts\x18\x03 \x03(\v2+.posts.LikePostResponse.ReactionCountsEntryR\x0ereactionCounts\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xea\x01\n" +
 	"\x12UnlikePostResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
 	"\vlikes_count\x18\x02 \x01(\x05R\n" +
-	"likesCount2\x90\x05\n" +
+	"likesCount\x12V\n" +
+	"\x0freaction_counts\x18\x03 \x03(\v2-.posts.UnlikePostResponse.ReactionCountsEntryR\x0ereactionCounts\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xde\x01\n" +
+	"\x19GetReactionCountsResponse\x12\x1f\n" +
+	"\vlikes_count\x18\x01 \x01(\x05R\n" +
+	"likesCount\x12]\n" +
+	"\x0freaction_counts\x18\x02 \x03(\v24.posts.GetReactionCountsResponse.ReactionCountsEntryR\x0ereactionCounts\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"0\n" +
+	"\x14BookmarkPostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x16UnbookmarkPostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\x12ReportPostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa1\x02\n" +
+	"\x0eReportResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1f\n" +
+	"\vreporter_id\x18\x03 \x01(\tR\n" +
+	"reporterId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1f\n" +
+	"\vresolved_by\x18\x06 \x01(\tR\n" +
+	"resolvedBy\x12\x1f\n" +
+	"\vresolved_at\x18\a \x01(\tR\n" +
+	"resolvedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12'\n" +
+	"\x04post\x18\t \x01(\v2\x13.posts.PostResponseR\x04post\"\x9b\x01\n" +
+	"\x12GetReportsResponse\x12/\n" +
+	"\areports\x18\x01 \x03(\v2\x15.posts.ReportResponseR\areports\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"6\n" +
+	"\x17CountPublicPostsRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\"0\n" +
+	"\x18CountPublicPostsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count2\xf0\v\n" +
 	"\vPostService\x12;\n" +
 	"\n" +
 	"CreatePost\x12\x18.posts.CreatePostRequest\x1a\x13.posts.PostResponse\x125\n" +
 	"\aGetPost\x12\x15.posts.GetPostRequest\x1a\x13.posts.PostResponse\x12;\n" +
-	"\bGetPosts\x12\x16.posts.GetPostsRequest\x1a\x17.posts.GetPostsResponse\x12;\n" +
+	"\bGetPosts\x12\x16.posts.GetPostsRequest\x1a\x17.posts.GetPostsResponse\x12M\n" +
+	"\x11GetPostsByHashtag\x12\x1f.posts.GetPostsByHashtagRequest\x1a\x17.posts.GetPostsResponse\x12;\n" +
 	"\n" +
 	"UpdatePost\x12\x18.posts.UpdatePostRequest\x1a\x13.posts.PostResponse\x12A\n" +
 	"\n" +
-	"DeletePost\x12\x18.posts.DeletePostRequest\x1a\x19.posts.DeletePostResponse\x12>\n" +
+	"DeletePost\x12\x18.posts.DeletePostRequest\x1a\x19.posts.DeletePostResponse\x129\n" +
+	"\tSharePost\x12\x17.posts.SharePostRequest\x1a\x13.posts.PostResponse\x12=\n" +
+	"\vRestorePost\x12\x19.posts.RestorePostRequest\x1a\x13.posts.PostResponse\x12>\n" +
 	"\n" +
 	"AddComment\x12\x18.posts.AddCommentRequest\x1a\x16.posts.CommentResponse\x12D\n" +
-	"\vGetComments\x12\x19.posts.GetCommentsRequest\x1a\x1a.posts.GetCommentsResponse\x12J\n" +
+	"\vGetComments\x12\x19.posts.GetCommentsRequest\x1a\x1a.posts.GetCommentsResponse\x12@\n" +
+	"\vEditComment\x12\x19.posts.EditCommentRequest\x1a\x16.posts.CommentResponse\x12J\n" +
 	"\rDeleteComment\x12\x1b.posts.DeleteCommentRequest\x1a\x1c.posts.DeleteCommentResponse\x12;\n" +
 	"\bLikePost\x12\x16.posts.LikePostRequest\x1a\x17.posts.LikePostResponse\x12A\n" +
 	"\n" +
-	"UnlikePost\x12\x18.posts.UnlikePostRequest\x1a\x19.posts.UnlikePostResponseB\x14Z\x12common/proto/postsb\x06proto3"
+	"UnlikePost\x12\x18.posts.UnlikePostRequest\x1a\x19.posts.UnlikePostResponse\x12V\n" +
+	"\x11GetReactionCounts\x12\x1f.posts.GetReactionCountsRequest\x1a .posts.GetReactionCountsResponse\x12G\n" +
+	"\fBookmarkPost\x12\x1a.posts.BookmarkPostRequest\x1a\x1b.posts.BookmarkPostResponse\x12M\n" +
+	"\x0eUnbookmarkPost\x12\x1c.posts.UnbookmarkPostRequest\x1a\x1d.posts.UnbookmarkPostResponse\x12C\n" +
+	"\fGetBookmarks\x12\x1a.posts.GetBookmarksRequest\x1a\x17.posts.GetPostsResponse\x12A\n" +
+	"\n" +
+	"ReportPost\x12\x18.posts.ReportPostRequest\x1a\x19.posts.ReportPostResponse\x12A\n" +
+	"\n" +
+	"GetReports\x12\x18.posts.GetReportsRequest\x1a\x19.posts.GetReportsResponse\x12C\n" +
+	"\rResolveReport\x12\x1b.posts.ResolveReportRequest\x1a\x15.posts.ReportResponse\x12S\n" +
+	"\x10CountPublicPosts\x12\x1e.posts.CountPublicPostsRequest\x1a\x1f.posts.CountPublicPostsResponseB\x14Z\x12common/proto/postsb\x06proto3"
 
 var (
 	file_posts_posts_proto_rawDescOnce sync.Once
@@ -1443,55 +3002,111 @@ func file_posts_posts_proto_rawDescGZIP() []byte {
 	return file_posts_posts_proto_rawDescData
 }
 
-var file_posts_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_posts_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_posts_posts_proto_goTypes = []any{
-	(*CreatePostRequest)(nil),     // 0: posts.CreatePostRequest
-	(*GetPostRequest)(nil),        // 1: posts.GetPostRequest
-	(*GetPostsRequest)(nil),       // 2: posts.GetPostsRequest
-	(*UpdatePostRequest)(nil),     // 3: posts.UpdatePostRequest
-	(*DeletePostRequest)(nil),     // 4: posts.DeletePostRequest
-	(*AddCommentRequest)(nil),     // 5: posts.AddCommentRequest
-	(*GetCommentsRequest)(nil),    // 6: posts.GetCommentsRequest
-	(*DeleteCommentRequest)(nil),  // 7: posts.DeleteCommentRequest
-	(*LikePostRequest)(nil),       // 8: posts.LikePostRequest
-	(*UnlikePostRequest)(nil),     // 9: posts.UnlikePostRequest
-	(*PostResponse)(nil),          // 10: posts.PostResponse
-	(*GetPostsResponse)(nil),      // 11: posts.GetPostsResponse
-	(*CommentResponse)(nil),       // 12: posts.CommentResponse
-	(*GetCommentsResponse)(nil),   // 13: posts.GetCommentsResponse
-	(*DeletePostResponse)(nil),    // 14: posts.DeletePostResponse
-	(*DeleteCommentResponse)(nil), // 15: posts.DeleteCommentResponse
-	(*LikePostResponse)(nil),      // 16: posts.LikePostResponse
-	(*UnlikePostResponse)(nil),    // 17: posts.UnlikePostResponse
+	(*CreatePostRequest)(nil),         // 0: posts.CreatePostRequest
+	(*GetPostRequest)(nil),            // 1: posts.GetPostRequest
+	(*GetPostsRequest)(nil),           // 2: posts.GetPostsRequest
+	(*GetPostsByHashtagRequest)(nil),  // 3: posts.GetPostsByHashtagRequest
+	(*UpdatePostRequest)(nil),         // 4: posts.UpdatePostRequest
+	(*SharePostRequest)(nil),          // 5: posts.SharePostRequest
+	(*DeletePostRequest)(nil),         // 6: posts.DeletePostRequest
+	(*RestorePostRequest)(nil),        // 7: posts.RestorePostRequest
+	(*AddCommentRequest)(nil),         // 8: posts.AddCommentRequest
+	(*GetCommentsRequest)(nil),        // 9: posts.GetCommentsRequest
+	(*EditCommentRequest)(nil),        // 10: posts.EditCommentRequest
+	(*DeleteCommentRequest)(nil),      // 11: posts.DeleteCommentRequest
+	(*LikePostRequest)(nil),           // 12: posts.LikePostRequest
+	(*UnlikePostRequest)(nil),         // 13: posts.UnlikePostRequest
+	(*GetReactionCountsRequest)(nil),  // 14: posts.GetReactionCountsRequest
+	(*BookmarkPostRequest)(nil),       // 15: posts.BookmarkPostRequest
+	(*UnbookmarkPostRequest)(nil),     // 16: posts.UnbookmarkPostRequest
+	(*GetBookmarksRequest)(nil),       // 17: posts.GetBookmarksRequest
+	(*ReportPostRequest)(nil),         // 18: posts.ReportPostRequest
+	(*GetReportsRequest)(nil),         // 19: posts.GetReportsRequest
+	(*ResolveReportRequest)(nil),      // 20: posts.ResolveReportRequest
+	(*PostResponse)(nil),              // 21: posts.PostResponse
+	(*Mention)(nil),                   // 22: posts.Mention
+	(*GetPostsResponse)(nil),          // 23: posts.GetPostsResponse
+	(*CommentResponse)(nil),           // 24: posts.CommentResponse
+	(*GetCommentsResponse)(nil),       // 25: posts.GetCommentsResponse
+	(*DeletePostResponse)(nil),        // 26: posts.DeletePostResponse
+	(*DeleteCommentResponse)(nil),     // 27: posts.DeleteCommentResponse
+	(*LikePostResponse)(nil),          // 28: posts.LikePostResponse
+	(*UnlikePostResponse)(nil),        // 29: posts.UnlikePostResponse
+	(*GetReactionCountsResponse)(nil), // 30: posts.GetReactionCountsResponse
+	(*BookmarkPostResponse)(nil),      // 31: posts.BookmarkPostResponse
+	(*UnbookmarkPostResponse)(nil),    // 32: posts.UnbookmarkPostResponse
+	(*ReportPostResponse)(nil),        // 33: posts.ReportPostResponse
+	(*ReportResponse)(nil),            // 34: posts.ReportResponse
+	(*GetReportsResponse)(nil),        // 35: posts.GetReportsResponse
+	(*CountPublicPostsRequest)(nil),   // 36: posts.CountPublicPostsRequest
+	(*CountPublicPostsResponse)(nil),  // 37: posts.CountPublicPostsResponse
+	nil,                               // 38: posts.LikePostResponse.ReactionCountsEntry
+	nil,                               // 39: posts.UnlikePostResponse.ReactionCountsEntry
+	nil,                               // 40: posts.GetReactionCountsResponse.ReactionCountsEntry
 }
 var file_posts_posts_proto_depIdxs = []int32{
-	10, // 0: posts.GetPostsResponse.posts:type_name -> posts.PostResponse
-	12, // 1: posts.GetCommentsResponse.comments:type_name -> posts.CommentResponse
-	0,  // 2: posts.PostService.CreatePost:input_type -> posts.CreatePostRequest
-	1,  // 3: posts.PostService.GetPost:input_type -> posts.GetPostRequest
-	2,  // 4: posts.PostService.GetPosts:input_type -> posts.GetPostsRequest
-	3,  // 5: posts.PostService.UpdatePost:input_type -> posts.UpdatePostRequest
-	4,  // 6: posts.PostService.DeletePost:input_type -> posts.DeletePostRequest
-	5,  // 7: posts.PostService.AddComment:input_type -> posts.AddCommentRequest
-	6,  // 8: posts.PostService.GetComments:input_type -> posts.GetCommentsRequest
-	7,  // 9: posts.PostService.DeleteComment:input_type -> posts.DeleteCommentRequest
-	8,  // 10: posts.PostService.LikePost:input_type -> posts.LikePostRequest
-	9,  // 11: posts.PostService.UnlikePost:input_type -> posts.UnlikePostRequest
-	10, // 12: posts.PostService.CreatePost:output_type -> posts.PostResponse
-	10, // 13: posts.PostService.GetPost:output_type -> posts.PostResponse
-	11, // 14: posts.PostService.GetPosts:output_type -> posts.GetPostsResponse
-	10, // 15: posts.PostService.UpdatePost:output_type -> posts.PostResponse
-	14, // 16: posts.PostService.DeletePost:output_type -> posts.DeletePostResponse
-	12, // 17: posts.PostService.AddComment:output_type -> posts.CommentResponse
-	13, // 18: posts.PostService.GetComments:output_type -> posts.GetCommentsResponse
-	15, // 19: posts.PostService.DeleteComment:output_type -> posts.DeleteCommentResponse
-	16, // 20: posts.PostService.LikePost:output_type -> posts.LikePostResponse
-	17, // 21: posts.PostService.UnlikePost:output_type -> posts.UnlikePostResponse
-	12, // [12:22] is the sub-list for method output_type
-	2,  // [2:12] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	22, // 0: posts.PostResponse.mentions:type_name -> posts.Mention
+	21, // 1: posts.PostResponse.original_post:type_name -> posts.PostResponse
+	21, // 2: posts.GetPostsResponse.posts:type_name -> posts.PostResponse
+	24, // 3: posts.CommentResponse.replies:type_name -> posts.CommentResponse
+	22, // 4: posts.CommentResponse.mentions:type_name -> posts.Mention
+	24, // 5: posts.GetCommentsResponse.comments:type_name -> posts.CommentResponse
+	38, // 6: posts.LikePostResponse.reaction_counts:type_name -> posts.LikePostResponse.ReactionCountsEntry
+	39, // 7: posts.UnlikePostResponse.reaction_counts:type_name -> posts.UnlikePostResponse.ReactionCountsEntry
+	40, // 8: posts.GetReactionCountsResponse.reaction_counts:type_name -> posts.GetReactionCountsResponse.ReactionCountsEntry
+	21, // 9: posts.ReportResponse.post:type_name -> posts.PostResponse
+	34, // 10: posts.GetReportsResponse.reports:type_name -> posts.ReportResponse
+	0,  // 11: posts.PostService.CreatePost:input_type -> posts.CreatePostRequest
+	1,  // 12: posts.PostService.GetPost:input_type -> posts.GetPostRequest
+	2,  // 13: posts.PostService.GetPosts:input_type -> posts.GetPostsRequest
+	3,  // 14: posts.PostService.GetPostsByHashtag:input_type -> posts.GetPostsByHashtagRequest
+	4,  // 15: posts.PostService.UpdatePost:input_type -> posts.UpdatePostRequest
+	6,  // 16: posts.PostService.DeletePost:input_type -> posts.DeletePostRequest
+	5,  // 17: posts.PostService.SharePost:input_type -> posts.SharePostRequest
+	7,  // 18: posts.PostService.RestorePost:input_type -> posts.RestorePostRequest
+	8,  // 19: posts.PostService.AddComment:input_type -> posts.AddCommentRequest
+	9,  // 20: posts.PostService.GetComments:input_type -> posts.GetCommentsRequest
+	10, // 21: posts.PostService.EditComment:input_type -> posts.EditCommentRequest
+	11, // 22: posts.PostService.DeleteComment:input_type -> posts.DeleteCommentRequest
+	12, // 23: posts.PostService.LikePost:input_type -> posts.LikePostRequest
+	13, // 24: posts.PostService.UnlikePost:input_type -> posts.UnlikePostRequest
+	14, // 25: posts.PostService.GetReactionCounts:input_type -> posts.GetReactionCountsRequest
+	15, // 26: posts.PostService.BookmarkPost:input_type -> posts.BookmarkPostRequest
+	16, // 27: posts.PostService.UnbookmarkPost:input_type -> posts.UnbookmarkPostRequest
+	17, // 28: posts.PostService.GetBookmarks:input_type -> posts.GetBookmarksRequest
+	18, // 29: posts.PostService.ReportPost:input_type -> posts.ReportPostRequest
+	19, // 30: posts.PostService.GetReports:input_type -> posts.GetReportsRequest
+	20, // 31: posts.PostService.ResolveReport:input_type -> posts.ResolveReportRequest
+	36, // 32: posts.PostService.CountPublicPosts:input_type -> posts.CountPublicPostsRequest
+	21, // 33: posts.PostService.CreatePost:output_type -> posts.PostResponse
+	21, // 34: posts.PostService.GetPost:output_type -> posts.PostResponse
+	23, // 35: posts.PostService.GetPosts:output_type -> posts.GetPostsResponse
+	23, // 36: posts.PostService.GetPostsByHashtag:output_type -> posts.GetPostsResponse
+	21, // 37: posts.PostService.UpdatePost:output_type -> posts.PostResponse
+	26, // 38: posts.PostService.DeletePost:output_type -> posts.DeletePostResponse
+	21, // 39: posts.PostService.SharePost:output_type -> posts.PostResponse
+	21, // 40: posts.PostService.RestorePost:output_type -> posts.PostResponse
+	24, // 41: posts.PostService.AddComment:output_type -> posts.CommentResponse
+	25, // 42: posts.PostService.GetComments:output_type -> posts.GetCommentsResponse
+	24, // 43: posts.PostService.EditComment:output_type -> posts.CommentResponse
+	27, // 44: posts.PostService.DeleteComment:output_type -> posts.DeleteCommentResponse
+	28, // 45: posts.PostService.LikePost:output_type -> posts.LikePostResponse
+	29, // 46: posts.PostService.UnlikePost:output_type -> posts.UnlikePostResponse
+	30, // 47: posts.PostService.GetReactionCounts:output_type -> posts.GetReactionCountsResponse
+	31, // 48: posts.PostService.BookmarkPost:output_type -> posts.BookmarkPostResponse
+	32, // 49: posts.PostService.UnbookmarkPost:output_type -> posts.UnbookmarkPostResponse
+	23, // 50: posts.PostService.GetBookmarks:output_type -> posts.GetPostsResponse
+	33, // 51: posts.PostService.ReportPost:output_type -> posts.ReportPostResponse
+	35, // 52: posts.PostService.GetReports:output_type -> posts.GetReportsResponse
+	34, // 53: posts.PostService.ResolveReport:output_type -> posts.ReportResponse
+	37, // 54: posts.PostService.CountPublicPosts:output_type -> posts.CountPublicPostsResponse
+	33, // [33:55] is the sub-list for method output_type
+	11, // [11:33] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_posts_posts_proto_init() }
@@ -1505,7 +3120,7 @@ func file_posts_posts_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_posts_proto_rawDesc), len(file_posts_posts_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PostService_CreatePost_FullMethodName    = "/posts.PostService/CreatePost"
-	PostService_GetPost_FullMethodName       = "/posts.PostService/GetPost"
-	PostService_GetPosts_FullMethodName      = "/posts.PostService/GetPosts"
-	PostService_UpdatePost_FullMethodName    = "/posts.PostService/UpdatePost"
-	PostService_DeletePost_FullMethodName    = "/posts.PostService/DeletePost"
-	PostService_AddComment_FullMethodName    = "/posts.PostService/AddComment"
-	PostService_GetComments_FullMethodName   = "/posts.PostService/GetComments"
-	PostService_DeleteComment_FullMethodName = "/posts.PostService/DeleteComment"
-	PostService_LikePost_FullMethodName      = "/posts.PostService/LikePost"
-	PostService_UnlikePost_FullMethodName    = "/posts.PostService/UnlikePost"
+	PostService_CreatePost_FullMethodName        = "/posts.PostService/CreatePost"
+	PostService_GetPost_FullMethodName           = "/posts.PostService/GetPost"
+	PostService_GetPosts_FullMethodName          = "/posts.PostService/GetPosts"
+	PostService_GetPostsByHashtag_FullMethodName = "/posts.PostService/GetPostsByHashtag"
+	PostService_UpdatePost_FullMethodName        = "/posts.PostService/UpdatePost"
+	PostService_DeletePost_FullMethodName        = "/posts.PostService/DeletePost"
+	PostService_SharePost_FullMethodName         = "/posts.PostService/SharePost"
+	PostService_RestorePost_FullMethodName       = "/posts.PostService/RestorePost"
+	PostService_AddComment_FullMethodName        = "/posts.PostService/AddComment"
+	PostService_GetComments_FullMethodName       = "/posts.PostService/GetComments"
+	PostService_EditComment_FullMethodName       = "/posts.PostService/EditComment"
+	PostService_DeleteComment_FullMethodName     = "/posts.PostService/DeleteComment"
+	PostService_LikePost_FullMethodName          = "/posts.PostService/LikePost"
+	PostService_UnlikePost_FullMethodName        = "/posts.PostService/UnlikePost"
+	PostService_GetReactionCounts_FullMethodName = "/posts.PostService/GetReactionCounts"
+	PostService_BookmarkPost_FullMethodName      = "/posts.PostService/BookmarkPost"
+	PostService_UnbookmarkPost_FullMethodName    = "/posts.PostService/UnbookmarkPost"
+	PostService_GetBookmarks_FullMethodName      = "/posts.PostService/GetBookmarks"
+	PostService_ReportPost_FullMethodName        = "/posts.PostService/ReportPost"
+	PostService_GetReports_FullMethodName        = "/posts.PostService/GetReports"
+	PostService_ResolveReport_FullMethodName     = "/posts.PostService/ResolveReport"
+	PostService_CountPublicPosts_FullMethodName  = "/posts.PostService/CountPublicPosts"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -43,20 +55,44 @@ type PostServiceClient interface {
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*PostResponse, error)
 	// GetPosts retrieves posts with pagination and filtering
 	GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	// GetPostsByHashtag retrieves posts tagged with a hashtag
+	GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
 	// UpdatePost updates a post
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*PostResponse, error)
 	// DeletePost deletes a post
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// SharePost reshares a post to the user's own feed
+	SharePost(ctx context.Context, in *SharePostRequest, opts ...grpc.CallOption) (*PostResponse, error)
+	// RestorePost restores a deleted post within the restore window
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*PostResponse, error)
 	// AddComment adds a comment to a post
 	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
 	// GetComments retrieves comments for a post
 	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error)
+	// EditComment updates the content of a comment
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
 	// DeleteComment deletes a comment
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 	// LikePost likes a post
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	// UnlikePost unlikes a post
 	UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*UnlikePostResponse, error)
+	// GetReactionCounts retrieves the number of each reaction on a post
+	GetReactionCounts(ctx context.Context, in *GetReactionCountsRequest, opts ...grpc.CallOption) (*GetReactionCountsResponse, error)
+	// BookmarkPost saves a post for the user to revisit later
+	BookmarkPost(ctx context.Context, in *BookmarkPostRequest, opts ...grpc.CallOption) (*BookmarkPostResponse, error)
+	// UnbookmarkPost removes a post from the user's bookmarks
+	UnbookmarkPost(ctx context.Context, in *UnbookmarkPostRequest, opts ...grpc.CallOption) (*UnbookmarkPostResponse, error)
+	// GetBookmarks retrieves the user's bookmarked posts, newest bookmark first
+	GetBookmarks(ctx context.Context, in *GetBookmarksRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	// ReportPost reports a post for moderation
+	ReportPost(ctx context.Context, in *ReportPostRequest, opts ...grpc.CallOption) (*ReportPostResponse, error)
+	// GetReports retrieves the moderation queue; admins only
+	GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsResponse, error)
+	// ResolveReport resolves a report and optionally hides the reported post; admins only
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ReportResponse, error)
+	// CountPublicPosts counts the posts of an author that everyone can see
+	CountPublicPosts(ctx context.Context, in *CountPublicPostsRequest, opts ...grpc.CallOption) (*CountPublicPostsResponse, error)
 }
 
 type postServiceClient struct {
@@ -97,6 +133,16 @@ func (c *postServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest, o
 	return out, nil
 }
 
+func (c *postServiceClient) GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPostsByHashtag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PostResponse)
@@ -117,6 +163,26 @@ func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReques
 	return out, nil
 }
 
+func (c *postServiceClient) SharePost(ctx context.Context, in *SharePostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, PostService_SharePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, PostService_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CommentResponse)
@@ -137,6 +203,16 @@ func (c *postServiceClient) GetComments(ctx context.Context, in *GetCommentsRequ
 	return out, nil
 }
 
+func (c *postServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, PostService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteCommentResponse)
@@ -167,6 +243,86 @@ func (c *postServiceClient) UnlikePost(ctx context.Context, in *UnlikePostReques
 	return out, nil
 }
 
+func (c *postServiceClient) GetReactionCounts(ctx context.Context, in *GetReactionCountsRequest, opts ...grpc.CallOption) (*GetReactionCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReactionCountsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetReactionCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) BookmarkPost(ctx context.Context, in *BookmarkPostRequest, opts ...grpc.CallOption) (*BookmarkPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookmarkPostResponse)
+	err := c.cc.Invoke(ctx, PostService_BookmarkPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnbookmarkPost(ctx context.Context, in *UnbookmarkPostRequest, opts ...grpc.CallOption) (*UnbookmarkPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnbookmarkPostResponse)
+	err := c.cc.Invoke(ctx, PostService_UnbookmarkPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetBookmarks(ctx context.Context, in *GetBookmarksRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ReportPost(ctx context.Context, in *ReportPostRequest, opts ...grpc.CallOption) (*ReportPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportPostResponse)
+	err := c.cc.Invoke(ctx, PostService_ReportPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportResponse)
+	err := c.cc.Invoke(ctx, PostService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) CountPublicPosts(ctx context.Context, in *CountPublicPostsRequest, opts ...grpc.CallOption) (*CountPublicPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountPublicPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_CountPublicPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility.
@@ -179,20 +335,44 @@ type PostServiceServer interface {
 	GetPost(context.Context, *GetPostRequest) (*PostResponse, error)
 	// GetPosts retrieves posts with pagination and filtering
 	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
+	// GetPostsByHashtag retrieves posts tagged with a hashtag
+	GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsResponse, error)
 	// UpdatePost updates a post
 	UpdatePost(context.Context, *UpdatePostRequest) (*PostResponse, error)
 	// DeletePost deletes a post
 	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// SharePost reshares a post to the user's own feed
+	SharePost(context.Context, *SharePostRequest) (*PostResponse, error)
+	// RestorePost restores a deleted post within the restore window
+	RestorePost(context.Context, *RestorePostRequest) (*PostResponse, error)
 	// AddComment adds a comment to a post
 	AddComment(context.Context, *AddCommentRequest) (*CommentResponse, error)
 	// GetComments retrieves comments for a post
 	GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error)
+	// EditComment updates the content of a comment
+	EditComment(context.Context, *EditCommentRequest) (*CommentResponse, error)
 	// DeleteComment deletes a comment
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	// LikePost likes a post
 	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
 	// UnlikePost unlikes a post
 	UnlikePost(context.Context, *UnlikePostRequest) (*UnlikePostResponse, error)
+	// GetReactionCounts retrieves the number of each reaction on a post
+	GetReactionCounts(context.Context, *GetReactionCountsRequest) (*GetReactionCountsResponse, error)
+	// BookmarkPost saves a post for the user to revisit later
+	BookmarkPost(context.Context, *BookmarkPostRequest) (*BookmarkPostResponse, error)
+	// UnbookmarkPost removes a post from the user's bookmarks
+	UnbookmarkPost(context.Context, *UnbookmarkPostRequest) (*UnbookmarkPostResponse, error)
+	// GetBookmarks retrieves the user's bookmarked posts, newest bookmark first
+	GetBookmarks(context.Context, *GetBookmarksRequest) (*GetPostsResponse, error)
+	// ReportPost reports a post for moderation
+	ReportPost(context.Context, *ReportPostRequest) (*ReportPostResponse, error)
+	// GetReports retrieves the moderation queue; admins only
+	GetReports(context.Context, *GetReportsRequest) (*GetReportsResponse, error)
+	// ResolveReport resolves a report and optionally hides the reported post; admins only
+	ResolveReport(context.Context, *ResolveReportRequest) (*ReportResponse, error)
+	// CountPublicPosts counts the posts of an author that everyone can see
+	CountPublicPosts(context.Context, *CountPublicPostsRequest) (*CountPublicPostsResponse, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -212,18 +392,30 @@ func (UnimplementedPostServiceServer) GetPost(context.Context, *GetPostRequest)
 func (UnimplementedPostServiceServer) GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
 }
+func (UnimplementedPostServiceServer) GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByHashtag not implemented")
+}
 func (UnimplementedPostServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*PostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
 }
 func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (UnimplementedPostServiceServer) SharePost(context.Context, *SharePostRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePost not implemented")
+}
+func (UnimplementedPostServiceServer) RestorePost(context.Context, *RestorePostRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
 func (UnimplementedPostServiceServer) AddComment(context.Context, *AddCommentRequest) (*CommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
 }
 func (UnimplementedPostServiceServer) GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
+func (UnimplementedPostServiceServer) EditComment(context.Context, *EditCommentRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
 func (UnimplementedPostServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
@@ -233,6 +425,30 @@ func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest
 func (UnimplementedPostServiceServer) UnlikePost(context.Context, *UnlikePostRequest) (*UnlikePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
 }
+func (UnimplementedPostServiceServer) GetReactionCounts(context.Context, *GetReactionCountsRequest) (*GetReactionCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReactionCounts not implemented")
+}
+func (UnimplementedPostServiceServer) BookmarkPost(context.Context, *BookmarkPostRequest) (*BookmarkPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookmarkPost not implemented")
+}
+func (UnimplementedPostServiceServer) UnbookmarkPost(context.Context, *UnbookmarkPostRequest) (*UnbookmarkPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbookmarkPost not implemented")
+}
+func (UnimplementedPostServiceServer) GetBookmarks(context.Context, *GetBookmarksRequest) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarks not implemented")
+}
+func (UnimplementedPostServiceServer) ReportPost(context.Context, *ReportPostRequest) (*ReportPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+}
+func (UnimplementedPostServiceServer) GetReports(context.Context, *GetReportsRequest) (*GetReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReports not implemented")
+}
+func (UnimplementedPostServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*ReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedPostServiceServer) CountPublicPosts(context.Context, *CountPublicPostsRequest) (*CountPublicPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPublicPosts not implemented")
+}
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 func (UnimplementedPostServiceServer) testEmbeddedByValue()                     {}
 
@@ -308,6 +524,24 @@ func _PostService_GetPosts_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_GetPostsByHashtag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByHashtagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByHashtag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsByHashtag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByHashtag(ctx, req.(*GetPostsByHashtagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdatePostRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +578,42 @@ func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_SharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SharePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SharePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SharePost(ctx, req.(*SharePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCommentRequest)
 	if err := dec(in); err != nil {
@@ -380,6 +650,24 @@ func _PostService_GetComments_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +722,150 @@ func _PostService_UnlikePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_GetReactionCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReactionCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetReactionCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetReactionCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetReactionCounts(ctx, req.(*GetReactionCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_BookmarkPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).BookmarkPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_BookmarkPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).BookmarkPost(ctx, req.(*BookmarkPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnbookmarkPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbookmarkPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnbookmarkPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnbookmarkPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnbookmarkPost(ctx, req.(*UnbookmarkPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetBookmarks(ctx, req.(*GetBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ReportPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ReportPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ReportPost(ctx, req.(*ReportPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetReports(ctx, req.(*GetReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CountPublicPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountPublicPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CountPublicPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CountPublicPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CountPublicPosts(ctx, req.(*CountPublicPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PostService_ServiceDesc is the grpc.ServiceDesc for PostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -453,6 +885,10 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPosts",
 			Handler:    _PostService_GetPosts_Handler,
 		},
+		{
+			MethodName: "GetPostsByHashtag",
+			Handler:    _PostService_GetPostsByHashtag_Handler,
+		},
 		{
 			MethodName: "UpdatePost",
 			Handler:    _PostService_UpdatePost_Handler,
@@ -461,6 +897,14 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
 		},
+		{
+			MethodName: "SharePost",
+			Handler:    _PostService_SharePost_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _PostService_RestorePost_Handler,
+		},
 		{
 			MethodName: "AddComment",
 			Handler:    _PostService_AddComment_Handler,
@@ -469,6 +913,10 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetComments",
 			Handler:    _PostService_GetComments_Handler,
 		},
+		{
+			MethodName: "EditComment",
+			Handler:    _PostService_EditComment_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _PostService_DeleteComment_Handler,
@@ -481,6 +929,38 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlikePost",
 			Handler:    _PostService_UnlikePost_Handler,
 		},
+		{
+			MethodName: "GetReactionCounts",
+			Handler:    _PostService_GetReactionCounts_Handler,
+		},
+		{
+			MethodName: "BookmarkPost",
+			Handler:    _PostService_BookmarkPost_Handler,
+		},
+		{
+			MethodName: "UnbookmarkPost",
+			Handler:    _PostService_UnbookmarkPost_Handler,
+		},
+		{
+			MethodName: "GetBookmarks",
+			Handler:    _PostService_GetBookmarks_Handler,
+		},
+		{
+			MethodName: "ReportPost",
+			Handler:    _PostService_ReportPost_Handler,
+		},
+		{
+			MethodName: "GetReports",
+			Handler:    _PostService_GetReports_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _PostService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "CountPublicPosts",
+			Handler:    _PostService_CountPublicPosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "posts/posts.proto",
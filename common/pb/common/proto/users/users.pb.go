@@ -24,10 +24,13 @@ const (
 // RegisterRequest is the request for registering a new user
 type RegisterRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Provider is the OAuth provider (google or microsoft)
+	// Provider is the OAuth provider (google, microsoft, github or apple)
 	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// Token is the OAuth token
-	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Token is the OAuth token; for apple it is the identity token
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Name is the user's name for providers that leave it out of the token (optional).
+	// Apple only shares it with the app on the user's first sign-in.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,6 +79,13 @@ func (x *RegisterRequest) GetToken() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // RegisterResponse is the response for registering a new user
 type RegisterResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -134,10 +144,13 @@ func (x *RegisterResponse) GetAccessToken() string {
 // LoginRequest is the request for logging in a user
 type LoginRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Provider is the OAuth provider (google or microsoft)
+	// Provider is the OAuth provider (google, microsoft, github or apple)
 	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// Token is the OAuth token
-	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Token is the OAuth token; for apple it is the identity token
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Name is the user's name for providers that leave it out of the token (optional).
+	// Apple only shares it with the app on the user's first sign-in.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +199,13 @@ func (x *LoginRequest) GetToken() string {
 	return ""
 }
 
+func (x *LoginRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // LoginResponse is the response for logging in a user
 type LoginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -295,7 +315,13 @@ type UpdateProfileRequest struct {
 	// Name is the user's name
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Avatar is the URL to the user's avatar
-	Avatar        string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// Bio is a short text about the user, at most 300 characters; unchanged when unset, cleared when empty
+	Bio *string `protobuf:"bytes,4,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	// Location is where the user is, at most 100 characters; unchanged when unset, cleared when empty
+	Location *string `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Website is the URL of the user's website; unchanged when unset, cleared when empty
+	Website       *string `protobuf:"bytes,6,opt,name=website,proto3,oneof" json:"website,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,6 +377,27 @@ func (x *UpdateProfileRequest) GetAvatar() string {
 	return ""
 }
 
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetWebsite() string {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ""
+}
+
 // ProfileResponse is the response containing a user's profile
 type ProfileResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -363,7 +410,21 @@ type ProfileResponse struct {
 	// Avatar is the URL to the user's avatar
 	Avatar string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	// CreatedAt is the timestamp when the user was created
-	CreatedAt     string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Username is the user's unique handle, used for @mentions
+	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	// UpdatedAt is the timestamp when the user's profile was last updated
+	UpdatedAt string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// EmailVerified is whether an OAuth provider has confirmed that the user owns the email
+	EmailVerified bool `protobuf:"varint,8,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	// Role is the user's role, user or admin
+	Role string `protobuf:"bytes,9,opt,name=role,proto3" json:"role,omitempty"`
+	// Bio is a short text about the user
+	Bio string `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
+	// Location is where the user is
+	Location string `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	// Website is the URL of the user's website
+	Website       string `protobuf:"bytes,12,opt,name=website,proto3" json:"website,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -433,6 +494,55 @@ func (x *ProfileResponse) GetCreatedAt() string {
 	return ""
 }
 
+func (x *ProfileResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ProfileResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProfileResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *ProfileResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *ProfileResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ProfileResponse) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
 // GoogleLoginRequest is the request for generating a Google OAuth URL
 type GoogleLoginRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -525,6 +635,162 @@ func (x *MicrosoftLoginRequest) GetRedirectUrl() string {
 	return ""
 }
 
+// GitHubLoginRequest is the request for generating a GitHub OAuth URL
+type GitHubLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RedirectURL is the URL to redirect to after authentication (optional)
+	RedirectUrl   string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubLoginRequest) Reset() {
+	*x = GitHubLoginRequest{}
+	mi := &file_users_users_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubLoginRequest) ProtoMessage() {}
+
+func (x *GitHubLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubLoginRequest.ProtoReflect.Descriptor instead.
+func (*GitHubLoginRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GitHubLoginRequest) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+// AppleLoginRequest is the request for generating a Sign in with Apple URL
+type AppleLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RedirectURL is the URL to redirect to after authentication (optional)
+	RedirectUrl   string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppleLoginRequest) Reset() {
+	*x = AppleLoginRequest{}
+	mi := &file_users_users_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppleLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppleLoginRequest) ProtoMessage() {}
+
+func (x *AppleLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppleLoginRequest.ProtoReflect.Descriptor instead.
+func (*AppleLoginRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AppleLoginRequest) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+// AppleCallbackRequest is the request for handling the callback from Sign in with Apple
+type AppleCallbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State is the state token for CSRF protection
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Code is the authorization code from Apple
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Name is the user's name, which Apple only posts to the callback on the first sign-in (optional)
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppleCallbackRequest) Reset() {
+	*x = AppleCallbackRequest{}
+	mi := &file_users_users_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppleCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppleCallbackRequest) ProtoMessage() {}
+
+func (x *AppleCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppleCallbackRequest.ProtoReflect.Descriptor instead.
+func (*AppleCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AppleCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AppleCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AppleCallbackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // OAuthURLResponse is the response containing an OAuth URL
 type OAuthURLResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -538,7 +804,7 @@ type OAuthURLResponse struct {
 
 func (x *OAuthURLResponse) Reset() {
 	*x = OAuthURLResponse{}
-	mi := &file_users_users_proto_msgTypes[9]
+	mi := &file_users_users_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -550,7 +816,7 @@ func (x *OAuthURLResponse) String() string {
 func (*OAuthURLResponse) ProtoMessage() {}
 
 func (x *OAuthURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_users_proto_msgTypes[9]
+	mi := &file_users_users_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +829,7 @@ func (x *OAuthURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthURLResponse.ProtoReflect.Descriptor instead.
 func (*OAuthURLResponse) Descriptor() ([]byte, []int) {
-	return file_users_users_proto_rawDescGZIP(), []int{9}
+	return file_users_users_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OAuthURLResponse) GetUrl() string {
@@ -593,7 +859,7 @@ type OAuthCallbackRequest struct {
 
 func (x *OAuthCallbackRequest) Reset() {
 	*x = OAuthCallbackRequest{}
-	mi := &file_users_users_proto_msgTypes[10]
+	mi := &file_users_users_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +871,7 @@ func (x *OAuthCallbackRequest) String() string {
 func (*OAuthCallbackRequest) ProtoMessage() {}
 
 func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_users_proto_msgTypes[10]
+	mi := &file_users_users_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +884,7 @@ func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthCallbackRequest.ProtoReflect.Descriptor instead.
 func (*OAuthCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_users_users_proto_rawDescGZIP(), []int{10}
+	return file_users_users_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *OAuthCallbackRequest) GetState() string {
@@ -646,7 +912,7 @@ type ValidateStateTokenRequest struct {
 
 func (x *ValidateStateTokenRequest) Reset() {
 	*x = ValidateStateTokenRequest{}
-	mi := &file_users_users_proto_msgTypes[11]
+	mi := &file_users_users_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -658,7 +924,7 @@ func (x *ValidateStateTokenRequest) String() string {
 func (*ValidateStateTokenRequest) ProtoMessage() {}
 
 func (x *ValidateStateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_users_proto_msgTypes[11]
+	mi := &file_users_users_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +937,7 @@ func (x *ValidateStateTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateStateTokenRequest.ProtoReflect.Descriptor instead.
 func (*ValidateStateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_users_users_proto_rawDescGZIP(), []int{11}
+	return file_users_users_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ValidateStateTokenRequest) GetState() string {
@@ -692,7 +958,7 @@ type ValidateStateTokenResponse struct {
 
 func (x *ValidateStateTokenResponse) Reset() {
 	*x = ValidateStateTokenResponse{}
-	mi := &file_users_users_proto_msgTypes[12]
+	mi := &file_users_users_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +970,7 @@ func (x *ValidateStateTokenResponse) String() string {
 func (*ValidateStateTokenResponse) ProtoMessage() {}
 
 func (x *ValidateStateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_users_proto_msgTypes[12]
+	mi := &file_users_users_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +983,7 @@ func (x *ValidateStateTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateStateTokenResponse.ProtoReflect.Descriptor instead.
 func (*ValidateStateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_users_users_proto_rawDescGZIP(), []int{12}
+	return file_users_users_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidateStateTokenResponse) GetValid() bool {
@@ -738,7 +1004,7 @@ type SignoutRequest struct {
 
 func (x *SignoutRequest) Reset() {
 	*x = SignoutRequest{}
-	mi := &file_users_users_proto_msgTypes[13]
+	mi := &file_users_users_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -750,7 +1016,7 @@ func (x *SignoutRequest) String() string {
 func (*SignoutRequest) ProtoMessage() {}
 
 func (x *SignoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_users_proto_msgTypes[13]
+	mi := &file_users_users_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +1029,7 @@ func (x *SignoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignoutRequest.ProtoReflect.Descriptor instead.
 func (*SignoutRequest) Descriptor() ([]byte, []int) {
-	return file_users_users_proto_rawDescGZIP(), []int{13}
+	return file_users_users_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SignoutRequest) GetToken() string {
@@ -784,7 +1050,7 @@ type SignoutResponse struct {
 
 func (x *SignoutResponse) Reset() {
 	*x = SignoutResponse{}
-	mi := &file_users_users_proto_msgTypes[14]
+	mi := &file_users_users_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -796,7 +1062,7 @@ func (x *SignoutResponse) String() string {
 func (*SignoutResponse) ProtoMessage() {}
 
 func (x *SignoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_users_proto_msgTypes[14]
+	mi := &file_users_users_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +1075,7 @@ func (x *SignoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignoutResponse.ProtoReflect.Descriptor instead.
 func (*SignoutResponse) Descriptor() ([]byte, []int) {
-	return file_users_users_proto_rawDescGZIP(), []int{14}
+	return file_users_users_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SignoutResponse) GetSuccess() bool {
@@ -819,66 +1085,1092 @@ func (x *SignoutResponse) GetSuccess() bool {
 	return false
 }
 
-var File_users_users_proto protoreflect.FileDescriptor
+// GetUsersByIDsRequest is the request for retrieving several users at once
+type GetUsersByIDsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserIds are the unique identifiers of the users to retrieve
+	UserIds       []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_users_users_proto_rawDesc = "" +
-	"\n" +
-	"\x11users/users.proto\x12\x05users\"C\n" +
-	"\x0fRegisterRequest\x12\x1a\n" +
-	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"N\n" +
-	"\x10RegisterResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"@\n" +
-	"\fLoginRequest\x12\x1a\n" +
-	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"K\n" +
-	"\rLoginResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\",\n" +
-	"\x11GetProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"[\n" +
-	"\x14UpdateProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06avatar\x18\x03 \x01(\tR\x06avatar\"\x8b\x01\n" +
-	"\x0fProfileResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
-	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"7\n" +
-	"\x12GoogleLoginRequest\x12!\n" +
-	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\":\n" +
-	"\x15MicrosoftLoginRequest\x12!\n" +
-	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\":\n" +
-	"\x10OAuthURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\"@\n" +
-	"\x14OAuthCallbackRequest\x12\x14\n" +
-	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"1\n" +
-	"\x19ValidateStateTokenRequest\x12\x14\n" +
-	"\x05state\x18\x01 \x01(\tR\x05state\"2\n" +
-	"\x1aValidateStateTokenResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\"&\n" +
-	"\x0eSignoutRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"+\n" +
-	"\x0fSignoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xb2\x05\n" +
-	"\vUserService\x12;\n" +
-	"\bRegister\x12\x16.users.RegisterRequest\x1a\x17.users.RegisterResponse\x122\n" +
-	"\x05Login\x12\x13.users.LoginRequest\x1a\x14.users.LoginResponse\x12>\n" +
-	"\n" +
-	"GetProfile\x12\x18.users.GetProfileRequest\x1a\x16.users.ProfileResponse\x12D\n" +
-	"\rUpdateProfile\x12\x1b.users.UpdateProfileRequest\x1a\x16.users.ProfileResponse\x12A\n" +
-	"\vGoogleLogin\x12\x19.users.GoogleLoginRequest\x1a\x17.users.OAuthURLResponse\x12G\n" +
+func (x *GetUsersByIDsRequest) Reset() {
+	*x = GetUsersByIDsRequest{}
+	mi := &file_users_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsRequest) ProtoMessage() {}
+
+func (x *GetUsersByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUsersByIDsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// UserSummary is the public info of a user
+type UserSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the unique identifier for the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Name is the user's name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Avatar is the URL to the user's avatar
+	Avatar string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// Username is the user's unique handle, used for @mentions
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// LastSeenAt is the timestamp when the user was last active; empty if they never were
+	LastSeenAt string `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// IsOnline indicates if the user was active within the online window
+	IsOnline      bool `protobuf:"varint,6,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_users_users_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserSummary) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSummary) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UserSummary) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserSummary) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+func (x *UserSummary) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+// GetUsersByUsernamesRequest is the request for retrieving several users by username at once
+type GetUsersByUsernamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Usernames are the handles of the users to retrieve, without the leading @
+	Usernames     []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByUsernamesRequest) Reset() {
+	*x = GetUsersByUsernamesRequest{}
+	mi := &file_users_users_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByUsernamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByUsernamesRequest) ProtoMessage() {}
+
+func (x *GetUsersByUsernamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByUsernamesRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByUsernamesRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUsersByUsernamesRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+// GetUsersByIDsResponse is the response containing the requested users
+type GetUsersByIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Users are the users that were found; unknown IDs are omitted
+	Users         []*UserSummary `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIDsResponse) Reset() {
+	*x = GetUsersByIDsResponse{}
+	mi := &file_users_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsResponse) ProtoMessage() {}
+
+func (x *GetUsersByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUsersByIDsResponse) GetUsers() []*UserSummary {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// SearchUsersRequest is the request for finding users by name or email
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the ID of the user searching; they are left out of the results
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Query is the text to match against names and emails
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Page is the page number for pagination
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// Limit is the number of users per page
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_users_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchUsersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// UserSearchResult is a user found by a search
+type UserSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User is the public info of the user that was found
+	User *UserSummary `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// FriendshipStatus is the searcher's relationship with the user (none, pending, friends, blocked)
+	FriendshipStatus string `protobuf:"bytes,2,opt,name=friendship_status,json=friendshipStatus,proto3" json:"friendship_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserSearchResult) Reset() {
+	*x = UserSearchResult{}
+	mi := &file_users_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSearchResult) ProtoMessage() {}
+
+func (x *UserSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSearchResult.ProtoReflect.Descriptor instead.
+func (*UserSearchResult) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserSearchResult) GetUser() *UserSummary {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserSearchResult) GetFriendshipStatus() string {
+	if x != nil {
+		return x.FriendshipStatus
+	}
+	return ""
+}
+
+// SearchUsersResponse is the response containing the users that matched a search
+type SearchUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Users are the users that matched the query
+	Users []*UserSearchResult `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// TotalCount is the total number of matching users
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Page is the current page number
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// TotalPages is the total number of pages
+	TotalPages    int32 `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_users_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*UserSearchResult {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchUsersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsersResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// DeleteAccountRequest is the request for deleting the authenticated user's account
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_users_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{25}
+}
+
+// DeleteAccountResponse is the response for deleting an account
+type DeleteAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates whether the account was deleted
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_users_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetUserRoleRequest is the request for changing a user's role
+type SetUserRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the unique identifier of the user whose role changes
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Role is the new role, user or admin
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_users_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// GetPublicProfileRequest is the request for retrieving another user's public profile
+type GetPublicProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UserId is the unique identifier of the user whose profile is retrieved
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileRequest) Reset() {
+	*x = GetPublicProfileRequest{}
+	mi := &file_users_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileRequest) ProtoMessage() {}
+
+func (x *GetPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPublicProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// PublicProfileResponse is a user's profile without the details the viewer may not see
+type PublicProfileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User is the public info of the user
+	User *UserSummary `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Email is the user's email; empty unless the viewer is allowed to see it
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// CreatedAt is the timestamp when the user joined
+	CreatedAt string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// FriendshipStatus is the viewer's relationship with the user (none, pending, friends, blocked),
+	// self when viewing their own profile, or unknown if the friends service could not be reached
+	FriendshipStatus string `protobuf:"bytes,4,opt,name=friendship_status,json=friendshipStatus,proto3" json:"friendship_status,omitempty"`
+	// Bio is a short text about the user
+	Bio string `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	// Location is where the user is
+	Location string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// Website is the URL of the user's website
+	Website string `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
+	// FollowersCount is the number of users who follow the user; unset if the friends service could not be reached
+	FollowersCount *int32 `protobuf:"varint,8,opt,name=followers_count,json=followersCount,proto3,oneof" json:"followers_count,omitempty"`
+	// FollowingCount is the number of users the user follows; unset if the friends service could not be reached
+	FollowingCount *int32 `protobuf:"varint,9,opt,name=following_count,json=followingCount,proto3,oneof" json:"following_count,omitempty"`
+	// IsFollowing indicates if the viewer follows the user
+	IsFollowing   bool `protobuf:"varint,10,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicProfileResponse) Reset() {
+	*x = PublicProfileResponse{}
+	mi := &file_users_users_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfileResponse) ProtoMessage() {}
+
+func (x *PublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*PublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PublicProfileResponse) GetUser() *UserSummary {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PublicProfileResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetFriendshipStatus() string {
+	if x != nil {
+		return x.FriendshipStatus
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetFollowersCount() int32 {
+	if x != nil && x.FollowersCount != nil {
+		return *x.FollowersCount
+	}
+	return 0
+}
+
+func (x *PublicProfileResponse) GetFollowingCount() int32 {
+	if x != nil && x.FollowingCount != nil {
+		return *x.FollowingCount
+	}
+	return 0
+}
+
+func (x *PublicProfileResponse) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+// MarkSeenRequest is the request for recording that the authenticated user is active
+type MarkSeenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSeenRequest) Reset() {
+	*x = MarkSeenRequest{}
+	mi := &file_users_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeenRequest) ProtoMessage() {}
+
+func (x *MarkSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeenRequest.ProtoReflect.Descriptor instead.
+func (*MarkSeenRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{30}
+}
+
+// MarkSeenResponse is the response to recording that the user is active
+type MarkSeenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success indicates if the activity was recorded
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSeenResponse) Reset() {
+	*x = MarkSeenResponse{}
+	mi := &file_users_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeenResponse) ProtoMessage() {}
+
+func (x *MarkSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeenResponse.ProtoReflect.Descriptor instead.
+func (*MarkSeenResponse) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MarkSeenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// IsTokenRevokedRequest is the request for checking whether a token has been revoked
+type IsTokenRevokedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Jti is the ID of the token, from its jti claim
+	Jti string `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti,omitempty"`
+	// UserId is the subject of the token, from its sub claim. A token of a user that no
+	// longer exists counts as revoked.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsTokenRevokedRequest) Reset() {
+	*x = IsTokenRevokedRequest{}
+	mi := &file_users_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsTokenRevokedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsTokenRevokedRequest) ProtoMessage() {}
+
+func (x *IsTokenRevokedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsTokenRevokedRequest.ProtoReflect.Descriptor instead.
+func (*IsTokenRevokedRequest) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IsTokenRevokedRequest) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *IsTokenRevokedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// IsTokenRevokedResponse is the response to checking whether a token has been revoked
+type IsTokenRevokedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revoked indicates if the token was revoked by signing out
+	Revoked       bool `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsTokenRevokedResponse) Reset() {
+	*x = IsTokenRevokedResponse{}
+	mi := &file_users_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsTokenRevokedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsTokenRevokedResponse) ProtoMessage() {}
+
+func (x *IsTokenRevokedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsTokenRevokedResponse.ProtoReflect.Descriptor instead.
+func (*IsTokenRevokedResponse) Descriptor() ([]byte, []int) {
+	return file_users_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *IsTokenRevokedResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+var File_users_users_proto protoreflect.FileDescriptor
+
+const file_users_users_proto_rawDesc = "" +
+	"\n" +
+	"\x11users/users.proto\x12\x05users\"W\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"N\n" +
+	"\x10RegisterResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"T\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"K\n" +
+	"\rLoginResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\",\n" +
+	"\x11GetProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd3\x01\n" +
+	"\x14UpdateProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x15\n" +
+	"\x03bio\x18\x04 \x01(\tH\x00R\x03bio\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\x05 \x01(\tH\x01R\blocation\x88\x01\x01\x12\x1d\n" +
+	"\awebsite\x18\x06 \x01(\tH\x02R\awebsite\x88\x01\x01B\x06\n" +
+	"\x04_bioB\v\n" +
+	"\t_locationB\n" +
+	"\n" +
+	"\b_website\"\xc9\x02\n" +
+	"\x0fProfileResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12%\n" +
+	"\x0eemail_verified\x18\b \x01(\bR\remailVerified\x12\x12\n" +
+	"\x04role\x18\t \x01(\tR\x04role\x12\x10\n" +
+	"\x03bio\x18\n" +
+	" \x01(\tR\x03bio\x12\x1a\n" +
+	"\blocation\x18\v \x01(\tR\blocation\x12\x18\n" +
+	"\awebsite\x18\f \x01(\tR\awebsite\"7\n" +
+	"\x12GoogleLoginRequest\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\":\n" +
+	"\x15MicrosoftLoginRequest\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\"7\n" +
+	"\x12GitHubLoginRequest\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\"6\n" +
+	"\x11AppleLoginRequest\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\"T\n" +
+	"\x14AppleCallbackRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\":\n" +
+	"\x10OAuthURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"@\n" +
+	"\x14OAuthCallbackRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"1\n" +
+	"\x19ValidateStateTokenRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\"2\n" +
+	"\x1aValidateStateTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\"&\n" +
+	"\x0eSignoutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"+\n" +
+	"\x0fSignoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
+	"\x14GetUsersByIDsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\xad\x01\n" +
+	"\vUserSummary\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12 \n" +
+	"\flast_seen_at\x18\x05 \x01(\tR\n" +
+	"lastSeenAt\x12\x1b\n" +
+	"\tis_online\x18\x06 \x01(\bR\bisOnline\":\n" +
+	"\x1aGetUsersByUsernamesRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"A\n" +
+	"\x15GetUsersByIDsResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.users.UserSummaryR\x05users\"m\n" +
+	"\x12SearchUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"g\n" +
+	"\x10UserSearchResult\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.users.UserSummaryR\x04user\x12+\n" +
+	"\x11friendship_status\x18\x02 \x01(\tR\x10friendshipStatus\"\x9a\x01\n" +
+	"\x13SearchUsersResponse\x12-\n" +
+	"\x05users\x18\x01 \x03(\v2\x17.users.UserSearchResultR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"\x16\n" +
+	"\x14DeleteAccountRequest\"1\n" +
+	"\x15DeleteAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x12SetUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"2\n" +
+	"\x17GetPublicProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x90\x03\n" +
+	"\x15PublicProfileResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.users.UserSummaryR\x04user\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12+\n" +
+	"\x11friendship_status\x18\x04 \x01(\tR\x10friendshipStatus\x12\x10\n" +
+	"\x03bio\x18\x05 \x01(\tR\x03bio\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x18\n" +
+	"\awebsite\x18\a \x01(\tR\awebsite\x12,\n" +
+	"\x0ffollowers_count\x18\b \x01(\x05H\x00R\x0efollowersCount\x88\x01\x01\x12,\n" +
+	"\x0ffollowing_count\x18\t \x01(\x05H\x01R\x0efollowingCount\x88\x01\x01\x12!\n" +
+	"\fis_following\x18\n" +
+	" \x01(\bR\visFollowingB\x12\n" +
+	"\x10_followers_countB\x12\n" +
+	"\x10_following_count\"\x11\n" +
+	"\x0fMarkSeenRequest\",\n" +
+	"\x10MarkSeenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\x15IsTokenRevokedRequest\x12\x10\n" +
+	"\x03jti\x18\x01 \x01(\tR\x03jti\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
+	"\x16IsTokenRevokedResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\bR\arevoked2\x95\f\n" +
+	"\vUserService\x12;\n" +
+	"\bRegister\x12\x16.users.RegisterRequest\x1a\x17.users.RegisterResponse\x122\n" +
+	"\x05Login\x12\x13.users.LoginRequest\x1a\x14.users.LoginResponse\x12>\n" +
+	"\n" +
+	"GetProfile\x12\x18.users.GetProfileRequest\x1a\x16.users.ProfileResponse\x12D\n" +
+	"\rUpdateProfile\x12\x1b.users.UpdateProfileRequest\x1a\x16.users.ProfileResponse\x12A\n" +
+	"\vGoogleLogin\x12\x19.users.GoogleLoginRequest\x1a\x17.users.OAuthURLResponse\x12G\n" +
 	"\x0eMicrosoftLogin\x12\x1c.users.MicrosoftLoginRequest\x1a\x17.users.OAuthURLResponse\x12C\n" +
 	"\x0eGoogleCallback\x12\x1b.users.OAuthCallbackRequest\x1a\x14.users.LoginResponse\x12F\n" +
 	"\x11MicrosoftCallback\x12\x1b.users.OAuthCallbackRequest\x1a\x14.users.LoginResponse\x12Y\n" +
 	"\x12ValidateStateToken\x12 .users.ValidateStateTokenRequest\x1a!.users.ValidateStateTokenResponse\x128\n" +
-	"\aSignout\x12\x15.users.SignoutRequest\x1a\x16.users.SignoutResponseB\x14Z\x12common/proto/usersb\x06proto3"
+	"\aSignout\x12\x15.users.SignoutRequest\x1a\x16.users.SignoutResponse\x12J\n" +
+	"\rGetUsersByIDs\x12\x1b.users.GetUsersByIDsRequest\x1a\x1c.users.GetUsersByIDsResponse\x12D\n" +
+	"\vSearchUsers\x12\x19.users.SearchUsersRequest\x1a\x1a.users.SearchUsersResponse\x12V\n" +
+	"\x13GetUsersByUsernames\x12!.users.GetUsersByUsernamesRequest\x1a\x1c.users.GetUsersByIDsResponse\x12J\n" +
+	"\rDeleteAccount\x12\x1b.users.DeleteAccountRequest\x1a\x1c.users.DeleteAccountResponse\x12A\n" +
+	"\vGitHubLogin\x12\x19.users.GitHubLoginRequest\x1a\x17.users.OAuthURLResponse\x12C\n" +
+	"\x0eGitHubCallback\x12\x1b.users.OAuthCallbackRequest\x1a\x14.users.LoginResponse\x12?\n" +
+	"\n" +
+	"AppleLogin\x12\x18.users.AppleLoginRequest\x1a\x17.users.OAuthURLResponse\x12B\n" +
+	"\rAppleCallback\x12\x1b.users.AppleCallbackRequest\x1a\x14.users.LoginResponse\x12@\n" +
+	"\vSetUserRole\x12\x19.users.SetUserRoleRequest\x1a\x16.users.ProfileResponse\x12P\n" +
+	"\x10GetPublicProfile\x12\x1e.users.GetPublicProfileRequest\x1a\x1c.users.PublicProfileResponse\x12;\n" +
+	"\bMarkSeen\x12\x16.users.MarkSeenRequest\x1a\x17.users.MarkSeenResponse\x12M\n" +
+	"\x0eIsTokenRevoked\x12\x1c.users.IsTokenRevokedRequest\x1a\x1d.users.IsTokenRevokedResponseB\x14Z\x12common/proto/usersb\x06proto3"
 
 var (
 	file_users_users_proto_rawDescOnce sync.Once
@@ -892,7 +2184,7 @@ func file_users_users_proto_rawDescGZIP() []byte {
 	return file_users_users_proto_rawDescData
 }
 
-var file_users_users_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_users_users_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_users_users_proto_goTypes = []any{
 	(*RegisterRequest)(nil),            // 0: users.RegisterRequest
 	(*RegisterResponse)(nil),           // 1: users.RegisterResponse
@@ -903,39 +2195,86 @@ var file_users_users_proto_goTypes = []any{
 	(*ProfileResponse)(nil),            // 6: users.ProfileResponse
 	(*GoogleLoginRequest)(nil),         // 7: users.GoogleLoginRequest
 	(*MicrosoftLoginRequest)(nil),      // 8: users.MicrosoftLoginRequest
-	(*OAuthURLResponse)(nil),           // 9: users.OAuthURLResponse
-	(*OAuthCallbackRequest)(nil),       // 10: users.OAuthCallbackRequest
-	(*ValidateStateTokenRequest)(nil),  // 11: users.ValidateStateTokenRequest
-	(*ValidateStateTokenResponse)(nil), // 12: users.ValidateStateTokenResponse
-	(*SignoutRequest)(nil),             // 13: users.SignoutRequest
-	(*SignoutResponse)(nil),            // 14: users.SignoutResponse
+	(*GitHubLoginRequest)(nil),         // 9: users.GitHubLoginRequest
+	(*AppleLoginRequest)(nil),          // 10: users.AppleLoginRequest
+	(*AppleCallbackRequest)(nil),       // 11: users.AppleCallbackRequest
+	(*OAuthURLResponse)(nil),           // 12: users.OAuthURLResponse
+	(*OAuthCallbackRequest)(nil),       // 13: users.OAuthCallbackRequest
+	(*ValidateStateTokenRequest)(nil),  // 14: users.ValidateStateTokenRequest
+	(*ValidateStateTokenResponse)(nil), // 15: users.ValidateStateTokenResponse
+	(*SignoutRequest)(nil),             // 16: users.SignoutRequest
+	(*SignoutResponse)(nil),            // 17: users.SignoutResponse
+	(*GetUsersByIDsRequest)(nil),       // 18: users.GetUsersByIDsRequest
+	(*UserSummary)(nil),                // 19: users.UserSummary
+	(*GetUsersByUsernamesRequest)(nil), // 20: users.GetUsersByUsernamesRequest
+	(*GetUsersByIDsResponse)(nil),      // 21: users.GetUsersByIDsResponse
+	(*SearchUsersRequest)(nil),         // 22: users.SearchUsersRequest
+	(*UserSearchResult)(nil),           // 23: users.UserSearchResult
+	(*SearchUsersResponse)(nil),        // 24: users.SearchUsersResponse
+	(*DeleteAccountRequest)(nil),       // 25: users.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),      // 26: users.DeleteAccountResponse
+	(*SetUserRoleRequest)(nil),         // 27: users.SetUserRoleRequest
+	(*GetPublicProfileRequest)(nil),    // 28: users.GetPublicProfileRequest
+	(*PublicProfileResponse)(nil),      // 29: users.PublicProfileResponse
+	(*MarkSeenRequest)(nil),            // 30: users.MarkSeenRequest
+	(*MarkSeenResponse)(nil),           // 31: users.MarkSeenResponse
+	(*IsTokenRevokedRequest)(nil),      // 32: users.IsTokenRevokedRequest
+	(*IsTokenRevokedResponse)(nil),     // 33: users.IsTokenRevokedResponse
 }
 var file_users_users_proto_depIdxs = []int32{
-	0,  // 0: users.UserService.Register:input_type -> users.RegisterRequest
-	2,  // 1: users.UserService.Login:input_type -> users.LoginRequest
-	4,  // 2: users.UserService.GetProfile:input_type -> users.GetProfileRequest
-	5,  // 3: users.UserService.UpdateProfile:input_type -> users.UpdateProfileRequest
-	7,  // 4: users.UserService.GoogleLogin:input_type -> users.GoogleLoginRequest
-	8,  // 5: users.UserService.MicrosoftLogin:input_type -> users.MicrosoftLoginRequest
-	10, // 6: users.UserService.GoogleCallback:input_type -> users.OAuthCallbackRequest
-	10, // 7: users.UserService.MicrosoftCallback:input_type -> users.OAuthCallbackRequest
-	11, // 8: users.UserService.ValidateStateToken:input_type -> users.ValidateStateTokenRequest
-	13, // 9: users.UserService.Signout:input_type -> users.SignoutRequest
-	1,  // 10: users.UserService.Register:output_type -> users.RegisterResponse
-	3,  // 11: users.UserService.Login:output_type -> users.LoginResponse
-	6,  // 12: users.UserService.GetProfile:output_type -> users.ProfileResponse
-	6,  // 13: users.UserService.UpdateProfile:output_type -> users.ProfileResponse
-	9,  // 14: users.UserService.GoogleLogin:output_type -> users.OAuthURLResponse
-	9,  // 15: users.UserService.MicrosoftLogin:output_type -> users.OAuthURLResponse
-	3,  // 16: users.UserService.GoogleCallback:output_type -> users.LoginResponse
-	3,  // 17: users.UserService.MicrosoftCallback:output_type -> users.LoginResponse
-	12, // 18: users.UserService.ValidateStateToken:output_type -> users.ValidateStateTokenResponse
-	14, // 19: users.UserService.Signout:output_type -> users.SignoutResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	19, // 0: users.GetUsersByIDsResponse.users:type_name -> users.UserSummary
+	19, // 1: users.UserSearchResult.user:type_name -> users.UserSummary
+	23, // 2: users.SearchUsersResponse.users:type_name -> users.UserSearchResult
+	19, // 3: users.PublicProfileResponse.user:type_name -> users.UserSummary
+	0,  // 4: users.UserService.Register:input_type -> users.RegisterRequest
+	2,  // 5: users.UserService.Login:input_type -> users.LoginRequest
+	4,  // 6: users.UserService.GetProfile:input_type -> users.GetProfileRequest
+	5,  // 7: users.UserService.UpdateProfile:input_type -> users.UpdateProfileRequest
+	7,  // 8: users.UserService.GoogleLogin:input_type -> users.GoogleLoginRequest
+	8,  // 9: users.UserService.MicrosoftLogin:input_type -> users.MicrosoftLoginRequest
+	13, // 10: users.UserService.GoogleCallback:input_type -> users.OAuthCallbackRequest
+	13, // 11: users.UserService.MicrosoftCallback:input_type -> users.OAuthCallbackRequest
+	14, // 12: users.UserService.ValidateStateToken:input_type -> users.ValidateStateTokenRequest
+	16, // 13: users.UserService.Signout:input_type -> users.SignoutRequest
+	18, // 14: users.UserService.GetUsersByIDs:input_type -> users.GetUsersByIDsRequest
+	22, // 15: users.UserService.SearchUsers:input_type -> users.SearchUsersRequest
+	20, // 16: users.UserService.GetUsersByUsernames:input_type -> users.GetUsersByUsernamesRequest
+	25, // 17: users.UserService.DeleteAccount:input_type -> users.DeleteAccountRequest
+	9,  // 18: users.UserService.GitHubLogin:input_type -> users.GitHubLoginRequest
+	13, // 19: users.UserService.GitHubCallback:input_type -> users.OAuthCallbackRequest
+	10, // 20: users.UserService.AppleLogin:input_type -> users.AppleLoginRequest
+	11, // 21: users.UserService.AppleCallback:input_type -> users.AppleCallbackRequest
+	27, // 22: users.UserService.SetUserRole:input_type -> users.SetUserRoleRequest
+	28, // 23: users.UserService.GetPublicProfile:input_type -> users.GetPublicProfileRequest
+	30, // 24: users.UserService.MarkSeen:input_type -> users.MarkSeenRequest
+	32, // 25: users.UserService.IsTokenRevoked:input_type -> users.IsTokenRevokedRequest
+	1,  // 26: users.UserService.Register:output_type -> users.RegisterResponse
+	3,  // 27: users.UserService.Login:output_type -> users.LoginResponse
+	6,  // 28: users.UserService.GetProfile:output_type -> users.ProfileResponse
+	6,  // 29: users.UserService.UpdateProfile:output_type -> users.ProfileResponse
+	12, // 30: users.UserService.GoogleLogin:output_type -> users.OAuthURLResponse
+	12, // 31: users.UserService.MicrosoftLogin:output_type -> users.OAuthURLResponse
+	3,  // 32: users.UserService.GoogleCallback:output_type -> users.LoginResponse
+	3,  // 33: users.UserService.MicrosoftCallback:output_type -> users.LoginResponse
+	15, // 34: users.UserService.ValidateStateToken:output_type -> users.ValidateStateTokenResponse
+	17, // 35: users.UserService.Signout:output_type -> users.SignoutResponse
+	21, // 36: users.UserService.GetUsersByIDs:output_type -> users.GetUsersByIDsResponse
+	24, // 37: users.UserService.SearchUsers:output_type -> users.SearchUsersResponse
+	21, // 38: users.UserService.GetUsersByUsernames:output_type -> users.GetUsersByIDsResponse
+	26, // 39: users.UserService.DeleteAccount:output_type -> users.DeleteAccountResponse
+	12, // 40: users.UserService.GitHubLogin:output_type -> users.OAuthURLResponse
+	3,  // 41: users.UserService.GitHubCallback:output_type -> users.LoginResponse
+	12, // 42: users.UserService.AppleLogin:output_type -> users.OAuthURLResponse
+	3,  // 43: users.UserService.AppleCallback:output_type -> users.LoginResponse
+	6,  // 44: users.UserService.SetUserRole:output_type -> users.ProfileResponse
+	29, // 45: users.UserService.GetPublicProfile:output_type -> users.PublicProfileResponse
+	31, // 46: users.UserService.MarkSeen:output_type -> users.MarkSeenResponse
+	33, // 47: users.UserService.IsTokenRevoked:output_type -> users.IsTokenRevokedResponse
+	26, // [26:48] is the sub-list for method output_type
+	4,  // [4:26] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_users_users_proto_init() }
@@ -943,13 +2282,15 @@ func file_users_users_proto_init() {
 	if File_users_users_proto != nil {
 		return
 	}
+	file_users_users_proto_msgTypes[5].OneofWrappers = []any{}
+	file_users_users_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_users_proto_rawDesc), len(file_users_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
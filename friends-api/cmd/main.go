@@ -3,6 +3,7 @@ package main
 import (
 	pb "common/pb/common/proto/friends"
 	"fmt"
+	"friends-api/internal/clients"
 	"friends-api/internal/config"
 	"friends-api/internal/controllers"
 	"friends-api/internal/middleware"
@@ -13,8 +14,11 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -28,7 +32,8 @@ func main() {
 	}
 
 	// Initialize logger
-	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output, cfg.Logging.File)
+	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output, cfg.Logging.File,
+		logger.RotationConfig(cfg.Logging.Rotation), logger.SamplingConfig(cfg.Logging.Sampling))
 	if err != nil {
 		fmt.Printf("Failed to initialize logger: %v\n", err)
 		os.Exit(1)
@@ -37,8 +42,8 @@ func main() {
 
 	log.Info("Starting Friends API")
 
-	// Connect to database
-	db, err := gorm.Open(mysql.Open(cfg.Database.GetDSN()), &gorm.Config{})
+	// Connect to database, waiting for it to come up
+	db, err := connectDatabase(cfg.Database, log)
 	if err != nil {
 		log.Fatal("Failed to connect to database", err)
 	}
@@ -52,26 +57,64 @@ func main() {
 	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
 	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
 
+	// Create missing tables and columns
+	if cfg.Database.AutoMigrate {
+		tables, err := repository.AutoMigrate(db)
+		if err != nil {
+			log.Fatal("Failed to migrate database", err)
+		}
+		log.Info("Migrated database", logger.Field("tables", tables))
+	}
+
+	// Limit how long a statement may run, after the migrations so that they are not limited
+	if cfg.Database.QueryTimeout > 0 {
+		if err := db.Use(repository.NewQueryTimeout(cfg.Database.QueryTimeout)); err != nil {
+			log.Fatal("Failed to register query timeout", err)
+		}
+		log.Info("Query timeout enabled", logger.Field("timeout", cfg.Database.QueryTimeout.String()))
+	}
+
 	// Initialize repositories
 	friendRepo := repository.NewFriendRepository(db)
+	followRepo := repository.NewFollowRepository(db)
+
+	// Initialize clients for other services
+	userClient, err := clients.NewUserClient(cfg.Services.UsersServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to users service", err)
+	}
+	userClient = clients.NewCachedUserClient(userClient, cfg.Services.UserCache.Size, cfg.Services.UserCache.TTL)
+	notificationClient, err := clients.NewNotificationClient(cfg.Services.UsersServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to notification service", err)
+	}
 
 	// Initialize services
-	friendService := services.NewFriendService(friendRepo, log)
+	friendService := services.NewFriendService(friendRepo, userClient, notificationClient, log)
+	followService := services.NewFollowService(followRepo, friendRepo, userClient, log)
 
 	// Initialize controllers
-	friendController := controllers.NewFriendController(friendService, log)
+	friendController := controllers.NewFriendController(friendService, followService, userClient, log)
 
-	// Initialize auth interceptor
+	// Initialize interceptors
+	requestIDInterceptor := middleware.NewRequestIDInterceptor(log)
 	authInterceptor := middleware.NewAuthInterceptor(cfg.JWT.Secret, log)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainUnaryInterceptor(requestIDInterceptor.Unary(), authInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(requestIDInterceptor.Stream(), authInterceptor.Stream()),
 	)
 
 	// Register services
 	pb.RegisterFriendServiceServer(grpcServer, friendController)
 
+	// Register health service; the database is connected at this point
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("friends.FriendService", healthpb.HealthCheckResponse_SERVING)
+
 	// Start gRPC server
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port))
 	if err != nil {
@@ -91,6 +134,55 @@ func main() {
 	<-quit
 
 	log.Info("Shutting down server...")
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
 	log.Info("Server exited properly")
 }
+
+const (
+	// defaultDBConnectAttempts is how many times the database connection is tried at startup
+	defaultDBConnectAttempts = 10
+
+	// defaultDBConnectMaxDelay caps the wait between two connection attempts
+	defaultDBConnectMaxDelay = 30 * time.Second
+
+	// dbConnectInitialDelay is the wait after the first failed attempt, doubled after each failure
+	dbConnectInitialDelay = time.Second
+)
+
+// connectDatabase connects to the database, retrying with exponential backoff since the
+// database may still be starting alongside the service
+func connectDatabase(cfg config.DatabaseConfig, log *logger.Logger) (*gorm.DB, error) {
+	attempts := cfg.ConnectAttempts
+	if attempts <= 0 {
+		attempts = defaultDBConnectAttempts
+	}
+	maxDelay := cfg.ConnectMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultDBConnectMaxDelay
+	}
+
+	delay := dbConnectInitialDelay
+	for attempt := 1; ; attempt++ {
+		// gorm.Open pings the database, so this fails until it accepts connections
+		db, err := gorm.Open(mysql.Open(cfg.GetDSN()), &gorm.Config{})
+		if err == nil {
+			log.Info("Connected to database", logger.Field("attempt", attempt))
+			return db, nil
+		}
+		if attempt >= attempts {
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempts, err)
+		}
+
+		log.Warn("Failed to connect to database",
+			logger.Field("attempt", attempt),
+			logger.Field("retry_in", delay.String()),
+			logger.Field("error", err.Error()))
+		time.Sleep(delay)
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS follows (
+    id VARCHAR(36) PRIMARY KEY,
+    follower_id VARCHAR(36) NOT NULL,
+    followee_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_follows_follower_followee ON follows(follower_id, followee_id);
+CREATE INDEX idx_follows_followee_id ON follows(followee_id);
@@ -0,0 +1,127 @@
+package clients
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultUserCacheTTL is how long a cached user is kept when no TTL is configured
+const defaultUserCacheTTL = 30 * time.Second
+
+// cachedUserClient wraps a UserClient with an LRU cache of the users looked up by ID. The
+// names and avatars of the same popular users are needed by most requests, so they are kept
+// for a short TTL instead of being asked for every time. A profile change, or a change in
+// whether the user is online, shows once the cached entry expires.
+type cachedUserClient struct {
+	next UserClient
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	recent  *list.List // most recently used first
+}
+
+// userCacheEntry is a cached user along with when it expires
+type userCacheEntry struct {
+	id        string
+	user      UserInfo
+	expiresAt time.Time
+}
+
+// NewCachedUserClient wraps a client with a cache of at most size users, kept for ttl. The
+// client is returned as is when size is zero, and a zero ttl falls back to the default.
+func NewCachedUserClient(next UserClient, size int, ttl time.Duration) UserClient {
+	if size <= 0 {
+		return next
+	}
+	if ttl <= 0 {
+		ttl = defaultUserCacheTTL
+	}
+
+	return &cachedUserClient{
+		next:    next,
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		recent:  list.New(),
+	}
+}
+
+// GetUsersByIDs retrieves several users at once, keyed by user ID. Only the users missing
+// from the cache are requested from the users service.
+func (c *cachedUserClient) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*UserInfo, error) {
+	users, missing := c.lookup(userIDs)
+	if len(missing) == 0 {
+		return users, nil
+	}
+
+	fetched, err := c.next.GetUsersByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(fetched)
+	for id, user := range fetched {
+		users[id] = user
+	}
+
+	return users, nil
+}
+
+// lookup returns the cached users among the IDs along with the IDs that have to be fetched
+func (c *cachedUserClient) lookup(userIDs []string) (map[string]*UserInfo, []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	users := make(map[string]*UserInfo, len(userIDs))
+	seen := make(map[string]bool, len(userIDs))
+	var missing []string
+	for _, id := range userIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if elem, ok := c.entries[id]; ok {
+			entry := elem.Value.(*userCacheEntry)
+			if now.Before(entry.expiresAt) {
+				c.recent.MoveToFront(elem)
+				user := entry.user
+				users[id] = &user
+				continue
+			}
+			c.recent.Remove(elem)
+			delete(c.entries, id)
+		}
+		missing = append(missing, id)
+	}
+
+	return users, missing
+}
+
+// store caches fetched users, evicting the least recently used ones beyond the cache size
+func (c *cachedUserClient) store(users map[string]*UserInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+	for id, user := range users {
+		entry := &userCacheEntry{id: id, user: *user, expiresAt: expiresAt}
+		if elem, ok := c.entries[id]; ok {
+			elem.Value = entry
+			c.recent.MoveToFront(elem)
+			continue
+		}
+		c.entries[id] = c.recent.PushFront(entry)
+	}
+
+	for c.recent.Len() > c.size {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*userCacheEntry).id)
+	}
+}
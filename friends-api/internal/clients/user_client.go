@@ -0,0 +1,73 @@
+package clients
+
+import (
+	pb "common/pb/common/proto/users"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// UserInfo holds the public profile fields of a user
+type UserInfo struct {
+	ID         string
+	Name       string
+	Avatar     string
+	Username   string
+	LastSeenAt string
+	IsOnline   bool
+}
+
+// UserClient defines the interface for looking up users in the users service
+type UserClient interface {
+	// GetUsersByIDs retrieves several users at once, keyed by user ID
+	GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*UserInfo, error)
+}
+
+// userClient implements the UserClient interface over gRPC
+type userClient struct {
+	client pb.UserServiceClient
+}
+
+// NewUserClient creates a new client for the users service
+func NewUserClient(usersServiceURL string) (UserClient, error) {
+	conn, err := grpc.Dial(usersServiceURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(propagateRequestID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userClient{
+		client: pb.NewUserServiceClient(conn),
+	}, nil
+}
+
+// GetUsersByIDs retrieves several users at once, keyed by user ID
+func (c *userClient) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*UserInfo, error) {
+	users := make(map[string]*UserInfo, len(userIDs))
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	resp, err := c.client.GetUsersByIDs(ctx, &pb.GetUsersByIDsRequest{
+		UserIds: userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range resp.Users {
+		users[user.UserId] = &UserInfo{
+			ID:         user.UserId,
+			Name:       user.Name,
+			Avatar:     user.Avatar,
+			Username:   user.Username,
+			LastSeenAt: user.LastSeenAt,
+			IsOnline:   user.IsOnline,
+		}
+	}
+
+	return users, nil
+}
@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	pb "common/pb/common/proto/friends"
+	"context"
+	"friends-api/internal/models"
+	"friends-api/internal/utils/errors"
+)
+
+// Follow makes the authenticated user follow another user
+func (c *FriendController) Follow(ctx context.Context, req *pb.FollowRequest) (*pb.FollowResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Follow user
+	err := c.follows.Follow(ctx, userID, req.UserId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to follow user", err)
+		return nil, err
+	}
+
+	return &pb.FollowResponse{
+		Success: true,
+	}, nil
+}
+
+// Unfollow stops the authenticated user following another user
+func (c *FriendController) Unfollow(ctx context.Context, req *pb.UnfollowRequest) (*pb.UnfollowResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Unfollow user
+	err := c.follows.Unfollow(ctx, userID, req.UserId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to unfollow user", err)
+		return nil, err
+	}
+
+	return &pb.UnfollowResponse{
+		Success: true,
+	}, nil
+}
+
+// GetFollowers retrieves the followers of a user
+func (c *FriendController) GetFollowers(ctx context.Context, req *pb.GetFollowersRequest) (*pb.GetFollowsResponse, error) {
+	// Get user ID from request or context
+	userID := req.UserId
+	if userID == "" {
+		var ok bool
+		userID, ok = ctx.Value("userID").(string)
+		if !ok {
+			c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+			return nil, errors.ErrUnauthenticated
+		}
+	}
+
+	// Get followers
+	follows, totalCount, totalPages, err := c.follows.GetFollowers(ctx, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get followers", err)
+		return nil, err
+	}
+
+	return c.convertFollows(ctx, follows, totalCount, req.Page, totalPages, func(follow *models.Follow) string {
+		return follow.FollowerID
+	}), nil
+}
+
+// GetFollowing retrieves the users a user follows
+func (c *FriendController) GetFollowing(ctx context.Context, req *pb.GetFollowingRequest) (*pb.GetFollowsResponse, error) {
+	// Get user ID from request or context
+	userID := req.UserId
+	if userID == "" {
+		var ok bool
+		userID, ok = ctx.Value("userID").(string)
+		if !ok {
+			c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+			return nil, errors.ErrUnauthenticated
+		}
+	}
+
+	// Get followed users
+	follows, totalCount, totalPages, err := c.follows.GetFollowing(ctx, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get followed users", err)
+		return nil, err
+	}
+
+	return c.convertFollows(ctx, follows, totalCount, req.Page, totalPages, func(follow *models.Follow) string {
+		return follow.FolloweeID
+	}), nil
+}
+
+// convertFollows converts a page of follows to a gRPC response listing the user on the side
+// of each follow that other picks, with their names and avatars looked up in one call
+func (c *FriendController) convertFollows(ctx context.Context, follows []*models.Follow, totalCount int64, page, totalPages int32, other func(*models.Follow) string) *pb.GetFollowsResponse {
+	response := &pb.GetFollowsResponse{
+		Users:      make([]*pb.FollowUserResponse, 0, len(follows)),
+		TotalCount: int32(totalCount),
+		Page:       page,
+		TotalPages: totalPages,
+	}
+
+	userIDs := make([]string, len(follows))
+	for i, follow := range follows {
+		userIDs[i] = other(follow)
+	}
+	users := c.lookupUsers(ctx, userIDs...)
+
+	for _, follow := range follows {
+		user := &pb.FollowUserResponse{
+			UserId:     other(follow),
+			FollowedAt: follow.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if info, ok := users[user.UserId]; ok {
+			user.Name = info.Name
+			user.Avatar = info.Avatar
+		}
+		response.Users = append(response.Users, user)
+	}
+
+	return response
+}
+
+// GetFollowingIDs retrieves the IDs of all the users a user follows
+func (c *FriendController) GetFollowingIDs(ctx context.Context, req *pb.GetFollowingIDsRequest) (*pb.GetFollowingIDsResponse, error) {
+	ids, err := c.follows.GetFollowingIDs(ctx, req.UserId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get followed user IDs", err)
+		return nil, err
+	}
+
+	return &pb.GetFollowingIDsResponse{
+		UserIds: ids,
+	}, nil
+}
+
+// GetFollowCounts counts a user's followers and followed users
+func (c *FriendController) GetFollowCounts(ctx context.Context, req *pb.GetFollowCountsRequest) (*pb.GetFollowCountsResponse, error) {
+	counts, err := c.follows.GetFollowCounts(ctx, req.UserId, req.ViewerId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to count follows", err)
+		return nil, err
+	}
+
+	return &pb.GetFollowCountsResponse{
+		FollowersCount: int32(counts.Followers),
+		FollowingCount: int32(counts.Following),
+		IsFollowing:    counts.IsFollowing,
+	}, nil
+}
@@ -3,22 +3,30 @@ package controllers
 import (
 	pb "common/pb/common/proto/friends"
 	"context"
+	"friends-api/internal/clients"
+	"friends-api/internal/models"
 	"friends-api/internal/services"
 	"friends-api/internal/utils/errors"
 	"friends-api/internal/utils/logger"
+
+	"google.golang.org/grpc/status"
 )
 
 // FriendController handles gRPC requests for friend-related operations
 type FriendController struct {
 	pb.UnimplementedFriendServiceServer
 	service services.FriendService
+	follows services.FollowService
+	users   clients.UserClient
 	logger  *logger.Logger
 }
 
 // NewFriendController creates a new friend controller
-func NewFriendController(service services.FriendService, logger *logger.Logger) *FriendController {
+func NewFriendController(service services.FriendService, follows services.FollowService, users clients.UserClient, logger *logger.Logger) *FriendController {
 	return &FriendController{
 		service: service,
+		follows: follows,
+		users:   users,
 		logger:  logger,
 	}
 }
@@ -28,30 +36,19 @@ func (c *FriendController) SendFriendRequest(ctx context.Context, req *pb.SendFr
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Send friend request
 	request, err := c.service.SendFriendRequest(ctx, userID, req.FriendId)
 	if err != nil {
-		c.logger.Error("Failed to send friend request", err)
+		c.logger.WithContext(ctx).Error("Failed to send friend request", err)
 		return nil, err
 	}
 
 	// Create response
-	return &pb.FriendRequestResponse{
-		RequestId:      request.ID,
-		SenderId:       request.SenderID,
-		SenderName:     "", // Would need to fetch from users service
-		SenderAvatar:   "", // Would need to fetch from users service
-		ReceiverId:     request.ReceiverID,
-		ReceiverName:   "", // Would need to fetch from users service
-		ReceiverAvatar: "", // Would need to fetch from users service
-		Status:         request.Status,
-		CreatedAt:      request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:      request.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}, nil
+	return convertFriendRequest(request, c.lookupUsers(ctx, request.SenderID, request.ReceiverID)), nil
 }
 
 // GetFriendRequests retrieves friend requests for a user
@@ -59,14 +56,14 @@ func (c *FriendController) GetFriendRequests(ctx context.Context, req *pb.GetFri
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Get friend requests
-	requests, totalCount, totalPages, err := c.service.GetFriendRequests(ctx, userID, req.Status, int(req.Page), int(req.Limit))
+	requests, totalCount, totalPages, err := c.service.GetFriendRequests(ctx, userID, req.Status, req.Direction, int(req.Page), int(req.Limit))
 	if err != nil {
-		c.logger.Error("Failed to get friend requests", err)
+		c.logger.WithContext(ctx).Error("Failed to get friend requests", err)
 		return nil, err
 	}
 
@@ -78,20 +75,18 @@ func (c *FriendController) GetFriendRequests(ctx context.Context, req *pb.GetFri
 		TotalPages: totalPages,
 	}
 
+	// Look up everyone involved in one call
+	userIDs := make([]string, 0, len(requests)*2)
+	for _, request := range requests {
+		userIDs = append(userIDs, request.SenderID, request.ReceiverID)
+	}
+	users := c.lookupUsers(ctx, userIDs...)
+
 	// Add requests to response
 	for _, request := range requests {
-		response.Requests = append(response.Requests, &pb.FriendRequestResponse{
-			RequestId:      request.ID,
-			SenderId:       request.SenderID,
-			SenderName:     "", // Would need to fetch from users service
-			SenderAvatar:   "", // Would need to fetch from users service
-			ReceiverId:     request.ReceiverID,
-			ReceiverName:   "", // Would need to fetch from users service
-			ReceiverAvatar: "", // Would need to fetch from users service
-			Status:         request.Status,
-			CreatedAt:      request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:      request.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		})
+		friendRequest := convertFriendRequest(request, users)
+		friendRequest.Direction = request.DirectionFor(userID)
+		response.Requests = append(response.Requests, friendRequest)
 	}
 
 	return response, nil
@@ -102,30 +97,19 @@ func (c *FriendController) AcceptFriendRequest(ctx context.Context, req *pb.Acce
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Accept friend request
 	request, err := c.service.AcceptFriendRequest(ctx, req.RequestId, userID)
 	if err != nil {
-		c.logger.Error("Failed to accept friend request", err)
+		c.logger.WithContext(ctx).Error("Failed to accept friend request", err)
 		return nil, err
 	}
 
 	// Create response
-	return &pb.FriendRequestResponse{
-		RequestId:      request.ID,
-		SenderId:       request.SenderID,
-		SenderName:     "", // Would need to fetch from users service
-		SenderAvatar:   "", // Would need to fetch from users service
-		ReceiverId:     request.ReceiverID,
-		ReceiverName:   "", // Would need to fetch from users service
-		ReceiverAvatar: "", // Would need to fetch from users service
-		Status:         request.Status,
-		CreatedAt:      request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:      request.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}, nil
+	return convertFriendRequest(request, c.lookupUsers(ctx, request.SenderID, request.ReceiverID)), nil
 }
 
 // RejectFriendRequest rejects a friend request
@@ -133,29 +117,97 @@ func (c *FriendController) RejectFriendRequest(ctx context.Context, req *pb.Reje
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Reject friend request
 	request, err := c.service.RejectFriendRequest(ctx, req.RequestId, userID)
 	if err != nil {
-		c.logger.Error("Failed to reject friend request", err)
+		c.logger.WithContext(ctx).Error("Failed to reject friend request", err)
 		return nil, err
 	}
 
 	// Create response
-	return &pb.FriendRequestResponse{
-		RequestId:      request.ID,
-		SenderId:       request.SenderID,
-		SenderName:     "", // Would need to fetch from users service
-		SenderAvatar:   "", // Would need to fetch from users service
-		ReceiverId:     request.ReceiverID,
-		ReceiverName:   "", // Would need to fetch from users service
-		ReceiverAvatar: "", // Would need to fetch from users service
-		Status:         request.Status,
-		CreatedAt:      request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:      request.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	return convertFriendRequest(request, c.lookupUsers(ctx, request.SenderID, request.ReceiverID)), nil
+}
+
+// AcceptFriendRequests accepts several friend requests, reporting the outcome for each
+func (c *FriendController) AcceptFriendRequests(ctx context.Context, req *pb.AcceptFriendRequestsRequest) (*pb.BatchFriendRequestsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Accept friend requests
+	results, err := c.service.AcceptFriendRequests(ctx, req.RequestIds, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to accept friend requests", err)
+		return nil, err
+	}
+
+	return c.convertBatchResults(ctx, results), nil
+}
+
+// RejectFriendRequests rejects several friend requests, reporting the outcome for each
+func (c *FriendController) RejectFriendRequests(ctx context.Context, req *pb.RejectFriendRequestsRequest) (*pb.BatchFriendRequestsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Reject friend requests
+	results, err := c.service.RejectFriendRequests(ctx, req.RequestIds, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to reject friend requests", err)
+		return nil, err
+	}
+
+	return c.convertBatchResults(ctx, results), nil
+}
+
+// AcceptAllFriendRequests accepts every pending friend request, reporting the outcome for each
+func (c *FriendController) AcceptAllFriendRequests(ctx context.Context, req *pb.AcceptAllFriendRequestsRequest) (*pb.BatchFriendRequestsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Accept friend requests
+	results, err := c.service.AcceptAllFriendRequests(ctx, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to accept all friend requests", err)
+		return nil, err
+	}
+
+	return c.convertBatchResults(ctx, results), nil
+}
+
+// CancelFriendRequest withdraws a pending friend request sent by the user
+func (c *FriendController) CancelFriendRequest(ctx context.Context, req *pb.CancelFriendRequestRequest) (*pb.CancelFriendRequestResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Cancel friend request
+	err := c.service.CancelFriendRequest(ctx, req.RequestId, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to cancel friend request", err)
+		return nil, err
+	}
+
+	// Create response
+	return &pb.CancelFriendRequestResponse{
+		Success: true,
 	}, nil
 }
 
@@ -167,15 +219,177 @@ func (c *FriendController) GetFriends(ctx context.Context, req *pb.GetFriendsReq
 		var ok bool
 		userID, ok = ctx.Value("userID").(string)
 		if !ok {
-			c.logger.Error("Failed to get user ID from context", nil)
+			c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 			return nil, errors.ErrUnauthenticated
 		}
 	}
 
+	return c.friendsPage(ctx, userID, req.Sort, req.Page, req.Limit)
+}
+
+// Page sizes of StreamFriends
+const (
+	defaultStreamPageSize = 100
+	maxStreamPageSize     = 500
+)
+
+// StreamFriends streams all friends of a user, one page per message. The stream ends after the
+// last page, or early when the client cancels it.
+func (c *FriendController) StreamFriends(req *pb.StreamFriendsRequest, stream pb.FriendService_StreamFriendsServer) error {
+	ctx := stream.Context()
+
+	// Get user ID from context or request
+	userID := req.UserId
+	if userID == "" {
+		var ok bool
+		userID, ok = ctx.Value("userID").(string)
+		if !ok {
+			c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+			return errors.ErrUnauthenticated
+		}
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultStreamPageSize
+	}
+	if pageSize > maxStreamPageSize {
+		pageSize = maxStreamPageSize
+	}
+
+	// Pages are separate queries, and storage order can change between them, so an export
+	// without a sort is streamed oldest first
+	sort := req.Sort
+	if sort == "" {
+		sort = models.FriendSortOldest
+	}
+	if sort == models.FriendSortNameAsc {
+		return c.streamFriendsByName(ctx, stream, userID, pageSize)
+	}
+
+	for page := int32(1); ; page++ {
+		// Stop loading pages for a client that went away
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		response, err := c.friendsPage(ctx, userID, sort, page, pageSize)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+		if page >= response.TotalPages {
+			return nil
+		}
+	}
+}
+
+// streamFriendsByName streams a user's friends in alphabetical order. The whole list has to be
+// loaded to sort it by name, so it is loaded once and sent in pages.
+func (c *FriendController) streamFriendsByName(ctx context.Context, stream pb.FriendService_StreamFriendsServer, userID string, pageSize int32) error {
+	friendships, err := c.service.GetFriendsByName(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	totalCount := int64(len(friendships))
+	totalPages := int32((totalCount + int64(pageSize) - 1) / int64(pageSize))
+	for page := int32(1); ; page++ {
+		// Stop looking up profiles for a client that went away
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		start := int64(page-1) * int64(pageSize)
+		end := start + int64(pageSize)
+		if end > totalCount {
+			end = totalCount
+		}
+		if start > end {
+			start = end
+		}
+		if err := stream.Send(c.friendsResponse(ctx, friendships[start:end], totalCount, page, totalPages)); err != nil {
+			return err
+		}
+		if page >= totalPages {
+			return nil
+		}
+	}
+}
+
+// friendsPage loads a page of a user's friends along with their profiles
+func (c *FriendController) friendsPage(ctx context.Context, userID, sort string, page, limit int32) (*pb.GetFriendsResponse, error) {
 	// Get friends
-	friendships, totalCount, totalPages, err := c.service.GetFriends(ctx, userID, int(req.Page), int(req.Limit))
+	friendships, totalCount, totalPages, err := c.service.GetFriends(ctx, userID, sort, int(page), int(limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get friends", err)
+		return nil, err
+	}
+
+	return c.friendsResponse(ctx, friendships, totalCount, page, totalPages), nil
+}
+
+// friendsResponse builds a page of friends along with their profiles
+func (c *FriendController) friendsResponse(ctx context.Context, friendships []*models.Friendship, totalCount int64, page, totalPages int32) *pb.GetFriendsResponse {
+	// Create response
+	response := &pb.GetFriendsResponse{
+		Friends:    make([]*pb.FriendResponse, 0, len(friendships)),
+		TotalCount: int32(totalCount),
+		Page:       page,
+		TotalPages: totalPages,
+	}
+
+	// Look up all friends in one call
+	friendIDs := make([]string, len(friendships))
+	for i, friendship := range friendships {
+		friendIDs[i] = friendship.FriendID
+	}
+	users := c.lookupUsers(ctx, friendIDs...)
+
+	// Add friends to response
+	for _, friendship := range friendships {
+		response.Friends = append(response.Friends, convertFriend(friendship, users))
+	}
+
+	return response
+}
+
+// GetFriendIDs retrieves the IDs of all of the authenticated user's friends
+func (c *FriendController) GetFriendIDs(ctx context.Context, req *pb.GetFriendIDsRequest) (*pb.GetFriendIDsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Get friend IDs
+	friendIDs, err := c.service.GetFriendIDs(ctx, userID)
 	if err != nil {
-		c.logger.Error("Failed to get friends", err)
+		c.logger.WithContext(ctx).Error("Failed to get friend IDs", err)
+		return nil, err
+	}
+
+	return &pb.GetFriendIDsResponse{
+		FriendIds: friendIDs,
+	}, nil
+}
+
+// GetMutualFriends retrieves the friends the user has in common with another user
+func (c *FriendController) GetMutualFriends(ctx context.Context, req *pb.GetMutualFriendsRequest) (*pb.GetFriendsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Get mutual friends
+	friendships, totalCount, totalPages, err := c.service.GetMutualFriends(ctx, userID, req.OtherUserId, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get mutual friends", err)
 		return nil, err
 	}
 
@@ -187,33 +401,135 @@ func (c *FriendController) GetFriends(ctx context.Context, req *pb.GetFriendsReq
 		TotalPages: totalPages,
 	}
 
+	// Look up all friends in one call
+	friendIDs := make([]string, len(friendships))
+	for i, friendship := range friendships {
+		friendIDs[i] = friendship.FriendID
+	}
+	users := c.lookupUsers(ctx, friendIDs...)
+
 	// Add friends to response
 	for _, friendship := range friendships {
-		response.Friends = append(response.Friends, &pb.FriendResponse{
-			UserId:       friendship.FriendID,
-			Name:         "", // Would need to fetch from users service
-			Avatar:       "", // Would need to fetch from users service
-			Email:        "", // Would need to fetch from users service
-			FriendsSince: friendship.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		})
+		response.Friends = append(response.Friends, convertFriend(friendship, users))
 	}
 
 	return response, nil
 }
 
+// convertFriend converts a friendship model to a gRPC friend response.
+// Email stays empty: the users service only shares public profile fields.
+func convertFriend(friendship *models.Friendship, users map[string]*clients.UserInfo) *pb.FriendResponse {
+	friend := &pb.FriendResponse{
+		UserId:       friendship.FriendID,
+		FriendsSince: friendship.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if user, ok := users[friendship.FriendID]; ok {
+		friend.Name = user.Name
+		friend.Avatar = user.Avatar
+		friend.LastSeenAt = user.LastSeenAt
+		friend.IsOnline = user.IsOnline
+	}
+	return friend
+}
+
+// convertFriendRequest converts a friend request model to a gRPC friend request response
+func convertFriendRequest(request *models.FriendRequest, users map[string]*clients.UserInfo) *pb.FriendRequestResponse {
+	response := &pb.FriendRequestResponse{
+		RequestId:  request.ID,
+		SenderId:   request.SenderID,
+		ReceiverId: request.ReceiverID,
+		Status:     request.Status,
+		CreatedAt:  request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  request.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if sender, ok := users[request.SenderID]; ok {
+		response.SenderName = sender.Name
+		response.SenderAvatar = sender.Avatar
+	}
+	if receiver, ok := users[request.ReceiverID]; ok {
+		response.ReceiverName = receiver.Name
+		response.ReceiverAvatar = receiver.Avatar
+	}
+	return response
+}
+
+// convertBatchResults converts the outcomes of a batch to a gRPC response, looking up the users
+// of all the updated requests in one call
+func (c *FriendController) convertBatchResults(ctx context.Context, results []*services.BatchResult) *pb.BatchFriendRequestsResponse {
+	userIDs := make([]string, 0, 2*len(results))
+	for _, result := range results {
+		if result.Err == nil {
+			userIDs = append(userIDs, result.Request.SenderID, result.Request.ReceiverID)
+		}
+	}
+	users := c.lookupUsers(ctx, userIDs...)
+
+	response := &pb.BatchFriendRequestsResponse{
+		Results: make([]*pb.FriendRequestResult, len(results)),
+	}
+	for i, result := range results {
+		converted := &pb.FriendRequestResult{
+			RequestId: result.RequestID,
+			Success:   result.Err == nil,
+		}
+		if result.Err != nil {
+			converted.Error = status.Convert(result.Err).Message()
+			response.FailedCount++
+		} else {
+			converted.Request = convertFriendRequest(result.Request, users)
+			response.SucceededCount++
+		}
+		response.Results[i] = converted
+	}
+	return response
+}
+
+// lookupUsers fetches the public profiles of the given users, in as few calls as the users
+// service allows. A users service failure is logged and leaves the users it was asked for
+// out of the map, so responses degrade to missing names and avatars instead of failing.
+func (c *FriendController) lookupUsers(ctx context.Context, userIDs ...string) map[string]*clients.UserInfo {
+	seen := make(map[string]bool, len(userIDs))
+	unique := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	// The users service accepts a limited number of IDs per lookup. A failed lookup only
+	// leaves its own users without names.
+	users := make(map[string]*clients.UserInfo, len(unique))
+	for start := 0; start < len(unique); start += services.MaxUsersPerLookup {
+		end := start + services.MaxUsersPerLookup
+		if end > len(unique) {
+			end = len(unique)
+		}
+		found, err := c.users.GetUsersByIDs(ctx, unique[start:end])
+		if err != nil {
+			c.logger.WithContext(ctx).Warn("Failed to look up users", logger.Field("error", err.Error()))
+			continue
+		}
+		for id, user := range found {
+			users[id] = user
+		}
+	}
+	return users
+}
+
 // RemoveFriend removes a friend
 func (c *FriendController) RemoveFriend(ctx context.Context, req *pb.RemoveFriendRequest) (*pb.RemoveFriendResponse, error) {
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Remove friend
 	err := c.service.RemoveFriend(ctx, userID, req.FriendId)
 	if err != nil {
-		c.logger.Error("Failed to remove friend", err)
+		c.logger.WithContext(ctx).Error("Failed to remove friend", err)
 		return nil, err
 	}
 
@@ -228,14 +544,14 @@ func (c *FriendController) BlockUser(ctx context.Context, req *pb.BlockUserReque
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Block user
 	err := c.service.BlockUser(ctx, userID, req.BlockedUserId)
 	if err != nil {
-		c.logger.Error("Failed to block user", err)
+		c.logger.WithContext(ctx).Error("Failed to block user", err)
 		return nil, err
 	}
 
@@ -250,14 +566,14 @@ func (c *FriendController) UnblockUser(ctx context.Context, req *pb.UnblockUserR
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Unblock user
 	err := c.service.UnblockUser(ctx, userID, req.BlockedUserId)
 	if err != nil {
-		c.logger.Error("Failed to unblock user", err)
+		c.logger.WithContext(ctx).Error("Failed to unblock user", err)
 		return nil, err
 	}
 
@@ -272,14 +588,14 @@ func (c *FriendController) GetBlockedUsers(ctx context.Context, req *pb.GetBlock
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Get blocked users
 	blockedUsers, totalCount, totalPages, err := c.service.GetBlockedUsers(ctx, userID, int(req.Page), int(req.Limit))
 	if err != nil {
-		c.logger.Error("Failed to get blocked users", err)
+		c.logger.WithContext(ctx).Error("Failed to get blocked users", err)
 		return nil, err
 	}
 
@@ -291,25 +607,48 @@ func (c *FriendController) GetBlockedUsers(ctx context.Context, req *pb.GetBlock
 		TotalPages:   totalPages,
 	}
 
+	// Look up all blocked users in one call
+	blockedIDs := make([]string, len(blockedUsers))
+	for i, blockedUser := range blockedUsers {
+		blockedIDs[i] = blockedUser.BlockedUserID
+	}
+	users := c.lookupUsers(ctx, blockedIDs...)
+
 	// Add blocked users to response
 	for _, blockedUser := range blockedUsers {
-		response.BlockedUsers = append(response.BlockedUsers, &pb.BlockedUserResponse{
+		blocked := &pb.BlockedUserResponse{
 			UserId:    blockedUser.BlockedUserID,
-			Name:      "", // Would need to fetch from users service
-			Avatar:    "", // Would need to fetch from users service
 			BlockedAt: blockedUser.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		})
+		}
+		if user, ok := users[blockedUser.BlockedUserID]; ok {
+			blocked.Name = user.Name
+			blocked.Avatar = user.Avatar
+		}
+		response.BlockedUsers = append(response.BlockedUsers, blocked)
 	}
 
 	return response, nil
 }
 
+// GetBlockedIDs retrieves the IDs of the users a user has blocked or been blocked by
+func (c *FriendController) GetBlockedIDs(ctx context.Context, req *pb.GetBlockedIDsRequest) (*pb.GetBlockedIDsResponse, error) {
+	ids, err := c.service.GetBlockedIDs(ctx, req.UserId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get blocked user IDs", err)
+		return nil, err
+	}
+
+	return &pb.GetBlockedIDsResponse{
+		UserIds: ids,
+	}, nil
+}
+
 // CheckFriendship checks if two users are friends
 func (c *FriendController) CheckFriendship(ctx context.Context, req *pb.CheckFriendshipRequest) (*pb.CheckFriendshipResponse, error) {
 	// Check friendship
 	status, requestID, err := c.service.CheckFriendship(ctx, req.UserId, req.FriendId)
 	if err != nil {
-		c.logger.Error("Failed to check friendship", err)
+		c.logger.WithContext(ctx).Error("Failed to check friendship", err)
 		return nil, err
 	}
 
@@ -320,3 +659,14 @@ func (c *FriendController) CheckFriendship(ctx context.Context, req *pb.CheckFri
 		RequestId:  requestID,
 	}, nil
 }
+
+// CheckFriendships checks the friendship status between a user and several other users
+func (c *FriendController) CheckFriendships(ctx context.Context, req *pb.CheckFriendshipsRequest) (*pb.CheckFriendshipsResponse, error) {
+	statuses, err := c.service.CheckFriendships(ctx, req.UserId, req.OtherUserIds)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to check friendships", err)
+		return nil, err
+	}
+
+	return &pb.CheckFriendshipsResponse{Statuses: statuses}, nil
+}
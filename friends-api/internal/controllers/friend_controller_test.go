@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "common/pb/common/proto/friends"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"friends-api/internal/clients"
+	"friends-api/internal/models"
+	"friends-api/internal/repository"
+	"friends-api/internal/services"
+	"friends-api/internal/testutil"
+	"friends-api/internal/utils/logger"
+)
+
+// fakeUsers is a users service where every user exists, and which refuses lookups of more
+// IDs than the real one accepts
+type fakeUsers struct {
+	calls int
+	fail  map[string]bool
+}
+
+func (u *fakeUsers) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*clients.UserInfo, error) {
+	u.calls++
+	if len(userIDs) > services.MaxUsersPerLookup {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d user IDs can be requested at once", services.MaxUsersPerLookup)
+	}
+	users := make(map[string]*clients.UserInfo, len(userIDs))
+	for _, id := range userIDs {
+		if u.fail[id] {
+			return nil, status.Error(codes.Unavailable, "users service unavailable")
+		}
+		users[id] = &clients.UserInfo{ID: id, Name: "Name of " + id}
+	}
+	return users, nil
+}
+
+func newTestLogger() *logger.Logger {
+	return &logger.Logger{Logger: zap.NewNop()}
+}
+
+// fakeFriendsStream collects the pages of a StreamFriends call
+type fakeFriendsStream struct {
+	grpc.ServerStream
+	pages []*pb.GetFriendsResponse
+}
+
+func (s *fakeFriendsStream) Context() context.Context {
+	return context.WithValue(context.Background(), "userID", "alice")
+}
+
+func (s *fakeFriendsStream) Send(page *pb.GetFriendsResponse) error {
+	s.pages = append(s.pages, page)
+	return nil
+}
+
+// newStreamController returns a controller over a fresh database where alice has the given
+// number of friends. They are stored in order of their IDs but were added in reverse, so the
+// oldest-first order differs from both the storage and the alphabetical order.
+func newStreamController(t *testing.T, users *fakeUsers, friends int) *FriendController {
+	t.Helper()
+
+	db := testutil.NewDB(t)
+	if _, err := repository.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	now := time.Now()
+	for i := 0; i < friends; i++ {
+		added := now.Add(-time.Duration(i) * time.Second)
+		friendship := &models.Friendship{UserID: "alice", FriendID: fmt.Sprintf("user-%03d", i), CreatedAt: added}
+		if err := db.Create(friendship).Error; err != nil {
+			t.Fatalf("failed to create friendship: %v", err)
+		}
+	}
+
+	service := services.NewFriendService(repository.NewFriendRepository(db), users, nil, newTestLogger())
+	return NewFriendController(service, nil, users, newTestLogger())
+}
+
+// streamedFriendIDs returns the friend IDs of all streamed pages in order
+func streamedFriendIDs(pages []*pb.GetFriendsResponse) []string {
+	var ids []string
+	for _, page := range pages {
+		for _, friend := range page.Friends {
+			ids = append(ids, friend.UserId)
+		}
+	}
+	return ids
+}
+
+func TestStreamFriendsWithoutSortIsOldestFirst(t *testing.T) {
+	controller := newStreamController(t, &fakeUsers{}, 5)
+
+	stream := &fakeFriendsStream{}
+	if err := controller.StreamFriends(&pb.StreamFriendsRequest{PageSize: 2}, stream); err != nil {
+		t.Fatalf("StreamFriends: %v", err)
+	}
+
+	want := []string{"user-004", "user-003", "user-002", "user-001", "user-000"}
+	if got := streamedFriendIDs(stream.pages); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got friends %v, want %v", got, want)
+	}
+	if len(stream.pages) != 3 {
+		t.Errorf("got %d pages, want 3", len(stream.pages))
+	}
+}
+
+func TestStreamFriendsByNameSortsOnce(t *testing.T) {
+	users := &fakeUsers{}
+	controller := newStreamController(t, users, 250)
+
+	stream := &fakeFriendsStream{}
+	if err := controller.StreamFriends(&pb.StreamFriendsRequest{Sort: models.FriendSortNameAsc, PageSize: 100}, stream); err != nil {
+		t.Fatalf("StreamFriends: %v", err)
+	}
+
+	ids := streamedFriendIDs(stream.pages)
+	if len(ids) != 250 || len(stream.pages) != 3 {
+		t.Fatalf("got %d friends in %d pages, want 250 in 3", len(ids), len(stream.pages))
+	}
+	for i, id := range ids {
+		if want := fmt.Sprintf("user-%03d", i); id != want {
+			t.Fatalf("friend %d is %s, want %s", i, id, want)
+		}
+	}
+
+	// Sorting names all 250 friends in 3 lookups, and each page names its own friends once
+	if users.calls != 6 {
+		t.Errorf("got %d user lookups, want 6", users.calls)
+	}
+}
+
+func TestLookupUsersSplitsLargeLookups(t *testing.T) {
+	users := &fakeUsers{}
+	controller := NewFriendController(nil, nil, users, newTestLogger())
+
+	// A page of 100 requests in both directions names 101 users: the caller and 100 others
+	ids := []string{"caller"}
+	for i := 0; i < 100; i++ {
+		ids = append(ids, "caller", fmt.Sprintf("user-%d", i))
+	}
+
+	found := controller.lookupUsers(context.Background(), ids...)
+	if len(found) != 101 {
+		t.Fatalf("got %d users, want 101", len(found))
+	}
+	if found["caller"] == nil || found["user-99"] == nil {
+		t.Error("lookup left out users")
+	}
+	if users.calls != 2 {
+		t.Errorf("got %d lookups, want 2", users.calls)
+	}
+}
+
+func TestLookupUsersKeepsUsersOfSuccessfulLookups(t *testing.T) {
+	users := &fakeUsers{fail: map[string]bool{"user-150": true}}
+	controller := NewFriendController(nil, nil, users, newTestLogger())
+
+	ids := make([]string, 0, 160)
+	for i := 0; i < 160; i++ {
+		ids = append(ids, fmt.Sprintf("user-%d", i))
+	}
+
+	// The second lookup fails, so only the users of the first one are named
+	found := controller.lookupUsers(context.Background(), ids...)
+	if len(found) != services.MaxUsersPerLookup {
+		t.Errorf("got %d users, want %d", len(found), services.MaxUsersPerLookup)
+	}
+}
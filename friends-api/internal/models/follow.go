@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Follow represents one user following another. Unlike a friendship it is one-way and needs
+// no acceptance, and unfollowing deletes it rather than keeping it soft-deleted.
+type Follow struct {
+	ID         string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	FollowerID string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_follows_follower_followee" json:"follower_id"`
+	FolloweeID string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_follows_follower_followee;index" json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for the Follow model
+func (Follow) TableName() string {
+	return "follows"
+}
+
+// BeforeCreate is a hook that is called before creating a follow
+func (f *Follow) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == "" {
+		f.ID = generateUUID()
+	}
+	return nil
+}
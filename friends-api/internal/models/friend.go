@@ -22,6 +22,21 @@ func (FriendRequest) TableName() string {
 	return "friend_requests"
 }
 
+// Friend request directions relative to the user listing them
+const (
+	DirectionIncoming = "incoming"
+	DirectionOutgoing = "outgoing"
+	DirectionAll      = "all"
+)
+
+// DirectionFor returns whether the request is incoming or outgoing for the given user
+func (fr *FriendRequest) DirectionFor(userID string) string {
+	if fr.SenderID == userID {
+		return DirectionOutgoing
+	}
+	return DirectionIncoming
+}
+
 // BeforeCreate is a hook that is called before creating a friend request
 func (fr *FriendRequest) BeforeCreate(tx *gorm.DB) error {
 	if fr.ID == "" {
@@ -33,13 +48,20 @@ func (fr *FriendRequest) BeforeCreate(tx *gorm.DB) error {
 // Friendship represents a friendship between two users
 type Friendship struct {
 	ID        string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
-	UserID    string         `gorm:"type:varchar(36);not null;index" json:"user_id"`
-	FriendID  string         `gorm:"type:varchar(36);not null;index" json:"friend_id"`
+	UserID    string         `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_friendships_user_friend" json:"user_id"`
+	FriendID  string         `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_friendships_user_friend" json:"friend_id"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	DeletedAt gorm.DeletedAt `gorm:"index;uniqueIndex:idx_friendships_user_friend" json:"-"`
 }
 
+// Friend list sort orders
+const (
+	FriendSortRecent  = "recent"   // Most recently added first
+	FriendSortOldest  = "oldest"   // Longest-standing first
+	FriendSortNameAsc = "name_asc" // Alphabetically by the friend's name
+)
+
 // TableName returns the table name for the Friendship model
 func (Friendship) TableName() string {
 	return "friendships"
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"friends-api/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// FollowRepository is the interface for follow-related operations
+type FollowRepository interface {
+	CreateFollow(follow *models.Follow) error
+	DeleteFollow(followerID, followeeID string) (bool, error)
+	IsFollowing(followerID, followeeID string) (bool, error)
+	GetFollowers(userID string, page, limit int) ([]*models.Follow, int64, error)
+	GetFollowing(userID string, page, limit int) ([]*models.Follow, int64, error)
+	GetFollowingIDs(userID string) ([]string, error)
+	CountFollows(userID string) (int64, int64, error)
+}
+
+// followRepository is the implementation of FollowRepository
+type followRepository struct {
+	db *gorm.DB
+}
+
+// NewFollowRepository creates a new follow repository
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+	return &followRepository{db: db}
+}
+
+// CreateFollow creates a new follow
+func (r *followRepository) CreateFollow(follow *models.Follow) error {
+	return r.db.Create(follow).Error
+}
+
+// DeleteFollow deletes a follow and reports whether there was one
+func (r *followRepository) DeleteFollow(followerID, followeeID string) (bool, error) {
+	result := r.db.Delete(&models.Follow{}, "follower_id = ? AND followee_id = ?", followerID, followeeID)
+	return result.RowsAffected > 0, result.Error
+}
+
+// IsFollowing checks if a user follows another user
+func (r *followRepository) IsFollowing(followerID, followeeID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Follow{}).Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetFollowers gets the follows of a user's followers, most recent first
+func (r *followRepository) GetFollowers(userID string, page, limit int) ([]*models.Follow, int64, error) {
+	return r.findFollows(r.db.Model(&models.Follow{}).Where("followee_id = ?", userID), page, limit)
+}
+
+// GetFollowing gets the follows of the users a user follows, most recent first
+func (r *followRepository) GetFollowing(userID string, page, limit int) ([]*models.Follow, int64, error) {
+	return r.findFollows(r.db.Model(&models.Follow{}).Where("follower_id = ?", userID), page, limit)
+}
+
+// findFollows counts the follows matched by query and gets a page of them, most recent first
+func (r *followRepository) findFollows(query *gorm.DB, page, limit int) ([]*models.Follow, int64, error) {
+	var follows []*models.Follow
+	var count int64
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err = query.Order("created_at DESC, id").Offset(offset).Limit(limit).Find(&follows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return follows, count, nil
+}
+
+// GetFollowingIDs gets the IDs of all the users a user follows
+func (r *followRepository) GetFollowingIDs(userID string) ([]string, error) {
+	var ids []string
+	err := r.db.Model(&models.Follow{}).Where("follower_id = ?", userID).Pluck("followee_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CountFollows counts a user's followers and the users they follow
+func (r *followRepository) CountFollows(userID string) (int64, int64, error) {
+	var followers, following int64
+	err := r.db.Model(&models.Follow{}).Where("followee_id = ?", userID).Count(&followers).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	err = r.db.Model(&models.Follow{}).Where("follower_id = ?", userID).Count(&following).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	return followers, following, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"fmt"
 	"friends-api/internal/models"
 
 	"gorm.io/gorm"
@@ -13,13 +14,18 @@ type FriendRepository interface {
 	GetFriendRequestByID(id string) (*models.FriendRequest, error)
 	GetFriendRequestsBySenderID(senderID string, status string, page, limit int) ([]*models.FriendRequest, int64, error)
 	GetFriendRequestsByReceiverID(receiverID string, status string, page, limit int) ([]*models.FriendRequest, int64, error)
+	GetFriendRequestsByUserID(userID string, status string, page, limit int) ([]*models.FriendRequest, int64, error)
+	GetPendingFriendRequestIDs(receiverID string) ([]string, error)
 	UpdateFriendRequestStatus(id string, status string) error
+	AcceptFriendRequest(request *models.FriendRequest) (bool, error)
 	DeleteFriendRequest(id string) error
 
 	// Friendships
 	CreateFriendship(friendship *models.Friendship) error
 	GetFriendshipByID(id string) (*models.Friendship, error)
-	GetFriendshipsByUserID(userID string, page, limit int) ([]*models.Friendship, int64, error)
+	GetFriendshipsByUserID(userID, sort string, page, limit int) ([]*models.Friendship, int64, error)
+	GetAllFriendshipsByUserID(userID string) ([]*models.Friendship, error)
+	GetMutualFriendships(userID, otherUserID string, page, limit int) ([]*models.Friendship, int64, error)
 	DeleteFriendship(userID, friendID string) error
 
 	// Blocked users
@@ -27,9 +33,11 @@ type FriendRepository interface {
 	UnblockUser(userID, blockedUserID string) error
 	GetBlockedUsers(userID string, page, limit int) ([]*models.BlockedUser, int64, error)
 	IsUserBlocked(userID, blockedUserID string) (bool, error)
+	GetBlockedIDs(userID string) ([]string, error)
 
 	// Check friendship status
 	CheckFriendship(userID, friendID string) (string, string, error)
+	CheckFriendships(userID string, otherIDs []string) (map[string]string, error)
 }
 
 // friendRepository is the implementation of FriendRepository
@@ -105,11 +113,76 @@ func (r *friendRepository) GetFriendRequestsByReceiverID(receiverID string, stat
 	return requests, count, nil
 }
 
+// GetFriendRequestsByUserID gets friend requests the user either sent or received
+func (r *friendRepository) GetFriendRequestsByUserID(userID string, status string, page, limit int) ([]*models.FriendRequest, int64, error) {
+	var requests []*models.FriendRequest
+	var count int64
+
+	query := r.db.Model(&models.FriendRequest{}).Where("sender_id = ? OR receiver_id = ?", userID, userID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err = query.Offset(offset).Limit(limit).Find(&requests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, count, nil
+}
+
 // UpdateFriendRequestStatus updates the status of a friend request
 func (r *friendRepository) UpdateFriendRequestStatus(id string, status string) error {
 	return r.db.Model(&models.FriendRequest{}).Where("id = ?", id).Update("status", status).Error
 }
 
+// GetPendingFriendRequestIDs gets the IDs of all pending friend requests received by a user, oldest first
+func (r *friendRepository) GetPendingFriendRequestIDs(receiverID string) ([]string, error) {
+	var ids []string
+	err := r.db.Model(&models.FriendRequest{}).
+		Where("receiver_id = ? AND status = ?", receiverID, "pending").
+		Order("created_at ASC").
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// AcceptFriendRequest marks a pending friend request as accepted and creates the friendship both
+// ways in one transaction. It reports false, changing nothing, if the request is no longer pending.
+func (r *friendRepository) AcceptFriendRequest(request *models.FriendRequest) (bool, error) {
+	accepted := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.FriendRequest{}).
+			Where("id = ? AND status = ?", request.ID, "pending").
+			Update("status", "accepted")
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Create(&models.Friendship{UserID: request.SenderID, FriendID: request.ReceiverID}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&models.Friendship{UserID: request.ReceiverID, FriendID: request.SenderID}).Error
+		if err != nil {
+			return err
+		}
+
+		accepted = true
+		return nil
+	})
+	return accepted && err == nil, err
+}
+
 // DeleteFriendRequest deletes a friend request
 func (r *friendRepository) DeleteFriendRequest(id string) error {
 	return r.db.Delete(&models.FriendRequest{}, "id = ?", id).Error
@@ -130,8 +203,14 @@ func (r *friendRepository) GetFriendshipByID(id string) (*models.Friendship, err
 	return &friendship, nil
 }
 
-// GetFriendshipsByUserID gets friendships by user ID
-func (r *friendRepository) GetFriendshipsByUserID(userID string, page, limit int) ([]*models.Friendship, int64, error) {
+// friendshipOrders maps the sort orders that can be done in SQL to their ORDER BY clauses
+var friendshipOrders = map[string]string{
+	models.FriendSortRecent: "created_at DESC, id",
+	models.FriendSortOldest: "created_at ASC, id",
+}
+
+// GetFriendshipsByUserID gets friendships by user ID. An empty sort keeps the storage order.
+func (r *friendRepository) GetFriendshipsByUserID(userID, sort string, page, limit int) ([]*models.Friendship, int64, error) {
 	var friendships []*models.Friendship
 	var count int64
 
@@ -142,6 +221,14 @@ func (r *friendRepository) GetFriendshipsByUserID(userID string, page, limit int
 		return nil, 0, err
 	}
 
+	if sort != "" {
+		order, ok := friendshipOrders[sort]
+		if !ok {
+			return nil, 0, fmt.Errorf("unsupported friendship sort %q", sort)
+		}
+		query = query.Order(order)
+	}
+
 	offset := (page - 1) * limit
 	err = query.Offset(offset).Limit(limit).Find(&friendships).Error
 	if err != nil {
@@ -151,6 +238,43 @@ func (r *friendRepository) GetFriendshipsByUserID(userID string, page, limit int
 	return friendships, count, nil
 }
 
+// GetAllFriendshipsByUserID gets every friendship of a user, for orders that cannot be applied in SQL
+func (r *friendRepository) GetAllFriendshipsByUserID(userID string) ([]*models.Friendship, error) {
+	var friendships []*models.Friendship
+	err := r.db.Where("user_id = ?", userID).Find(&friendships).Error
+	if err != nil {
+		return nil, err
+	}
+	return friendships, nil
+}
+
+// GetMutualFriendships gets the user's friendships with people who are also friends with the other user.
+// Friends who have blocked, or been blocked by, either user are left out.
+func (r *friendRepository) GetMutualFriendships(userID, otherUserID string, page, limit int) ([]*models.Friendship, int64, error) {
+	var friendships []*models.Friendship
+	var count int64
+
+	users := []string{userID, otherUserID}
+	query := r.db.Model(&models.Friendship{}).
+		Where("user_id = ?", userID).
+		Where("friend_id IN (?)", r.db.Model(&models.Friendship{}).Select("friend_id").Where("user_id = ?", otherUserID)).
+		Where("friend_id NOT IN (?)", r.db.Model(&models.BlockedUser{}).Select("blocked_user_id").Where("user_id IN ?", users)).
+		Where("friend_id NOT IN (?)", r.db.Model(&models.BlockedUser{}).Select("user_id").Where("blocked_user_id IN ?", users))
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err = query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&friendships).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return friendships, count, nil
+}
+
 // DeleteFriendship deletes a friendship
 func (r *friendRepository) DeleteFriendship(userID, friendID string) error {
 	// Delete both directions of the friendship
@@ -161,9 +285,49 @@ func (r *friendRepository) DeleteFriendship(userID, friendID string) error {
 	return r.db.Delete(&models.Friendship{}, "user_id = ? AND friend_id = ?", friendID, userID).Error
 }
 
-// BlockUser blocks a user
+// BlockUser blocks a user, removing any friendship, pending friend requests and follows
+// between the two users in the same transaction
 func (r *friendRepository) BlockUser(blockedUser *models.BlockedUser) error {
-	return r.db.Create(blockedUser).Error
+	userID, otherID := blockedUser.UserID, blockedUser.BlockedUserID
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Delete(&models.Friendship{}, "(user_id = ? AND friend_id = ?) OR (user_id = ? AND friend_id = ?)", userID, otherID, otherID, userID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("(sender_id = ? AND receiver_id = ?) OR (sender_id = ? AND receiver_id = ?)", userID, otherID, otherID, userID).
+			Where("status = ?", "pending").
+			Delete(&models.FriendRequest{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Delete(&models.Follow{}, "(follower_id = ? AND followee_id = ?) OR (follower_id = ? AND followee_id = ?)", userID, otherID, otherID, userID).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(blockedUser).Error
+	})
+}
+
+// GetBlockedIDs gets the IDs of the users a user has blocked or been blocked by
+func (r *friendRepository) GetBlockedIDs(userID string) ([]string, error) {
+	var blocked []*models.BlockedUser
+	err := r.db.Where("user_id = ? OR blocked_user_id = ?", userID, userID).Find(&blocked).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(blocked))
+	for _, block := range blocked {
+		if block.UserID == userID {
+			ids = append(ids, block.BlockedUserID)
+		} else {
+			ids = append(ids, block.UserID)
+		}
+	}
+	return ids, nil
 }
 
 // UnblockUser unblocks a user
@@ -171,7 +335,7 @@ func (r *friendRepository) UnblockUser(userID, blockedUserID string) error {
 	return r.db.Delete(&models.BlockedUser{}, "user_id = ? AND blocked_user_id = ?", userID, blockedUserID).Error
 }
 
-// GetBlockedUsers gets blocked users
+// GetBlockedUsers gets blocked users, most recently blocked first
 func (r *friendRepository) GetBlockedUsers(userID string, page, limit int) ([]*models.BlockedUser, int64, error) {
 	var blockedUsers []*models.BlockedUser
 	var count int64
@@ -184,7 +348,7 @@ func (r *friendRepository) GetBlockedUsers(userID string, page, limit int) ([]*m
 	}
 
 	offset := (page - 1) * limit
-	err = query.Offset(offset).Limit(limit).Find(&blockedUsers).Error
+	err = query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&blockedUsers).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -204,9 +368,21 @@ func (r *friendRepository) IsUserBlocked(userID, blockedUserID string) (bool, er
 
 // CheckFriendship checks the friendship status between two users
 func (r *friendRepository) CheckFriendship(userID, friendID string) (string, string, error) {
+	// Check if one has blocked the other; a block in either direction takes precedence
+	var blockedCount int64
+	err := r.db.Model(&models.BlockedUser{}).
+		Where("(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)", userID, friendID, friendID, userID).
+		Count(&blockedCount).Error
+	if err != nil {
+		return "", "", err
+	}
+	if blockedCount > 0 {
+		return "blocked", "", nil
+	}
+
 	// Check if they are friends
 	var friendshipCount int64
-	err := r.db.Model(&models.Friendship{}).Where("user_id = ? AND friend_id = ?", userID, friendID).Count(&friendshipCount).Error
+	err = r.db.Model(&models.Friendship{}).Where("user_id = ? AND friend_id = ?", userID, friendID).Count(&friendshipCount).Error
 	if err != nil {
 		return "", "", err
 	}
@@ -225,18 +401,62 @@ func (r *friendRepository) CheckFriendship(userID, friendID string) (string, str
 		return "", "", err
 	}
 
-	// Check if one has blocked the other
-	var blockedCount int64
-	err = r.db.Model(&models.BlockedUser{}).
-		Where("(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)", userID, friendID, friendID, userID).
-		Count(&blockedCount).Error
+	// No relationship
+	return "none", "", nil
+}
+
+// CheckFriendships checks the friendship status between a user and each of the other users,
+// keyed by the other user's ID. It runs one query per kind of relationship whatever the
+// number of users, with the same precedence as CheckFriendship.
+func (r *friendRepository) CheckFriendships(userID string, otherIDs []string) (map[string]string, error) {
+	statuses := make(map[string]string, len(otherIDs))
+	for _, id := range otherIDs {
+		statuses[id] = "none"
+	}
+	if len(otherIDs) == 0 {
+		return statuses, nil
+	}
+
+	// Pending requests in either direction
+	var requests []*models.FriendRequest
+	err := r.db.Where("(sender_id = ? AND receiver_id IN ?) OR (receiver_id = ? AND sender_id IN ?)", userID, otherIDs, userID, otherIDs).
+		Where("status = ?", "pending").
+		Find(&requests).Error
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	if blockedCount > 0 {
-		return "blocked", "", nil
+	for _, request := range requests {
+		if request.SenderID == userID {
+			statuses[request.ReceiverID] = "pending"
+		} else {
+			statuses[request.SenderID] = "pending"
+		}
 	}
 
-	// No relationship
-	return "none", "", nil
+	// Friendships
+	var friendIDs []string
+	err = r.db.Model(&models.Friendship{}).Where("user_id = ? AND friend_id IN ?", userID, otherIDs).Pluck("friend_id", &friendIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range friendIDs {
+		statuses[id] = "friends"
+	}
+
+	// Blocks in either direction take precedence
+	var blocked []*models.BlockedUser
+	err = r.db.Where("(user_id = ? AND blocked_user_id IN ?) OR (blocked_user_id = ? AND user_id IN ?)", userID, otherIDs, userID, otherIDs).
+		Find(&blocked).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocked {
+		if block.UserID == userID {
+			statuses[block.BlockedUserID] = "blocked"
+		} else {
+			statuses[block.UserID] = "blocked"
+		}
+	}
+
+	return statuses, nil
 }
\ No newline at end of file
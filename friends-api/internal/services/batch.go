@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"friends-api/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize is the most friend requests that can be acted on in one call
+const maxBatchSize = 100
+
+// BatchResult is the outcome of acting on one friend request of a batch. Err is nil and
+// Request is the updated request on success.
+type BatchResult struct {
+	RequestID string
+	Request   *models.FriendRequest
+	Err       error
+}
+
+// AcceptFriendRequests accepts several friend requests received by the user. Each request is
+// accepted on its own, so one that cannot be accepted does not stop the others.
+func (s *friendService) AcceptFriendRequests(ctx context.Context, requestIDs []string, userID string) ([]*BatchResult, error) {
+	if err := validateBatch(requestIDs); err != nil {
+		return nil, err
+	}
+	return s.applyBatch(ctx, requestIDs, userID, s.AcceptFriendRequest), nil
+}
+
+// RejectFriendRequests rejects several friend requests received by the user. Each request is
+// rejected on its own, so one that cannot be rejected does not stop the others.
+func (s *friendService) RejectFriendRequests(ctx context.Context, requestIDs []string, userID string) ([]*BatchResult, error) {
+	if err := validateBatch(requestIDs); err != nil {
+		return nil, err
+	}
+	return s.applyBatch(ctx, requestIDs, userID, s.RejectFriendRequest), nil
+}
+
+// AcceptAllFriendRequests accepts every pending friend request received by the user, oldest first
+func (s *friendService) AcceptAllFriendRequests(ctx context.Context, userID string) ([]*BatchResult, error) {
+	requestIDs, err := s.repo.GetPendingFriendRequestIDs(userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get pending friend requests", err)
+		return nil, status.Error(codes.Internal, "failed to get pending friend requests")
+	}
+	return s.applyBatch(ctx, requestIDs, userID, s.AcceptFriendRequest), nil
+}
+
+// applyBatch applies action to each request in order, skipping repeated IDs. The action checks
+// that the user may act on the request, just as for a single request.
+func (s *friendService) applyBatch(ctx context.Context, requestIDs []string, userID string, action func(ctx context.Context, requestID, userID string) (*models.FriendRequest, error)) []*BatchResult {
+	results := make([]*BatchResult, 0, len(requestIDs))
+	seen := make(map[string]bool, len(requestIDs))
+	for _, requestID := range requestIDs {
+		if seen[requestID] {
+			continue
+		}
+		seen[requestID] = true
+
+		request, err := action(ctx, requestID, userID)
+		results = append(results, &BatchResult{
+			RequestID: requestID,
+			Request:   request,
+			Err:       err,
+		})
+	}
+	return results
+}
+
+// validateBatch checks that a batch names at least one and at most maxBatchSize requests
+func validateBatch(requestIDs []string) error {
+	if len(requestIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "request IDs are required")
+	}
+	if len(requestIDs) > maxBatchSize {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d request IDs can be given at once", maxBatchSize))
+	}
+	return nil
+}
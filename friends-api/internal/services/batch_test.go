@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchActionsReportEachRequest(t *testing.T) {
+	ctx := context.Background()
+
+	for _, action := range []string{"accept", "reject"} {
+		t.Run(action, func(t *testing.T) {
+			s := newTestService(t)
+			fromBob := s.sendRequest(t, "bob", "alice")
+			fromCarol := s.sendRequest(t, "carol", "alice")
+			toDave := s.sendRequest(t, "alice", "dave")
+			fromErin := s.sendRequest(t, "erin", "alice")
+			if _, err := s.RejectFriendRequest(ctx, fromErin, "alice"); err != nil {
+				t.Fatalf("RejectFriendRequest: %v", err)
+			}
+
+			batch := s.AcceptFriendRequests
+			wantStatus := "friends"
+			if action == "reject" {
+				batch = s.RejectFriendRequests
+				wantStatus = "none"
+			}
+
+			// Repeated IDs are acted on once
+			ids := []string{fromBob, "missing", toDave, fromErin, fromCarol, fromBob}
+			results, err := batch(ctx, ids, "alice")
+			if err != nil {
+				t.Fatalf("batch: %v", err)
+			}
+
+			want := []struct {
+				requestID string
+				code      codes.Code
+			}{
+				{fromBob, codes.OK},
+				{"missing", codes.NotFound},
+				{toDave, codes.PermissionDenied},
+				{fromErin, codes.FailedPrecondition},
+				{fromCarol, codes.OK},
+			}
+			if len(results) != len(want) {
+				t.Fatalf("got %d results, want %d", len(results), len(want))
+			}
+			for i, w := range want {
+				result := results[i]
+				if result.RequestID != w.requestID || status.Code(result.Err) != w.code {
+					t.Errorf("result %d: got %s with %v, want %s with %v", i, result.RequestID, result.Err, w.requestID, w.code)
+				}
+				if (result.Request != nil) != (w.code == codes.OK) {
+					t.Errorf("result %d: got request %v with error %v", i, result.Request, result.Err)
+				}
+			}
+
+			// The valid requests went through in spite of the invalid ones around them
+			statuses, err := s.CheckFriendships(ctx, "alice", []string{"bob", "carol", "dave", "erin"})
+			if err != nil {
+				t.Fatalf("CheckFriendships: %v", err)
+			}
+			for userID, want := range map[string]string{"bob": wantStatus, "carol": wantStatus, "dave": "pending", "erin": "none"} {
+				if statuses[userID] != want {
+					t.Errorf("alice and %s are %q, want %q", userID, statuses[userID], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchActionsValidateSize(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	tooMany := make([]string, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = "request"
+	}
+	for name, ids := range map[string][]string{"empty": nil, "too many": tooMany} {
+		if _, err := s.AcceptFriendRequests(ctx, ids, "alice"); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("accept %s: got %v, want InvalidArgument", name, err)
+		}
+		if _, err := s.RejectFriendRequests(ctx, ids, "alice"); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("reject %s: got %v, want InvalidArgument", name, err)
+		}
+	}
+}
+
+func TestAcceptAllFriendRequestsOnlyAcceptsReceivedPendingRequests(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	s.sendRequest(t, "bob", "alice")
+	s.sendRequest(t, "carol", "alice")
+	s.sendRequest(t, "alice", "dave")
+	s.sendRequest(t, "erin", "frank")
+
+	results, err := s.AcceptAllFriendRequests(ctx, "alice")
+	if err != nil {
+		t.Fatalf("AcceptAllFriendRequests: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			t.Errorf("request %s: %v", result.RequestID, result.Err)
+		}
+	}
+
+	statuses, err := s.CheckFriendships(ctx, "alice", []string{"bob", "carol", "dave"})
+	if err != nil {
+		t.Fatalf("CheckFriendships: %v", err)
+	}
+	for userID, want := range map[string]string{"bob": "friends", "carol": "friends", "dave": "pending"} {
+		if statuses[userID] != want {
+			t.Errorf("alice and %s are %q, want %q", userID, statuses[userID], want)
+		}
+	}
+	if statuses, _ := s.CheckFriendships(ctx, "erin", []string{"frank"}); statuses["frank"] != "pending" {
+		t.Errorf("a request between other users was accepted: %q", statuses["frank"])
+	}
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlockUserCancelsPendingRequests(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	requestID := s.sendRequest(t, "alice", "bob")
+	s.sendRequest(t, "carol", "bob")
+
+	if err := s.BlockUser(ctx, "bob", "alice"); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+
+	// Alice's request is gone for both of them, and cannot be accepted any more
+	for _, userID := range []string{"alice", "bob"} {
+		requests, _, _, err := s.GetFriendRequests(ctx, userID, "pending", "all", 1, 10)
+		if err != nil {
+			t.Fatalf("GetFriendRequests for %s: %v", userID, err)
+		}
+		for _, request := range requests {
+			if request.ID == requestID {
+				t.Errorf("%s still has the pending request of the blocked user", userID)
+			}
+		}
+	}
+	if _, err := s.AcceptFriendRequest(ctx, requestID, "bob"); err == nil {
+		t.Error("AcceptFriendRequest accepted the request of a blocked user")
+	}
+	if friendshipStatus, _, _ := s.CheckFriendship(ctx, "bob", "alice"); friendshipStatus == "friends" {
+		t.Error("bob and alice are friends after the block")
+	}
+
+	// A new request from Alice is refused
+	if _, err := s.SendFriendRequest(ctx, "alice", "bob"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("new request from the blocked user: got %v, want PermissionDenied", err)
+	}
+
+	// Requests between other users are left alone
+	requests, count, _, err := s.GetFriendRequests(ctx, "carol", "pending", "all", 1, 10)
+	if err != nil || count != 1 || requests[0].ReceiverID != "bob" {
+		t.Errorf("carol's request: got %d requests, %v", count, err)
+	}
+}
+
+func TestBlockUserRemovesFriendship(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	s.befriend(t, "alice", "bob")
+
+	if err := s.BlockUser(ctx, "alice", "bob"); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+
+	friends, _, _, err := s.GetFriends(ctx, "bob", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetFriends: %v", err)
+	}
+	if len(friends) != 0 {
+		t.Errorf("bob has %d friends after being blocked, want 0", len(friends))
+	}
+	if err := s.BlockUser(ctx, "alice", "bob"); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second BlockUser: got %v, want AlreadyExists", err)
+	}
+}
+
+func TestBlocksWorkInBothDirections(t *testing.T) {
+	tests := []struct {
+		name    string
+		blocker string
+		blocked string
+	}{
+		{"sender blocked receiver", "alice", "bob"},
+		{"receiver blocked sender", "bob", "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t)
+			ctx := context.Background()
+			if err := s.BlockUser(ctx, tt.blocker, tt.blocked); err != nil {
+				t.Fatalf("BlockUser: %v", err)
+			}
+
+			if _, err := s.SendFriendRequest(ctx, "alice", "bob"); status.Code(err) != codes.PermissionDenied {
+				t.Errorf("SendFriendRequest: got %v, want PermissionDenied", err)
+			}
+
+			// Both users see the block, whoever made it
+			for _, pair := range [][2]string{{"alice", "bob"}, {"bob", "alice"}} {
+				friendshipStatus, _, err := s.CheckFriendship(ctx, pair[0], pair[1])
+				if err != nil {
+					t.Fatalf("CheckFriendship: %v", err)
+				}
+				if friendshipStatus != "blocked" {
+					t.Errorf("CheckFriendship(%s, %s): got %q, want blocked", pair[0], pair[1], friendshipStatus)
+				}
+			}
+		})
+	}
+}
+
+func TestBlockedUserCanRequestAgainAfterUnblock(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	if err := s.BlockUser(ctx, "bob", "alice"); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+	if err := s.UnblockUser(ctx, "bob", "alice"); err != nil {
+		t.Fatalf("UnblockUser: %v", err)
+	}
+
+	s.sendRequest(t, "alice", "bob")
+}
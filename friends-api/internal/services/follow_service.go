@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"friends-api/internal/clients"
+	"friends-api/internal/models"
+	"friends-api/internal/repository"
+	"friends-api/internal/utils/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FollowService is the interface for follow-related operations. Follows are independent of
+// friendships: following someone neither needs nor creates a friendship, and vice versa.
+type FollowService interface {
+	Follow(ctx context.Context, followerID, followeeID string) error
+	Unfollow(ctx context.Context, followerID, followeeID string) error
+	GetFollowers(ctx context.Context, userID string, page, limit int) ([]*models.Follow, int64, int32, error)
+	GetFollowing(ctx context.Context, userID string, page, limit int) ([]*models.Follow, int64, int32, error)
+	GetFollowingIDs(ctx context.Context, userID string) ([]string, error)
+	GetFollowCounts(ctx context.Context, userID, viewerID string) (*FollowCounts, error)
+}
+
+// FollowCounts holds how many followers a user has and how many users they follow, and
+// whether the viewer is one of the followers
+type FollowCounts struct {
+	Followers   int64
+	Following   int64
+	IsFollowing bool
+}
+
+// followService is the implementation of FollowService
+type followService struct {
+	follows repository.FollowRepository
+	friends repository.FriendRepository
+	users   clients.UserClient
+	logger  *logger.Logger
+}
+
+// NewFollowService creates a new follow service. The friend repository is only used to look
+// up blocks, which apply to follows as well.
+func NewFollowService(follows repository.FollowRepository, friends repository.FriendRepository, users clients.UserClient, logger *logger.Logger) FollowService {
+	return &followService{
+		follows: follows,
+		friends: friends,
+		users:   users,
+		logger:  logger,
+	}
+}
+
+// Follow makes a user follow another user
+func (s *followService) Follow(ctx context.Context, followerID, followeeID string) error {
+	if followeeID == "" {
+		return status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if followerID == followeeID {
+		return status.Error(codes.InvalidArgument, "cannot follow yourself")
+	}
+
+	// Make sure the user exists, so follows are never stored for unknown users
+	users, err := s.users.GetUsersByIDs(ctx, []string{followeeID})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to look up followed user", err)
+		return status.Error(codes.Internal, "failed to look up user")
+	}
+	if _, ok := users[followeeID]; !ok {
+		return status.Error(codes.NotFound, "user not found")
+	}
+
+	// Refuse follows across a block in either direction
+	blockedByFollowee, err := s.friends.IsUserBlocked(followeeID, followerID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
+		return err
+	}
+	if blockedByFollowee {
+		return status.Error(codes.PermissionDenied, "cannot follow a user who has blocked you")
+	}
+
+	blockedByFollower, err := s.friends.IsUserBlocked(followerID, followeeID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
+		return err
+	}
+	if blockedByFollower {
+		return status.Error(codes.PermissionDenied, "cannot follow a user you have blocked")
+	}
+
+	following, err := s.follows.IsFollowing(followerID, followeeID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check follow", err)
+		return err
+	}
+	if following {
+		return status.Error(codes.AlreadyExists, "already following this user")
+	}
+
+	err = s.follows.CreateFollow(&models.Follow{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create follow", err)
+		return err
+	}
+
+	return nil
+}
+
+// Unfollow stops a user following another user
+func (s *followService) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	if followeeID == "" {
+		return status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	deleted, err := s.follows.DeleteFollow(followerID, followeeID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to delete follow", err)
+		return err
+	}
+	if !deleted {
+		return status.Error(codes.NotFound, "not following this user")
+	}
+
+	return nil
+}
+
+// GetFollowers gets the followers of a user
+func (s *followService) GetFollowers(ctx context.Context, userID string, page, limit int) ([]*models.Follow, int64, int32, error) {
+	page, limit = followPage(page, limit)
+
+	follows, count, err := s.follows.GetFollowers(userID, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get followers", err)
+		return nil, 0, 0, err
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return follows, count, totalPages, nil
+}
+
+// GetFollowing gets the users a user follows
+func (s *followService) GetFollowing(ctx context.Context, userID string, page, limit int) ([]*models.Follow, int64, int32, error) {
+	page, limit = followPage(page, limit)
+
+	follows, count, err := s.follows.GetFollowing(userID, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get followed users", err)
+		return nil, 0, 0, err
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return follows, count, totalPages, nil
+}
+
+// followPage fills in the page and page size of a follow list when they are not given
+func followPage(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	return page, limit
+}
+
+// GetFollowingIDs gets the IDs of all the users a user follows
+func (s *followService) GetFollowingIDs(ctx context.Context, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	ids, err := s.follows.GetFollowingIDs(userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get followed user IDs", err)
+		return nil, status.Error(codes.Internal, "failed to get followed users")
+	}
+
+	return ids, nil
+}
+
+// GetFollowCounts counts a user's followers and followed users, and checks whether the
+// viewer follows the user when a viewer is given
+func (s *followService) GetFollowCounts(ctx context.Context, userID, viewerID string) (*FollowCounts, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	followers, following, err := s.follows.CountFollows(userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count follows", err)
+		return nil, status.Error(codes.Internal, "failed to count follows")
+	}
+
+	counts := &FollowCounts{
+		Followers: followers,
+		Following: following,
+	}
+
+	if viewerID != "" && viewerID != userID {
+		counts.IsFollowing, err = s.follows.IsFollowing(viewerID, userID)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to check follow", err)
+			return nil, status.Error(codes.Internal, "failed to count follows")
+		}
+	}
+
+	return counts, nil
+}
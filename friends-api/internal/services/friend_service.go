@@ -3,11 +3,17 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"friends-api/internal/clients"
 	"friends-api/internal/models"
 	"friends-api/internal/repository"
 	"friends-api/internal/utils/logger"
+	"sort"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -15,61 +21,106 @@ import (
 type FriendService interface {
 	// Friend requests
 	SendFriendRequest(ctx context.Context, senderID, receiverID string) (*models.FriendRequest, error)
-	GetFriendRequests(ctx context.Context, userID, status string, page, limit int) ([]*models.FriendRequest, int64, int32, error)
+	GetFriendRequests(ctx context.Context, userID, requestStatus, direction string, page, limit int) ([]*models.FriendRequest, int64, int32, error)
 	AcceptFriendRequest(ctx context.Context, requestID, userID string) (*models.FriendRequest, error)
 	RejectFriendRequest(ctx context.Context, requestID, userID string) (*models.FriendRequest, error)
+	CancelFriendRequest(ctx context.Context, requestID, userID string) error
+	AcceptFriendRequests(ctx context.Context, requestIDs []string, userID string) ([]*BatchResult, error)
+	RejectFriendRequests(ctx context.Context, requestIDs []string, userID string) ([]*BatchResult, error)
+	AcceptAllFriendRequests(ctx context.Context, userID string) ([]*BatchResult, error)
 
 	// Friendships
-	GetFriends(ctx context.Context, userID string, page, limit int) ([]*models.Friendship, int64, int32, error)
+	GetFriends(ctx context.Context, userID, sort string, page, limit int) ([]*models.Friendship, int64, int32, error)
+	GetFriendsByName(ctx context.Context, userID string) ([]*models.Friendship, error)
+	GetFriendIDs(ctx context.Context, userID string) ([]string, error)
+	GetMutualFriends(ctx context.Context, userID, otherUserID string, page, limit int) ([]*models.Friendship, int64, int32, error)
 	RemoveFriend(ctx context.Context, userID, friendID string) error
 
 	// Blocked users
 	BlockUser(ctx context.Context, userID, blockedUserID string) error
 	UnblockUser(ctx context.Context, userID, blockedUserID string) error
 	GetBlockedUsers(ctx context.Context, userID string, page, limit int) ([]*models.BlockedUser, int64, int32, error)
+	GetBlockedIDs(ctx context.Context, userID string) ([]string, error)
 
 	// Check friendship status
 	CheckFriendship(ctx context.Context, userID, friendID string) (string, string, error)
+	CheckFriendships(ctx context.Context, userID string, otherIDs []string) (map[string]string, error)
 }
 
 // friendService is the implementation of FriendService
 type friendService struct {
-	repo   repository.FriendRepository
-	logger *logger.Logger
+	repo          repository.FriendRepository
+	users         clients.UserClient
+	notifications clients.NotificationClient
+	logger        *logger.Logger
 }
 
 // NewFriendService creates a new friend service
-func NewFriendService(repo repository.FriendRepository, logger *logger.Logger) FriendService {
+func NewFriendService(repo repository.FriendRepository, users clients.UserClient, notifications clients.NotificationClient, logger *logger.Logger) FriendService {
 	return &friendService{
-		repo:   repo,
-		logger: logger,
+		repo:          repo,
+		users:         users,
+		notifications: notifications,
+		logger:        logger,
 	}
 }
 
 // SendFriendRequest sends a friend request
 func (s *friendService) SendFriendRequest(ctx context.Context, senderID, receiverID string) (*models.FriendRequest, error) {
 	// Check if sender and receiver are the same
+	if receiverID == "" {
+		return nil, status.Error(codes.InvalidArgument, "friend ID is required")
+	}
 	if senderID == receiverID {
-		return nil, errors.New("cannot send friend request to yourself")
+		return nil, status.Error(codes.InvalidArgument, "cannot send friend request to yourself")
+	}
+
+	// Make sure the receiver exists, so requests are never stored for unknown users
+	users, err := s.users.GetUsersByIDs(ctx, []string{receiverID})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to look up receiver", err)
+		return nil, status.Error(codes.Internal, "failed to look up user")
+	}
+	if _, ok := users[receiverID]; !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	// Refuse requests across a block in either direction
+	blockedByReceiver, err := s.repo.IsUserBlocked(receiverID, senderID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
+		return nil, err
+	}
+	if blockedByReceiver {
+		return nil, status.Error(codes.PermissionDenied, "cannot send friend request to a user who has blocked you")
+	}
+
+	blockedBySender, err := s.repo.IsUserBlocked(senderID, receiverID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
+		return nil, err
+	}
+	if blockedBySender {
+		return nil, status.Error(codes.PermissionDenied, "cannot send friend request to a user you have blocked")
 	}
 
 	// Check if they are already friends
-	status, _, err := s.repo.CheckFriendship(senderID, receiverID)
+	friendshipStatus, _, err := s.repo.CheckFriendship(senderID, receiverID)
 	if err != nil && err != gorm.ErrRecordNotFound {
-		s.logger.Error("Failed to check friendship", err)
+		s.logger.WithContext(ctx).Error("Failed to check friendship", err)
 		return nil, err
 	}
 
-	if status == "friends" {
-		return nil, errors.New("already friends")
+	if friendshipStatus == "friends" {
+		return nil, status.Error(codes.AlreadyExists, "already friends")
 	}
 
-	if status == "pending" {
-		return nil, errors.New("friend request already sent")
+	if friendshipStatus == "pending" {
+		return nil, status.Error(codes.AlreadyExists, "friend request already sent")
 	}
 
-	if status == "blocked" {
-		return nil, errors.New("cannot send friend request to blocked user")
+	if friendshipStatus == "blocked" {
+		return nil, status.Error(codes.PermissionDenied, "cannot send friend request to blocked user")
 	}
 
 	// Create friend request
@@ -81,19 +132,44 @@ func (s *friendService) SendFriendRequest(ctx context.Context, senderID, receive
 
 	err = s.repo.CreateFriendRequest(request)
 	if err != nil {
-		s.logger.Error("Failed to create friend request", err)
+		s.logger.WithContext(ctx).Error("Failed to create friend request", err)
 		return nil, err
 	}
 
+	s.notify(ctx, receiverID, clients.NotificationFriendRequest, request.ID)
+
 	return request, nil
 }
 
+// notify records a notification for a user. The friendship change has already been made,
+// so a failure is only logged.
+func (s *friendService) notify(ctx context.Context, userID, notificationType, targetID string) {
+	if err := s.notifications.Notify(ctx, userID, notificationType, targetID); err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to create notification",
+			logger.Field("recipient_id", userID),
+			logger.Field("type", notificationType),
+			logger.Field("error", err.Error()))
+	}
+}
+
 // GetFriendRequests gets friend requests for a user
-func (s *friendService) GetFriendRequests(ctx context.Context, userID, status string, page, limit int) ([]*models.FriendRequest, int64, int32, error) {
-	// Get friend requests
-	requests, count, err := s.repo.GetFriendRequestsByReceiverID(userID, status, page, limit)
+func (s *friendService) GetFriendRequests(ctx context.Context, userID, requestStatus, direction string, page, limit int) ([]*models.FriendRequest, int64, int32, error) {
+	// Get friend requests for the requested direction
+	var requests []*models.FriendRequest
+	var count int64
+	var err error
+	switch direction {
+	case "", models.DirectionIncoming:
+		requests, count, err = s.repo.GetFriendRequestsByReceiverID(userID, requestStatus, page, limit)
+	case models.DirectionOutgoing:
+		requests, count, err = s.repo.GetFriendRequestsBySenderID(userID, requestStatus, page, limit)
+	case models.DirectionAll:
+		requests, count, err = s.repo.GetFriendRequestsByUserID(userID, requestStatus, page, limit)
+	default:
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "direction must be incoming, outgoing or all")
+	}
 	if err != nil {
-		s.logger.Error("Failed to get friend requests", err)
+		s.logger.WithContext(ctx).Error("Failed to get friend requests", err)
 		return nil, 0, 0, err
 	}
 
@@ -106,80 +182,63 @@ func (s *friendService) GetFriendRequests(ctx context.Context, userID, status st
 // AcceptFriendRequest accepts a friend request
 func (s *friendService) AcceptFriendRequest(ctx context.Context, requestID, userID string) (*models.FriendRequest, error) {
 	// Get friend request
-	request, err := s.repo.GetFriendRequestByID(requestID)
+	request, err := s.getFriendRequest(ctx, requestID)
 	if err != nil {
-		s.logger.Error("Failed to get friend request", err)
 		return nil, err
 	}
 
 	// Check if the user is the receiver of the request
 	if request.ReceiverID != userID {
-		return nil, errors.New("not authorized to accept this friend request")
+		return nil, status.Error(codes.PermissionDenied, "not authorized to accept this friend request")
 	}
 
 	// Check if the request is pending
 	if request.Status != "pending" {
-		return nil, errors.New("friend request is not pending")
+		return nil, status.Error(codes.FailedPrecondition, "friend request is not pending")
 	}
 
-	// Update request status
-	err = s.repo.UpdateFriendRequestStatus(requestID, "accepted")
+	// Update request status and create the friendship both ways, together so that a failure
+	// leaves the request pending rather than half accepted
+	accepted, err := s.repo.AcceptFriendRequest(request)
 	if err != nil {
-		s.logger.Error("Failed to update friend request status", err)
-		return nil, err
+		s.logger.WithContext(ctx).Error("Failed to accept friend request", err)
+		return nil, status.Error(codes.Internal, "failed to accept friend request")
 	}
-
-	// Create friendship (both ways)
-	friendship1 := &models.Friendship{
-		UserID:   request.SenderID,
-		FriendID: request.ReceiverID,
-	}
-	err = s.repo.CreateFriendship(friendship1)
-	if err != nil {
-		s.logger.Error("Failed to create friendship", err)
-		return nil, err
-	}
-
-	friendship2 := &models.Friendship{
-		UserID:   request.ReceiverID,
-		FriendID: request.SenderID,
-	}
-	err = s.repo.CreateFriendship(friendship2)
-	if err != nil {
-		s.logger.Error("Failed to create friendship", err)
-		return nil, err
+	if !accepted {
+		return nil, status.Error(codes.FailedPrecondition, "friend request is not pending")
 	}
 
 	// Update request
 	request.Status = "accepted"
 	request.UpdatedAt = time.Now()
 
+	s.notify(ctx, request.SenderID, clients.NotificationFriendAccept, request.ID)
+
 	return request, nil
 }
 
 // RejectFriendRequest rejects a friend request
 func (s *friendService) RejectFriendRequest(ctx context.Context, requestID, userID string) (*models.FriendRequest, error) {
 	// Get friend request
-	request, err := s.repo.GetFriendRequestByID(requestID)
+	request, err := s.getFriendRequest(ctx, requestID)
 	if err != nil {
-		s.logger.Error("Failed to get friend request", err)
 		return nil, err
 	}
 
 	// Check if the user is the receiver of the request
 	if request.ReceiverID != userID {
-		return nil, errors.New("not authorized to reject this friend request")
+		return nil, status.Error(codes.PermissionDenied, "not authorized to reject this friend request")
 	}
 
 	// Check if the request is pending
 	if request.Status != "pending" {
-		return nil, errors.New("friend request is not pending")
+		return nil, status.Error(codes.FailedPrecondition, "friend request is not pending")
 	}
 
 	// Update request status
 	err = s.repo.UpdateFriendRequestStatus(requestID, "rejected")
 	if err != nil {
-		s.logger.Error("Failed to update friend request status", err)
+		s.logger.WithContext(ctx).Error("Failed to update friend request status", err)
 		return nil, err
 	}
 
@@ -190,12 +249,210 @@ func (s *friendService) RejectFriendRequest(ctx context.Context, requestID, user
 	return request, nil
 }
 
-// GetFriends gets friends for a user
-func (s *friendService) GetFriends(ctx context.Context, userID string, page, limit int) ([]*models.Friendship, int64, int32, error) {
+// CancelFriendRequest withdraws a pending friend request. The request is deleted so
+// that the sender can send a new one later.
+func (s *friendService) CancelFriendRequest(ctx context.Context, requestID, userID string) error {
+	// Get friend request
+	request, err := s.getFriendRequest(ctx, requestID)
+	if err != nil {
+		return err
+	}
+
+	// Check if the user is the sender of the request
+	if request.SenderID != userID {
+		return status.Error(codes.PermissionDenied, "not authorized to cancel this friend request")
+	}
+
+	// Check if the request is pending
+	if request.Status != "pending" {
+		return status.Error(codes.FailedPrecondition, "friend request is not pending")
+	}
+
+	// Delete request
+	err = s.repo.DeleteFriendRequest(requestID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to delete friend request", err)
+		return status.Error(codes.Internal, "failed to cancel friend request")
+	}
+
+	return nil
+}
+
+// getFriendRequest loads a friend request, reporting a missing one as not found
+func (s *friendService) getFriendRequest(ctx context.Context, requestID string) (*models.FriendRequest, error) {
+	request, err := s.repo.GetFriendRequestByID(requestID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "friend request not found")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get friend request", err)
+		return nil, status.Error(codes.Internal, "failed to get friend request")
+	}
+	return request, nil
+}
+
+// GetFriends gets friends for a user in the given sort order
+func (s *friendService) GetFriends(ctx context.Context, userID, sort string, page, limit int) ([]*models.Friendship, int64, int32, error) {
 	// Get friendships
-	friendships, count, err := s.repo.GetFriendshipsByUserID(userID, page, limit)
+	var friendships []*models.Friendship
+	var count int64
+	var err error
+	switch sort {
+	case "", models.FriendSortRecent, models.FriendSortOldest:
+		friendships, count, err = s.repo.GetFriendshipsByUserID(userID, sort, page, limit)
+	case models.FriendSortNameAsc:
+		var all []*models.Friendship
+		all, err = s.friendsByName(ctx, userID)
+		friendships, count = pageOf(all, page, limit)
+	default:
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "sort must be recent, oldest or name_asc")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get friendships", err)
+		return nil, 0, 0, err
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return friendships, count, totalPages, nil
+}
+
+// GetFriendIDs gets the IDs of all of the user's friends
+func (s *friendService) GetFriendIDs(ctx context.Context, userID string) ([]string, error) {
+	friendships, err := s.repo.GetAllFriendshipsByUserID(userID)
 	if err != nil {
-		s.logger.Error("Failed to get friendships", err)
+		s.logger.WithContext(ctx).Error("Failed to get friendships", err)
+		return nil, status.Error(codes.Internal, "failed to get friends")
+	}
+
+	friendIDs := make([]string, len(friendships))
+	for i, friendship := range friendships {
+		friendIDs[i] = friendship.FriendID
+	}
+
+	return friendIDs, nil
+}
+
+// GetBlockedIDs gets the IDs of the users a user has blocked or been blocked by
+func (s *friendService) GetBlockedIDs(ctx context.Context, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	ids, err := s.repo.GetBlockedIDs(userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get blocked user IDs", err)
+		return nil, status.Error(codes.Internal, "failed to get blocked users")
+	}
+
+	return ids, nil
+}
+
+// MaxUsersPerLookup is the most IDs the users service accepts in one GetUsersByIDs call
+const MaxUsersPerLookup = 100
+
+// GetFriendsByName gets all of the user's friends in alphabetical order, for callers that go
+// through the whole list and would otherwise sort it again for every page
+func (s *friendService) GetFriendsByName(ctx context.Context, userID string) ([]*models.Friendship, error) {
+	friendships, err := s.friendsByName(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get friendships", err)
+		return nil, err
+	}
+	return friendships, nil
+}
+
+// friendsByName gets the user's friends in alphabetical order.
+// Names live in the users service, so all friendships are loaded and sorted here; friends
+// whose profile cannot be found are listed last.
+func (s *friendService) friendsByName(ctx context.Context, userID string) ([]*models.Friendship, error) {
+	friendships, err := s.repo.GetAllFriendshipsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The users service accepts a limited number of IDs per lookup
+	names := make(map[string]string, len(friendships))
+	for start := 0; start < len(friendships); start += MaxUsersPerLookup {
+		end := start + MaxUsersPerLookup
+		if end > len(friendships) {
+			end = len(friendships)
+		}
+		ids := make([]string, 0, end-start)
+		for _, friendship := range friendships[start:end] {
+			ids = append(ids, friendship.FriendID)
+		}
+		users, err := s.users.GetUsersByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up friend names: %w", err)
+		}
+		for id, user := range users {
+			names[id] = strings.ToLower(user.Name)
+		}
+	}
+
+	sort.SliceStable(friendships, func(i, j int) bool {
+		a, aok := names[friendships[i].FriendID]
+		b, bok := names[friendships[j].FriendID]
+		if aok != bok {
+			return aok
+		}
+		if a != b {
+			return a < b
+		}
+		return friendships[i].FriendID < friendships[j].FriendID
+	})
+
+	return friendships, nil
+}
+
+// pageOf returns a page of friendships along with the total count
+func pageOf(friendships []*models.Friendship, page, limit int) ([]*models.Friendship, int64) {
+	count := int64(len(friendships))
+	start := (page - 1) * limit
+	if page < 1 || limit < 1 || start >= len(friendships) {
+		return []*models.Friendship{}, count
+	}
+	end := start + limit
+	if end > len(friendships) {
+		end = len(friendships)
+	}
+	return friendships[start:end], count
+}
+
+// GetMutualFriends gets the friends a user has in common with another user
+func (s *friendService) GetMutualFriends(ctx context.Context, userID, otherUserID string, page, limit int) ([]*models.Friendship, int64, int32, error) {
+	// Validate input
+	if otherUserID == "" {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "other user ID is required")
+	}
+	if userID == otherUserID {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "cannot get mutual friends with yourself")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	// Reveal nothing across a block in either direction
+	for _, pair := range [][2]string{{userID, otherUserID}, {otherUserID, userID}} {
+		blocked, err := s.repo.IsUserBlocked(pair[0], pair[1])
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
+			return nil, 0, 0, err
+		}
+		if blocked {
+			return []*models.Friendship{}, 0, 0, nil
+		}
+	}
+
+	// Get friendships shared with the other user
+	friendships, count, err := s.repo.GetMutualFriendships(userID, otherUserID, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get mutual friendships", err)
 		return nil, 0, 0, err
 	}
 
@@ -208,20 +465,20 @@ func (s *friendService) GetFriends(ctx context.Context, userID string, page, lim
 // RemoveFriend removes a friend
 func (s *friendService) RemoveFriend(ctx context.Context, userID, friendID string) error {
 	// Check if they are friends
-	status, _, err := s.repo.CheckFriendship(userID, friendID)
+	friendshipStatus, _, err := s.repo.CheckFriendship(userID, friendID)
 	if err != nil {
-		s.logger.Error("Failed to check friendship", err)
+		s.logger.WithContext(ctx).Error("Failed to check friendship", err)
 		return err
 	}
 
-	if status != "friends" {
-		return errors.New("not friends")
+	if friendshipStatus != "friends" {
+		return status.Error(codes.NotFound, "not friends")
 	}
 
 	// Delete friendship
 	err = s.repo.DeleteFriendship(userID, friendID)
 	if err != nil {
-		s.logger.Error("Failed to delete friendship", err)
+		s.logger.WithContext(ctx).Error("Failed to delete friendship", err)
 		return err
 	}
 
@@ -232,36 +489,21 @@ func (s *friendService) RemoveFriend(ctx context.Context, userID, friendID strin
 func (s *friendService) BlockUser(ctx context.Context, userID, blockedUserID string) error {
 	// Check if user is trying to block themselves
 	if userID == blockedUserID {
-		return errors.New("cannot block yourself")
+		return status.Error(codes.InvalidArgument, "cannot block yourself")
 	}
 
 	// Check if already blocked
 	isBlocked, err := s.repo.IsUserBlocked(userID, blockedUserID)
 	if err != nil {
-		s.logger.Error("Failed to check if user is blocked", err)
+		s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
 		return err
 	}
 
 	if isBlocked {
-		return errors.New("user is already blocked")
-	}
-
-	// Remove friendship if they are friends
-	status, _, err := s.repo.CheckFriendship(userID, blockedUserID)
-	if err != nil && err != gorm.ErrRecordNotFound {
-		s.logger.Error("Failed to check friendship", err)
-		return err
+		return status.Error(codes.AlreadyExists, "user is already blocked")
 	}
 
-	if status == "friends" {
-		err = s.repo.DeleteFriendship(userID, blockedUserID)
-		if err != nil {
-			s.logger.Error("Failed to delete friendship", err)
-			return err
-		}
-	}
-
-	// Block user
+	// Block user; this also removes any friendship and pending requests between them
 	blockedUser := &models.BlockedUser{
 		UserID:        userID,
 		BlockedUserID: blockedUserID,
@@ -269,7 +511,7 @@ func (s *friendService) BlockUser(ctx context.Context, userID, blockedUserID str
 
 	err = s.repo.BlockUser(blockedUser)
 	if err != nil {
-		s.logger.Error("Failed to block user", err)
+		s.logger.WithContext(ctx).Error("Failed to block user", err)
 		return err
 	}
 
@@ -281,18 +523,18 @@ func (s *friendService) UnblockUser(ctx context.Context, userID, blockedUserID s
 	// Check if blocked
 	isBlocked, err := s.repo.IsUserBlocked(userID, blockedUserID)
 	if err != nil {
-		s.logger.Error("Failed to check if user is blocked", err)
+		s.logger.WithContext(ctx).Error("Failed to check if user is blocked", err)
 		return err
 	}
 
 	if !isBlocked {
-		return errors.New("user is not blocked")
+		return status.Error(codes.NotFound, "user is not blocked")
 	}
 
 	// Unblock user
 	err = s.repo.UnblockUser(userID, blockedUserID)
 	if err != nil {
-		s.logger.Error("Failed to unblock user", err)
+		s.logger.WithContext(ctx).Error("Failed to unblock user", err)
 		return err
 	}
 
@@ -304,7 +546,7 @@ func (s *friendService) GetBlockedUsers(ctx context.Context, userID string, page
 	// Get blocked users
 	blockedUsers, count, err := s.repo.GetBlockedUsers(userID, page, limit)
 	if err != nil {
-		s.logger.Error("Failed to get blocked users", err)
+		s.logger.WithContext(ctx).Error("Failed to get blocked users", err)
 		return nil, 0, 0, err
 	}
 
@@ -322,11 +564,36 @@ func (s *friendService) CheckFriendship(ctx context.Context, userID, friendID st
 	}
 
 	// Check friendship status
-	status, requestID, err := s.repo.CheckFriendship(userID, friendID)
+	friendshipStatus, requestID, err := s.repo.CheckFriendship(userID, friendID)
 	if err != nil && err != gorm.ErrRecordNotFound {
-		s.logger.Error("Failed to check friendship", err)
-		return "", "", err
+		s.logger.WithContext(ctx).Error("Failed to check friendship", err)
+		return "", "", status.Error(codes.Internal, "failed to check friendship")
+	}
+
+	return friendshipStatus, requestID, nil
+}
+
+// maxFriendshipChecks is the most users whose status can be checked in one CheckFriendships call
+const maxFriendshipChecks = 100
+
+// CheckFriendships checks the friendship status between a user and each of the other users
+func (s *friendService) CheckFriendships(ctx context.Context, userID string, otherIDs []string) (map[string]string, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if len(otherIDs) > maxFriendshipChecks {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users can be checked at once", maxFriendshipChecks)
+	}
+
+	statuses, err := s.repo.CheckFriendships(userID, otherIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check friendships", err)
+		return nil, status.Error(codes.Internal, "failed to check friendships")
+	}
+
+	if _, ok := statuses[userID]; ok {
+		statuses[userID] = "self"
 	}
 
-	return status, requestID, nil
+	return statuses, nil
 }
\ No newline at end of file
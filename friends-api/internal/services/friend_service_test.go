@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"friends-api/internal/models"
+	"friends-api/internal/testutil"
+)
+
+func TestSendFriendRequestValidatesReceiver(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		receiverID string
+		want       codes.Code
+	}{
+		{"unknown user", "ghost", codes.NotFound},
+		{"self", "alice", codes.InvalidArgument},
+		{"no one", "", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.SendFriendRequest(ctx, "alice", tt.receiverID); status.Code(err) != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	_, count, _, err := s.GetFriendRequests(ctx, "alice", "", "all", 1, 10)
+	if err != nil {
+		t.Fatalf("GetFriendRequests: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("got %d requests stored, want 0", count)
+	}
+}
+
+func TestCheckFriendshipsReportsEachUser(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	s.befriend(t, "alice", "bob")
+	s.sendRequest(t, "alice", "carol")
+	s.sendRequest(t, "dave", "alice")
+	if err := s.BlockUser(ctx, "alice", "erin"); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+	if err := s.BlockUser(ctx, "frank", "alice"); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+
+	// A block takes precedence over a friendship it was added beside
+	s.befriend(t, "alice", "heidi")
+	if err := s.db.Create(&models.BlockedUser{UserID: "heidi", BlockedUserID: "alice"}).Error; err != nil {
+		t.Fatalf("failed to block: %v", err)
+	}
+
+	want := map[string]string{
+		"alice": "self",
+		"bob":   "friends",
+		"carol": "pending",
+		"dave":  "pending",
+		"erin":  "blocked",
+		"frank": "blocked",
+		"gina":  "none",
+		"heidi": "blocked",
+	}
+	otherIDs := []string{"bob", "carol", "dave", "erin", "frank", "gina", "heidi", "alice", "bob"}
+
+	queries := testutil.CountQueries(t, s.db)
+	statuses, err := s.CheckFriendships(ctx, "alice", otherIDs)
+	if err != nil {
+		t.Fatalf("CheckFriendships: %v", err)
+	}
+	batchQueries := *queries
+
+	if len(statuses) != len(want) {
+		t.Errorf("got %d statuses, want %d: %v", len(statuses), len(want), statuses)
+	}
+	for userID, w := range want {
+		if statuses[userID] != w {
+			t.Errorf("%s: got %q, want %q", userID, statuses[userID], w)
+		}
+
+		// The batch agrees with checking each user on their own
+		single, _, err := s.CheckFriendship(ctx, "alice", userID)
+		if err != nil {
+			t.Fatalf("CheckFriendship %s: %v", userID, err)
+		}
+		if single != statuses[userID] {
+			t.Errorf("%s: CheckFriendship says %q, CheckFriendships says %q", userID, single, statuses[userID])
+		}
+	}
+
+	// The number of queries does not depend on how many users are checked
+	*queries = 0
+	if _, err := s.CheckFriendships(ctx, "alice", []string{"bob"}); err != nil {
+		t.Fatalf("CheckFriendships: %v", err)
+	}
+	if *queries != batchQueries {
+		t.Errorf("checking 1 user took %d queries, checking %d took %d", *queries, len(otherIDs), batchQueries)
+	}
+}
+
+func TestCheckFriendshipsValidatesInput(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	tooMany := make([]string, maxFriendshipChecks+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("user-%d", i)
+	}
+	if _, err := s.CheckFriendships(ctx, "alice", tooMany); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("too many users: got %v, want InvalidArgument", err)
+	}
+	if _, err := s.CheckFriendships(ctx, "", []string{"bob"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("no user: got %v, want InvalidArgument", err)
+	}
+
+	statuses, err := s.CheckFriendships(ctx, "alice", nil)
+	if err != nil || len(statuses) != 0 {
+		t.Errorf("no other users: got %v, %v, want an empty map", statuses, err)
+	}
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"friends-api/internal/clients"
+	"friends-api/internal/repository"
+	"friends-api/internal/testutil"
+	"friends-api/internal/utils/logger"
+)
+
+// fakeUsers is a users service where every user exists except the missing ones
+type fakeUsers struct {
+	missing map[string]bool
+}
+
+func (u fakeUsers) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*clients.UserInfo, error) {
+	users := make(map[string]*clients.UserInfo, len(userIDs))
+	for _, id := range userIDs {
+		if !u.missing[id] {
+			users[id] = &clients.UserInfo{ID: id, Name: "Name of " + id}
+		}
+	}
+	return users, nil
+}
+
+// fakeNotifications drops every notification
+type fakeNotifications struct{}
+
+func (fakeNotifications) Notify(ctx context.Context, userID, notificationType, targetID string) error {
+	return nil
+}
+
+// testService is a friend service over a fresh database
+type testService struct {
+	*friendService
+	db *gorm.DB
+}
+
+// newTestService returns a friend service over a fresh, migrated database
+func newTestService(tb testing.TB) *testService {
+	tb.Helper()
+
+	db := testutil.NewDB(tb)
+	if _, err := repository.AutoMigrate(db); err != nil {
+		tb.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	service := NewFriendService(
+		repository.NewFriendRepository(db),
+		fakeUsers{missing: map[string]bool{"ghost": true}},
+		fakeNotifications{},
+		&logger.Logger{Logger: zap.NewNop()},
+	)
+
+	return &testService{friendService: service.(*friendService), db: db}
+}
+
+// sendRequest sends a friend request that is expected to go through
+func (s *testService) sendRequest(tb testing.TB, senderID, receiverID string) string {
+	tb.Helper()
+
+	request, err := s.SendFriendRequest(context.Background(), senderID, receiverID)
+	if err != nil {
+		tb.Fatalf("SendFriendRequest from %s to %s: %v", senderID, receiverID, err)
+	}
+	return request.ID
+}
+
+// befriend makes two users friends through an accepted request
+func (s *testService) befriend(tb testing.TB, a, b string) {
+	tb.Helper()
+
+	requestID := s.sendRequest(tb, a, b)
+	if _, err := s.AcceptFriendRequest(context.Background(), requestID, b); err != nil {
+		tb.Fatalf("AcceptFriendRequest: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+// Package testutil holds helpers shared by the tests of the service's packages
+package testutil
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// NewDB opens an empty SQLite database in a temporary directory, closed when the test ends.
+// It stands in for MySQL in tests: the tables still have to be created by the caller, with
+// repository.AutoMigrate. Row locks are not supported, but write transactions take the
+// database lock up front, so concurrent writers are still serialized.
+func NewDB(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	dsn := filepath.Join(tb.TempDir(), "test.db") + "?_busy_timeout=5000&_txlock=immediate&_journal_mode=WAL&_foreign_keys=on"
+	db, err := gorm.Open(dialector{sqlite.Open(dsn)}, &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		tb.Fatalf("failed to open test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		tb.Fatalf("failed to get test database: %v", err)
+	}
+	tb.Cleanup(func() { sqlDB.Close() })
+
+	return db
+}
+
+// CountQueries counts the SELECT statements run on db from now on
+func CountQueries(tb testing.TB, db *gorm.DB) *int {
+	tb.Helper()
+
+	count := new(int)
+	name := "testutil:count_queries:" + tb.Name()
+	if err := db.Callback().Query().After("gorm:query").Register(name, func(*gorm.DB) { *count++ }); err != nil {
+		tb.Fatalf("failed to register query counter: %v", err)
+	}
+	tb.Cleanup(func() { _ = db.Callback().Query().Remove(name) })
+
+	return count
+}
+
+// dialector is the SQLite dialector with MySQL enum columns stored as text
+type dialector struct {
+	gorm.Dialector
+}
+
+// DataTypeOf maps enum columns, which SQLite has no type for, to text
+func (d dialector) DataTypeOf(field *schema.Field) string {
+	if strings.HasPrefix(string(field.DataType), "enum") {
+		return "text"
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+// Migrator returns the SQLite migrator, using this dialector for column types
+func (d dialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return sqlite.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}
+}
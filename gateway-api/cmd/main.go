@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	_ "gateway-api/docs"
 	"net/http"
 	"os"
@@ -10,8 +11,11 @@ import (
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.uber.org/zap"
 
+	"gateway-api/internal/clients"
 	"gateway-api/internal/config"
+	"gateway-api/internal/middleware"
 	"gateway-api/internal/routes"
 	"gateway-api/internal/utils/logger"
 )
@@ -51,27 +55,33 @@ func main() {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	// Create Gin router
+	// Create Gin router. Its contexts fall back to the request's, so a client disconnect or
+	// the request timeout cancels the backend calls made with them.
 	router := gin.Default()
+	router.ContextWithFallback = true
+
+	// Count in-flight requests so the shutdown can report what it drains
+	inFlight := middleware.NewInFlight()
+	router.Use(inFlight.Track())
+
+	// Bound how long a request may take
+	router.Use(middleware.RequestTimeout(cfg))
+
+	// Tag every request with an ID that is forwarded to the backends
+	router.Use(middleware.RequestID(logger))
 
 	// Setup CORS middleware
-	router.Use(func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
-
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
-			return
-		}
+	router.Use(middleware.CORS(cfg))
 
-		c.Next()
-	})
+	// Connect to downstream services once; the connections are shared by every route
+	conns, err := clients.NewClients(cfg, logger)
+	if err != nil {
+		logger.Fatal("Failed to connect to downstream services", err)
+	}
 
 	// Setup routes
 	apiV1 := router.Group("/api/v1")
-	routes.SetupRoutes(apiV1, cfg, logger)
+	routes.SetupRoutes(apiV1, cfg, logger, conns)
 
 	// Swagger documentation
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -93,7 +103,22 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	logger.Info("Shutting down server...")
+	draining := inFlight.Count()
+	logger.Info("Shutting down server...", zap.Int64("in_flight_requests", draining))
+
+	// Stop accepting connections and wait for in-flight requests to finish
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("Server forced to shut down", err, zap.Int64("abandoned_requests", inFlight.Count()))
+	} else {
+		logger.Info("Drained in-flight requests", zap.Int64("drained_requests", draining))
+	}
+
+	// Close the downstream connections once no request can use them
+	if err := conns.Close(); err != nil {
+		logger.Error("Failed to close downstream connections", err)
+	}
 
 	logger.Info("Server exited properly")
 }
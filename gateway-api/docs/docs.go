@@ -24,21 +24,71 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/google": {
+        "/admin/reports": {
             "get": {
-                "description": "Redirects the user to Google's OAuth login page",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get reported posts for moderation, oldest report first, with how often each post has been reported. Admins only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
+                ],
+                "summary": "Get reports",
+                "parameters": [
+                    {
+                        "enum": [
+                            "open",
+                            "resolved"
+                        ],
+                        "type": "string",
+                        "default": "open",
+                        "description": "Report status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of reports per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Initiate Google OAuth login",
                 "responses": {
-                    "302": {
-                        "description": "Redirect to Google",
+                    "200": {
+                        "description": "Reports",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ReportsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -50,37 +100,122 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/google/callback": {
-            "get": {
-                "description": "Handles the callback from Google's OAuth login",
+        "/admin/reports/{id}/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resolve a report together with the other open reports on the same post, optionally hiding the post from everyone. Admins only.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Handle Google OAuth callback",
+                "summary": "Resolve a report",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "State token for CSRF protection",
-                        "name": "state",
-                        "in": "query",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
+                    {
+                        "description": "Resolve request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResolveReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report resolved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Report"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grant or revoke the admin role of a user. Only admins can call this endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Set user role",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Authorization code",
-                        "name": "code",
-                        "in": "query",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User authenticated successfully",
+                        "description": "Role updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.AuthResponse"
+                            "$ref": "#/definitions/models.UserProfile"
                         }
                     },
                     "400": {
@@ -89,6 +224,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Admins cannot change their own role",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -98,19 +257,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/microsoft": {
+        "/auth/apple": {
             "get": {
-                "description": "Redirects the user to Microsoft's OAuth login page",
+                "description": "Redirects the user to Apple's login page",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "Initiate Microsoft OAuth login",
+                "summary": "Initiate Sign in with Apple",
                 "responses": {
                     "302": {
-                        "description": "Redirect to Microsoft",
+                        "description": "Redirect to Apple",
                         "schema": {
                             "type": "string"
                         }
@@ -124,37 +283,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/microsoft/callback": {
-            "get": {
-                "description": "Handles the callback from Microsoft's OAuth login",
+        "/auth/apple/callback": {
+            "post": {
+                "description": "Handles the form post from Apple's login page",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "Handle Microsoft OAuth callback",
+                "summary": "Handle Sign in with Apple callback",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "State token for CSRF protection",
                         "name": "state",
-                        "in": "query",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
                         "description": "Authorization code",
                         "name": "code",
-                        "in": "query",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON with the user's name, sent by Apple on the first sign-in only",
+                        "name": "user",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL to redirect to after authentication",
+                        "name": "redirect_url",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User authenticated successfully",
+                    "302": {
+                        "description": "Redirect to frontend with token and user data",
                         "schema": {
-                            "$ref": "#/definitions/models.AuthResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -163,6 +337,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Email address is not verified",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -172,32 +352,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/signout": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Signs out the user by invalidating the token",
+        "/auth/github": {
+            "get": {
+                "description": "Redirects the user to GitHub's OAuth login page",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "Sign out the user",
+                "summary": "Initiate GitHub OAuth login",
                 "responses": {
-                    "200": {
-                        "description": "User signed out successfully",
-                        "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "302": {
+                        "description": "Redirect to GitHub",
                         "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "type": "string"
                         }
                     },
                     "500": {
@@ -209,52 +378,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends": {
+        "/auth/github/callback": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get friends with pagination",
+                "description": "Handles the callback from GitHub's OAuth login",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "friends"
+                    "auth"
                 ],
-                "summary": "Get friends",
+                "summary": "Handle GitHub OAuth callback",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "State token for CSRF protection",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of friends per page",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL to redirect to after authentication",
+                        "name": "redirect_url",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Friends list with pagination",
+                    "302": {
+                        "description": "Redirect to frontend with token and user data",
                         "schema": {
-                            "$ref": "#/definitions/models.FriendsResponse"
+                            "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Email address is not verified",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -262,45 +438,79 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/block/{id}": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/auth/google": {
+            "get": {
+                "description": "Redirects the user to Google's OAuth login page",
+                "produces": [
+                    "application/json"
                 ],
-                "description": "Block a user",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Initiate Google OAuth login",
+                "responses": {
+                    "302": {
+                        "description": "Redirect to Google",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google/callback": {
+            "get": {
+                "description": "Handles the callback from Google's OAuth login",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "friends"
+                    "auth"
                 ],
-                "summary": "Block a user",
+                "summary": "Handle Google OAuth callback",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID to block",
-                        "name": "id",
-                        "in": "path",
+                        "description": "State token for CSRF protection",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL to redirect to after authentication",
+                        "name": "redirect_url",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User blocked successfully",
+                    "302": {
+                        "description": "Redirect to frontend with token and user data",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
+                            "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "403": {
+                        "description": "Email address is not verified",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -312,45 +522,81 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+            }
+        },
+        "/auth/microsoft": {
+            "get": {
+                "description": "Redirects the user to Microsoft's OAuth login page",
+                "produces": [
+                    "application/json"
                 ],
-                "description": "Unblock a user",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Initiate Microsoft OAuth login",
+                "responses": {
+                    "302": {
+                        "description": "Redirect to Microsoft",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/microsoft/callback": {
+            "get": {
+                "description": "Handles the callback from Microsoft's OAuth login",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "friends"
+                    "auth"
                 ],
-                "summary": "Unblock a user",
+                "summary": "Handle Microsoft OAuth callback",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID to unblock",
-                        "name": "id",
-                        "in": "path",
+                        "description": "State token for CSRF protection",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL to redirect to after authentication",
+                        "name": "redirect_url",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User unblocked successfully",
+                    "302": {
+                        "description": "Redirect to frontend with token and user data",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
+                            "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "403": {
+                        "description": "Email address is not verified",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -364,32 +610,72 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/requests": {
+        "/auth/signout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Signs out the user by invalidating the token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Sign out the user",
+                "responses": {
+                    "200": {
+                        "description": "User signed out successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get friend requests with pagination",
+                "description": "Get the user's own posts, their friends' posts and public posts, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "friends"
+                    "posts"
                 ],
-                "summary": "Get friend requests",
+                "summary": "Get feed",
                 "parameters": [
                     {
                         "enum": [
-                            "pending",
-                            "accepted",
-                            "rejected"
+                            "following"
                         ],
                         "type": "string",
-                        "default": "pending",
-                        "description": "Filter requests by status",
-                        "name": "status",
+                        "description": "Only show the posts of users the user follows",
+                        "name": "feed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue the feed from the next_cursor of a previous response instead of paging by number",
+                        "name": "cursor",
                         "in": "query"
                     },
                     {
@@ -402,16 +688,22 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of requests per page",
+                        "description": "Number of posts per page",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Friend requests with pagination",
+                        "description": "Posts",
                         "schema": {
-                            "$ref": "#/definitions/models.FriendRequestsResponse"
+                            "$ref": "#/definitions/models.PostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor or feed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
@@ -427,44 +719,59 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/friends": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send a friend request to another user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get friends with pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "friends"
                 ],
-                "summary": "Send a friend request",
+                "summary": "Get friends",
                 "parameters": [
                     {
-                        "description": "Friend request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.FriendRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of friends per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "recent",
+                            "oldest",
+                            "name_asc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, most recently added, longest-standing or alphabetical",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Friend request sent successfully",
+                    "200": {
+                        "description": "Friends list with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.FriendRequestDetails"
+                            "$ref": "#/definitions/models.FriendsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid sort order",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -484,25 +791,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/requests/{id}/accept": {
-            "put": {
-                "security": [
+        "/friends/block/{id}": {
+            "post": {
+                "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Accept a friend request",
+                "description": "Block a user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "friends"
                 ],
-                "summary": "Accept a friend request",
+                "summary": "Block a user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Request ID",
+                        "description": "User ID to block",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -510,9 +817,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Friend request accepted successfully",
+                        "description": "User blocked successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.FriendRequestDetails"
+                            "$ref": "#/definitions/models.SuccessResponse"
                         }
                     },
                     "401": {
@@ -522,7 +829,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Request not found",
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User is already blocked",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -534,27 +847,25 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/friends/requests/{id}/reject": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Reject a friend request",
+                "description": "Unblock a user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "friends"
                 ],
-                "summary": "Reject a friend request",
+                "summary": "Unblock a user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Request ID",
+                        "description": "User ID to unblock",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -562,9 +873,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Friend request rejected successfully",
+                        "description": "User unblocked successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.FriendRequestDetails"
+                            "$ref": "#/definitions/models.SuccessResponse"
                         }
                     },
                     "401": {
@@ -574,7 +885,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Request not found",
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -588,35 +899,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/{id}": {
-            "delete": {
+        "/friends/blocked": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a friend",
+                "description": "Get the users the current user has blocked, most recently blocked first, with pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "friends"
                 ],
-                "summary": "Remove a friend",
+                "summary": "Get blocked users",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Friend ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of blocked users per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Friend removed successfully",
+                        "description": "Blocked users with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
+                            "$ref": "#/definitions/models.BlockedUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
@@ -625,8 +949,58 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Friend not found",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/friends/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download all friends of the current user as NDJSON, one friend per line. The friends are streamed from the friends service a page at a time, so the download starts right away however many friends there are. If the export fails partway, its last line is an error object",
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "friends"
+                ],
+                "summary": "Export friends",
+                "parameters": [
+                    {
+                        "enum": [
+                            "recent",
+                            "oldest",
+                            "name_asc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, most recently added, longest-standing or alphabetical",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "One friend per line",
+                        "schema": {
+                            "$ref": "#/definitions/models.Friend"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sort order",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -640,21 +1014,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups": {
+        "/friends/requests": {
             "get": {
-                "description": "Get groups with pagination and filtering",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get friend requests with pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Get groups",
+                "summary": "Get friend requests",
                 "parameters": [
                     {
+                        "enum": [
+                            "pending",
+                            "accepted",
+                            "rejected"
+                        ],
                         "type": "string",
-                        "description": "Search query",
-                        "name": "query",
+                        "default": "pending",
+                        "description": "Filter requests by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "incoming",
+                            "outgoing",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "incoming",
+                        "description": "Requests the user received, sent, or both",
+                        "name": "direction",
                         "in": "query"
                     },
                     {
@@ -667,16 +1064,28 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of groups per page",
+                        "description": "Number of requests per page",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Groups",
+                        "description": "Friend requests with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.GroupsResponse"
+                            "$ref": "#/definitions/models.FriendRequestsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid direction",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -693,7 +1102,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new group",
+                "description": "Send a friend request to another user",
                 "consumes": [
                     "application/json"
                 ],
@@ -701,25 +1110,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Create a group",
+                "summary": "Send a friend request",
                 "parameters": [
                     {
-                        "description": "Group creation request",
+                        "description": "Friend request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.GroupCreateRequest"
+                            "$ref": "#/definitions/models.FriendRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Group created successfully",
+                        "description": "Friend request sent successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.Group"
+                            "$ref": "#/definitions/models.FriendRequestDetails"
                         }
                     },
                     "400": {
@@ -734,43 +1143,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Blocked by or blocking the recipient",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/groups/{id}": {
-            "get": {
-                "description": "Get a group by ID",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "groups"
-                ],
-                "summary": "Get a group",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Group",
+                    },
+                    "404": {
+                        "description": "User not found",
                         "schema": {
-                            "$ref": "#/definitions/models.Group"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Group not found",
+                    "409": {
+                        "description": "Already friends or request already sent",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -782,14 +1168,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/friends/requests/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a group by ID",
+                "description": "Accept up to 100 friend requests at once. Each request is accepted on its own, so one that cannot be accepted does not stop the others; the response has the outcome for each",
                 "consumes": [
                     "application/json"
                 ],
@@ -797,32 +1185,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Update a group",
+                "summary": "Accept several friend requests",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update group request",
+                        "description": "Friend requests to accept",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.GroupUpdateRequest"
+                            "$ref": "#/definitions/models.FriendRequestBatch"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group updated successfully",
+                        "description": "Outcome for each friend request",
                         "schema": {
-                            "$ref": "#/definitions/models.Group"
+                            "$ref": "#/definitions/models.FriendRequestBatchResponse"
                         }
                     },
                     "400": {
@@ -837,12 +1218,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Group not found",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -850,35 +1225,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/friends/requests/accept-all": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a group by ID",
+                "description": "Accept every pending friend request the current user has received, oldest first. The response has the outcome for each",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
-                ],
-                "summary": "Delete a group",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "friends"
                 ],
+                "summary": "Accept all friend requests",
                 "responses": {
                     "200": {
-                        "description": "Group deleted successfully",
+                        "description": "Outcome for each friend request",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
+                            "$ref": "#/definitions/models.FriendRequestBatchResponse"
                         }
                     },
                     "401": {
@@ -887,12 +1255,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Group not found",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -902,48 +1264,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/members": {
-            "get": {
-                "description": "Get members of a group with pagination",
+        "/friends/requests/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reject up to 100 friend requests at once. Each request is rejected on its own, so one that cannot be rejected does not stop the others; the response has the outcome for each",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Get group members",
+                "summary": "Reject several friend requests",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of members per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Friend requests to reject",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FriendRequestBatch"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group members with pagination",
+                        "description": "Outcome for each friend request",
                         "schema": {
-                            "$ref": "#/definitions/models.GroupMembersResponse"
+                            "$ref": "#/definitions/models.FriendRequestBatchResponse"
                         }
                     },
-                    "404": {
-                        "description": "Group not found",
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -955,25 +1319,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/friends/requests/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Join a group",
+                "description": "Cancel a pending friend request that the current user sent. A new request can be sent afterwards",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Join a group",
+                "summary": "Cancel a friend request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
+                        "description": "Request ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -981,9 +1347,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group joined successfully",
+                        "description": "Friend request canceled successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessWithCountResponse"
+                            "$ref": "#/definitions/models.SuccessResponse"
                         }
                     },
                     "401": {
@@ -992,8 +1358,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Only the sender can cancel the request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Group not found",
+                        "description": "Request not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Request is no longer pending",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1005,25 +1383,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/friends/requests/{id}/accept": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Leave a group",
+                "description": "Accept a friend request",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Leave a group",
+                "summary": "Accept a friend request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
+                        "description": "Request ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1031,9 +1411,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group left successfully",
+                        "description": "Friend request accepted successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessWithCountResponse"
+                            "$ref": "#/definitions/models.FriendRequestDetails"
                         }
                     },
                     "401": {
@@ -1042,8 +1422,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Only the recipient can accept the request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Group not found",
+                        "description": "Request not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Request is no longer pending",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1057,49 +1449,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/posts": {
-            "get": {
+        "/friends/requests/{id}/reject": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get posts in a group with pagination",
+                "description": "Reject a friend request",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Get posts in a group",
+                "summary": "Reject a friend request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
+                        "description": "Request ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of posts per page",
-                        "name": "limit",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group posts with pagination",
+                        "description": "Friend request rejected successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.PostsResponse"
+                            "$ref": "#/definitions/models.FriendRequestDetails"
                         }
                     },
                     "401": {
@@ -1109,13 +1487,19 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Not a member of the group",
+                        "description": "Only the recipient can reject the request",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Group not found",
+                        "description": "Request not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Request is no longer pending",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1127,53 +1511,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/friends/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new post in a group",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Remove a friend",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "friends"
                 ],
-                "summary": "Create a post in a group",
+                "summary": "Remove a friend",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
+                        "description": "Friend ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Post content",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.GroupPostRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created post",
-                        "schema": {
-                            "$ref": "#/definitions/models.Post"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                    "200": {
+                        "description": "Friend removed successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "$ref": "#/definitions/models.SuccessResponse"
                         }
                     },
                     "401": {
@@ -1182,14 +1550,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Not a member of the group",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
                     "404": {
-                        "description": "Group not found",
+                        "description": "Friend not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1203,38 +1565,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts": {
+        "/friends/{id}/mutual": {
             "get": {
-                "description": "Get posts with pagination and filtering",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the friends the current user has in common with another user, with pagination. Users who have blocked, or been blocked by, either user are left out",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "friends"
                 ],
-                "summary": "Get posts",
+                "summary": "Get mutual friends",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter posts by author ID",
-                        "name": "author_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter posts by group ID",
-                        "name": "group_id",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "public",
-                            "private"
-                        ],
-                        "type": "string",
-                        "description": "Filter posts by visibility",
-                        "name": "visibility",
-                        "in": "query"
+                        "description": "Other user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "integer",
@@ -1246,16 +1598,28 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of posts per page",
+                        "description": "Number of friends per page",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Posts",
+                        "description": "Mutual friends with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.PostsResponse"
+                            "$ref": "#/definitions/models.FriendsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -1265,40 +1629,152 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/friends/{id}/status": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new post",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get whether the current user is friends with another user, has a pending request with them or is blocked. The status is one of none, pending, friends, blocked or self, and request_id is set while a request is pending",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "friends"
                 ],
-                "summary": "Create a post",
+                "summary": "Get friendship status",
                 "parameters": [
                     {
-                        "description": "Post creation request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.PostCreateRequest"
-                        }
+                        "type": "string",
+                        "description": "Other user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Friendship status",
+                        "schema": {
+                            "$ref": "#/definitions/models.FriendshipStatus"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups": {
+            "get": {
+                "description": "Get groups with pagination and filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of groups per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "recent",
+                            "oldest",
+                            "members_desc",
+                            "name_asc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, by creation date, member count or name",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Groups",
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sort order",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Create a group",
+                "parameters": [
+                    {
+                        "description": "Group creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Post created successfully",
+                        "description": "Group created successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.Group"
                         }
                     },
                     "400": {
@@ -1322,20 +1798,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{id}": {
+        "/groups/{id}": {
             "get": {
-                "description": "Get a post by ID",
+                "description": "Get a group by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Get a post",
+                "summary": "Get a group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1343,13 +1819,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post",
+                        "description": "Group",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.Group"
                         }
                     },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1368,7 +1844,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a post by ID",
+                "description": "Update a group by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1376,32 +1852,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Update a post",
+                "summary": "Update a group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update post request",
+                        "description": "Update group request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.PostUpdateRequest"
+                            "$ref": "#/definitions/models.GroupUpdateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post updated successfully",
+                        "description": "Group updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.Group"
                         }
                     },
                     "400": {
@@ -1416,8 +1892,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Only the creator or an admin can update the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1436,18 +1918,18 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a post by ID",
+                "description": "Delete a group by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Delete a post",
+                "summary": "Delete a group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1455,7 +1937,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post deleted successfully",
+                        "description": "Group deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/models.SuccessResponse"
                         }
@@ -1466,8 +1948,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Only the creator can delete the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1481,20 +1969,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{id}/comments": {
+        "/groups/{id}/join-requests": {
             "get": {
-                "description": "Get comments for a post with pagination",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the pending requests to join a private group. Only the creator and admins can see them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Get comments for a post",
+                "summary": "Get join requests",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1509,20 +2002,32 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Number of comments per page",
+                        "description": "Number of requests per page",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Comments",
+                        "description": "Pending join requests with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.CommentsResponse"
+                            "$ref": "#/definitions/models.JoinRequestsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1534,63 +2039,66 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/groups/{id}/join-requests/{requestId}/approve": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a comment to a post",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Approve a pending join request and add the requester to the group. Only the creator and admins can approve requests",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Add a comment to a post",
+                "summary": "Approve a join request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Comment request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CommentCreateRequest"
-                        }
+                        "type": "string",
+                        "description": "Join request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Comment added successfully",
+                    "200": {
+                        "description": "Approved join request",
                         "schema": {
-                            "$ref": "#/definitions/models.Comment"
+                            "$ref": "#/definitions/models.JoinRequest"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group or join request not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Join request already reviewed",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1604,42 +2112,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{id}/comments/{commentId}": {
-            "delete": {
+        "/groups/{id}/join-requests/{requestId}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a comment from a post",
+                "description": "Reject a pending join request. Only the creator and admins can reject requests",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Delete a comment",
+                "summary": "Reject a join request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Comment ID",
-                        "name": "commentId",
+                        "description": "Join request ID",
+                        "name": "requestId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Comment deleted successfully",
+                        "description": "Rejected join request",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
+                            "$ref": "#/definitions/models.JoinRequest"
                         }
                     },
                     "401": {
@@ -1648,8 +2156,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Post or comment not found",
+                        "description": "Group or join request not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Join request already reviewed",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1663,25 +2183,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{id}/like": {
+        "/groups/{id}/members": {
+            "get": {
+                "description": "Get members of a group with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get group members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of members per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group members with pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupMembersResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Like a post",
+                "description": "Join a public group, or ask to join a private group. Requests to join a private group stay pending until an admin approves them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Like a post",
+                "summary": "Join a group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1689,9 +2262,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post liked successfully",
+                        "description": "Group joined or join request pending",
                         "schema": {
-                            "$ref": "#/definitions/models.LikeResponse"
+                            "$ref": "#/definitions/models.JoinGroupResponse"
                         }
                     },
                     "401": {
@@ -1701,7 +2274,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already a member or join request pending",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1720,18 +2299,18 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Unlike a post",
+                "description": "Leave a group",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "groups"
                 ],
-                "summary": "Unlike a post",
+                "summary": "Leave a group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1739,9 +2318,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post unliked successfully",
+                        "description": "Group left successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.LikeResponse"
+                            "$ref": "#/definitions/models.SuccessWithCountResponse"
                         }
                     },
                     "401": {
@@ -1751,7 +2330,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Post not found",
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Not a member, or the creator",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1765,39 +2350,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/login": {
-            "post": {
-                "description": "Login a user with OAuth provider",
-                "consumes": [
-                    "application/json"
+        "/groups/{id}/members/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Download all members of a group as NDJSON, one member per line. The members are streamed from the groups service a page at a time, so the download starts right away however large the group is. Only members can export the members of a private group. If the export fails partway, its last line is an error object",
                 "produces": [
-                    "application/json"
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "users"
+                    "groups"
                 ],
-                "summary": "Login a user",
+                "summary": "Export group members",
                 "parameters": [
                     {
-                        "description": "Login request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.AuthRequest"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User logged in successfully",
+                        "description": "One member per line",
                         "schema": {
-                            "$ref": "#/definitions/models.AuthResponse"
+                            "$ref": "#/definitions/models.GroupMember"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the private group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -1811,26 +2408,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "get": {
+        "/groups/{id}/members/{userId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the profile of the authenticated user",
+                "description": "Remove a member from a group. Creators and admins can remove members, only the creator can remove admins, and the creator cannot be removed. The removed member's group posts are kept, as indicated by posts_retained",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "groups"
+                ],
+                "summary": "Remove a group member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
                     "200": {
-                        "description": "User profile",
+                        "description": "Member removed successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.UserProfile"
+                            "$ref": "#/definitions/models.RemoveMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
@@ -1839,6 +2458,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1846,14 +2477,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/groups/{id}/members/{userId}/role": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the profile of the authenticated user",
+                "description": "Promote a group member to admin or demote an admin to member. Only the group creator can grant or revoke admin, and the creator's role cannot be changed",
                 "consumes": [
                     "application/json"
                 ],
@@ -1861,25 +2494,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "groups"
                 ],
-                "summary": "Update user profile",
+                "summary": "Change a member's role",
                 "parameters": [
                     {
-                        "description": "Update profile request",
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member user ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.ProfileUpdateRequest"
+                            "$ref": "#/definitions/models.MemberRoleRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User profile updated successfully",
+                        "description": "Updated member",
                         "schema": {
-                            "$ref": "#/definitions/models.UserProfile"
+                            "$ref": "#/definitions/models.GroupMember"
                         }
                     },
                     "400": {
@@ -1894,6 +2541,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1903,126 +2562,3557 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/register": {
-            "post": {
-                "description": "Register a new user with OAuth provider",
-                "consumes": [
-                    "application/json"
+        "/groups/{id}/posts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Get posts in a group with pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "groups"
                 ],
-                "summary": "Register a new user",
+                "summary": "Get posts in a group",
                 "parameters": [
                     {
-                        "description": "Registration request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.AuthRequest"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of posts per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User registered successfully",
+                    "200": {
+                        "description": "Group posts with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.AuthResponse"
+                            "$ref": "#/definitions/models.PostsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Not a member of the group",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
-                    }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new post in a group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Create a post in a group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/posts/{postId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit the content of a post in a group, and its media when media is given. Only the author and the group's creator and admins can edit a post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Edit a post in a group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author or a group admin",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a post in a group with its media, likes and comments. Only the author and the group's creator and admins can delete a post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Delete a post in a group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author or a group admin",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/posts/{postId}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the comments on a post in a group, oldest first, with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get comments on a group post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of comments per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comments with pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a comment to a post in a group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Comment on a group post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupPostCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/posts/{postId}/like": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Like a post in a group. Liking a post twice has no effect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Like a group post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post liked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.LikeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the current user's like from a post in a group",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Unlike a group post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post unliked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.LikeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/transfer-ownership": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make another member the creator of the group. Only the creator can transfer the ownership; they become an admin and can leave the group afterwards",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Transfer group ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New creator",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TransferOwnershipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New creator",
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupMember"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the creator can transfer the ownership",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The new creator is not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Report that the gateway process is running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Check gateway liveness",
+                "responses": {
+                    "200": {
+                        "description": "Gateway is up",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Probe the gRPC health service of every backend and report their combined status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Check service health",
+                "responses": {
+                    "200": {
+                        "description": "All services are serving",
+                        "schema": {
+                            "$ref": "#/definitions/models.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "One or more services are not serving",
+                        "schema": {
+                            "$ref": "#/definitions/models.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/media/upload-url": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a presigned URL to upload a media file to, and the URL to attach to a post once it is uploaded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Get a media upload URL",
+                "parameters": [
+                    {
+                        "description": "Upload URL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UploadURLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload URL",
+                        "schema": {
+                            "$ref": "#/definitions/models.UploadURLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Media uploads are not configured",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/metrics": {
+            "get": {
+                "description": "Report the circuit breaker state of every backend. An open breaker fails requests to its service with a 503 until a probe call succeeds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Get gateway metrics",
+                "responses": {
+                    "200": {
+                        "description": "Circuit breaker states",
+                        "schema": {
+                            "$ref": "#/definitions/models.MetricsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the current user's notifications, newest first, with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Number of notifications per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only return unread notifications",
+                        "name": "unread_only",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notifications with pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark every notification of the current user as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "Unread notification count after the change",
+                        "schema": {
+                            "$ref": "#/definitions/models.UnreadCountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of notifications the current user has not read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get unread notification count",
+                "responses": {
+                    "200": {
+                        "description": "Unread notification count",
+                        "schema": {
+                            "$ref": "#/definitions/models.UnreadCountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark a notification as read. Marking a read notification again has no effect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unread notification count after the change",
+                        "schema": {
+                            "$ref": "#/definitions/models.UnreadCountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts": {
+            "get": {
+                "description": "Get posts with pagination and filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter posts by author ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter posts by group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "public",
+                            "private"
+                        ],
+                        "type": "string",
+                        "description": "Filter posts by visibility",
+                        "name": "visibility",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "following"
+                        ],
+                        "type": "string",
+                        "description": "Only show the posts of users the user follows",
+                        "name": "feed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue a feed from the next_cursor of a previous response instead of paging by number",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of posts per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor or feed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Create a post",
+                "parameters": [
+                    {
+                        "description": "Post creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PostCreateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key return the post created first",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Post created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the group",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A request with the idempotency key is in progress",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/bookmarks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the user's bookmarked posts, newest bookmark first. Posts the user can no longer see are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get bookmarks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of posts per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookmarked posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/tags/{tag}": {
+            "get": {
+                "description": "Get posts whose content contains a hashtag, with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get posts by hashtag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hashtag, without the leading #",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of posts per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}": {
+            "get": {
+                "description": "Get a post by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a post by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Update a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update post request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PostUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a post by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Delete a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/bookmark": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Save a post to the user's bookmarks; bookmarking a post twice has no effect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Bookmark a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post bookmarked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Post not visible to the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a post from the user's bookmarks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Remove a bookmark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookmark removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Bookmark not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/comments": {
+            "get": {
+                "description": "Get comments for a post with pagination, optionally as a tree of replies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get comments for a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Nest replies beneath top-level comments",
+                        "name": "threaded",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of comments per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comments",
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a comment to a post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Add a comment to a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentCreateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key return the comment created first",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Post not visible, or the author and the user have blocked one another",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A request with the idempotency key is in progress",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/comments/{commentId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit the content of a comment on a post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Edit a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the comment",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a comment from a post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/like": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the user's reaction on a post, replacing any previous reaction. Defaults to a like.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "React to a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reaction request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post liked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.LikeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unlike a post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Unlike a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post unliked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.LikeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/reactions": {
+            "get": {
+                "description": "Get the number of each reaction type on a post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get reaction counts for a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reaction counts",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReactionCountsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/report": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report a post for moderation. A user can report each post they can see once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Report a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Report request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReportPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post reported successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Post not visible to the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Post already reported by the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a deleted post; only possible for a limited time after deletion",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Restore a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post restored successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the post",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Deleted post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Post can no longer be restored",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/share": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Share a post as a new post of the user's, with an optional comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Share a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.SharePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Post shared successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Post not visible to the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ready": {
+            "get": {
+                "description": "Probe users, posts, friends and groups services and report each one's state",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Check gateway readiness",
+                "responses": {
+                    "200": {
+                        "description": "Gateway is ready",
+                        "schema": {
+                            "$ref": "#/definitions/models.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "One or more services are not ready",
+                        "schema": {
+                            "$ref": "#/definitions/models.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Login a user with OAuth provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "Login request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User logged in successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Email address is not verified",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the profile of the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "User profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserProfile"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the profile of the authenticated user. Name and avatar are kept when empty; bio, location and website are kept when omitted and cleared when empty.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "description": "Update profile request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ProfileUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User profile updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete the account of the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete account",
+                "responses": {
+                    "200": {
+                        "description": "Account deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/groups": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the groups the current user is a member of, most recently joined first, with the user's role in each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get my groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of groups per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Groups",
+                        "schema": {
+                            "$ref": "#/definitions/models.GroupsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/register": {
+            "post": {
+                "description": "Register a new user with OAuth provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Registration request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User registered successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Email address is not verified",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Find users by name or email, excluding the caller, with each result's friendship status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Search users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text to match against names and emails",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of users per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching users with pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing search query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a user's name, avatar, public post count and friendship status with the caller. The email is only included if the configured visibility allows the caller to see it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a user's public profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Public profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.PublicUserProfile"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/follow": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Follow a user to see their posts in the following feed. Following is one-way and does not need the other user's approval",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "follows"
+                ],
+                "summary": "Follow a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID to follow",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User followed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Cannot follow yourself",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Blocked by or blocking the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already following the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop following a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "follows"
+                ],
+                "summary": "Unfollow a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID to unfollow",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User unfollowed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not following the user",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/followers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the users who follow a user, most recent first, with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "follows"
+                ],
+                "summary": "Get followers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of users per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Followers with pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.FollowsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/following": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the users a user follows, most recent first, with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "follows"
+                ],
+                "summary": "Get followed users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of users per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Followed users with pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.FollowsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AuthRequest": {
+            "type": "object",
+            "required": [
+                "access_token",
+                "provider"
+            ],
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "maxLength": 4096,
+                    "example": "ya29.a0AfB_byC..."
+                },
+                "name": {
+                    "description": "Name is only needed for apple, which shares it with the app on the first sign-in only",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Jane Doe"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "google",
+                        "microsoft",
+                        "github",
+                        "apple"
+                    ],
+                    "example": "google"
+                }
+            }
+        },
+        "models.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
+        "models.BlockedUser": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "blocked_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user456"
+                }
+            }
+        },
+        "models.BlockedUsersResponse": {
+            "type": "object",
+            "properties": {
+                "blocked_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BlockedUser"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.CircuitBreakerStats": {
+            "type": "object",
+            "properties": {
+                "consecutive_failures": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "state": {
+                    "type": "string",
+                    "example": "closed"
+                }
+            }
+        },
+        "models.Comment": {
+            "type": "object",
+            "properties": {
+                "author_avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "author_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "author_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "comment_id": {
+                    "type": "string",
+                    "example": "comment123"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "This is a comment"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "depth": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "edited": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Mention"
+                    }
+                },
+                "parent_comment_id": {
+                    "type": "string",
+                    "example": "comment122"
+                },
+                "post_id": {
+                    "type": "string",
+                    "example": "post123"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                }
+            }
+        },
+        "models.CommentCreateRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "This is a comment"
+                },
+                "parent_comment_id": {
+                    "type": "string",
+                    "maxLength": 36,
+                    "example": "comment123"
+                }
+            }
+        },
+        "models.CommentUpdateRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "This is an edited comment"
+                }
+            }
+        },
+        "models.CommentsResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code is a stable, machine-readable reason, see the ErrorCode constants",
+                    "type": "string",
+                    "example": "not_found"
+                },
+                "details": {
+                    "description": "Details holds per-field messages of invalid requests, keyed by field name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "description": "Error is a human-readable message",
+                    "type": "string"
+                }
+            }
+        },
+        "models.FollowUser": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "followed_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user456"
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.AuthRequest": {
+        },
+        "models.FollowsResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FollowUser"
+                    }
+                }
+            }
+        },
+        "models.Friend": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane.doe@example.com"
+                },
+                "friends_since": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "is_online": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "last_seen_at": {
+                    "description": "Absent if the friend was never active",
+                    "type": "string",
+                    "example": "2023-01-02T08:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user456"
+                }
+            }
+        },
+        "models.FriendRequest": {
             "type": "object",
             "required": [
-                "access_token",
-                "provider"
+                "friend_id"
             ],
             "properties": {
-                "access_token": {
+                "friend_id": {
+                    "type": "string",
+                    "maxLength": 36,
+                    "example": "user456"
+                }
+            }
+        },
+        "models.FriendRequestBatch": {
+            "type": "object",
+            "required": [
+                "request_ids"
+            ],
+            "properties": {
+                "request_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "req123",
+                        "req456"
+                    ]
+                }
+            }
+        },
+        "models.FriendRequestBatchResponse": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FriendRequestResult"
+                    }
+                },
+                "succeeded_count": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.FriendRequestDetails": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "incoming"
+                },
+                "receiver_avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar2.jpg"
+                },
+                "receiver_id": {
+                    "type": "string",
+                    "example": "user456"
+                },
+                "receiver_name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "req123"
+                },
+                "sender_avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "sender_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "sender_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "models.FriendRequestResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Why the request failed",
+                    "type": "string",
+                    "example": "friend request is not pending"
+                },
+                "request": {
+                    "description": "The updated request on success",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FriendRequestDetails"
+                        }
+                    ]
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "req123"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.FriendRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FriendRequestDetails"
+                    }
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.FriendsResponse": {
+            "type": "object",
+            "properties": {
+                "friends": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Friend"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.FriendshipStatus": {
+            "type": "object",
+            "properties": {
+                "are_friends": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "request_id": {
+                    "description": "Set while a friend request is pending",
+                    "type": "string",
+                    "example": "req123"
+                },
+                "status": {
+                    "description": "none, pending, friends, blocked or self",
+                    "type": "string",
+                    "example": "pending"
+                }
+            }
+        },
+        "models.Group": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/group-avatar.jpg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "creator_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "creator_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A group for tech enthusiasts"
+                },
+                "group_id": {
                     "type": "string",
-                    "example": "ya29.a0AfB_byC..."
+                    "example": "group123"
                 },
-                "provider": {
+                "members_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tech Enthusiasts"
+                },
+                "role": {
+                    "description": "Role is the current user's role in the group, only set when listing their own groups",
                     "type": "string",
                     "enum": [
-                        "google",
-                        "microsoft"
+                        "creator",
+                        "admin",
+                        "member"
                     ],
-                    "example": "google"
+                    "example": "admin"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "visibility": {
+                    "type": "string",
+                    "example": "public"
                 }
             }
         },
-        "models.AuthResponse": {
+        "models.GroupCreateRequest": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "access_token": {
+                "avatar": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "maxLength": 255,
+                    "example": "https://example.com/group-avatar.jpg"
                 },
-                "user_id": {
+                "description": {
                     "type": "string",
-                    "example": "user123"
+                    "maxLength": 2000,
+                    "example": "A group for tech enthusiasts"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Tech Enthusiasts"
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "private"
+                    ],
+                    "example": "private"
                 }
             }
         },
-        "models.Comment": {
+        "models.GroupMember": {
             "type": "object",
             "properties": {
-                "author_avatar": {
+                "avatar": {
                     "type": "string",
                     "example": "https://example.com/avatar.jpg"
                 },
-                "author_id": {
+                "joined_at": {
                     "type": "string",
-                    "example": "user123"
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "author_name": {
+                "name": {
                     "type": "string",
                     "example": "John Doe"
                 },
-                "comment_id": {
+                "role": {
                     "type": "string",
-                    "example": "comment123"
+                    "example": "admin"
                 },
-                "content": {
+                "user_id": {
                     "type": "string",
-                    "example": "This is a comment"
+                    "example": "user123"
+                }
+            }
+        },
+        "models.GroupMembersResponse": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GroupMember"
+                    }
                 },
-                "created_at": {
-                    "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "post_id": {
-                    "type": "string",
-                    "example": "post123"
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
                 },
-                "updated_at": {
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.GroupPostCommentRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
                     "type": "string",
-                    "example": "2023-01-02T12:00:00Z"
+                    "example": "Great post!"
                 }
             }
         },
-        "models.CommentCreateRequest": {
+        "models.GroupPostRequest": {
             "type": "object",
             "required": [
                 "content"
@@ -2030,23 +6120,63 @@ const docTemplate = `{
             "properties": {
                 "content": {
                     "type": "string",
-                    "example": "This is a comment"
+                    "example": "This is a post in the group"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"https://example.com/image1.jpg\"]"
+                    ]
                 }
             }
         },
-        "models.CommentsResponse": {
+        "models.GroupUpdateRequest": {
             "type": "object",
             "properties": {
-                "comments": {
+                "avatar": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://example.com/updated-group-avatar.jpg"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "An updated group for tech enthusiasts"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Tech Enthusiasts Updated"
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "private"
+                    ],
+                    "example": "public"
+                }
+            }
+        },
+        "models.GroupsResponse": {
+            "type": "object",
+            "properties": {
+                "groups": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Comment"
+                        "$ref": "#/definitions/models.Group"
                     }
                 },
                 "page": {
                     "type": "integer",
                     "example": 1
                 },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
                 "total_count": {
                     "type": "integer",
                     "example": 42
@@ -2057,108 +6187,203 @@ const docTemplate = `{
                 }
             }
         },
-        "models.ErrorResponse": {
+        "models.HealthResponse": {
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string"
+                "services": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ok"
                 }
             }
         },
-        "models.Friend": {
+        "models.JoinGroupResponse": {
             "type": "object",
             "properties": {
-                "avatar": {
+                "members_count": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "boolean"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.JoinRequest": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string",
-                    "example": "https://example.com/avatar.jpg"
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "email": {
+                "group_id": {
                     "type": "string",
-                    "example": "jane.doe@example.com"
+                    "example": "group123"
                 },
-                "friends_since": {
+                "request_id": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "req123"
                 },
-                "name": {
+                "reviewed_at": {
                     "type": "string",
-                    "example": "Jane Doe"
+                    "example": "2023-01-02T12:00:00Z"
                 },
-                "user_id": {
+                "reviewed_by": {
                     "type": "string",
                     "example": "user456"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
                 }
             }
         },
-        "models.FriendRequest": {
+        "models.JoinRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.JoinRequest"
+                    }
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.LikeResponse": {
+            "type": "object",
+            "properties": {
+                "likes_count": {
+                    "type": "integer"
+                },
+                "reaction_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.MemberRoleRequest": {
             "type": "object",
             "required": [
-                "friend_id"
+                "role"
             ],
             "properties": {
-                "friend_id": {
+                "role": {
                     "type": "string",
-                    "example": "user456"
+                    "enum": [
+                        "admin",
+                        "member"
+                    ],
+                    "example": "admin"
                 }
             }
         },
-        "models.FriendRequestDetails": {
+        "models.Mention": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "user_id": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "user456"
                 },
-                "receiver_avatar": {
+                "username": {
+                    "type": "string",
+                    "example": "jane.doe"
+                }
+            }
+        },
+        "models.MetricsResponse": {
+            "type": "object",
+            "properties": {
+                "circuit_breakers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.CircuitBreakerStats"
+                    }
+                }
+            }
+        },
+        "models.Notification": {
+            "type": "object",
+            "properties": {
+                "actor_avatar": {
                     "type": "string",
-                    "example": "https://example.com/avatar2.jpg"
+                    "example": "https://example.com/avatar.jpg"
                 },
-                "receiver_id": {
+                "actor_id": {
                     "type": "string",
                     "example": "user456"
                 },
-                "receiver_name": {
+                "actor_name": {
                     "type": "string",
                     "example": "Jane Doe"
                 },
-                "request_id": {
-                    "type": "string",
-                    "example": "req123"
-                },
-                "sender_avatar": {
+                "created_at": {
                     "type": "string",
-                    "example": "https://example.com/avatar.jpg"
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "sender_id": {
+                "notification_id": {
                     "type": "string",
-                    "example": "user123"
+                    "example": "notif123"
                 },
-                "sender_name": {
-                    "type": "string",
-                    "example": "John Doe"
+                "read": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "status": {
+                "target_id": {
                     "type": "string",
-                    "example": "pending"
+                    "example": "req123"
                 },
-                "updated_at": {
+                "type": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "friend_request"
                 }
             }
         },
-        "models.FriendRequestsResponse": {
+        "models.NotificationsResponse": {
             "type": "object",
             "properties": {
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Notification"
+                    }
+                },
                 "page": {
                     "type": "integer",
                     "example": 1
                 },
-                "requests": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.FriendRequestDetails"
-                    }
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
                 },
                 "total_count": {
                     "type": "integer",
@@ -2167,17 +6392,27 @@ const docTemplate = `{
                 "total_pages": {
                     "type": "integer",
                     "example": 5
+                },
+                "unread_count": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "models.FriendsResponse": {
+        "models.Pagination": {
             "type": "object",
             "properties": {
-                "friends": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Friend"
-                    }
+                "has_next": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "has_prev": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
                 },
                 "page": {
                     "type": "integer",
@@ -2193,105 +6428,169 @@ const docTemplate = `{
                 }
             }
         },
-        "models.Group": {
+        "models.Post": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "example": "https://example.com/group-avatar.jpg"
-                },
-                "created_at": {
+                "author_avatar": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "https://example.com/avatar.jpg"
                 },
-                "creator_id": {
+                "author_id": {
                     "type": "string",
                     "example": "user123"
                 },
-                "creator_name": {
+                "author_name": {
                     "type": "string",
                     "example": "John Doe"
                 },
-                "description": {
+                "comments_count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "content": {
                     "type": "string",
-                    "example": "A group for tech enthusiasts"
+                    "example": "This is a post"
                 },
-                "group_id": {
+                "created_at": {
                     "type": "string",
-                    "example": "group123"
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "members_count": {
+                "hidden": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "is_liked": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "likes_count": {
                     "type": "integer",
                     "example": 42
                 },
-                "name": {
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"https://example.com/image1.jpg\"]"
+                    ]
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Mention"
+                    }
+                },
+                "original_post": {
+                    "$ref": "#/definitions/models.Post"
+                },
+                "original_post_id": {
+                    "description": "OriginalPostID is set when the post shares another post; OriginalPost is left out\nwhen the shared post was deleted or is hidden from the user",
                     "type": "string",
-                    "example": "Tech Enthusiasts"
+                    "example": "post122"
+                },
+                "post_id": {
+                    "type": "string",
+                    "example": "post123"
+                },
+                "reports_count": {
+                    "description": "ReportsCount and Hidden are only set when an admin views reported posts",
+                    "type": "integer",
+                    "example": 2
+                },
+                "shares_count": {
+                    "type": "integer",
+                    "example": 3
                 },
                 "updated_at": {
                     "type": "string",
                     "example": "2023-01-02T12:00:00Z"
+                },
+                "visibility": {
+                    "type": "string",
+                    "example": "public"
                 }
             }
         },
-        "models.GroupCreateRequest": {
+        "models.PostCreateRequest": {
             "type": "object",
             "required": [
-                "name"
+                "content",
+                "visibility"
             ],
             "properties": {
-                "avatar": {
+                "content": {
                     "type": "string",
-                    "example": "https://example.com/group-avatar.jpg"
+                    "example": "This is a post"
                 },
-                "description": {
-                    "type": "string",
-                    "example": "A group for tech enthusiasts"
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"https://example.com/image1.jpg\"]"
+                    ]
                 },
-                "name": {
+                "visibility": {
                     "type": "string",
-                    "example": "Tech Enthusiasts"
+                    "enum": [
+                        "public",
+                        "friends",
+                        "private"
+                    ],
+                    "example": "public"
                 }
             }
         },
-        "models.GroupMember": {
+        "models.PostUpdateRequest": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "joined_at": {
-                    "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
-                },
-                "name": {
+                "content": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "This is an updated post"
                 },
-                "role": {
-                    "type": "string",
-                    "example": "admin"
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"https://example.com/image2.jpg\"]"
+                    ]
                 },
-                "user_id": {
+                "visibility": {
                     "type": "string",
-                    "example": "user123"
+                    "enum": [
+                        "public",
+                        "friends",
+                        "private"
+                    ],
+                    "example": "friends"
                 }
             }
         },
-        "models.GroupMembersResponse": {
+        "models.PostsResponse": {
             "type": "object",
             "properties": {
-                "members": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.GroupMember"
-                    }
+                "next_cursor": {
+                    "type": "string",
+                    "example": "MjAyNC0wMS0wMVQxMjowMDowMFp8MTIzZTQ1Njc"
                 },
                 "page": {
                     "type": "integer",
                     "example": 1
                 },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                },
                 "total_count": {
                     "type": "integer",
                     "example": 42
@@ -2302,203 +6601,256 @@ const docTemplate = `{
                 }
             }
         },
-        "models.GroupPostRequest": {
+        "models.ProfileUpdateRequest": {
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
-                "content": {
+                "avatar": {
                     "type": "string",
-                    "example": "This is a post in the group"
+                    "maxLength": 255,
+                    "example": "https://example.com/avatar.jpg"
                 },
-                "media": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"https://example.com/image1.jpg\"]"
-                    ]
+                "bio": {
+                    "description": "Bio, Location and Website are left unchanged when omitted and cleared when empty",
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Gopher, hiker and coffee drinker"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Lisbon, Portugal"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "John Doe"
+                },
+                "website": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://example.com"
                 }
             }
         },
-        "models.GroupUpdateRequest": {
+        "models.PublicUserProfile": {
             "type": "object",
             "properties": {
                 "avatar": {
                     "type": "string",
-                    "example": "https://example.com/updated-group-avatar.jpg"
+                    "example": "https://example.com/avatar.jpg"
                 },
-                "description": {
+                "bio": {
                     "type": "string",
-                    "example": "An updated group for tech enthusiasts"
+                    "example": "Gopher, hiker and coffee drinker"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "email": {
+                    "description": "Only present when the caller may see it",
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "followers_count": {
+                    "description": "Absent when the friends service is unavailable",
+                    "type": "integer",
+                    "example": 120
+                },
+                "following_count": {
+                    "description": "Absent when the friends service is unavailable",
+                    "type": "integer",
+                    "example": 80
+                },
+                "friendship_status": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "pending",
+                        "friends",
+                        "blocked",
+                        "self",
+                        "unknown"
+                    ],
+                    "example": "friends"
+                },
+                "is_following": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "is_online": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "last_seen_at": {
+                    "description": "Absent if the user was never active",
+                    "type": "string",
+                    "example": "2023-01-02T08:30:00Z"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Lisbon, Portugal"
                 },
                 "name": {
                     "type": "string",
-                    "example": "Tech Enthusiasts Updated"
-                }
-            }
-        },
-        "models.GroupsResponse": {
-            "type": "object",
-            "properties": {
-                "groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Group"
-                    }
+                    "example": "John Doe"
                 },
-                "page": {
+                "public_post_count": {
+                    "description": "Absent when the posts service is unavailable",
                     "type": "integer",
-                    "example": 1
+                    "example": 12
                 },
-                "total_count": {
-                    "type": "integer",
-                    "example": 42
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
                 },
-                "total_pages": {
-                    "type": "integer",
-                    "example": 5
+                "username": {
+                    "type": "string",
+                    "example": "john.doe"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://example.com"
                 }
             }
         },
-        "models.LikeResponse": {
+        "models.ReactionCountsResponse": {
             "type": "object",
             "properties": {
                 "likes_count": {
                     "type": "integer"
                 },
-                "success": {
-                    "type": "boolean"
+                "reaction_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "models.Post": {
+        "models.ReactionRequest": {
             "type": "object",
             "properties": {
-                "author_avatar": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "author_id": {
-                    "type": "string",
-                    "example": "user123"
-                },
-                "author_name": {
+                "reaction_type": {
                     "type": "string",
-                    "example": "John Doe"
-                },
-                "comments_count": {
-                    "type": "integer",
-                    "example": 10
+                    "enum": [
+                        "like",
+                        "love",
+                        "laugh",
+                        "angry",
+                        "sad"
+                    ],
+                    "example": "love"
+                }
+            }
+        },
+        "models.RemoveMemberResponse": {
+            "type": "object",
+            "properties": {
+                "members_count": {
+                    "type": "integer"
                 },
-                "content": {
-                    "type": "string",
-                    "example": "This is a post"
+                "posts_retained": {
+                    "type": "boolean"
                 },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.Report": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
                 },
-                "is_liked": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "likes_count": {
-                    "type": "integer",
-                    "example": 42
-                },
-                "media": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"https://example.com/image1.jpg\"]"
+                "post": {
+                    "description": "Post is left out when the reported post has been deleted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Post"
+                        }
                     ]
                 },
                 "post_id": {
                     "type": "string",
                     "example": "post123"
                 },
-                "updated_at": {
+                "reason": {
                     "type": "string",
-                    "example": "2023-01-02T12:00:00Z"
+                    "enum": [
+                        "spam",
+                        "harassment",
+                        "hate_speech",
+                        "violence",
+                        "nudity",
+                        "misinformation",
+                        "other"
+                    ],
+                    "example": "spam"
                 },
-                "visibility": {
+                "report_id": {
                     "type": "string",
-                    "example": "public"
-                }
-            }
-        },
-        "models.PostCreateRequest": {
-            "type": "object",
-            "required": [
-                "content",
-                "visibility"
-            ],
-            "properties": {
-                "content": {
+                    "example": "report123"
+                },
+                "reporter_id": {
                     "type": "string",
-                    "example": "This is a post"
+                    "example": "user456"
                 },
-                "media": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"https://example.com/image1.jpg\"]"
-                    ]
+                "resolved_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
                 },
-                "visibility": {
+                "resolved_by": {
+                    "type": "string",
+                    "example": "user789"
+                },
+                "status": {
                     "type": "string",
                     "enum": [
-                        "public",
-                        "private"
+                        "open",
+                        "resolved"
                     ],
-                    "example": "public"
+                    "example": "open"
                 }
             }
         },
-        "models.PostUpdateRequest": {
+        "models.ReportPostRequest": {
             "type": "object",
+            "required": [
+                "reason"
+            ],
             "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "This is an updated post"
-                },
-                "media": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"https://example.com/image2.jpg\"]"
-                    ]
-                },
-                "visibility": {
+                "reason": {
                     "type": "string",
                     "enum": [
-                        "public",
-                        "private"
+                        "spam",
+                        "harassment",
+                        "hate_speech",
+                        "violence",
+                        "nudity",
+                        "misinformation",
+                        "other"
                     ],
-                    "example": "private"
+                    "example": "spam"
                 }
             }
         },
-        "models.PostsResponse": {
+        "models.ReportsResponse": {
             "type": "object",
             "properties": {
                 "page": {
                     "type": "integer",
                     "example": 1
                 },
-                "posts": {
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "reports": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Post"
+                        "$ref": "#/definitions/models.Report"
                     }
                 },
                 "total_count": {
@@ -2511,16 +6863,21 @@ const docTemplate = `{
                 }
             }
         },
-        "models.ProfileUpdateRequest": {
+        "models.ResolveReportRequest": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "name": {
+                "hide_post": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.SharePostRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "Worth a read"
                 }
             }
         },
@@ -2543,6 +6900,72 @@ const docTemplate = `{
                 }
             }
         },
+        "models.TransferOwnershipRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string",
+                    "example": "user456"
+                }
+            }
+        },
+        "models.UnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "unread_count": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.UploadURLRequest": {
+            "type": "object",
+            "required": [
+                "content_type",
+                "size"
+            ],
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "image/jpeg"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 204800
+                }
+            }
+        },
+        "models.UploadURLResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:15:00Z"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "media_url": {
+                    "type": "string",
+                    "example": "https://cdn.example.com/uploads/123/abc.jpg"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "PUT"
+                },
+                "upload_url": {
+                    "type": "string",
+                    "example": "https://media.example.com/uploads/123/abc.jpg?X-Amz-Signature=..."
+                }
+            }
+        },
         "models.UserProfile": {
             "type": "object",
             "properties": {
@@ -2550,6 +6973,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "https://example.com/avatar.jpg"
                 },
+                "bio": {
+                    "type": "string",
+                    "example": "Gopher, hiker and coffee drinker"
+                },
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
@@ -2558,10 +6985,26 @@ const docTemplate = `{
                     "type": "string",
                     "example": "john.doe@example.com"
                 },
+                "email_verified": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Lisbon, Portugal"
+                },
                 "name": {
                     "type": "string",
                     "example": "John Doe"
                 },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "admin"
+                    ],
+                    "example": "user"
+                },
                 "updated_at": {
                     "type": "string",
                     "example": "2023-01-02T12:00:00Z"
@@ -2569,6 +7012,88 @@ const docTemplate = `{
                 "user_id": {
                     "type": "string",
                     "example": "user123"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john.doe"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://example.com"
+                }
+            }
+        },
+        "models.UserRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "admin"
+                    ],
+                    "example": "admin"
+                }
+            }
+        },
+        "models.UserSearchResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserSearchResult"
+                    }
+                }
+            }
+        },
+        "models.UserSearchResult": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "friendship_status": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "pending",
+                        "friends",
+                        "blocked",
+                        "unknown"
+                    ],
+                    "example": "none"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john.doe"
                 }
             }
         }
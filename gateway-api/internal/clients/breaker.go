@@ -0,0 +1,154 @@
+package clients
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"gateway-api/internal/utils/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Circuit breaker states
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half_open"
+)
+
+// Breaker stops calls to a backend once it has failed threshold times in a row, so requests
+// fail fast instead of each waiting for a timeout. After openTimeout a single probe call is
+// let through: its success closes the breaker again, its failure keeps it open.
+type Breaker struct {
+	service     string
+	threshold   int
+	openTimeout time.Duration
+	logger      *logger.Logger
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// newBreaker creates a closed breaker; a non-positive threshold disables it
+func newBreaker(service string, threshold int, openTimeout time.Duration, logger *logger.Logger) *Breaker {
+	return &Breaker{
+		service:     service,
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		logger:      logger,
+		state:       BreakerClosed,
+	}
+}
+
+// Stats returns the state of the breaker and the number of consecutive failed calls. An open
+// breaker whose timeout has passed is reported half-open, as the next call will probe.
+func (b *Breaker) Stats() (string, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.openTimeout {
+		return BreakerHalfOpen, b.failures
+	}
+	return b.state, b.failures
+}
+
+// Available reports whether calls may currently reach the backend
+func (b *Breaker) Available() bool {
+	state, _ := b.Stats()
+	return state != BreakerOpen
+}
+
+// allow reports whether a call may go ahead, turning an open breaker half-open once its
+// timeout has passed. Only one probe call runs at a time while half-open.
+func (b *Breaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerClosed:
+		return true
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.setState(BreakerHalfOpen)
+	}
+
+	if b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record updates the breaker with the result of a call. Only an unreachable or timed-out
+// backend counts as a failure; any other response shows that the backend is up.
+func (b *Breaker) record(err error) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if status.Code(err) == codes.Canceled {
+		// The caller gave up, which says nothing about the backend
+		return
+	}
+
+	if !isTransient(err) {
+		b.failures = 0
+		if b.state != BreakerClosed {
+			b.setState(BreakerClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.openedAt = time.Now()
+		if b.state != BreakerOpen {
+			b.setState(BreakerOpen)
+		}
+	}
+}
+
+// setState changes the state and logs the transition; b.mu must be held
+func (b *Breaker) setState(state string) {
+	b.state = state
+	if state == BreakerOpen {
+		b.logger.Warn("Circuit breaker opened", zap.String("service", b.service), zap.Int("consecutive_failures", b.failures))
+	} else {
+		b.logger.Info("Circuit breaker state changed", zap.String("service", b.service), zap.String("state", state))
+	}
+}
+
+// interceptor fails calls with Unavailable while the breaker is open and records the result
+// of the others. Health checks bypass the breaker so they always report the backend's real state.
+func (b *Breaker) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if strings.HasPrefix(method, healthServicePrefix) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if !b.allow() {
+			return status.Errorf(codes.Unavailable, "%s service is unavailable", b.service)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+		return err
+	}
+}
\ No newline at end of file
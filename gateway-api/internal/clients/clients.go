@@ -0,0 +1,194 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/middleware"
+	"gateway-api/internal/utils/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+// Clients holds one shared gRPC connection per downstream service.
+// Connections are created once at startup and reused by every controller and service.
+type Clients struct {
+	Users   *grpc.ClientConn
+	Posts   *grpc.ClientConn
+	Friends *grpc.ClientConn
+	Groups  *grpc.ClientConn
+
+	// Breakers holds the circuit breaker of each connection, keyed by service name
+	Breakers map[string]*Breaker
+}
+
+// NewClients creates a client connection to every downstream service. Connections are
+// established lazily unless the startup ready check is enabled, in which case every service
+// must become reachable within the dial timeout.
+func NewClients(cfg *config.Config, logger *logger.Logger) (*Clients, error) {
+	c := &Clients{Breakers: make(map[string]*Breaker)}
+
+	targets := []struct {
+		name string
+		url  string
+		conn **grpc.ClientConn
+	}{
+		{"users", cfg.UsersServiceURL, &c.Users},
+		{"posts", cfg.PostsServiceURL, &c.Posts},
+		{"friends", cfg.FriendsServiceURL, &c.Friends},
+		{"groups", cfg.GroupsServiceURL, &c.Groups},
+	}
+
+	for _, target := range targets {
+		threshold, openTimeout := cfg.CircuitBreakerSettings(target.name)
+		breaker := newBreaker(target.name, threshold, openTimeout, logger)
+		c.Breakers[target.name] = breaker
+
+		// The breaker sees the outcome of a call after all of its retries
+		conn, err := grpc.NewClient(target.url,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(
+				propagateRequestID,
+				propagateUserID,
+				breaker.interceptor(),
+				timeoutAndRetry(cfg.GRPC.CallTimeout, cfg.GRPC.MaxRetries, cfg.GRPC.RetryBackoff),
+			),
+			// Streams last as long as the request that reads them, so they get neither the
+			// call timeout nor retries
+			grpc.WithChainStreamInterceptor(propagateRequestIDStream, propagateUserIDStream),
+			grpc.WithConnectParams(grpc.ConnectParams{
+				Backoff:           backoff.DefaultConfig,
+				MinConnectTimeout: cfg.GRPC.DialTimeout,
+			}),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:    cfg.GRPC.KeepaliveTime,
+				Timeout: cfg.GRPC.KeepaliveTimeout,
+			}),
+		)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed to create client for %s service: %w", target.name, err)
+		}
+		*target.conn = conn
+
+		go watchState(conn, target.name, logger)
+
+		if cfg.GRPC.ReadyCheck {
+			if err := waitForReady(conn, cfg.GRPC.DialTimeout); err != nil {
+				c.Close()
+				return nil, fmt.Errorf("%s service at %s is not reachable: %w", target.name, target.url, err)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// watchState logs every state change of a connection until it is closed
+func watchState(conn *grpc.ClientConn, service string, logger *logger.Logger) {
+	state := conn.GetState()
+	for state != connectivity.Shutdown && conn.WaitForStateChange(context.Background(), state) {
+		state = conn.GetState()
+		if state == connectivity.TransientFailure {
+			logger.Warn("gRPC connection failed", zap.String("service", service), zap.String("state", state.String()))
+		} else {
+			logger.Info("gRPC connection state changed", zap.String("service", service), zap.String("state", state.String()))
+		}
+	}
+}
+
+// waitForReady connects and waits until the connection is ready or the timeout expires
+func waitForReady(conn *grpc.ClientConn, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection still %s after %s", state, timeout)
+		}
+	}
+}
+
+// All returns the connections keyed by service name
+func (c *Clients) All() map[string]*grpc.ClientConn {
+	return map[string]*grpc.ClientConn{
+		"users":   c.Users,
+		"posts":   c.Posts,
+		"friends": c.Friends,
+		"groups":  c.Groups,
+	}
+}
+
+// Close closes every open connection and returns the first error encountered
+func (c *Clients) Close() error {
+	var firstErr error
+	for _, conn := range []*grpc.ClientConn{c.Users, c.Posts, c.Friends, c.Groups} {
+		if conn == nil {
+			continue
+		}
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// propagateRequestID forwards the request ID set by the RequestID middleware to the backend
+func propagateRequestID(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if requestID, ok := ctx.Value(middleware.RequestIDKey).(string); ok && requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", requestID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// propagateRequestIDStream does for streaming calls what propagateRequestID does for unary ones
+func propagateRequestIDStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if requestID, ok := ctx.Value(middleware.RequestIDKey).(string); ok && requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", requestID)
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// propagateUserID sends the ID of the user the gateway authenticated to the backend in the
+// user_id metadata, along with their token when the call does not carry one already. Backends
+// take the caller's identity from there, checked against the token, and never from a request
+// body.
+func propagateUserID(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withUserID(ctx), method, req, reply, cc, opts...)
+}
+
+// propagateUserIDStream does for streaming calls what propagateUserID does for unary ones
+func propagateUserIDStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withUserID(ctx), desc, cc, method, opts...)
+}
+
+// withUserID adds the authenticated user's ID and token to the outgoing metadata. Anonymous
+// requests are returned unchanged.
+func withUserID(ctx context.Context) context.Context {
+	userID, _ := ctx.Value("userID").(string)
+	if userID == "" {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("user_id", userID)
+	if len(md.Get("authorization")) == 0 {
+		if token, _ := ctx.Value("jwt_token").(string); token != "" {
+			md.Set("authorization", "Bearer "+token)
+		}
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
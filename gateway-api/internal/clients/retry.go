@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readMethodPrefixes are the name prefixes of the read-only RPCs, the only ones safe to send twice
+var readMethodPrefixes = []string{"Get", "Search", "Check"}
+
+// healthServicePrefix names the health checks, which the health controller bounds and reports itself
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// timeoutAndRetry bounds every call attempt by callTimeout and retries read-only RPCs up to
+// maxRetries times while the backend is unavailable or too slow, doubling the wait from
+// backoff between attempts. Mutating RPCs are never retried, as a timed-out call may still
+// have been applied.
+func timeoutAndRetry(callTimeout time.Duration, maxRetries int, backoff time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		retries := 0
+		if isReadMethod(method) {
+			retries = maxRetries
+		}
+
+		delay := backoff
+		for attempt := 0; ; attempt++ {
+			err := invokeWithTimeout(ctx, callTimeout, method, req, reply, cc, invoker, opts...)
+			if err == nil || attempt >= retries || !isTransient(err) || ctx.Err() != nil {
+				return err
+			}
+
+			// Wait with jitter so retries from concurrent requests don't arrive together
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))):
+			}
+			delay *= 2
+		}
+	}
+}
+
+// invokeWithTimeout makes one call attempt, bounded by timeout unless it is not positive
+func invokeWithTimeout(ctx context.Context, timeout time.Duration, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// isReadMethod reports whether method is a read-only RPC, judged by its name
+func isReadMethod(method string) bool {
+	if strings.HasPrefix(method, healthServicePrefix) {
+		return false
+	}
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTransient reports whether a call failed in a way that another attempt may not
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
\ No newline at end of file
@@ -0,0 +1,155 @@
+package clients
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "common/pb/common/proto/posts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakyPostServer fails the first failures calls it receives with code, holding each failed
+// call for delay, and answers the rest
+type flakyPostServer struct {
+	pb.UnimplementedPostServiceServer
+	failures int32
+	code     codes.Code
+	delay    time.Duration
+	calls    atomic.Int32
+}
+
+func (s *flakyPostServer) answer(ctx context.Context) error {
+	if s.calls.Add(1) > s.failures {
+		return nil
+	}
+	if s.delay > 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.delay):
+		}
+	}
+	return status.Error(s.code, "backend failure")
+}
+
+func (s *flakyPostServer) GetPost(ctx context.Context, req *pb.GetPostRequest) (*pb.PostResponse, error) {
+	if err := s.answer(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.PostResponse{PostId: req.PostId}, nil
+}
+
+func (s *flakyPostServer) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb.PostResponse, error) {
+	if err := s.answer(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.PostResponse{PostId: "created"}, nil
+}
+
+// newRetryingClient serves server in memory and returns a client to it that goes through
+// timeoutAndRetry
+func newRetryingClient(t *testing.T, server pb.PostServiceServer, callTimeout time.Duration, maxRetries int) pb.PostServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pb.RegisterPostServiceServer(s, server)
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(timeoutAndRetry(callTimeout, maxRetries, time.Millisecond)),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial in-memory server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewPostServiceClient(conn)
+}
+
+func TestTimeoutAndRetryRetriesReadsUntilTheyPass(t *testing.T) {
+	server := &flakyPostServer{failures: 2, code: codes.Unavailable}
+	client := newRetryingClient(t, server, 0, 3)
+
+	resp, err := client.GetPost(context.Background(), &pb.GetPostRequest{PostId: "post-1"})
+	if err != nil {
+		t.Fatalf("GetPost: %v", err)
+	}
+	if resp.PostId != "post-1" {
+		t.Errorf("got post %q, want %q", resp.PostId, "post-1")
+	}
+	if got := server.calls.Load(); got != 3 {
+		t.Errorf("server called %d times, want 3", got)
+	}
+}
+
+func TestTimeoutAndRetryGivesUpAfterMaxRetries(t *testing.T) {
+	server := &flakyPostServer{failures: 10, code: codes.Unavailable}
+	client := newRetryingClient(t, server, 0, 2)
+
+	_, err := client.GetPost(context.Background(), &pb.GetPostRequest{PostId: "post-1"})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v, want Unavailable", err)
+	}
+	if got := server.calls.Load(); got != 3 {
+		t.Errorf("server called %d times, want 3", got)
+	}
+}
+
+func TestTimeoutAndRetryRetriesSlowReads(t *testing.T) {
+	server := &flakyPostServer{failures: 1, code: codes.Unavailable, delay: time.Second}
+	client := newRetryingClient(t, server, 50*time.Millisecond, 1)
+
+	if _, err := client.GetPost(context.Background(), &pb.GetPostRequest{PostId: "post-1"}); err != nil {
+		t.Fatalf("GetPost: %v", err)
+	}
+	if got := server.calls.Load(); got != 2 {
+		t.Errorf("server called %d times, want 2", got)
+	}
+}
+
+func TestTimeoutAndRetryDoesNotRetryWrites(t *testing.T) {
+	server := &flakyPostServer{failures: 1, code: codes.Unavailable}
+	client := newRetryingClient(t, server, 0, 3)
+
+	_, err := client.CreatePost(context.Background(), &pb.CreatePostRequest{UserId: "user-1"})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v, want Unavailable", err)
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("server called %d times, want 1", got)
+	}
+
+	// A write that times out may still have been applied, so it is not sent again either
+	server = &flakyPostServer{failures: 1, code: codes.Unavailable, delay: time.Second}
+	client = newRetryingClient(t, server, 50*time.Millisecond, 3)
+	if _, err := client.CreatePost(context.Background(), &pb.CreatePostRequest{UserId: "user-1"}); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("got %v, want DeadlineExceeded", err)
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("server called %d times after a timeout, want 1", got)
+	}
+}
+
+func TestTimeoutAndRetryDoesNotRetryPermanentErrors(t *testing.T) {
+	server := &flakyPostServer{failures: 1, code: codes.NotFound}
+	client := newRetryingClient(t, server, 0, 3)
+
+	if _, err := client.GetPost(context.Background(), &pb.GetPostRequest{PostId: "post-1"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("got %v, want NotFound", err)
+	}
+	if got := server.calls.Load(); got != 1 {
+		t.Errorf("server called %d times, want 1", got)
+	}
+}
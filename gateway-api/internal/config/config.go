@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -14,34 +15,175 @@ type Config struct {
 	Environment string `mapstructure:"environment"`
 	Port        string `mapstructure:"port"`
 
+	// ShutdownTimeout is how long a shutdown waits for in-flight requests to finish
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+
+	// RequestTimeout bounds the handling of a request, including its backend calls
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+
+	// ExportTimeout replaces RequestTimeout for the export routes, which stream for longer
+	ExportTimeout time.Duration `mapstructure:"export_timeout"`
+
 	// gRPC client configurations
 	UsersServiceURL   string `mapstructure:"users_service_url"`
 	PostsServiceURL   string `mapstructure:"posts_service_url"`
 	FriendsServiceURL string `mapstructure:"friends_service_url"`
 	GroupsServiceURL  string `mapstructure:"groups_service_url"`
 
+	// gRPC client connection settings
+	GRPC struct {
+		// DialTimeout bounds each connection attempt and the startup ready check
+		DialTimeout time.Duration `mapstructure:"dial_timeout"`
+		// KeepaliveTime is how long a connection may be idle before it is pinged; the backends
+		// reject pings more often than every 5 minutes with their default enforcement policy
+		KeepaliveTime    time.Duration `mapstructure:"keepalive_time"`
+		KeepaliveTimeout time.Duration `mapstructure:"keepalive_timeout"`
+		// ReadyCheck makes startup fail unless every service is reachable within DialTimeout
+		ReadyCheck bool `mapstructure:"ready_check"`
+		// CallTimeout bounds every call attempt; a non-positive value leaves calls unbounded
+		CallTimeout time.Duration `mapstructure:"call_timeout"`
+		// MaxRetries is how many times a read-only call is retried when the backend is
+		// unavailable or times out, waiting RetryBackoff and doubling it between attempts
+		MaxRetries   int           `mapstructure:"max_retries"`
+		RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+	} `mapstructure:"grpc"`
+
 	// App URL
 	AppURL string `mapstructure:"app_url"`
 
 	// Auth configurations
 	JWTSecret string `mapstructure:"jwt_secret"`
+	// TokenRevocationCacheTTL is how long the answer to whether a token was signed out is reused,
+	// which bounds how long a revoked token keeps working; a non-positive value checks every request
+	TokenRevocationCacheTTL time.Duration `mapstructure:"token_revocation_cache_ttl"`
 
 	// OAuth configurations
 	OAuth struct {
+		// Scopes lists the scopes requested from each provider, so extra ones can be asked for
+		// without a code change
 		Google struct {
-			ClientID     string `mapstructure:"client_id"`
-			ClientSecret string `mapstructure:"client_secret"`
-			RedirectURL  string `mapstructure:"redirect_url"`
+			ClientID     string   `mapstructure:"client_id"`
+			ClientSecret string   `mapstructure:"client_secret"`
+			RedirectURL  string   `mapstructure:"redirect_url"`
+			Scopes       []string `mapstructure:"scopes"`
 		} `mapstructure:"google"`
 		Microsoft struct {
-			ClientID     string `mapstructure:"client_id"`
-			ClientSecret string `mapstructure:"client_secret"`
-			RedirectURL  string `mapstructure:"redirect_url"`
+			ClientID     string   `mapstructure:"client_id"`
+			ClientSecret string   `mapstructure:"client_secret"`
+			RedirectURL  string   `mapstructure:"redirect_url"`
+			Scopes       []string `mapstructure:"scopes"`
 		} `mapstructure:"microsoft"`
+		GitHub struct {
+			ClientID     string   `mapstructure:"client_id"`
+			ClientSecret string   `mapstructure:"client_secret"`
+			RedirectURL  string   `mapstructure:"redirect_url"`
+			Scopes       []string `mapstructure:"scopes"`
+		} `mapstructure:"github"`
+		// Apple only needs the callback URL here; its client credentials are kept by the users-api,
+		// which performs the code exchange
+		Apple struct {
+			RedirectURL string `mapstructure:"redirect_url"`
+		} `mapstructure:"apple"`
 	} `mapstructure:"oauth"`
 
 	// Logging configurations
 	LogLevel string `mapstructure:"log_level"`
+
+	// CORS configurations
+	CORS struct {
+		// AllowedOrigins lists origins allowed to call the API; a single "*" allows any origin
+		// but only takes effect when AllowCredentials is false
+		AllowedOrigins   []string `mapstructure:"allowed_origins"`
+		AllowedMethods   []string `mapstructure:"allowed_methods"`
+		AllowedHeaders   []string `mapstructure:"allowed_headers"`
+		ExposedHeaders   []string `mapstructure:"exposed_headers"`
+		AllowCredentials bool     `mapstructure:"allow_credentials"`
+	} `mapstructure:"cors"`
+
+	// Rate limiting configurations
+	RateLimit struct {
+		RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+		Burst             int     `mapstructure:"burst"`
+		// Routes overrides the limit for individual routes, keyed by route path (e.g. /api/v1/posts/tags/:tag)
+		Routes map[string]RouteRateLimit `mapstructure:"routes"`
+	} `mapstructure:"rate_limit"`
+
+	// Circuit breakers of the downstream services
+	CircuitBreaker struct {
+		// FailureThreshold is how many calls in a row may fail before calls to the service
+		// fail fast; a non-positive value disables the breakers
+		FailureThreshold int `mapstructure:"failure_threshold"`
+		// OpenTimeout is how long calls fail fast before one is let through to probe the service
+		OpenTimeout time.Duration `mapstructure:"open_timeout"`
+		// Services overrides the settings for individual services, keyed by name (e.g. posts)
+		Services map[string]CircuitBreakerSettings `mapstructure:"services"`
+	} `mapstructure:"circuit_breaker"`
+
+	// Pagination of list endpoints
+	Pagination struct {
+		// MaxLimit is the largest page size clients can ask for; larger limits are clamped to it
+		MaxLimit int `mapstructure:"max_limit"`
+	} `mapstructure:"pagination"`
+
+	// Request body size limits
+	BodyLimit struct {
+		// MaxBytes is the largest request body accepted; a non-positive value disables the limit
+		MaxBytes int64 `mapstructure:"max_bytes"`
+		// Groups overrides the limit for route groups, keyed by path prefix (e.g. /api/v1/media)
+		Groups map[string]int64 `mapstructure:"groups"`
+	} `mapstructure:"body_limit"`
+
+	// Presence tracking; authenticated requests mark the user as active in the users service
+	Presence struct {
+		// MarkInterval is the least time between two activity reports for the same user, so that
+		// busy users do not cause a call per request; a non-positive value disables tracking
+		MarkInterval time.Duration `mapstructure:"mark_interval"`
+	} `mapstructure:"presence"`
+
+	// Media upload configurations; uploads go straight to an S3-compatible bucket through
+	// presigned URLs and are disabled while no bucket is set
+	Media struct {
+		Endpoint        string `mapstructure:"endpoint"`
+		Region          string `mapstructure:"region"`
+		Bucket          string `mapstructure:"bucket"`
+		AccessKeyID     string `mapstructure:"access_key_id"`
+		SecretAccessKey string `mapstructure:"secret_access_key"`
+		// UsePathStyle addresses the bucket as endpoint/bucket instead of bucket.endpoint, as MinIO expects
+		UsePathStyle bool `mapstructure:"use_path_style"`
+		// PublicURL is the base URL uploaded objects are served from, such as a CDN; defaults to the bucket URL
+		PublicURL    string        `mapstructure:"public_url"`
+		MaxSize      int64         `mapstructure:"max_size"`
+		AllowedTypes []string      `mapstructure:"allowed_types"`
+		URLExpiry    time.Duration `mapstructure:"url_expiry"`
+	} `mapstructure:"media"`
+}
+
+// RouteRateLimit holds the token-bucket settings for a single route
+type RouteRateLimit struct {
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	Burst             int     `mapstructure:"burst"`
+}
+
+// CircuitBreakerSettings overrides the circuit breaker of a single service; zero values
+// fall back to the global settings
+type CircuitBreakerSettings struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"`
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+}
+
+// CircuitBreakerSettings returns the failure threshold and open timeout of a service's breaker
+func (c *Config) CircuitBreakerSettings(service string) (int, time.Duration) {
+	threshold := c.CircuitBreaker.FailureThreshold
+	openTimeout := c.CircuitBreaker.OpenTimeout
+	if override, ok := c.CircuitBreaker.Services[service]; ok {
+		if override.FailureThreshold != 0 {
+			threshold = override.FailureThreshold
+		}
+		if override.OpenTimeout != 0 {
+			openTimeout = override.OpenTimeout
+		}
+	}
+	return threshold, openTimeout
 }
 
 // LoadConfig loads configuration from environment variables and config files
@@ -49,20 +191,82 @@ func LoadConfig() (*Config, error) {
 	// Set default values
 	viper.SetDefault("environment", "development")
 	viper.SetDefault("port", "8000")
+	viper.SetDefault("shutdown_timeout", 15*time.Second)
+	viper.SetDefault("request_timeout", 30*time.Second)
+	viper.SetDefault("export_timeout", 10*time.Minute)
 	viper.SetDefault("users_service_url", "localhost:50051")
 	viper.SetDefault("posts_service_url", "localhost:50052")
 	viper.SetDefault("friends_service_url", "localhost:50053")
 	viper.SetDefault("groups_service_url", "localhost:50054")
 	viper.SetDefault("jwt_secret", "your-secret-key")
+	viper.SetDefault("token_revocation_cache_ttl", 5*time.Second)
 	viper.SetDefault("log_level", "info")
 
+	// gRPC client default values
+	viper.SetDefault("grpc.dial_timeout", 5*time.Second)
+	viper.SetDefault("grpc.keepalive_time", 5*time.Minute)
+	viper.SetDefault("grpc.keepalive_timeout", 10*time.Second)
+	viper.SetDefault("grpc.ready_check", false)
+	viper.SetDefault("grpc.call_timeout", 10*time.Second)
+	viper.SetDefault("grpc.max_retries", 2)
+	viper.SetDefault("grpc.retry_backoff", 100*time.Millisecond)
+
+	// CORS default values
+	viper.SetDefault("cors.allowed_origins", []string{"http://localhost:3000"})
+	viper.SetDefault("cors.allowed_methods", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"})
+	viper.SetDefault("cors.allowed_headers", []string{"Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", "Accept", "Origin", "Cache-Control", "X-Requested-With", "X-Request-ID", "Idempotency-Key"})
+	viper.SetDefault("cors.exposed_headers", []string{"X-Request-ID"})
+	viper.SetDefault("cors.allow_credentials", true)
+
+	// Rate limit default values; a non-positive rate disables limiting
+	viper.SetDefault("rate_limit.requests_per_second", 10)
+	viper.SetDefault("rate_limit.burst", 20)
+	viper.SetDefault("rate_limit.routes", map[string]interface{}{
+		"/api/v1/users/search": map[string]interface{}{"requests_per_second": 2, "burst": 5},
+	})
+
+	// Circuit breaker default values
+	viper.SetDefault("circuit_breaker.failure_threshold", 5)
+	viper.SetDefault("circuit_breaker.open_timeout", 30*time.Second)
+
+	// Pagination default values
+	viper.SetDefault("pagination.max_limit", 100)
+
+	// Body limit default values
+	viper.SetDefault("body_limit.max_bytes", 1<<20)
+	viper.SetDefault("body_limit.groups", map[string]interface{}{
+		"/api/v1/media": 4 << 20,
+	})
+
+	// Presence default values
+	viper.SetDefault("presence.mark_interval", time.Minute)
+
+	// Media upload default values
+	viper.SetDefault("media.endpoint", "http://localhost:9000")
+	viper.SetDefault("media.region", "us-east-1")
+	viper.SetDefault("media.bucket", "")
+	viper.SetDefault("media.access_key_id", "")
+	viper.SetDefault("media.secret_access_key", "")
+	viper.SetDefault("media.use_path_style", true)
+	viper.SetDefault("media.public_url", "")
+	viper.SetDefault("media.max_size", 10<<20)
+	viper.SetDefault("media.allowed_types", []string{"image/jpeg", "image/png", "image/gif", "image/webp", "video/mp4"})
+	viper.SetDefault("media.url_expiry", 15*time.Minute)
+
 	// OAuth default values
 	viper.SetDefault("oauth.google.client_id", "your-google-client-id")
 	viper.SetDefault("oauth.google.client_secret", "your-google-client-secret")
 	viper.SetDefault("oauth.google.redirect_url", "http://localhost:8000/api/v1/auth/google/callback")
+	viper.SetDefault("oauth.google.scopes", []string{"openid", "profile", "email"})
 	viper.SetDefault("oauth.microsoft.client_id", "your-microsoft-client-id")
 	viper.SetDefault("oauth.microsoft.client_secret", "your-microsoft-client-secret")
 	viper.SetDefault("oauth.microsoft.redirect_url", "http://localhost:8000/api/v1/auth/microsoft/callback")
+	viper.SetDefault("oauth.microsoft.scopes", []string{"openid", "profile", "email", "User.Read"})
+	viper.SetDefault("oauth.github.client_id", "your-github-client-id")
+	viper.SetDefault("oauth.github.client_secret", "your-github-client-secret")
+	viper.SetDefault("oauth.github.redirect_url", "http://localhost:8000/api/v1/auth/github/callback")
+	viper.SetDefault("oauth.github.scopes", []string{"read:user", "user:email"})
+	viper.SetDefault("oauth.apple.redirect_url", "http://localhost:8000/api/v1/auth/apple/callback")
 
 	// Set config file name and paths
 	viper.SetConfigName("config")
@@ -105,22 +309,79 @@ func LoadConfig() (*Config, error) {
 		defaultConfig := map[string]interface{}{
 			"environment":         config.Environment,
 			"port":                config.Port,
+			"shutdown_timeout":    config.ShutdownTimeout.String(),
+			"request_timeout":     config.RequestTimeout.String(),
+			"export_timeout":      config.ExportTimeout.String(),
 			"users_service_url":   config.UsersServiceURL,
 			"posts_service_url":   "127.0.0.1:50052",
 			"friends_service_url": config.FriendsServiceURL,
 			"groups_service_url":  config.GroupsServiceURL,
 			"jwt_secret":          config.JWTSecret,
 			"log_level":           config.LogLevel,
+			"grpc": map[string]interface{}{
+				"dial_timeout":      config.GRPC.DialTimeout.String(),
+				"keepalive_time":    config.GRPC.KeepaliveTime.String(),
+				"keepalive_timeout": config.GRPC.KeepaliveTimeout.String(),
+				"ready_check":       config.GRPC.ReadyCheck,
+				"call_timeout":      config.GRPC.CallTimeout.String(),
+				"max_retries":       config.GRPC.MaxRetries,
+				"retry_backoff":     config.GRPC.RetryBackoff.String(),
+			},
+			"cors": map[string]interface{}{
+				"allowed_origins":   config.CORS.AllowedOrigins,
+				"allowed_methods":   config.CORS.AllowedMethods,
+				"allowed_headers":   config.CORS.AllowedHeaders,
+				"exposed_headers":   config.CORS.ExposedHeaders,
+				"allow_credentials": config.CORS.AllowCredentials,
+			},
+			"rate_limit": map[string]interface{}{
+				"requests_per_second": config.RateLimit.RequestsPerSecond,
+				"burst":               config.RateLimit.Burst,
+			},
+			"circuit_breaker": map[string]interface{}{
+				"failure_threshold": config.CircuitBreaker.FailureThreshold,
+				"open_timeout":      config.CircuitBreaker.OpenTimeout.String(),
+			},
+			"pagination": map[string]interface{}{
+				"max_limit": config.Pagination.MaxLimit,
+			},
+			"body_limit": map[string]interface{}{
+				"max_bytes": config.BodyLimit.MaxBytes,
+				"groups":    config.BodyLimit.Groups,
+			},
+			"media": map[string]interface{}{
+				"endpoint":          config.Media.Endpoint,
+				"region":            config.Media.Region,
+				"bucket":            config.Media.Bucket,
+				"access_key_id":     "your-media-access-key-id",
+				"secret_access_key": "your-media-secret-access-key",
+				"use_path_style":    config.Media.UsePathStyle,
+				"public_url":        config.Media.PublicURL,
+				"max_size":          config.Media.MaxSize,
+				"allowed_types":     config.Media.AllowedTypes,
+				"url_expiry":        config.Media.URLExpiry.String(),
+			},
 			"oauth": map[string]interface{}{
 				"google": map[string]interface{}{
 					"client_id":     "your-google-client-id",
 					"client_secret": "your-google-client-secret",
 					"redirect_url":  "http://localhost:8000/api/v1/auth/google/callback",
+					"scopes":        config.OAuth.Google.Scopes,
 				},
 				"microsoft": map[string]interface{}{
 					"client_id":     "your-microsoft-client-id",
 					"client_secret": "your-microsoft-client-secret",
 					"redirect_url":  "http://localhost:8000/api/v1/auth/microsoft/callback",
+					"scopes":        config.OAuth.Microsoft.Scopes,
+				},
+				"github": map[string]interface{}{
+					"client_id":     "your-github-client-id",
+					"client_secret": "your-github-client-secret",
+					"redirect_url":  "http://localhost:8000/api/v1/auth/github/callback",
+					"scopes":        config.OAuth.GitHub.Scopes,
+				},
+				"apple": map[string]interface{}{
+					"redirect_url": "http://localhost:8000/api/v1/auth/apple/callback",
 				},
 			},
 		}
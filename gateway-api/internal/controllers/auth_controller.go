@@ -8,6 +8,9 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"gateway-api/internal/config"
 	"gateway-api/internal/models"
@@ -46,10 +49,8 @@ func (c *AuthController) GoogleLogin(ctx *gin.Context) {
 	url, err := c.authService.GoogleLogin(ctx)
 
 	if err != nil {
-		c.logger.Error("Failed to generate Google login URL", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to initiate Google login",
-		})
+		c.logger.WithContext(ctx).Error("Failed to generate Google login URL", err)
+		respondWithError(ctx, err, "Failed to initiate Google login")
 		return
 	}
 
@@ -71,10 +72,8 @@ func (c *AuthController) MicrosoftLogin(ctx *gin.Context) {
 	// Call the auth service
 	loginUrl, err := c.authService.MicrosoftLogin(ctx)
 	if err != nil {
-		c.logger.Error("Failed to generate Microsoft login URL", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to initiate Microsoft login",
-		})
+		c.logger.WithContext(ctx).Error("Failed to generate Microsoft login URL", err)
+		respondWithError(ctx, err, "Failed to initiate Microsoft login")
 		return
 	}
 
@@ -93,6 +92,7 @@ func (c *AuthController) MicrosoftLogin(ctx *gin.Context) {
 // @Param redirect_url query string false "URL to redirect to after authentication"
 // @Success 302 {string} string "Redirect to frontend with token and user data"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 403 {object} models.ErrorResponse "Email address is not verified"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /auth/microsoft/callback [get]
 func (c *AuthController) MicrosoftCallback(ctx *gin.Context) {
@@ -103,150 +103,233 @@ func (c *AuthController) MicrosoftCallback(ctx *gin.Context) {
 
 	// Validate state token to prevent CSRF attacks
 	if !c.authService.ValidateStateToken(state) {
-		c.logger.Error("Invalid state token", nil)
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid state token",
-		})
+		c.logger.WithContext(ctx).Error("Invalid state token", nil)
+		respondWithStatus(ctx, http.StatusBadRequest, "Invalid state token")
 		return
 	}
 
 	// Call the auth service
 	resp, err := c.authService.MicrosoftCallback(ctx, state, code)
 	if err != nil {
-		c.logger.Error("Failed to handle Microsoft callback", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate with Microsoft",
-		})
+		c.logger.WithContext(ctx).Error("Failed to handle Microsoft callback", err)
+		if respondEmailNotVerified(ctx, err) {
+			return
+		}
+		respondWithError(ctx, err, "Failed to authenticate with Microsoft")
 		return
 	}
 
-	// Create a new context with the JWT token
-	ctxWithToken := context.WithValue(context.Background(), "jwt_token", resp.AccessToken)
+	c.redirectWithSession(ctx, resp, redirectURLStr)
+}
 
-	// Get user profile from user service
-	userProfile, err := c.userService.GetProfile(ctxWithToken, resp.UserID)
-	if err != nil {
-		c.logger.Error("Failed to get user profile", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get user profile",
-		})
+// GoogleCallback handles the callback from Google OAuth
+// @Summary Handle Google OAuth callback
+// @Description Handles the callback from Google's OAuth login
+// @Tags auth
+// @Produce json
+// @Param state query string true "State token for CSRF protection"
+// @Param code query string true "Authorization code"
+// @Param redirect_url query string false "URL to redirect to after authentication"
+// @Success 302 {string} string "Redirect to frontend with token and user data"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 403 {object} models.ErrorResponse "Email address is not verified"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /auth/google/callback [get]
+func (c *AuthController) GoogleCallback(ctx *gin.Context) {
+	// Get state, code, and redirect_url from query parameters
+	state := ctx.Query("state")
+	code := ctx.Query("code")
+	redirectURLStr := ctx.Query("redirect_url")
+
+	// Validate state token to prevent CSRF attacks
+	if !c.authService.ValidateStateToken(state) {
+		c.logger.WithContext(ctx).Error("Invalid state token", nil)
+		respondWithStatus(ctx, http.StatusBadRequest, "Invalid state token")
 		return
 	}
 
-	// Convert token and user to JSON
-	tokenJSON, err := json.Marshal(resp)
+	// Call the auth service
+	resp, err := c.authService.GoogleCallback(ctx, state, code)
 	if err != nil {
-		c.logger.Error("Failed to marshal token", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+		c.logger.WithContext(ctx).Error("Failed to handle Google callback", err)
+		if respondEmailNotVerified(ctx, err) {
+			return
+		}
+		respondWithError(ctx, err, "Failed to authenticate with Google")
 		return
 	}
 
-	userJSON, err := json.Marshal(userProfile)
+	c.redirectWithSession(ctx, resp, redirectURLStr)
+}
+
+// GitHubLogin initiates GitHub OAuth login
+// @Summary Initiate GitHub OAuth login
+// @Description Redirects the user to GitHub's OAuth login page
+// @Tags auth
+// @Produce json
+// @Success 302 {string} string "Redirect to GitHub"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /auth/github [get]
+func (c *AuthController) GitHubLogin(ctx *gin.Context) {
+	// Call the auth service
+	loginURL, err := c.authService.GitHubLogin(ctx)
 	if err != nil {
-		c.logger.Error("Failed to marshal user", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+		c.logger.WithContext(ctx).Error("Failed to generate GitHub login URL", err)
+		respondWithError(ctx, err, "Failed to initiate GitHub login")
 		return
 	}
 
-	// Determine the redirect URL
-	var redirectURL *url.URL
-	var parseErr error
+	// Return the login URL as JSON
+	ctx.JSON(http.StatusOK, gin.H{"login_url": loginURL})
+}
 
-	if redirectURLStr != "" {
-		// Validate the redirect URL
-		redirectURL, parseErr = url.Parse(redirectURLStr)
-		if parseErr != nil || redirectURL.Scheme == "" || redirectURL.Host == "" {
-			c.logger.Error("Invalid redirect URL", parseErr)
-			// Use a hardcoded default URL
-			redirectURL, parseErr = url.Parse(c.cfg.AppURL + "/login")
-			if parseErr != nil {
-				c.logger.Error("Failed to parse default redirect URL", parseErr)
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
-				return
-			}
-		}
-	} else {
-		// Use a hardcoded default URL
-		redirectURL, parseErr = url.Parse(c.cfg.AppURL + "/login")
-		if parseErr != nil {
-			c.logger.Error("Failed to parse default redirect URL", parseErr)
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+// GitHubCallback handles the callback from GitHub OAuth
+// @Summary Handle GitHub OAuth callback
+// @Description Handles the callback from GitHub's OAuth login
+// @Tags auth
+// @Produce json
+// @Param state query string true "State token for CSRF protection"
+// @Param code query string true "Authorization code"
+// @Param redirect_url query string false "URL to redirect to after authentication"
+// @Success 302 {string} string "Redirect to frontend with token and user data"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 403 {object} models.ErrorResponse "Email address is not verified"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /auth/github/callback [get]
+func (c *AuthController) GitHubCallback(ctx *gin.Context) {
+	// Get state, code, and redirect_url from query parameters
+	state := ctx.Query("state")
+	code := ctx.Query("code")
+	redirectURLStr := ctx.Query("redirect_url")
+
+	// Validate state token to prevent CSRF attacks
+	if !c.authService.ValidateStateToken(state) {
+		c.logger.WithContext(ctx).Error("Invalid state token", nil)
+		respondWithStatus(ctx, http.StatusBadRequest, "Invalid state token")
+		return
+	}
+
+	// Call the auth service
+	resp, err := c.authService.GitHubCallback(ctx, state, code)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to handle GitHub callback", err)
+		if respondEmailNotVerified(ctx, err) {
 			return
 		}
+		respondWithError(ctx, err, "Failed to authenticate with GitHub")
+		return
 	}
 
-	// Add token and user data to query parameters
-	query := redirectURL.Query()
-	query.Set("token", string(tokenJSON))
-	query.Set("user", string(userJSON))
-	redirectURL.RawQuery = query.Encode()
+	c.redirectWithSession(ctx, resp, redirectURLStr)
+}
 
-	// Redirect to frontend application with token and user data
-	ctx.Redirect(http.StatusTemporaryRedirect, redirectURL.String())
+// AppleLogin initiates Sign in with Apple
+// @Summary Initiate Sign in with Apple
+// @Description Redirects the user to Apple's login page
+// @Tags auth
+// @Produce json
+// @Success 302 {string} string "Redirect to Apple"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /auth/apple [get]
+func (c *AuthController) AppleLogin(ctx *gin.Context) {
+	// Call the auth service
+	loginURL, err := c.authService.AppleLogin(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to generate Apple login URL", err)
+		respondWithError(ctx, err, "Failed to initiate Apple login")
+		return
+	}
+
+	// Return the login URL as JSON
+	ctx.JSON(http.StatusOK, gin.H{"login_url": loginURL})
 }
 
-// GoogleCallback handles the callback from Google OAuth
-// @Summary Handle Google OAuth callback
-// @Description Handles the callback from Google's OAuth login
+// appleUser is the user object Apple posts to the callback on the first sign-in only
+type appleUser struct {
+	Name struct {
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+	} `json:"name"`
+}
+
+// AppleCallback handles the callback from Sign in with Apple
+// @Summary Handle Sign in with Apple callback
+// @Description Handles the form post from Apple's login page
 // @Tags auth
+// @Accept x-www-form-urlencoded
 // @Produce json
-// @Param state query string true "State token for CSRF protection"
-// @Param code query string true "Authorization code"
+// @Param state formData string true "State token for CSRF protection"
+// @Param code formData string true "Authorization code"
+// @Param user formData string false "JSON with the user's name, sent by Apple on the first sign-in only"
 // @Param redirect_url query string false "URL to redirect to after authentication"
 // @Success 302 {string} string "Redirect to frontend with token and user data"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 403 {object} models.ErrorResponse "Email address is not verified"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
-// @Router /auth/google/callback [get]
-func (c *AuthController) GoogleCallback(ctx *gin.Context) {
-	// Get state, code, and redirect_url from query parameters
-	state := ctx.Query("state")
-	code := ctx.Query("code")
+// @Router /auth/apple/callback [post]
+func (c *AuthController) AppleCallback(ctx *gin.Context) {
+	// Apple posts the result as a form rather than query parameters
+	state := ctx.PostForm("state")
+	code := ctx.PostForm("code")
 	redirectURLStr := ctx.Query("redirect_url")
 
 	// Validate state token to prevent CSRF attacks
 	if !c.authService.ValidateStateToken(state) {
-		c.logger.Error("Invalid state token", nil)
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: "Invalid state token",
-		})
+		c.logger.WithContext(ctx).Error("Invalid state token", nil)
+		respondWithStatus(ctx, http.StatusBadRequest, "Invalid state token")
 		return
 	}
 
+	// The name is missing on later sign-ins, which is not an error
+	var name string
+	if userJSON := ctx.PostForm("user"); userJSON != "" {
+		var user appleUser
+		if err := json.Unmarshal([]byte(userJSON), &user); err != nil {
+			c.logger.WithContext(ctx).Error("Failed to parse Apple user", err)
+		} else {
+			name = strings.TrimSpace(user.Name.FirstName + " " + user.Name.LastName)
+		}
+	}
+
 	// Call the auth service
-	resp, err := c.authService.GoogleCallback(ctx, state, code)
+	resp, err := c.authService.AppleCallback(ctx, state, code, name)
 	if err != nil {
-		c.logger.Error("Failed to handle Google callback", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate with Google",
-		})
+		c.logger.WithContext(ctx).Error("Failed to handle Apple callback", err)
+		if respondEmailNotVerified(ctx, err) {
+			return
+		}
+		respondWithError(ctx, err, "Failed to authenticate with Apple")
 		return
 	}
 
+	c.redirectWithSession(ctx, resp, redirectURLStr)
+}
+
+// redirectWithSession sends a freshly logged-in user back to the frontend with their token and profile
+func (c *AuthController) redirectWithSession(ctx *gin.Context, resp *models.AuthResponse, redirectURLStr string) {
 	// Create a new context with the JWT token
-	ctxWithToken := context.WithValue(context.Background(), "jwt_token", resp.AccessToken)
+	ctxWithToken := context.WithValue(ctx, "jwt_token", resp.AccessToken)
 
 	// Get user profile from user service
 	userProfile, err := c.userService.GetProfile(ctxWithToken, resp.UserID)
 	if err != nil {
-		c.logger.Error("Failed to get user profile", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get user profile",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get user profile", err)
+		respondWithError(ctx, err, "Failed to get user profile")
 		return
 	}
 
 	// Convert token and user to JSON
 	tokenJSON, err := json.Marshal(resp)
 	if err != nil {
-		c.logger.Error("Failed to marshal token", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+		c.logger.WithContext(ctx).Error("Failed to marshal token", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to process authentication")
 		return
 	}
 
 	userJSON, err := json.Marshal(userProfile)
 	if err != nil {
-		c.logger.Error("Failed to marshal user", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+		c.logger.WithContext(ctx).Error("Failed to marshal user", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to process authentication")
 		return
 	}
 
@@ -258,12 +341,12 @@ func (c *AuthController) GoogleCallback(ctx *gin.Context) {
 		// Validate the redirect URL
 		redirectURL, parseErr = url.Parse(redirectURLStr)
 		if parseErr != nil || redirectURL.Scheme == "" || redirectURL.Host == "" {
-			c.logger.Error("Invalid redirect URL", parseErr)
+			c.logger.WithContext(ctx).Error("Invalid redirect URL", parseErr)
 			// Use a hardcoded default URL
 			redirectURL, parseErr = url.Parse(c.cfg.AppURL + "/login")
 			if parseErr != nil {
-				c.logger.Error("Failed to parse default redirect URL", parseErr)
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+				c.logger.WithContext(ctx).Error("Failed to parse default redirect URL", parseErr)
+				respondWithStatus(ctx, http.StatusInternalServerError, "Failed to process authentication")
 				return
 			}
 		}
@@ -271,8 +354,8 @@ func (c *AuthController) GoogleCallback(ctx *gin.Context) {
 		// Use a hardcoded default URL
 		redirectURL, parseErr = url.Parse(c.cfg.AppURL + "/login")
 		if parseErr != nil {
-			c.logger.Error("Failed to parse default redirect URL", parseErr)
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process authentication"})
+			c.logger.WithContext(ctx).Error("Failed to parse default redirect URL", parseErr)
+			respondWithStatus(ctx, http.StatusInternalServerError, "Failed to process authentication")
 			return
 		}
 	}
@@ -283,8 +366,9 @@ func (c *AuthController) GoogleCallback(ctx *gin.Context) {
 	query.Set("user", string(userJSON))
 	redirectURL.RawQuery = query.Encode()
 
-	// Redirect to frontend application with token and user data
-	ctx.Redirect(http.StatusTemporaryRedirect, redirectURL.String())
+	// Redirect to frontend application with token and user data. See Other turns the form post
+	// of the Apple callback into a GET; the other callbacks already are GET requests.
+	ctx.Redirect(http.StatusSeeOther, redirectURL.String())
 }
 
 // Signout signs out the user
@@ -301,9 +385,7 @@ func (c *AuthController) Signout(ctx *gin.Context) {
 	// Get the token from the Authorization header
 	authHeader := ctx.GetHeader("Authorization")
 	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-		ctx.JSON(http.StatusUnauthorized, models.ErrorResponse{
-			Error: "Unauthorized",
-		})
+		respondWithStatus(ctx, http.StatusUnauthorized, "Unauthorized")
 		return
 	}
 
@@ -314,10 +396,8 @@ func (c *AuthController) Signout(ctx *gin.Context) {
 	success, err := c.authService.Signout(ctx, token)
 
 	if err != nil {
-		c.logger.Error("Failed to sign out user", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to sign out user",
-		})
+		c.logger.WithContext(ctx).Error("Failed to sign out user", err)
+		respondWithError(ctx, err, "Failed to sign out user")
 		return
 	}
 
@@ -325,3 +405,29 @@ func (c *AuthController) Signout(ctx *gin.Context) {
 		Success: success,
 	})
 }
+
+// respondEmailNotVerified answers with 403 when the users-api refused a sign-in because the
+// provider has not verified the user's email, and reports whether it did
+func respondEmailNotVerified(ctx *gin.Context, err error) bool {
+	if status.Code(err) != codes.PermissionDenied {
+		return false
+	}
+	respondWithStatus(ctx, http.StatusForbidden, "Email address is not verified")
+	return true
+}
+
+// tokenErrorCode returns the response code for an Unauthenticated error from a backend, taken
+// from the reason the service's auth interceptor attached to it
+func tokenErrorCode(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			switch info.Reason {
+			case "TOKEN_MISSING":
+				return models.ErrorCodeTokenMissing
+			case "TOKEN_EXPIRED":
+				return models.ErrorCodeTokenExpired
+			}
+		}
+	}
+	return models.ErrorCodeTokenInvalid
+}
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gateway-api/internal/models"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// respondWithBindError answers a request body that could not be bound to request.
+// Validation failures and mistyped fields are reported per field, keyed by their JSON name,
+// instead of as the raw validator message.
+func respondWithBindError(ctx *gin.Context, err error, request interface{}) {
+	var validationErrs validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+
+	switch {
+	case errors.As(err, &validationErrs):
+		details := make(map[string]string, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			details[jsonFieldName(request, fieldErr.StructField())] = validationMessage(fieldErr)
+		}
+		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request",
+			Code:    models.ErrorCodeInvalidArgument,
+			Details: details,
+		})
+	case errors.As(err, &typeErr):
+		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Invalid request",
+			Code:  models.ErrorCodeInvalidArgument,
+			Details: map[string]string{
+				typeErr.Field: "must be a " + jsonTypeName(typeErr.Type),
+			},
+		})
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		respondWithStatus(ctx, http.StatusBadRequest, "Request body is not valid JSON")
+	case errors.Is(err, io.EOF):
+		respondWithStatus(ctx, http.StatusBadRequest, "Request body is required")
+	default:
+		respondWithStatus(ctx, http.StatusBadRequest, "Invalid request")
+	}
+}
+
+// validationMessage describes a failed validation rule in words
+func validationMessage(fieldErr validator.FieldError) string {
+	unit := "characters"
+	switch fieldErr.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		unit = "items"
+	}
+
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "max":
+		return fmt.Sprintf("must be at most %s %s", fieldErr.Param(), unit)
+	case "min":
+		return fmt.Sprintf("must be at least %s %s", fieldErr.Param(), unit)
+	case "gt":
+		return "must be greater than " + fieldErr.Param()
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(fieldErr.Param()), ", ")
+	case "url", "http_url":
+		return "must be a valid URL"
+	case "http_url|eq=":
+		return "must be a valid URL or empty"
+	default:
+		return "is invalid"
+	}
+}
+
+// jsonFieldName returns the JSON name of a field of the request struct, falling back
+// to the Go name for fields without a json tag
+func jsonFieldName(request interface{}, structField string) string {
+	t := reflect.TypeOf(request)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return structField
+	}
+
+	field, ok := t.FieldByName(structField)
+	if !ok {
+		return structField
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return structField
+	}
+	return name
+}
+
+// jsonTypeName names a Go type the way a JSON client thinks of it
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "list"
+	default:
+		return "object"
+	}
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/models"
+)
+
+// bindRoute binds the body of a request to a new request of type T and answers 204 when it is valid
+func bindRoute[T any](router *gin.Engine, path string) {
+	router.POST(path, func(ctx *gin.Context) {
+		var request T
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			respondWithBindError(ctx, err, &request)
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	})
+}
+
+func TestRespondWithBindErrorReportsFields(t *testing.T) {
+	router := newTestRouter("")
+	bindRoute[models.GroupCreateRequest](router, "/groups")
+	bindRoute[models.ProfileUpdateRequest](router, "/profile")
+	bindRoute[models.FriendRequestBatch](router, "/batch")
+
+	tooManyIDs := `{"request_ids":["r"` + strings.Repeat(`,"r"`, 100) + `]}`
+	tests := []struct {
+		name        string
+		path        string
+		body        string
+		wantError   string
+		wantDetails map[string]string
+	}{
+		{"missing field", "/groups", `{"description":"about"}`, "Invalid request", map[string]string{"name": "is required"}},
+		{"too long", "/groups", `{"name":"` + strings.Repeat("x", 256) + `"}`, "Invalid request", map[string]string{"name": "must be at most 255 characters"}},
+		{"several fields", "/groups", `{"avatar":"not a url","visibility":"secret"}`, "Invalid request", map[string]string{
+			"name":       "is required",
+			"avatar":     "must be a valid URL",
+			"visibility": "must be one of public, private",
+		}},
+		{"invalid website", "/profile", `{"website":"ftp://example.com"}`, "Invalid request", map[string]string{"website": "must be a valid URL or empty"}},
+		{"too many items", "/batch", tooManyIDs, "Invalid request", map[string]string{"request_ids": "must be at most 100 items"}},
+		{"mistyped field", "/groups", `{"name":5}`, "Invalid request", map[string]string{"name": "must be a string"}},
+		{"malformed JSON", "/groups", `{"name":`, "Request body is not valid JSON", nil},
+		{"no body", "/groups", "", "Request body is required", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := decodeError(t, serve(router, http.MethodPost, tt.path, tt.body), http.StatusBadRequest)
+			if resp.Error != tt.wantError || resp.Code != models.ErrorCodeInvalidArgument {
+				t.Errorf("got error %q with code %q, want %q", resp.Error, resp.Code, tt.wantError)
+			}
+			if !reflect.DeepEqual(resp.Details, tt.wantDetails) {
+				t.Errorf("got details %v, want %v", resp.Details, tt.wantDetails)
+			}
+		})
+	}
+
+	// Valid requests go through, including an emptied website
+	assertStatus(t, serve(router, http.MethodPost, "/groups", `{"name":"Gophers","visibility":"private"}`), http.StatusNoContent)
+	assertStatus(t, serve(router, http.MethodPost, "/profile", `{"website":""}`), http.StatusNoContent)
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"gateway-api/internal/models"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcError is the HTTP status and error code a gRPC status code is answered with
+type grpcError struct {
+	status int
+	code   string
+}
+
+// grpcErrors maps the gRPC status codes that describe the request, or the backend's
+// availability, to HTTP. Every other code is a server error.
+var grpcErrors = map[codes.Code]grpcError{
+	codes.InvalidArgument:    {http.StatusBadRequest, models.ErrorCodeInvalidArgument},
+	codes.OutOfRange:         {http.StatusBadRequest, models.ErrorCodeInvalidArgument},
+	codes.Unauthenticated:    {http.StatusUnauthorized, models.ErrorCodeTokenInvalid},
+	codes.PermissionDenied:   {http.StatusForbidden, models.ErrorCodePermissionDenied},
+	codes.NotFound:           {http.StatusNotFound, models.ErrorCodeNotFound},
+	codes.AlreadyExists:      {http.StatusConflict, models.ErrorCodeAlreadyExists},
+	codes.FailedPrecondition: {http.StatusConflict, models.ErrorCodeFailedPrecondition},
+	codes.ResourceExhausted:  {http.StatusTooManyRequests, models.ErrorCodeResourceExhausted},
+	codes.Unavailable:        {http.StatusServiceUnavailable, models.ErrorCodeUnavailable},
+	codes.DeadlineExceeded:   {http.StatusGatewayTimeout, models.ErrorCodeDeadlineExceeded},
+}
+
+// statusCodes gives the error code of the HTTP statuses handlers answer with directly
+var statusCodes = map[int]string{
+	http.StatusBadRequest:            models.ErrorCodeInvalidArgument,
+	http.StatusUnauthorized:          models.ErrorCodeTokenInvalid,
+	http.StatusForbidden:             models.ErrorCodePermissionDenied,
+	http.StatusNotFound:              models.ErrorCodeNotFound,
+	http.StatusConflict:              models.ErrorCodeFailedPrecondition,
+	http.StatusGone:                  models.ErrorCodeFailedPrecondition,
+	http.StatusRequestEntityTooLarge: models.ErrorCodeInvalidArgument,
+	http.StatusUnsupportedMediaType:  models.ErrorCodeInvalidArgument,
+	http.StatusTooManyRequests:       models.ErrorCodeResourceExhausted,
+	http.StatusServiceUnavailable:    models.ErrorCodeUnavailable,
+	http.StatusGatewayTimeout:        models.ErrorCodeDeadlineExceeded,
+}
+
+// respondWithError answers a failed backend call. Errors caused by the request keep the
+// backend's message and get the matching 4xx status, along with any field violations it
+// reported. Anything else is answered with message and a 5xx, so internals don't leak.
+func respondWithError(ctx *gin.Context, err error, message string) {
+	st := status.Convert(err)
+	mapped, ok := grpcErrors[st.Code()]
+	if !ok {
+		respondWithStatus(ctx, http.StatusInternalServerError, message)
+		return
+	}
+
+	if mapped.status >= http.StatusInternalServerError {
+		ctx.JSON(mapped.status, models.ErrorResponse{
+			Error: message,
+			Code:  mapped.code,
+		})
+		return
+	}
+
+	// Rejected tokens tell clients whether signing in again helps, as the auth middleware does
+	code := mapped.code
+	if st.Code() == codes.Unauthenticated {
+		code = tokenErrorCode(err)
+	}
+
+	ctx.JSON(mapped.status, models.ErrorResponse{
+		Error:   st.Message(),
+		Code:    code,
+		Details: fieldViolations(st),
+	})
+}
+
+// respondWithStatus answers with an error status and message chosen by the handler
+func respondWithStatus(ctx *gin.Context, httpStatus int, message string) {
+	code, ok := statusCodes[httpStatus]
+	if !ok {
+		code = models.ErrorCodeInternal
+	}
+
+	ctx.JSON(httpStatus, models.ErrorResponse{
+		Error: message,
+		Code:  code,
+	})
+}
+
+// fieldViolations returns the invalid fields a backend attached to its status, if any
+func fieldViolations(st *status.Status) map[string]string {
+	var details map[string]string
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			if details == nil {
+				details = make(map[string]string)
+			}
+			details[violation.GetField()] = violation.GetDescription()
+		}
+	}
+	return details
+}
\ No newline at end of file
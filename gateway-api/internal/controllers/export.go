@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/models"
+	"gateway-api/internal/utils/logger"
+)
+
+// streamNDJSON answers with the items read from a backend stream as NDJSON, one JSON object
+// per line, writing every page as soon as it arrives. next returns the items of the next page,
+// and io.EOF after the last one.
+//
+// A failure before the first page is answered like any other error. Once the download has
+// started its status can no longer change, so a later failure ends it with an error object as
+// its last line. A client that disconnects cancels the stream and gets nothing more.
+func streamNDJSON[T any](ctx *gin.Context, log *logger.Logger, filename, failure string, next func() ([]T, error)) {
+	items, err := next()
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.WithContext(ctx).Error(failure, err)
+		respondWithError(ctx, err, failure)
+		return
+	}
+
+	ctx.Header("Content-Type", "application/x-ndjson")
+	ctx.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	ctx.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(ctx.Writer)
+	for err == nil {
+		for _, item := range items {
+			if err := encoder.Encode(item); err != nil {
+				// The client is gone; the stream is canceled with the request
+				return
+			}
+		}
+		ctx.Writer.Flush()
+
+		items, err = next()
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(ctx.Request.Context().Err(), context.Canceled) {
+		return
+	}
+
+	log.WithContext(ctx).Error(failure, err)
+	code := models.ErrorCodeInternal
+	if errors.Is(ctx.Request.Context().Err(), context.DeadlineExceeded) {
+		code = models.ErrorCodeDeadlineExceeded
+	}
+	_ = encoder.Encode(models.ErrorResponse{Error: failure, Code: code})
+	ctx.Writer.Flush()
+}
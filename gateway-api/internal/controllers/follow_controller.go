@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	friends2 "common/pb/common/proto/friends"
+	"gateway-api/internal/models"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Follow handles following a user
+// @Summary Follow a user
+// @Description Follow a user to see their posts in the following feed. Following is one-way and does not need the other user's approval
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID to follow"
+// @Success 200 {object} models.SuccessResponse "User followed successfully"
+// @Failure 400 {object} models.ErrorResponse "Cannot follow yourself"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Blocked by or blocking the user"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 409 {object} models.ErrorResponse "Already following the user"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/{id}/follow [post]
+func (c *FriendController) Follow(ctx *gin.Context) {
+	followeeID := ctx.Param("id")
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.Follow(authCtx, &friends2.FollowRequest{
+		UserId: followeeID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to follow user", err)
+		respondWithError(ctx, err, "Failed to follow user")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: resp.Success,
+	})
+}
+
+// Unfollow handles unfollowing a user
+// @Summary Unfollow a user
+// @Description Stop following a user
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID to unfollow"
+// @Success 200 {object} models.SuccessResponse "User unfollowed successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Not following the user"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/{id}/follow [delete]
+func (c *FriendController) Unfollow(ctx *gin.Context) {
+	followeeID := ctx.Param("id")
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.Unfollow(authCtx, &friends2.UnfollowRequest{
+		UserId: followeeID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to unfollow user", err)
+		respondWithError(ctx, err, "Failed to unfollow user")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: resp.Success,
+	})
+}
+
+// GetFollowers handles retrieving the followers of a user
+// @Summary Get followers
+// @Description Get the users who follow a user, most recent first, with pagination
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of users per page" default(10)
+// @Success 200 {object} models.FollowsResponse "Followers with pagination"
+// @Failure 400 {object} models.ErrorResponse "Invalid pagination"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/{id}/followers [get]
+func (c *FriendController) GetFollowers(ctx *gin.Context) {
+	userID := ctx.Param("id")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.GetFollowers(authCtx, &friends2.GetFollowersRequest{
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get followers", err)
+		respondWithError(ctx, err, "Failed to get followers")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convertFollows(resp, page, limit))
+}
+
+// GetFollowing handles retrieving the users a user follows
+// @Summary Get followed users
+// @Description Get the users a user follows, most recent first, with pagination
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of users per page" default(10)
+// @Success 200 {object} models.FollowsResponse "Followed users with pagination"
+// @Failure 400 {object} models.ErrorResponse "Invalid pagination"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/{id}/following [get]
+func (c *FriendController) GetFollowing(ctx *gin.Context) {
+	userID := ctx.Param("id")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.GetFollowing(authCtx, &friends2.GetFollowingRequest{
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get followed users", err)
+		respondWithError(ctx, err, "Failed to get followed users")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convertFollows(resp, page, limit))
+}
+
+// convertFollows converts a page of follows to model format
+func convertFollows(resp *friends2.GetFollowsResponse, page, limit int) models.FollowsResponse {
+	users := make([]models.FollowUser, len(resp.Users))
+	for i, user := range resp.Users {
+		users[i] = models.FollowUser{
+			UserID:     user.UserId,
+			Name:       user.Name,
+			Avatar:     user.Avatar,
+			FollowedAt: user.FollowedAt,
+		}
+	}
+
+	return models.FollowsResponse{
+		Users:      users,
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	}
+}
@@ -7,12 +7,12 @@ import (
 	"gateway-api/internal/models"
 
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"gateway-api/internal/config"
 	"gateway-api/internal/utils/logger"
@@ -44,17 +44,11 @@ func (c *FriendController) createAuthContext(ctx *gin.Context) (context.Context,
 	})
 
 	// Create a new context with the metadata
-	return metadata.NewOutgoingContext(context.Background(), md), nil
+	return metadata.NewOutgoingContext(ctx, md), nil
 }
 
 // NewFriendController creates a new friend controller
-func NewFriendController(cfg *config.Config, logger *logger.Logger) *FriendController {
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.FriendsServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to friends service", err)
-	}
-
+func NewFriendController(cfg *config.Config, logger *logger.Logger, conn *grpc.ClientConn) *FriendController {
 	// Create a client
 	client := friends2.NewFriendServiceClient(conn)
 
@@ -73,23 +67,26 @@ func NewFriendController(cfg *config.Config, logger *logger.Logger) *FriendContr
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of friends per page" default(10)
+// @Param sort query string false "Sort order, most recently added, longest-standing or alphabetical" Enums(recent, oldest, name_asc)
 // @Success 200 {object} models.FriendsResponse "Friends list with pagination"
+// @Failure 400 {object} models.ErrorResponse "Invalid sort order"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /friends [get]
 func (c *FriendController) GetFriends(ctx *gin.Context) {
 	userID := ctx.GetString("userID")
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+	sort := ctx.Query("sort")
 
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -98,33 +95,118 @@ func (c *FriendController) GetFriends(ctx *gin.Context) {
 		UserId: userID,
 		Page:   int32(page),
 		Limit:  int32(limit),
+		Sort:   sort,
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to get friends", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get friends",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get friends", err)
+		respondWithError(ctx, err, "Failed to get friends")
 		return
 	}
 
-	// Convert friends to model format
-	friends := make([]models.Friend, len(resp.Friends))
-	for i, friend := range resp.Friends {
-		friends[i] = models.Friend{
-			UserID:       friend.UserId,
-			Name:         friend.Name,
-			Avatar:       friend.Avatar,
-			Email:        friend.Email,
-			FriendsSince: friend.FriendsSince,
+	ctx.JSON(http.StatusOK, models.FriendsResponse{
+		Friends:    convertFriends(resp.Friends),
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// ExportFriends handles downloading all of the user's friends
+// @Summary Export friends
+// @Description Download all friends of the current user as NDJSON, one friend per line. The friends are streamed from the friends service a page at a time, so the download starts right away however many friends there are. If the export fails partway, its last line is an error object
+// @Tags friends
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param sort query string false "Sort order, most recently added, longest-standing or alphabetical" Enums(recent, oldest, name_asc)
+// @Success 200 {object} models.Friend "One friend per line"
+// @Failure 400 {object} models.ErrorResponse "Invalid sort order"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/export [get]
+func (c *FriendController) ExportFriends(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Open the stream; it is canceled with the request
+	stream, err := c.client.StreamFriends(authCtx, &friends2.StreamFriendsRequest{
+		UserId: userID,
+		Sort:   ctx.Query("sort"),
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to export friends", err)
+		respondWithError(ctx, err, "Failed to export friends")
+		return
+	}
+
+	streamNDJSON(ctx, c.logger, "friends.ndjson", "Failed to export friends", func() ([]models.Friend, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
 		}
+		return convertFriends(resp.Friends), nil
+	})
+}
+
+// GetMutualFriends handles retrieving the friends the user has in common with another user
+// @Summary Get mutual friends
+// @Description Get the friends the current user has in common with another user, with pagination. Users who have blocked, or been blocked by, either user are left out
+// @Tags friends
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Other user ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of friends per page" default(10)
+// @Success 200 {object} models.FriendsResponse "Mutual friends with pagination"
+// @Failure 400 {object} models.ErrorResponse "Invalid user ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/{id}/mutual [get]
+func (c *FriendController) GetMutualFriends(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+	otherUserID := ctx.Param("id")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.GetMutualFriends(authCtx, &friends2.GetMutualFriendsRequest{
+		UserId:      userID,
+		OtherUserId: otherUserID,
+		Page:        int32(page),
+		Limit:       int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get mutual friends", err)
+		respondWithError(ctx, err, "Failed to get mutual friends")
+		return
 	}
 
 	ctx.JSON(http.StatusOK, models.FriendsResponse{
-		Friends:    friends,
+		Friends:    convertFriends(resp.Friends),
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	})
 }
 
@@ -139,6 +221,9 @@ func (c *FriendController) GetFriends(ctx *gin.Context) {
 // @Success 201 {object} models.FriendRequestDetails "Friend request sent successfully"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Blocked by or blocking the recipient"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 409 {object} models.ErrorResponse "Already friends or request already sent"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /friends/requests [post]
 func (c *FriendController) SendFriendRequest(ctx *gin.Context) {
@@ -147,19 +232,20 @@ func (c *FriendController) SendFriendRequest(ctx *gin.Context) {
 	var request models.FriendRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	if request.FriendID == userID {
+		respondWithStatus(ctx, http.StatusBadRequest, "Cannot send a friend request to yourself")
 		return
 	}
 
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -170,10 +256,8 @@ func (c *FriendController) SendFriendRequest(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to send friend request", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to send friend request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to send friend request", err)
+		respondWithError(ctx, err, "Failed to send friend request")
 		return
 	}
 
@@ -198,42 +282,48 @@ func (c *FriendController) SendFriendRequest(ctx *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param status query string false "Filter requests by status" Enums(pending, accepted, rejected) default(pending)
+// @Param direction query string false "Requests the user received, sent, or both" Enums(incoming, outgoing, all) default(incoming)
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of requests per page" default(10)
 // @Success 200 {object} models.FriendRequestsResponse "Friend requests with pagination"
+// @Failure 400 {object} models.ErrorResponse "Invalid direction"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /friends/requests [get]
 func (c *FriendController) GetFriendRequests(ctx *gin.Context) {
 	userID := ctx.GetString("userID")
-	status := ctx.DefaultQuery("status", "pending")
+	requestStatus := ctx.DefaultQuery("status", "pending")
+	direction := ctx.DefaultQuery("direction", "incoming")
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
 
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
 	// Call the gRPC service
 	resp, err := c.client.GetFriendRequests(authCtx, &friends2.GetFriendRequestsRequest{
-		UserId: userID,
-		Status: status,
-		Page:   int32(page),
-		Limit:  int32(limit),
+		UserId:    userID,
+		Status:    requestStatus,
+		Direction: direction,
+		Page:      int32(page),
+		Limit:     int32(limit),
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to get friend requests", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get friend requests",
-		})
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, "Direction must be incoming, outgoing or all")
+			return
+		}
+		c.logger.WithContext(ctx).Error("Failed to get friend requests", err)
+		respondWithError(ctx, err, "Failed to get friend requests")
 		return
 	}
 
@@ -251,6 +341,7 @@ func (c *FriendController) GetFriendRequests(ctx *gin.Context) {
 			Status:         request.Status,
 			CreatedAt:      request.CreatedAt,
 			UpdatedAt:      request.UpdatedAt,
+			Direction:      request.Direction,
 		}
 	}
 
@@ -259,6 +350,7 @@ func (c *FriendController) GetFriendRequests(ctx *gin.Context) {
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	})
 }
 
@@ -271,7 +363,9 @@ func (c *FriendController) GetFriendRequests(ctx *gin.Context) {
 // @Param id path string true "Request ID"
 // @Success 200 {object} models.FriendRequestDetails "Friend request accepted successfully"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Only the recipient can accept the request"
 // @Failure 404 {object} models.ErrorResponse "Request not found"
+// @Failure 409 {object} models.ErrorResponse "Request is no longer pending"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /friends/requests/{id}/accept [put]
 func (c *FriendController) AcceptFriendRequest(ctx *gin.Context) {
@@ -281,10 +375,8 @@ func (c *FriendController) AcceptFriendRequest(ctx *gin.Context) {
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -295,10 +387,8 @@ func (c *FriendController) AcceptFriendRequest(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to accept friend request", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to accept friend request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to accept friend request", err)
+		respondWithError(ctx, err, "Failed to accept friend request")
 		return
 	}
 
@@ -325,7 +415,9 @@ func (c *FriendController) AcceptFriendRequest(ctx *gin.Context) {
 // @Param id path string true "Request ID"
 // @Success 200 {object} models.FriendRequestDetails "Friend request rejected successfully"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Only the recipient can reject the request"
 // @Failure 404 {object} models.ErrorResponse "Request not found"
+// @Failure 409 {object} models.ErrorResponse "Request is no longer pending"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /friends/requests/{id}/reject [put]
 func (c *FriendController) RejectFriendRequest(ctx *gin.Context) {
@@ -335,10 +427,8 @@ func (c *FriendController) RejectFriendRequest(ctx *gin.Context) {
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -349,10 +439,8 @@ func (c *FriendController) RejectFriendRequest(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to reject friend request", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to reject friend request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to reject friend request", err)
+		respondWithError(ctx, err, "Failed to reject friend request")
 		return
 	}
 
@@ -370,6 +458,58 @@ func (c *FriendController) RejectFriendRequest(ctx *gin.Context) {
 	})
 }
 
+// CancelFriendRequest handles withdrawing a sent friend request
+// @Summary Cancel a friend request
+// @Description Cancel a pending friend request that the current user sent. A new request can be sent afterwards
+// @Tags friends
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Request ID"
+// @Success 200 {object} models.SuccessResponse "Friend request canceled successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Only the sender can cancel the request"
+// @Failure 404 {object} models.ErrorResponse "Request not found"
+// @Failure 409 {object} models.ErrorResponse "Request is no longer pending"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/requests/{id} [delete]
+func (c *FriendController) CancelFriendRequest(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+	requestID := ctx.Param("id")
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.CancelFriendRequest(authCtx, &friends2.CancelFriendRequestRequest{
+		RequestId: requestID,
+		UserId:    userID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to cancel friend request", err)
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "Only the sender can cancel this friend request")
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Friend request not found")
+		case codes.FailedPrecondition:
+			respondWithStatus(ctx, http.StatusConflict, "Friend request is no longer pending")
+		default:
+			respondWithError(ctx, err, "Failed to cancel friend request")
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: resp.Success,
+	})
+}
+
 // RemoveFriend handles removing a friend
 // @Summary Remove a friend
 // @Description Remove a friend
@@ -389,10 +529,8 @@ func (c *FriendController) RemoveFriend(ctx *gin.Context) {
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -403,10 +541,8 @@ func (c *FriendController) RemoveFriend(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to remove friend", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to remove friend",
-		})
+		c.logger.WithContext(ctx).Error("Failed to remove friend", err)
+		respondWithError(ctx, err, "Failed to remove friend")
 		return
 	}
 
@@ -415,6 +551,110 @@ func (c *FriendController) RemoveFriend(ctx *gin.Context) {
 	})
 }
 
+// GetBlockedUsers handles retrieving the users the current user has blocked
+// @Summary Get blocked users
+// @Description Get the users the current user has blocked, most recently blocked first, with pagination
+// @Tags friends
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of blocked users per page" default(10)
+// @Success 200 {object} models.BlockedUsersResponse "Blocked users with pagination"
+// @Failure 400 {object} models.ErrorResponse "Invalid pagination"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/blocked [get]
+func (c *FriendController) GetBlockedUsers(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.GetBlockedUsers(authCtx, &friends2.GetBlockedUsersRequest{
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get blocked users", err)
+		respondWithError(ctx, err, "Failed to get blocked users")
+		return
+	}
+
+	// Convert blocked users to model format
+	blockedUsers := make([]models.BlockedUser, len(resp.BlockedUsers))
+	for i, blockedUser := range resp.BlockedUsers {
+		blockedUsers[i] = models.BlockedUser{
+			UserID:    blockedUser.UserId,
+			Name:      blockedUser.Name,
+			Avatar:    blockedUser.Avatar,
+			BlockedAt: blockedUser.BlockedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, models.BlockedUsersResponse{
+		BlockedUsers: blockedUsers,
+		TotalCount:   resp.TotalCount,
+		Page:         resp.Page,
+		TotalPages:   resp.TotalPages,
+		Pagination:   models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// CheckFriendship handles retrieving the relationship between the current user and another user
+// @Summary Get friendship status
+// @Description Get whether the current user is friends with another user, has a pending request with them or is blocked. The status is one of none, pending, friends, blocked or self, and request_id is set while a request is pending
+// @Tags friends
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Other user ID"
+// @Success 200 {object} models.FriendshipStatus "Friendship status"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/{id}/status [get]
+func (c *FriendController) CheckFriendship(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+	otherUserID := ctx.Param("id")
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.CheckFriendship(authCtx, &friends2.CheckFriendshipRequest{
+		UserId:   userID,
+		FriendId: otherUserID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to check friendship", err)
+		respondWithError(ctx, err, "Failed to check friendship")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.FriendshipStatus{
+		AreFriends: resp.AreFriends,
+		Status:     resp.Status,
+		RequestID:  resp.RequestId,
+	})
+}
+
 // BlockUser handles blocking a user
 // @Summary Block a user
 // @Description Block a user
@@ -425,6 +665,7 @@ func (c *FriendController) RemoveFriend(ctx *gin.Context) {
 // @Success 200 {object} models.SuccessResponse "User blocked successfully"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 409 {object} models.ErrorResponse "User is already blocked"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /friends/block/{id} [post]
 func (c *FriendController) BlockUser(ctx *gin.Context) {
@@ -434,10 +675,8 @@ func (c *FriendController) BlockUser(ctx *gin.Context) {
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -448,10 +687,8 @@ func (c *FriendController) BlockUser(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to block user", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to block user",
-		})
+		c.logger.WithContext(ctx).Error("Failed to block user", err)
+		respondWithError(ctx, err, "Failed to block user")
 		return
 	}
 
@@ -479,10 +716,8 @@ func (c *FriendController) UnblockUser(ctx *gin.Context) {
 	// Create context with authorization metadata
 	authCtx, err := c.createAuthContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to create auth context", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to authenticate request",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
 		return
 	}
 
@@ -493,10 +728,8 @@ func (c *FriendController) UnblockUser(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to unblock user", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to unblock user",
-		})
+		c.logger.WithContext(ctx).Error("Failed to unblock user", err)
+		respondWithError(ctx, err, "Failed to unblock user")
 		return
 	}
 
@@ -504,3 +737,20 @@ func (c *FriendController) UnblockUser(ctx *gin.Context) {
 		Success: resp.Success,
 	})
 }
+
+// convertFriends converts friends from the friends service to the API model
+func convertFriends(friends []*friends2.FriendResponse) []models.Friend {
+	converted := make([]models.Friend, len(friends))
+	for i, friend := range friends {
+		converted[i] = models.Friend{
+			UserID:       friend.UserId,
+			Name:         friend.Name,
+			Avatar:       friend.Avatar,
+			Email:        friend.Email,
+			FriendsSince: friend.FriendsSince,
+			LastSeenAt:   friend.LastSeenAt,
+			IsOnline:     friend.IsOnline,
+		}
+	}
+	return converted
+}
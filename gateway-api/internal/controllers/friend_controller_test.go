@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	pb "common/pb/common/proto/friends"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gateway-api/internal/models"
+)
+
+// fakeFriendServer answers every friend call with err, and counts the friend requests it receives
+type fakeFriendServer struct {
+	pb.UnimplementedFriendServiceServer
+	err      error
+	requests atomic.Int32
+}
+
+func (s *fakeFriendServer) SendFriendRequest(ctx context.Context, req *pb.SendFriendRequestRequest) (*pb.FriendRequestResponse, error) {
+	s.requests.Add(1)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pb.FriendRequestResponse{RequestId: "r1", SenderId: req.UserId, ReceiverId: req.FriendId, Status: "pending"}, nil
+}
+
+func (s *fakeFriendServer) AcceptFriendRequest(ctx context.Context, req *pb.AcceptFriendRequestRequest) (*pb.FriendRequestResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeFriendServer) RemoveFriend(ctx context.Context, req *pb.RemoveFriendRequest) (*pb.RemoveFriendResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeFriendServer) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeFriendServer) CheckFriendship(ctx context.Context, req *pb.CheckFriendshipRequest) (*pb.CheckFriendshipResponse, error) {
+	return nil, s.err
+}
+
+func newFriendRouter(t *testing.T, server pb.FriendServiceServer) *gin.Engine {
+	conn := newBufConn(t, func(s *grpc.Server) {
+		pb.RegisterFriendServiceServer(s, server)
+	})
+	controller := NewFriendController(newTestConfig(), newTestLogger(), conn)
+
+	router := newTestRouter("alice")
+	router.POST("/friends/requests", controller.SendFriendRequest)
+	router.PUT("/friends/requests/:id/accept", controller.AcceptFriendRequest)
+	router.DELETE("/friends/:id", controller.RemoveFriend)
+	router.POST("/friends/block/:id", controller.BlockUser)
+	router.GET("/friends/:id/status", controller.CheckFriendship)
+	return router
+}
+
+func TestFriendCallsMapBackendErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"accept a missing request", http.MethodPut, "/friends/requests/r1/accept", status.Error(codes.NotFound, "friend request not found"), http.StatusNotFound, models.ErrorCodeNotFound},
+		{"accept another user's request", http.MethodPut, "/friends/requests/r1/accept", status.Error(codes.PermissionDenied, "not the receiver of this request"), http.StatusForbidden, models.ErrorCodePermissionDenied},
+		{"remove a non-friend", http.MethodDelete, "/friends/bob", status.Error(codes.NotFound, "not friends"), http.StatusNotFound, models.ErrorCodeNotFound},
+		{"block a missing user", http.MethodPost, "/friends/block/bob", status.Error(codes.NotFound, "user not found"), http.StatusNotFound, models.ErrorCodeNotFound},
+		{"check a blocked user", http.MethodGet, "/friends/bob/status", status.Error(codes.PermissionDenied, "blocked"), http.StatusForbidden, models.ErrorCodePermissionDenied},
+		{"backend down", http.MethodDelete, "/friends/bob", status.Error(codes.Internal, "database unavailable"), http.StatusInternalServerError, models.ErrorCodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newFriendRouter(t, &fakeFriendServer{err: tt.err})
+			resp := decodeError(t, serve(router, tt.method, tt.path, ""), tt.wantStatus)
+			if resp.Code != tt.wantCode {
+				t.Errorf("got code %q, want %q", resp.Code, tt.wantCode)
+			}
+
+			// The backend's message is passed on for client errors only
+			message := status.Convert(tt.err).Message()
+			if isClientError := tt.wantStatus < http.StatusInternalServerError; (resp.Error == message) != isClientError {
+				t.Errorf("got message %q for backend message %q", resp.Error, message)
+			}
+		})
+	}
+}
+
+func TestSendFriendRequestValidatesTarget(t *testing.T) {
+	// Requests to oneself are refused without asking the friends service
+	server := &fakeFriendServer{}
+	router := newFriendRouter(t, server)
+	resp := decodeError(t, serve(router, http.MethodPost, "/friends/requests", `{"friend_id":"alice"}`), http.StatusBadRequest)
+	if resp.Error != "Cannot send a friend request to yourself" {
+		t.Errorf("got error %q, want the self-request error", resp.Error)
+	}
+	if got := server.requests.Load(); got != 0 {
+		t.Errorf("friends service called %d times for a self-request, want 0", got)
+	}
+
+	// The friends service looks the target up, and an unknown one is a 404
+	server = &fakeFriendServer{err: status.Error(codes.NotFound, "user not found")}
+	router = newFriendRouter(t, server)
+	resp = decodeError(t, serve(router, http.MethodPost, "/friends/requests", `{"friend_id":"ghost"}`), http.StatusNotFound)
+	if resp.Error != "user not found" || resp.Code != models.ErrorCodeNotFound {
+		t.Errorf("got %+v, want the user not found error", resp)
+	}
+
+	server = &fakeFriendServer{}
+	router = newFriendRouter(t, server)
+	assertStatus(t, serve(router, http.MethodPost, "/friends/requests", `{"friend_id":"bob"}`), http.StatusCreated)
+}
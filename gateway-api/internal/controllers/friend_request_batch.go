@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	friends2 "common/pb/common/proto/friends"
+	"gateway-api/internal/models"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AcceptFriendRequests handles accepting several friend requests at once
+// @Summary Accept several friend requests
+// @Description Accept up to 100 friend requests at once. Each request is accepted on its own, so one that cannot be accepted does not stop the others; the response has the outcome for each
+// @Tags friends
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.FriendRequestBatch true "Friend requests to accept"
+// @Success 200 {object} models.FriendRequestBatchResponse "Outcome for each friend request"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/requests/accept [post]
+func (c *FriendController) AcceptFriendRequests(ctx *gin.Context) {
+	var request models.FriendRequestBatch
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.AcceptFriendRequests(authCtx, &friends2.AcceptFriendRequestsRequest{
+		RequestIds: request.RequestIDs,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to accept friend requests", err)
+		respondWithError(ctx, err, "Failed to accept friend requests")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convertFriendRequestBatch(resp))
+}
+
+// RejectFriendRequests handles rejecting several friend requests at once
+// @Summary Reject several friend requests
+// @Description Reject up to 100 friend requests at once. Each request is rejected on its own, so one that cannot be rejected does not stop the others; the response has the outcome for each
+// @Tags friends
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.FriendRequestBatch true "Friend requests to reject"
+// @Success 200 {object} models.FriendRequestBatchResponse "Outcome for each friend request"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/requests/reject [post]
+func (c *FriendController) RejectFriendRequests(ctx *gin.Context) {
+	var request models.FriendRequestBatch
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.RejectFriendRequests(authCtx, &friends2.RejectFriendRequestsRequest{
+		RequestIds: request.RequestIDs,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to reject friend requests", err)
+		respondWithError(ctx, err, "Failed to reject friend requests")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convertFriendRequestBatch(resp))
+}
+
+// AcceptAllFriendRequests handles accepting every pending friend request
+// @Summary Accept all friend requests
+// @Description Accept every pending friend request the current user has received, oldest first. The response has the outcome for each
+// @Tags friends
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.FriendRequestBatchResponse "Outcome for each friend request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /friends/requests/accept-all [post]
+func (c *FriendController) AcceptAllFriendRequests(ctx *gin.Context) {
+	// Create context with authorization metadata
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	// Call the gRPC service
+	resp, err := c.client.AcceptAllFriendRequests(authCtx, &friends2.AcceptAllFriendRequestsRequest{})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to accept all friend requests", err)
+		respondWithError(ctx, err, "Failed to accept all friend requests")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convertFriendRequestBatch(resp))
+}
+
+// convertFriendRequestBatch converts the outcomes for a batch of friend requests to model format
+func convertFriendRequestBatch(resp *friends2.BatchFriendRequestsResponse) models.FriendRequestBatchResponse {
+	results := make([]models.FriendRequestResult, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = models.FriendRequestResult{
+			RequestID: result.RequestId,
+			Success:   result.Success,
+			Error:     result.Error,
+		}
+		if request := result.Request; request != nil {
+			results[i].Request = &models.FriendRequestDetails{
+				RequestID:      request.RequestId,
+				SenderID:       request.SenderId,
+				SenderName:     request.SenderName,
+				SenderAvatar:   request.SenderAvatar,
+				ReceiverID:     request.ReceiverId,
+				ReceiverName:   request.ReceiverName,
+				ReceiverAvatar: request.ReceiverAvatar,
+				Status:         request.Status,
+				CreatedAt:      request.CreatedAt,
+				UpdatedAt:      request.UpdatedAt,
+			}
+		}
+	}
+
+	return models.FriendRequestBatchResponse{
+		Results:        results,
+		SucceededCount: resp.SucceededCount,
+		FailedCount:    resp.FailedCount,
+	}
+}
@@ -1,14 +1,13 @@
 package controllers
 
 import (
-	"context"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	pb "common/pb/common/proto/groups"
 	"gateway-api/internal/config"
@@ -24,13 +23,7 @@ type GroupController struct {
 }
 
 // NewGroupController creates a new group controller
-func NewGroupController(cfg *config.Config, logger *logger.Logger) *GroupController {
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.GroupsServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to groups service", err)
-	}
-
+func NewGroupController(cfg *config.Config, logger *logger.Logger, conn *grpc.ClientConn) *GroupController {
 	// Create a client
 	client := pb.NewGroupServiceClient(conn)
 
@@ -61,9 +54,7 @@ func (c *GroupController) CreateGroup(ctx *gin.Context) {
 	var request models.GroupCreateRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -73,7 +64,7 @@ func (c *GroupController) CreateGroup(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.CreateGroup(ctxWithToken, &pb.CreateGroupRequest{
@@ -81,13 +72,12 @@ func (c *GroupController) CreateGroup(ctx *gin.Context) {
 		Name:        request.Name,
 		Description: request.Description,
 		Avatar:      request.Avatar,
+		Visibility:  request.Visibility,
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to create group", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to create group",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create group", err)
+		respondWithError(ctx, err, "Failed to create group")
 		return
 	}
 
@@ -101,6 +91,7 @@ func (c *GroupController) CreateGroup(ctx *gin.Context) {
 		"members_count": resp.MembersCount,
 		"posts_count":   resp.PostsCount,
 		"is_member":     resp.IsMember,
+		"visibility":    resp.Visibility,
 		"created_at":    resp.CreatedAt,
 		"updated_at":    resp.UpdatedAt,
 	})
@@ -127,7 +118,7 @@ func (c *GroupController) GetGroup(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.GetGroup(ctxWithToken, &pb.GetGroupRequest{
@@ -136,10 +127,8 @@ func (c *GroupController) GetGroup(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to get group", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get group",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get group", err)
+		respondWithError(ctx, err, "Failed to get group")
 		return
 	}
 
@@ -151,6 +140,7 @@ func (c *GroupController) GetGroup(ctx *gin.Context) {
 		CreatorID:    resp.CreatorId,
 		CreatorName:  resp.CreatorName,
 		MembersCount: resp.MembersCount,
+		Visibility:   resp.Visibility,
 		CreatedAt:    resp.CreatedAt,
 		UpdatedAt:    resp.UpdatedAt,
 	})
@@ -164,16 +154,21 @@ func (c *GroupController) GetGroup(ctx *gin.Context) {
 // @Param query query string false "Search query"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of groups per page" default(10)
+// @Param sort query string false "Sort order, by creation date, member count or name" Enums(recent, oldest, members_desc, name_asc)
 // @Success 200 {object} models.GroupsResponse "Groups"
+// @Failure 400 {object} models.ErrorResponse "Invalid sort order"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /groups [get]
 func (c *GroupController) GetGroups(ctx *gin.Context) {
 	userID := ctx.GetString("userID") // May be empty if not authenticated
 	query := ctx.Query("query")
+	sort := ctx.Query("sort")
 	token := ctx.GetString("jwt_token")
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
 
 	// Create metadata with authorization token
 	md := metadata.New(map[string]string{
@@ -181,7 +176,7 @@ func (c *GroupController) GetGroups(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.GetGroups(ctxWithToken, &pb.GetGroupsRequest{
@@ -189,13 +184,12 @@ func (c *GroupController) GetGroups(ctx *gin.Context) {
 		Query:  query,
 		Page:   int32(page),
 		Limit:  int32(limit),
+		Sort:   sort,
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to get groups", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get groups",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get groups", err)
+		respondWithError(ctx, err, "Failed to get groups")
 		return
 	}
 
@@ -210,6 +204,7 @@ func (c *GroupController) GetGroups(ctx *gin.Context) {
 			CreatorID:    group.CreatorId,
 			CreatorName:  group.CreatorName,
 			MembersCount: group.MembersCount,
+			Visibility:   group.Visibility,
 			CreatedAt:    group.CreatedAt,
 			UpdatedAt:    group.UpdatedAt,
 		}
@@ -220,6 +215,74 @@ func (c *GroupController) GetGroups(ctx *gin.Context) {
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// GetUserGroups handles retrieving the groups the current user belongs to
+// @Summary Get my groups
+// @Description Get the groups the current user is a member of, most recently joined first, with the user's role in each
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of groups per page" default(10)
+// @Success 200 {object} models.GroupsResponse "Groups"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/me/groups [get]
+func (c *GroupController) GetUserGroups(ctx *gin.Context) {
+	token := ctx.GetString("jwt_token")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.GetUserGroups(ctxWithToken, &pb.GetUserGroupsRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get user groups", err)
+		respondWithError(ctx, err, "Failed to get groups")
+		return
+	}
+
+	// Convert groups to model format
+	groups := make([]models.Group, len(resp.Groups))
+	for i, group := range resp.Groups {
+		groups[i] = models.Group{
+			GroupID:      group.GroupId,
+			Name:         group.Name,
+			Description:  group.Description,
+			Avatar:       group.Avatar,
+			CreatorID:    group.CreatorId,
+			CreatorName:  group.CreatorName,
+			MembersCount: group.MembersCount,
+			Visibility:   group.Visibility,
+			CreatedAt:    group.CreatedAt,
+			UpdatedAt:    group.UpdatedAt,
+			Role:         group.Role,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, models.GroupsResponse{
+		Groups:     groups,
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	})
 }
 
@@ -235,6 +298,7 @@ func (c *GroupController) GetGroups(ctx *gin.Context) {
 // @Success 200 {object} models.Group "Group updated successfully"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Only the creator or an admin can update the group"
 // @Failure 404 {object} models.ErrorResponse "Group not found"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /groups/{id} [put]
@@ -246,9 +310,7 @@ func (c *GroupController) UpdateGroup(ctx *gin.Context) {
 	var request models.GroupUpdateRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -258,7 +320,7 @@ func (c *GroupController) UpdateGroup(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.UpdateGroup(ctxWithToken, &pb.UpdateGroupRequest{
@@ -267,13 +329,12 @@ func (c *GroupController) UpdateGroup(ctx *gin.Context) {
 		Name:        request.Name,
 		Description: request.Description,
 		Avatar:      request.Avatar,
+		Visibility:  request.Visibility,
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to update group", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to update group",
-		})
+		c.logger.WithContext(ctx).Error("Failed to update group", err)
+		respondWithError(ctx, err, "Failed to update group")
 		return
 	}
 
@@ -285,6 +346,7 @@ func (c *GroupController) UpdateGroup(ctx *gin.Context) {
 		CreatorID:    resp.CreatorId,
 		CreatorName:  resp.CreatorName,
 		MembersCount: resp.MembersCount,
+		Visibility:   resp.Visibility,
 		CreatedAt:    resp.CreatedAt,
 		UpdatedAt:    resp.UpdatedAt,
 	})
@@ -299,6 +361,7 @@ func (c *GroupController) UpdateGroup(ctx *gin.Context) {
 // @Param id path string true "Group ID"
 // @Success 200 {object} models.SuccessResponse "Group deleted successfully"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Only the creator can delete the group"
 // @Failure 404 {object} models.ErrorResponse "Group not found"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /groups/{id} [delete]
@@ -313,7 +376,7 @@ func (c *GroupController) DeleteGroup(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.DeleteGroup(ctxWithToken, &pb.DeleteGroupRequest{
@@ -322,10 +385,8 @@ func (c *GroupController) DeleteGroup(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to delete group", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to delete group",
-		})
+		c.logger.WithContext(ctx).Error("Failed to delete group", err)
+		respondWithError(ctx, err, "Failed to delete group")
 		return
 	}
 
@@ -336,14 +397,15 @@ func (c *GroupController) DeleteGroup(ctx *gin.Context) {
 
 // JoinGroup handles joining a group
 // @Summary Join a group
-// @Description Join a group
+// @Description Join a public group, or ask to join a private group. Requests to join a private group stay pending until an admin approves them
 // @Tags groups
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Group ID"
-// @Success 200 {object} models.SuccessWithCountResponse "Group joined successfully"
+// @Success 200 {object} models.JoinGroupResponse "Group joined or join request pending"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 404 {object} models.ErrorResponse "Group not found"
+// @Failure 409 {object} models.ErrorResponse "Already a member or join request pending"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /groups/{id}/members [post]
 func (c *GroupController) JoinGroup(ctx *gin.Context) {
@@ -357,7 +419,7 @@ func (c *GroupController) JoinGroup(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.JoinGroup(ctxWithToken, &pb.JoinGroupRequest{
@@ -366,16 +428,15 @@ func (c *GroupController) JoinGroup(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to join group", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to join group",
-		})
+		c.logger.WithContext(ctx).Error("Failed to join group", err)
+		respondWithError(ctx, err, "Failed to join group")
 		return
 	}
 
-	ctx.JSON(http.StatusOK, models.SuccessWithCountResponse{
+	ctx.JSON(http.StatusOK, models.JoinGroupResponse{
 		Success:      resp.Success,
 		MembersCount: int(resp.MembersCount),
+		Pending:      resp.Pending,
 	})
 }
 
@@ -389,6 +450,7 @@ func (c *GroupController) JoinGroup(ctx *gin.Context) {
 // @Success 200 {object} models.SuccessWithCountResponse "Group left successfully"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 404 {object} models.ErrorResponse "Group not found"
+// @Failure 409 {object} models.ErrorResponse "Not a member, or the creator"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /groups/{id}/members [delete]
 func (c *GroupController) LeaveGroup(ctx *gin.Context) {
@@ -402,7 +464,7 @@ func (c *GroupController) LeaveGroup(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.LeaveGroup(ctxWithToken, &pb.LeaveGroupRequest{
@@ -411,10 +473,8 @@ func (c *GroupController) LeaveGroup(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to leave group", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to leave group",
-		})
+		c.logger.WithContext(ctx).Error("Failed to leave group", err)
+		respondWithError(ctx, err, "Failed to leave group")
 		return
 	}
 
@@ -440,8 +500,10 @@ func (c *GroupController) GetGroupMembers(ctx *gin.Context) {
 	groupID := ctx.Param("id")
 	token := ctx.GetString("jwt_token")
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
 
 	// Create metadata with authorization token
 	md := metadata.New(map[string]string{
@@ -449,7 +511,7 @@ func (c *GroupController) GetGroupMembers(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.GetGroupMembers(ctxWithToken, &pb.GetGroupMembersRequest{
@@ -459,31 +521,402 @@ func (c *GroupController) GetGroupMembers(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to get group members", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get group members",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get group members", err)
+		respondWithError(ctx, err, "Failed to get group members")
 		return
 	}
 
-	// Convert members to model format
-	members := make([]models.GroupMember, len(resp.Members))
-	for i, member := range resp.Members {
-		members[i] = models.GroupMember{
-			UserID:   member.UserId,
-			Name:     member.Name,
-			Avatar:   member.Avatar,
-			Role:     member.Role,
-			JoinedAt: member.JoinedAt,
+	ctx.JSON(http.StatusOK, models.GroupMembersResponse{
+		Members:    convertGroupMembers(resp.Members),
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// ExportGroupMembers handles downloading all members of a group
+// @Summary Export group members
+// @Description Download all members of a group as NDJSON, one member per line. The members are streamed from the groups service a page at a time, so the download starts right away however large the group is. Only members can export the members of a private group. If the export fails partway, its last line is an error object
+// @Tags groups
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Success 200 {object} models.GroupMember "One member per line"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the private group"
+// @Failure 404 {object} models.ErrorResponse "Group not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/members/export [get]
+func (c *GroupController) ExportGroupMembers(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	token := ctx.GetString("jwt_token")
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Open the stream; it is canceled with the request
+	stream, err := c.client.StreamGroupMembers(metadata.NewOutgoingContext(ctx, md), &pb.StreamGroupMembersRequest{
+		GroupId: groupID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to export group members", err)
+		respondWithError(ctx, err, "Failed to export group members")
+		return
+	}
+
+	streamNDJSON(ctx, c.logger, "group-members.ndjson", "Failed to export group members", func() ([]models.GroupMember, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
 		}
+		return convertGroupMembers(resp.Members), nil
+	})
+}
+
+// RemoveMember handles removing another member from a group
+// @Summary Remove a group member
+// @Description Remove a member from a group. Creators and admins can remove members, only the creator can remove admins, and the creator cannot be removed. The removed member's group posts are kept, as indicated by posts_retained
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param userId path string true "Member user ID"
+// @Success 200 {object} models.RemoveMemberResponse "Member removed successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Group or member not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/members/{userId} [delete]
+func (c *GroupController) RemoveMember(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	memberID := ctx.Param("userId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.RemoveMember(ctxWithToken, &pb.RemoveMemberRequest{
+		GroupId:  groupID,
+		UserId:   userID,
+		MemberId: memberID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to remove member", err)
+		respondWithError(ctx, err, "Failed to remove member")
+		return
 	}
 
-	ctx.JSON(http.StatusOK, models.GroupMembersResponse{
-		Members:    members,
+	ctx.JSON(http.StatusOK, models.RemoveMemberResponse{
+		Success:       resp.Success,
+		MembersCount:  int(resp.MembersCount),
+		PostsRetained: resp.PostsRetained,
+	})
+}
+
+// UpdateMemberRole handles changing the role of a group member
+// @Summary Change a member's role
+// @Description Promote a group member to admin or demote an admin to member. Only the group creator can grant or revoke admin, and the creator's role cannot be changed
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param userId path string true "Member user ID"
+// @Param request body models.MemberRoleRequest true "New role"
+// @Success 200 {object} models.GroupMember "Updated member"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Group or member not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/members/{userId}/role [put]
+func (c *GroupController) UpdateMemberRole(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	memberID := ctx.Param("userId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	var request models.MemberRoleRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	roleRequest := &pb.ChangeMemberRoleRequest{
+		GroupId:  groupID,
+		UserId:   userID,
+		MemberId: memberID,
+	}
+
+	// Call the gRPC service with the context containing the token
+	var resp *pb.GroupMemberResponse
+	var err error
+	if request.Role == "admin" {
+		resp, err = c.client.PromoteMember(ctxWithToken, roleRequest)
+	} else {
+		resp, err = c.client.DemoteMember(ctxWithToken, roleRequest)
+	}
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to update member role", err)
+		respondWithError(ctx, err, "Failed to update member role")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.GroupMember{
+		UserID:   resp.UserId,
+		Name:     resp.Name,
+		Avatar:   resp.Avatar,
+		Role:     resp.Role,
+		JoinedAt: resp.JoinedAt,
+	})
+}
+
+// TransferOwnership handles making another member the creator of a group
+// @Summary Transfer group ownership
+// @Description Make another member the creator of the group. Only the creator can transfer the ownership; they become an admin and can leave the group afterwards
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param request body models.TransferOwnershipRequest true "New creator"
+// @Success 200 {object} models.GroupMember "New creator"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Only the creator can transfer the ownership"
+// @Failure 404 {object} models.ErrorResponse "Group not found"
+// @Failure 409 {object} models.ErrorResponse "The new creator is not a member of the group"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/transfer-ownership [post]
+func (c *GroupController) TransferOwnership(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	var request models.TransferOwnershipRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.TransferOwnership(ctxWithToken, &pb.TransferOwnershipRequest{
+		GroupId:      groupID,
+		UserId:       userID,
+		NewCreatorId: request.UserID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to transfer group ownership", err)
+		respondWithError(ctx, err, "Failed to transfer group ownership")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.GroupMember{
+		UserID:   resp.UserId,
+		Name:     resp.Name,
+		Avatar:   resp.Avatar,
+		Role:     resp.Role,
+		JoinedAt: resp.JoinedAt,
+	})
+}
+
+// GetJoinRequests handles retrieving the pending join requests of a group
+// @Summary Get join requests
+// @Description Get the pending requests to join a private group. Only the creator and admins can see them
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of requests per page" default(10)
+// @Success 200 {object} models.JoinRequestsResponse "Pending join requests with pagination"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Group not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/join-requests [get]
+func (c *GroupController) GetJoinRequests(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.GetJoinRequests(ctxWithToken, &pb.GetJoinRequestsRequest{
+		GroupId: groupID,
+		UserId:  userID,
+		Page:    int32(page),
+		Limit:   int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get join requests", err)
+		respondWithError(ctx, err, "Failed to get join requests")
+		return
+	}
+
+	// Convert requests to model format
+	requests := make([]models.JoinRequest, len(resp.Requests))
+	for i, request := range resp.Requests {
+		requests[i] = convertJoinRequest(request)
+	}
+
+	ctx.JSON(http.StatusOK, models.JoinRequestsResponse{
+		Requests:   requests,
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// ApproveJoinRequest handles approving a request to join a group
+// @Summary Approve a join request
+// @Description Approve a pending join request and add the requester to the group. Only the creator and admins can approve requests
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param requestId path string true "Join request ID"
+// @Success 200 {object} models.JoinRequest "Approved join request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Group or join request not found"
+// @Failure 409 {object} models.ErrorResponse "Join request already reviewed"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/join-requests/{requestId}/approve [post]
+func (c *GroupController) ApproveJoinRequest(ctx *gin.Context) {
+	c.reviewJoinRequest(ctx, true)
+}
+
+// RejectJoinRequest handles rejecting a request to join a group
+// @Summary Reject a join request
+// @Description Reject a pending join request. Only the creator and admins can reject requests
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param requestId path string true "Join request ID"
+// @Success 200 {object} models.JoinRequest "Rejected join request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Group or join request not found"
+// @Failure 409 {object} models.ErrorResponse "Join request already reviewed"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/join-requests/{requestId}/reject [post]
+func (c *GroupController) RejectJoinRequest(ctx *gin.Context) {
+	c.reviewJoinRequest(ctx, false)
+}
+
+// reviewJoinRequest approves or rejects the join request named in the path
+func (c *GroupController) reviewJoinRequest(ctx *gin.Context, approve bool) {
+	groupID := ctx.Param("id")
+	requestID := ctx.Param("requestId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
 	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	reviewRequest := &pb.ReviewJoinRequestRequest{
+		GroupId:   groupID,
+		UserId:    userID,
+		RequestId: requestID,
+	}
+
+	// Call the gRPC service with the context containing the token
+	var resp *pb.JoinRequestResponse
+	var err error
+	if approve {
+		resp, err = c.client.ApproveJoinRequest(ctxWithToken, reviewRequest)
+	} else {
+		resp, err = c.client.RejectJoinRequest(ctxWithToken, reviewRequest)
+	}
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to review join request", err)
+		respondWithError(ctx, err, "Failed to review join request")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convertJoinRequest(resp))
+}
+
+// convertJoinRequest converts a gRPC join request to the API model
+func convertJoinRequest(request *pb.JoinRequestResponse) models.JoinRequest {
+	return models.JoinRequest{
+		RequestID:  request.RequestId,
+		GroupID:    request.GroupId,
+		UserID:     request.UserId,
+		Status:     request.Status,
+		ReviewedBy: request.ReviewedBy,
+		CreatedAt:  request.CreatedAt,
+		ReviewedAt: request.ReviewedAt,
+	}
+}
+
+// convertGroupMembers converts gRPC group members to the API model
+func convertGroupMembers(members []*pb.GroupMemberResponse) []models.GroupMember {
+	converted := make([]models.GroupMember, len(members))
+	for i, member := range members {
+		converted[i] = models.GroupMember{
+			UserID:   member.UserId,
+			Name:     member.Name,
+			Avatar:   member.Avatar,
+			Role:     member.Role,
+			JoinedAt: member.JoinedAt,
+		}
+	}
+	return converted
 }
 
 // CreateGroupPost handles creating a post in a group
@@ -510,9 +943,7 @@ func (c *GroupController) CreateGroupPost(ctx *gin.Context) {
 	var request models.GroupPostRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -522,7 +953,7 @@ func (c *GroupController) CreateGroupPost(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.CreateGroupPost(ctxWithToken, &pb.CreateGroupPostRequest{
@@ -533,10 +964,8 @@ func (c *GroupController) CreateGroupPost(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to create group post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to create group post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create group post", err)
+		respondWithError(ctx, err, "Failed to create group post")
 		return
 	}
 
@@ -575,8 +1004,10 @@ func (c *GroupController) GetGroupPosts(ctx *gin.Context) {
 	userID := ctx.GetString("userID") // May be empty if not authenticated
 	token := ctx.GetString("jwt_token")
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
 
 	// Create metadata with authorization token
 	md := metadata.New(map[string]string{
@@ -584,7 +1015,7 @@ func (c *GroupController) GetGroupPosts(ctx *gin.Context) {
 	})
 
 	// Create new context with metadata
-	ctxWithToken := metadata.NewOutgoingContext(context.Background(), md)
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
 
 	// Call the gRPC service with the context containing the token
 	resp, err := c.client.GetGroupPosts(ctxWithToken, &pb.GetGroupPostsRequest{
@@ -595,10 +1026,12 @@ func (c *GroupController) GetGroupPosts(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		c.logger.Error("Failed to get group posts", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get group posts",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get group posts", err)
+		if status.Code(err) == codes.PermissionDenied {
+			respondWithStatus(ctx, http.StatusForbidden, "Only members can see posts in a private group")
+			return
+		}
+		respondWithError(ctx, err, "Failed to get group posts")
 		return
 	}
 
@@ -625,5 +1058,348 @@ func (c *GroupController) GetGroupPosts(ctx *gin.Context) {
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// UpdateGroupPost handles editing a post in a group
+// @Summary Edit a post in a group
+// @Description Edit the content of a post in a group, and its media when media is given. Only the author and the group's creator and admins can edit a post
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param postId path string true "Post ID"
+// @Param request body models.GroupPostRequest true "Post content"
+// @Success 200 {object} models.Post "Updated post"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not the author or a group admin"
+// @Failure 404 {object} models.ErrorResponse "Group or post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/posts/{postId} [put]
+func (c *GroupController) UpdateGroupPost(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	postID := ctx.Param("postId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	var request models.GroupPostRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.UpdateGroupPost(ctxWithToken, &pb.UpdateGroupPostRequest{
+		GroupId: groupID,
+		PostId:  postID,
+		UserId:  userID,
+		Content: request.Content,
+		Media:   request.Media,
 	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to update group post", err)
+		respondWithError(ctx, err, "Failed to update group post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.Post{
+		PostID:        resp.PostId,
+		AuthorID:      resp.AuthorId,
+		AuthorName:    resp.AuthorName,
+		AuthorAvatar:  resp.AuthorAvatar,
+		Content:       resp.Content,
+		Media:         resp.Media,
+		LikesCount:    resp.LikesCount,
+		CommentsCount: resp.CommentsCount,
+		IsLiked:       resp.IsLiked,
+		CreatedAt:     resp.CreatedAt,
+		UpdatedAt:     resp.UpdatedAt,
+	})
+}
+
+// DeleteGroupPost handles deleting a post in a group
+// @Summary Delete a post in a group
+// @Description Delete a post in a group with its media, likes and comments. Only the author and the group's creator and admins can delete a post
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param postId path string true "Post ID"
+// @Success 200 {object} models.SuccessResponse "Post deleted successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not the author or a group admin"
+// @Failure 404 {object} models.ErrorResponse "Group or post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/posts/{postId} [delete]
+func (c *GroupController) DeleteGroupPost(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	postID := ctx.Param("postId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.DeleteGroupPost(ctxWithToken, &pb.DeleteGroupPostRequest{
+		GroupId: groupID,
+		PostId:  postID,
+		UserId:  userID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to delete group post", err)
+		respondWithError(ctx, err, "Failed to delete group post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: resp.Success,
+	})
+}
+
+// LikeGroupPost handles liking a post in a group
+// @Summary Like a group post
+// @Description Like a post in a group. Liking a post twice has no effect
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param postId path string true "Post ID"
+// @Success 200 {object} models.LikeResponse "Post liked successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the group"
+// @Failure 404 {object} models.ErrorResponse "Group or post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/posts/{postId}/like [post]
+func (c *GroupController) LikeGroupPost(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	postID := ctx.Param("postId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.LikeGroupPost(ctxWithToken, &pb.GroupPostLikeRequest{
+		GroupId: groupID,
+		PostId:  postID,
+		UserId:  userID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to like group post", err)
+		respondWithError(ctx, err, "Failed to like group post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.LikeResponse{
+		Success:    resp.Success,
+		LikesCount: int(resp.LikesCount),
+	})
+}
+
+// UnlikeGroupPost handles removing the user's like from a post in a group
+// @Summary Unlike a group post
+// @Description Remove the current user's like from a post in a group
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param postId path string true "Post ID"
+// @Success 200 {object} models.LikeResponse "Post unliked successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Group or post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/posts/{postId}/like [delete]
+func (c *GroupController) UnlikeGroupPost(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	postID := ctx.Param("postId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.UnlikeGroupPost(ctxWithToken, &pb.GroupPostLikeRequest{
+		GroupId: groupID,
+		PostId:  postID,
+		UserId:  userID,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to unlike group post", err)
+		respondWithError(ctx, err, "Failed to unlike group post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.LikeResponse{
+		Success:    resp.Success,
+		LikesCount: int(resp.LikesCount),
+	})
+}
+
+// AddGroupPostComment handles commenting on a post in a group
+// @Summary Comment on a group post
+// @Description Add a comment to a post in a group
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param postId path string true "Post ID"
+// @Param request body models.GroupPostCommentRequest true "Comment content"
+// @Success 201 {object} models.Comment "Comment added successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the group"
+// @Failure 404 {object} models.ErrorResponse "Group or post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/posts/{postId}/comments [post]
+func (c *GroupController) AddGroupPostComment(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	postID := ctx.Param("postId")
+	userID := ctx.GetString("userID")
+	token := ctx.GetString("jwt_token")
+
+	var request models.GroupPostCommentRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.AddGroupPostComment(ctxWithToken, &pb.AddGroupPostCommentRequest{
+		GroupId: groupID,
+		PostId:  postID,
+		UserId:  userID,
+		Content: request.Content,
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to add group post comment", err)
+		respondWithError(ctx, err, "Failed to add comment")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convertGroupPostComment(resp))
+}
+
+// GetGroupPostComments handles retrieving the comments on a post in a group
+// @Summary Get comments on a group post
+// @Description Get the comments on a post in a group, oldest first, with pagination
+// @Tags groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param postId path string true "Post ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of comments per page" default(10)
+// @Success 200 {object} models.CommentsResponse "Comments with pagination"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the group"
+// @Failure 404 {object} models.ErrorResponse "Group or post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /groups/{id}/posts/{postId}/comments [get]
+func (c *GroupController) GetGroupPostComments(ctx *gin.Context) {
+	groupID := ctx.Param("id")
+	postID := ctx.Param("postId")
+	userID := ctx.GetString("userID") // May be empty if not authenticated
+	token := ctx.GetString("jwt_token")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := c.client.GetGroupPostComments(ctxWithToken, &pb.GetGroupPostCommentsRequest{
+		GroupId: groupID,
+		PostId:  postID,
+		UserId:  userID,
+		Page:    int32(page),
+		Limit:   int32(limit),
+	})
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get group post comments", err)
+		respondWithError(ctx, err, "Failed to get comments")
+		return
+	}
+
+	// Convert comments to model format
+	comments := make([]models.Comment, len(resp.Comments))
+	for i, comment := range resp.Comments {
+		comments[i] = convertGroupPostComment(comment)
+	}
+
+	ctx.JSON(http.StatusOK, models.CommentsResponse{
+		Comments:   comments,
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	})
+}
+
+// convertGroupPostComment converts a group post comment of the groups service to the model format
+func convertGroupPostComment(comment *pb.GroupPostCommentResponse) models.Comment {
+	return models.Comment{
+		CommentID:    comment.CommentId,
+		PostID:       comment.PostId,
+		AuthorID:     comment.AuthorId,
+		AuthorName:   comment.AuthorName,
+		AuthorAvatar: comment.AuthorAvatar,
+		Content:      comment.Content,
+		Mentions:     []models.Mention{},
+		CreatedAt:    comment.CreatedAt,
+		UpdatedAt:    comment.UpdatedAt,
+	}
 }
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	pb "common/pb/common/proto/groups"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gateway-api/internal/models"
+)
+
+// fakeGroupServer answers every group call with err
+type fakeGroupServer struct {
+	pb.UnimplementedGroupServiceServer
+	err error
+}
+
+func (s *fakeGroupServer) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GroupResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeGroupServer) GetGroupPosts(ctx context.Context, req *pb.GetGroupPostsRequest) (*pb.GetGroupPostsResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeGroupServer) GetGroupPostComments(ctx context.Context, req *pb.GetGroupPostCommentsRequest) (*pb.GetGroupPostCommentsResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeGroupServer) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeGroupServer) JoinGroup(ctx context.Context, req *pb.JoinGroupRequest) (*pb.JoinGroupResponse, error) {
+	return nil, s.err
+}
+
+func (s *fakeGroupServer) CreateGroupPost(ctx context.Context, req *pb.CreateGroupPostRequest) (*pb.GroupPostResponse, error) {
+	return nil, s.err
+}
+
+func newGroupRouter(t *testing.T, err error) *gin.Engine {
+	conn := newBufConn(t, func(server *grpc.Server) {
+		pb.RegisterGroupServiceServer(server, &fakeGroupServer{err: err})
+	})
+	controller := NewGroupController(newTestConfig(), newTestLogger(), conn)
+
+	router := newTestRouter("mallory")
+	router.GET("/groups/:id", controller.GetGroup)
+	router.GET("/groups/:id/posts", controller.GetGroupPosts)
+	router.GET("/groups/:id/posts/:postId/comments", controller.GetGroupPostComments)
+	router.DELETE("/groups/:id", controller.DeleteGroup)
+	router.POST("/groups/:id/members", controller.JoinGroup)
+	router.POST("/groups/:id/posts", controller.CreateGroupPost)
+	return router
+}
+
+func TestGroupReadsMapBackendErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"non-member", status.Error(codes.PermissionDenied, "not a member of this group"), http.StatusForbidden, models.ErrorCodePermissionDenied},
+		{"missing group", status.Error(codes.NotFound, "group not found"), http.StatusNotFound, models.ErrorCodeNotFound},
+	}
+	paths := []string{"/groups/g1", "/groups/g1/posts", "/groups/g1/posts/p1/comments"}
+
+	for _, tt := range tests {
+		router := newGroupRouter(t, tt.err)
+		for _, path := range paths {
+			t.Run(tt.name+" "+path, func(t *testing.T) {
+				resp := decodeError(t, serve(router, http.MethodGet, path, ""), tt.wantStatus)
+				if resp.Code != tt.wantCode {
+					t.Errorf("got code %q, want %q", resp.Code, tt.wantCode)
+				}
+			})
+		}
+	}
+}
+
+func TestGroupWritesMapBackendErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"delete a missing group", http.MethodDelete, "/groups/g1", "", status.Error(codes.NotFound, "group not found"), http.StatusNotFound, models.ErrorCodeNotFound},
+		{"delete as non-creator", http.MethodDelete, "/groups/g1", "", status.Error(codes.PermissionDenied, "only the creator can delete the group"), http.StatusForbidden, models.ErrorCodePermissionDenied},
+		{"join a missing group", http.MethodPost, "/groups/g1/members", "", status.Error(codes.NotFound, "group not found"), http.StatusNotFound, models.ErrorCodeNotFound},
+		{"post as non-member", http.MethodPost, "/groups/g1/posts", `{"content":"hello"}`, status.Error(codes.PermissionDenied, "only members can post"), http.StatusForbidden, models.ErrorCodePermissionDenied},
+		{"post in a missing group", http.MethodPost, "/groups/g1/posts", `{"content":"hello"}`, status.Error(codes.NotFound, "group not found"), http.StatusNotFound, models.ErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newGroupRouter(t, tt.err)
+			resp := decodeError(t, serve(router, tt.method, tt.path, tt.body), tt.wantStatus)
+			if resp.Code != tt.wantCode {
+				t.Errorf("got code %q, want %q", resp.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+	"gateway-api/internal/models"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"gateway-api/internal/clients"
+	"gateway-api/internal/config"
+	"gateway-api/internal/utils/logger"
+)
+
+// healthCheckTimeout bounds how long a single downstream health probe may take
+const healthCheckTimeout = 2 * time.Second
+
+// HealthController handles health checks of the gateway and its downstream services
+type HealthController struct {
+	cfg      *config.Config
+	logger   *logger.Logger
+	clients  map[string]healthpb.HealthClient
+	breakers map[string]*clients.Breaker
+}
+
+// NewHealthController creates a new health controller probing the given connections and
+// reporting their circuit breakers
+func NewHealthController(cfg *config.Config, logger *logger.Logger, conns map[string]*grpc.ClientConn, breakers map[string]*clients.Breaker) *HealthController {
+	clients := make(map[string]healthpb.HealthClient, len(conns))
+	for name, conn := range conns {
+		clients[name] = healthpb.NewHealthClient(conn)
+	}
+
+	return &HealthController{
+		cfg:      cfg,
+		logger:   logger,
+		clients:  clients,
+		breakers: breakers,
+	}
+}
+
+// Health handles liveness checks; it only reports that the gateway process is up
+// @Summary Check gateway liveness
+// @Description Report that the gateway process is running
+// @Tags health
+// @Produce json
+// @Success 200 {object} map[string]string "Gateway is up"
+// @Router /health [get]
+func (c *HealthController) Health(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{
+		"status": "ok",
+	})
+}
+
+// Healthz handles aggregated health checks of all downstream services
+// @Summary Check service health
+// @Description Probe the gRPC health service of every backend and report their combined status
+// @Tags health
+// @Produce json
+// @Success 200 {object} models.HealthResponse "All services are serving"
+// @Failure 503 {object} models.HealthResponse "One or more services are not serving"
+// @Router /healthz [get]
+func (c *HealthController) Healthz(ctx *gin.Context) {
+	c.respondWithServiceHealth(ctx)
+}
+
+// Ready handles readiness checks; the gateway is ready once every backend is serving
+// @Summary Check gateway readiness
+// @Description Probe users, posts, friends and groups services and report each one's state
+// @Tags health
+// @Produce json
+// @Success 200 {object} models.HealthResponse "Gateway is ready"
+// @Failure 503 {object} models.HealthResponse "One or more services are not ready"
+// @Router /ready [get]
+func (c *HealthController) Ready(ctx *gin.Context) {
+	c.respondWithServiceHealth(ctx)
+}
+
+// Metrics handles reporting the circuit breaker of every backend
+// @Summary Get gateway metrics
+// @Description Report the circuit breaker state of every backend. An open breaker fails requests to its service with a 503 until a probe call succeeds
+// @Tags health
+// @Produce json
+// @Success 200 {object} models.MetricsResponse "Circuit breaker states"
+// @Router /metrics [get]
+func (c *HealthController) Metrics(ctx *gin.Context) {
+	breakers := make(map[string]models.CircuitBreakerStats, len(c.breakers))
+	for name, breaker := range c.breakers {
+		state, failures := breaker.Stats()
+		breakers[name] = models.CircuitBreakerStats{
+			State:               state,
+			ConsecutiveFailures: failures,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, models.MetricsResponse{
+		CircuitBreakers: breakers,
+	})
+}
+
+// respondWithServiceHealth probes every backend concurrently and writes the combined result
+func (c *HealthController) respondWithServiceHealth(ctx *gin.Context) {
+	services, healthy := c.checkServices(ctx.Request.Context())
+	if !healthy {
+		ctx.JSON(http.StatusServiceUnavailable, models.HealthResponse{
+			Status:   "unavailable",
+			Services: services,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.HealthResponse{
+		Status:   "ok",
+		Services: services,
+	})
+}
+
+// checkServices returns the serving status of each backend and whether all of them are serving
+func (c *HealthController) checkServices(ctx context.Context) (map[string]string, bool) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	services := make(map[string]string, len(c.clients))
+	healthy := true
+
+	for name, client := range c.clients {
+		wg.Add(1)
+		go func(name string, client healthpb.HealthClient) {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			serving := healthpb.HealthCheckResponse_UNKNOWN.String()
+			resp, err := client.Check(probeCtx, &healthpb.HealthCheckRequest{})
+			if err != nil {
+				c.logger.WithContext(ctx).Error("Health check failed for "+name+" service", err)
+			} else {
+				serving = resp.Status.String()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			services[name] = serving
+			if err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+				healthy = false
+			}
+		}(name, client)
+	}
+	wg.Wait()
+
+	return services, healthy
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"gateway-api/internal/utils/logger"
+)
+
+// newBufConn serves the services registered by register in memory and returns a connection to them
+func newBufConn(t *testing.T, register func(*grpc.Server), opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	if err != nil {
+		t.Fatalf("failed to dial in-memory server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// newTestConfig returns the configuration handlers rely on, with the defaults of LoadConfig
+func newTestConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.Pagination.MaxLimit = 100
+	return cfg
+}
+
+// newTestLogger returns a logger that discards everything
+func newTestLogger() *logger.Logger {
+	return &logger.Logger{Logger: zap.NewNop()}
+}
+
+// newTestRouter returns a router where requests are authenticated as userID, if set
+func newTestRouter(userID string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	if userID != "" {
+		router.Use(func(c *gin.Context) {
+			c.Set("userID", userID)
+			c.Set("jwt_token", "token-of-"+userID)
+		})
+	}
+	return router
+}
+
+// serve sends a request with an optional JSON body to router
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// decodeError decodes an error response, failing the test unless the status is want
+func decodeError(t *testing.T, w *httptest.ResponseRecorder, want int) models.ErrorResponse {
+	t.Helper()
+
+	if w.Code != want {
+		t.Fatalf("got status %d, want %d: %s", w.Code, want, w.Body.String())
+	}
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode error response %q: %v", w.Body.String(), err)
+	}
+	return resp
+}
+
+// assertStatus fails the test unless the response has the given status
+func assertStatus(t *testing.T, w *httptest.ResponseRecorder, want int) {
+	t.Helper()
+
+	if w.Code != want {
+		t.Errorf("got status %d, want %d: %s", w.Code, want, w.Body.String())
+	}
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"fmt"
+	"gateway-api/internal/models"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// idempotencyKeyHeader lets clients retry a create request without creating a duplicate
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength is the longest idempotency key the posts service stores
+const maxIdempotencyKeyLength = 255
+
+// idempotencyKey reads the optional Idempotency-Key header. A key that is too long is answered
+// with 400, in which case ok is false and the handler must stop.
+func idempotencyKey(ctx *gin.Context) (key string, ok bool) {
+	key = strings.TrimSpace(ctx.GetHeader(idempotencyKeyHeader))
+	if len(key) > maxIdempotencyKeyLength {
+		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: "Invalid idempotency key",
+			Code:  models.ErrorCodeInvalidArgument,
+			Details: map[string]string{
+				idempotencyKeyHeader: fmt.Sprintf("must be at most %d characters", maxIdempotencyKeyLength),
+			},
+		})
+		return "", false
+	}
+	return key, true
+}
\ No newline at end of file
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"gateway-api/internal/services"
+	"gateway-api/internal/utils/logger"
+)
+
+// MediaController handles media-related requests
+type MediaController struct {
+	cfg          *config.Config
+	logger       *logger.Logger
+	mediaService services.MediaService
+}
+
+// NewMediaController creates a new media controller
+func NewMediaController(cfg *config.Config, logger *logger.Logger, mediaService services.MediaService) *MediaController {
+	return &MediaController{
+		cfg:          cfg,
+		logger:       logger,
+		mediaService: mediaService,
+	}
+}
+
+// CreateUploadURL handles issuing a presigned media upload URL
+// @Summary Get a media upload URL
+// @Description Get a presigned URL to upload a media file to, and the URL to attach to a post once it is uploaded
+// @Tags media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.UploadURLRequest true "Upload URL request"
+// @Success 200 {object} models.UploadURLResponse "Upload URL"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 413 {object} models.ErrorResponse "File too large"
+// @Failure 415 {object} models.ErrorResponse "Unsupported media type"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 503 {object} models.ErrorResponse "Media uploads are not configured"
+// @Router /media/upload-url [post]
+func (c *MediaController) CreateUploadURL(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+
+	var request models.UploadURLRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Call the media service
+	resp, err := c.mediaService.CreateUploadURL(ctx, userID, request)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnsupportedMediaType):
+			respondWithStatus(ctx, http.StatusUnsupportedMediaType, "Unsupported media type")
+		case errors.Is(err, services.ErrMediaTooLarge):
+			respondWithStatus(ctx, http.StatusRequestEntityTooLarge, "File too large")
+		case errors.Is(err, services.ErrMediaUploadsDisabled):
+			respondWithStatus(ctx, http.StatusServiceUnavailable, "Media uploads are not configured")
+		default:
+			c.logger.WithContext(ctx).Error("Failed to create media upload URL", err)
+			respondWithStatus(ctx, http.StatusInternalServerError, "Failed to create media upload URL")
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
\ No newline at end of file
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	npb "common/pb/common/proto/notifications"
+	"context"
+	"errors"
+	"gateway-api/internal/models"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/utils/logger"
+)
+
+// NotificationController handles notification-related requests
+type NotificationController struct {
+	cfg    *config.Config
+	logger *logger.Logger
+	client npb.NotificationServiceClient
+}
+
+// NewNotificationController creates a new notification controller. Notifications are
+// served by the users service, so conn is the users service connection.
+func NewNotificationController(cfg *config.Config, logger *logger.Logger, conn *grpc.ClientConn) *NotificationController {
+	return &NotificationController{
+		cfg:    cfg,
+		logger: logger,
+		client: npb.NewNotificationServiceClient(conn),
+	}
+}
+
+// createAuthContext creates a new context with the JWT token in the metadata
+func (c *NotificationController) createAuthContext(ctx *gin.Context) (context.Context, error) {
+	token := ctx.GetString("jwt_token")
+	if token == "" {
+		return nil, errors.New("no token found in context")
+	}
+
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
+// GetNotifications handles retrieving the current user's notifications
+// @Summary Get notifications
+// @Description Get the current user's notifications, newest first, with pagination
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of notifications per page" default(20)
+// @Param unread_only query bool false "Only return unread notifications" default(false)
+// @Success 200 {object} models.NotificationsResponse "Notifications with pagination"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /notifications [get]
+func (c *NotificationController) GetNotifications(ctx *gin.Context) {
+	page, limit, ok := parsePagination(ctx, c.cfg, 20)
+	if !ok {
+		return
+	}
+	unreadOnly, _ := strconv.ParseBool(ctx.DefaultQuery("unread_only", "false"))
+
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	resp, err := c.client.GetNotifications(authCtx, &npb.GetNotificationsRequest{
+		Page:       int32(page),
+		Limit:      int32(limit),
+		UnreadOnly: unreadOnly,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get notifications", err)
+		respondWithError(ctx, err, "Failed to get notifications")
+		return
+	}
+
+	notifications := make([]models.Notification, len(resp.Notifications))
+	for i, n := range resp.Notifications {
+		notifications[i] = models.Notification{
+			NotificationID: n.NotificationId,
+			Type:           n.Type,
+			ActorID:        n.ActorId,
+			ActorName:      n.ActorName,
+			ActorAvatar:    n.ActorAvatar,
+			TargetID:       n.TargetId,
+			Read:           n.Read,
+			CreatedAt:      n.CreatedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, models.NotificationsResponse{
+		Notifications: notifications,
+		TotalCount:    resp.TotalCount,
+		Page:          resp.Page,
+		TotalPages:    resp.TotalPages,
+		Pagination:    models.NewPagination(page, limit, int64(resp.TotalCount)),
+		UnreadCount:   resp.UnreadCount,
+	})
+}
+
+// GetUnreadCount handles counting the current user's unread notifications
+// @Summary Get unread notification count
+// @Description Get the number of notifications the current user has not read
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.UnreadCountResponse "Unread notification count"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /notifications/unread-count [get]
+func (c *NotificationController) GetUnreadCount(ctx *gin.Context) {
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	resp, err := c.client.GetUnreadCount(authCtx, &npb.GetUnreadCountRequest{})
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get unread notification count", err)
+		respondWithError(ctx, err, "Failed to get unread notification count")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.UnreadCountResponse{
+		UnreadCount: resp.UnreadCount,
+	})
+}
+
+// MarkRead handles marking one of the current user's notifications as read
+// @Summary Mark a notification as read
+// @Description Mark a notification as read. Marking a read notification again has no effect
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID"
+// @Success 200 {object} models.UnreadCountResponse "Unread notification count after the change"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Notification not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /notifications/{id}/read [post]
+func (c *NotificationController) MarkRead(ctx *gin.Context) {
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	resp, err := c.client.MarkRead(authCtx, &npb.MarkReadRequest{
+		NotificationId: ctx.Param("id"),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			respondWithStatus(ctx, http.StatusNotFound, "Notification not found")
+			return
+		}
+		c.logger.WithContext(ctx).Error("Failed to mark notification as read", err)
+		respondWithError(ctx, err, "Failed to mark notification as read")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.UnreadCountResponse{
+		UnreadCount: resp.UnreadCount,
+	})
+}
+
+// MarkAllRead handles marking all of the current user's notifications as read
+// @Summary Mark all notifications as read
+// @Description Mark every notification of the current user as read
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.UnreadCountResponse "Unread notification count after the change"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /notifications/read-all [post]
+func (c *NotificationController) MarkAllRead(ctx *gin.Context) {
+	authCtx, err := c.createAuthContext(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		respondWithStatus(ctx, http.StatusInternalServerError, "Failed to authenticate request")
+		return
+	}
+
+	resp, err := c.client.MarkAllRead(authCtx, &npb.MarkAllReadRequest{})
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to mark notifications as read", err)
+		respondWithError(ctx, err, "Failed to mark notifications as read")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.UnreadCountResponse{
+		UnreadCount: resp.UnreadCount,
+	})
+}
\ No newline at end of file
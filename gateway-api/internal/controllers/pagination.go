@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parsePagination reads the page and limit query parameters of a list endpoint. Missing,
+// malformed and zero values fall back to the first page and defaultLimit, and limits above
+// the configured maximum are clamped to it. Negative values are answered with 400, in which
+// case ok is false and the handler must stop.
+func parsePagination(ctx *gin.Context, cfg *config.Config, defaultLimit int) (page, limit int, ok bool) {
+	page = queryInt(ctx, "page", 1)
+	limit = queryInt(ctx, "limit", defaultLimit)
+
+	if page < 0 || limit < 0 {
+		details := make(map[string]string, 2)
+		if page < 0 {
+			details["page"] = "must not be negative"
+		}
+		if limit < 0 {
+			details["limit"] = "must not be negative"
+		}
+		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid pagination",
+			Code:    models.ErrorCodeInvalidArgument,
+			Details: details,
+		})
+		return 0, 0, false
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if maxLimit := cfg.Pagination.MaxLimit; maxLimit > 0 && limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return page, limit, true
+}
+
+// queryInt reads an integer query parameter, falling back to def when it is missing or malformed
+func queryInt(ctx *gin.Context, name string, def int) int {
+	value, err := strconv.Atoi(ctx.Query(name))
+	if err != nil {
+		return def
+	}
+	return value
+}
\ No newline at end of file
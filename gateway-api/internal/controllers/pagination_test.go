@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/models"
+)
+
+func TestParsePagination(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantPage  int
+		wantLimit int
+	}{
+		{"defaults", "", 1, 20},
+		{"explicit", "?page=3&limit=50", 3, 50},
+		{"malformed", "?page=abc&limit=ten", 1, 20},
+		{"zero", "?page=0&limit=0", 1, 20},
+		{"limit at the maximum", "?limit=100", 1, 100},
+		{"limit above the maximum", "?limit=100000", 1, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/posts"+tt.query, nil)
+
+			page, limit, ok := parsePagination(ctx, newTestConfig(), 20)
+			if !ok {
+				t.Fatalf("got a %d response, want the pagination accepted", w.Code)
+			}
+			if page != tt.wantPage || limit != tt.wantLimit {
+				t.Errorf("got page %d limit %d, want page %d limit %d", page, limit, tt.wantPage, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestParsePaginationRejectsNegativeValues(t *testing.T) {
+	tests := []struct {
+		query       string
+		wantDetails map[string]string
+	}{
+		{"?page=-1", map[string]string{"page": "must not be negative"}},
+		{"?limit=-5", map[string]string{"limit": "must not be negative"}},
+		{"?page=-1&limit=-5", map[string]string{"page": "must not be negative", "limit": "must not be negative"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/posts"+tt.query, nil)
+
+			if _, _, ok := parsePagination(ctx, newTestConfig(), 20); ok {
+				t.Fatal("got the pagination accepted, want it refused")
+			}
+			resp := decodeError(t, w, http.StatusBadRequest)
+			if resp.Code != models.ErrorCodeInvalidArgument || !reflect.DeepEqual(resp.Details, tt.wantDetails) {
+				t.Errorf("got %+v, want details %v", resp, tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestParsePaginationWithoutMaximum(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/posts?limit=500", nil)
+
+	cfg := newTestConfig()
+	cfg.Pagination.MaxLimit = 0
+	if _, limit, _ := parsePagination(ctx, cfg, 20); limit != 500 {
+		t.Errorf("got limit %d, want 500 when no maximum is configured", limit)
+	}
+}
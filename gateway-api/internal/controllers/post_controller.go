@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"gateway-api/internal/config"
 	"gateway-api/internal/models"
@@ -20,9 +22,7 @@ type PostController struct {
 }
 
 // NewPostController creates a new post controller
-func NewPostController(cfg *config.Config, logger *logger.Logger) *PostController {
-	postService := services.NewPostService(cfg, logger)
-
+func NewPostController(cfg *config.Config, logger *logger.Logger, postService services.PostService) *PostController {
 	return &PostController{
 		cfg:         cfg,
 		logger:      logger,
@@ -38,9 +38,13 @@ func NewPostController(cfg *config.Config, logger *logger.Logger) *PostControlle
 // @Produce json
 // @Security BearerAuth
 // @Param request body models.PostCreateRequest true "Post creation request"
+// @Param Idempotency-Key header string false "Retries with the same key return the post created first"
 // @Success 201 {object} models.Post "Post created successfully"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the group"
+// @Failure 404 {object} models.ErrorResponse "Group not found"
+// @Failure 409 {object} models.ErrorResponse "A request with the idempotency key is in progress"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /posts [post]
 func (c *PostController) CreatePost(ctx *gin.Context) {
@@ -49,20 +53,32 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 	var request models.PostCreateRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	idempotencyKey, ok := idempotencyKey(ctx)
+	if !ok {
 		return
 	}
 
 	// Call the post service
-	resp, err := c.postService.CreatePost(ctx, userID, request)
+	resp, err := c.postService.CreatePost(ctx, userID, request, idempotencyKey)
 
 	if err != nil {
-		c.logger.Error("Failed to create post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to create post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to create post", err)
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "Only group members can post in this group")
+			return
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Group not found")
+			return
+		}
+		respondWithError(ctx, err, "Failed to create post")
 		return
 	}
 
@@ -87,10 +103,8 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 	resp, err := c.postService.GetPost(ctx, postID, userID)
 
 	if err != nil {
-		c.logger.Error("Failed to get post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get post", err)
+		respondWithError(ctx, err, "Failed to get post")
 		return
 	}
 
@@ -105,9 +119,13 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 // @Param author_id query string false "Filter posts by author ID"
 // @Param group_id query string false "Filter posts by group ID"
 // @Param visibility query string false "Filter posts by visibility" Enums(public, private)
+// @Param feed query string false "Only show the posts of users the user follows" Enums(following)
+// @Param cursor query string false "Continue a feed from the next_cursor of a previous response instead of paging by number"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of posts per page" default(10)
 // @Success 200 {object} models.PostsResponse "Posts"
+// @Failure 400 {object} models.ErrorResponse "Invalid cursor or feed"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /posts [get]
 func (c *PostController) GetPosts(ctx *gin.Context) {
@@ -115,18 +133,97 @@ func (c *PostController) GetPosts(ctx *gin.Context) {
 	authorID := ctx.Query("author_id")
 	groupID := ctx.Query("group_id")
 	visibility := ctx.Query("visibility")
+	feed := ctx.Query("feed")
+	cursor := ctx.Query("cursor")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Call the post service
+	resp, err := c.postService.GetPosts(ctx, userID, authorID, groupID, visibility, feed, cursor, page, limit)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get posts", err)
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		respondWithError(ctx, err, "Failed to get posts")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetFeed handles retrieving the user's feed
+// @Summary Get feed
+// @Description Get the user's own posts, their friends' posts and public posts, newest first
+// @Tags posts
+// @Produce json
+// @Security BearerAuth
+// @Param feed query string false "Only show the posts of users the user follows" Enums(following)
+// @Param cursor query string false "Continue the feed from the next_cursor of a previous response instead of paging by number"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of posts per page" default(10)
+// @Success 200 {object} models.PostsResponse "Posts"
+// @Failure 400 {object} models.ErrorResponse "Invalid cursor or feed"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /feed [get]
+func (c *PostController) GetFeed(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+	feed := ctx.Query("feed")
+	cursor := ctx.Query("cursor")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// A feed is a post listing without author or group filters
+	resp, err := c.postService.GetPosts(ctx, userID, "", "", "", feed, cursor, page, limit)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get feed", err)
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		respondWithError(ctx, err, "Failed to get feed")
+		return
+	}
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPostsByHashtag handles retrieving posts tagged with a hashtag
+// @Summary Get posts by hashtag
+// @Description Get posts whose content contains a hashtag, with pagination
+// @Tags posts
+// @Produce json
+// @Param tag path string true "Hashtag, without the leading #"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of posts per page" default(10)
+// @Success 200 {object} models.PostsResponse "Posts"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/tags/{tag} [get]
+func (c *PostController) GetPostsByHashtag(ctx *gin.Context) {
+	userID := ctx.GetString("userID") // May be empty if not authenticated
+	tag := ctx.Param("tag")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
 
 	// Call the post service
-	resp, err := c.postService.GetPosts(ctx, userID, authorID, groupID, visibility, page, limit)
+	resp, err := c.postService.GetPostsByHashtag(ctx, userID, tag, page, limit)
 
 	if err != nil {
-		c.logger.Error("Failed to get posts", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get posts",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get posts by hashtag", err)
+		respondWithError(ctx, err, "Failed to get posts")
 		return
 	}
 
@@ -155,9 +252,7 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 	var request models.PostUpdateRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -165,10 +260,12 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 	resp, err := c.postService.UpdatePost(ctx, postID, userID, request)
 
 	if err != nil {
-		c.logger.Error("Failed to update post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to update post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to update post", err)
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		respondWithError(ctx, err, "Failed to update post")
 		return
 	}
 
@@ -195,10 +292,8 @@ func (c *PostController) DeletePost(ctx *gin.Context) {
 	success, err := c.postService.DeletePost(ctx, postID, userID)
 
 	if err != nil {
-		c.logger.Error("Failed to delete post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to delete post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to delete post", err)
+		respondWithError(ctx, err, "Failed to delete post")
 		return
 	}
 
@@ -207,12 +302,106 @@ func (c *PostController) DeletePost(ctx *gin.Context) {
 	})
 }
 
+// SharePost handles sharing a post
+// @Summary Share a post
+// @Description Share a post as a new post of the user's, with an optional comment
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body models.SharePostRequest false "Share request"
+// @Success 201 {object} models.Post "Post shared successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Post not visible to the user"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/share [post]
+func (c *PostController) SharePost(ctx *gin.Context) {
+	postID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+
+	// The body is optional, an empty request shares without a comment
+	var request models.SharePostRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			respondWithBindError(ctx, err, &request)
+			return
+		}
+	}
+
+	// Call the post service
+	resp, err := c.postService.SharePost(ctx, postID, userID, request)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to share post", err)
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "You don't have permission to view this post")
+			return
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Post not found")
+			return
+		}
+		respondWithError(ctx, err, "Failed to share post")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+// RestorePost handles restoring a deleted post
+// @Summary Restore a post
+// @Description Restore a deleted post; only possible for a limited time after deletion
+// @Tags posts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 200 {object} models.Post "Post restored successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not the author of the post"
+// @Failure 404 {object} models.ErrorResponse "Deleted post not found"
+// @Failure 410 {object} models.ErrorResponse "Post can no longer be restored"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/restore [post]
+func (c *PostController) RestorePost(ctx *gin.Context) {
+	postID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+
+	// Call the post service
+	resp, err := c.postService.RestorePost(ctx, postID, userID)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to restore post", err)
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "Only the author can restore this post")
+			return
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Deleted post not found")
+			return
+		case codes.FailedPrecondition:
+			respondWithStatus(ctx, http.StatusGone, "Post can no longer be restored")
+			return
+		}
+		respondWithError(ctx, err, "Failed to restore post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // GetComments handles retrieving comments for a post
 // @Summary Get comments for a post
-// @Description Get comments for a post with pagination
+// @Description Get comments for a post with pagination, optionally as a tree of replies
 // @Tags posts
 // @Produce json
 // @Param id path string true "Post ID"
+// @Param threaded query bool false "Nest replies beneath top-level comments" default(false)
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of comments per page" default(10)
 // @Success 200 {object} models.CommentsResponse "Comments"
@@ -221,18 +410,24 @@ func (c *PostController) DeletePost(ctx *gin.Context) {
 // @Router /posts/{id}/comments [get]
 func (c *PostController) GetComments(ctx *gin.Context) {
 	postID := ctx.Param("id")
+	userID := ctx.GetString("userID") // May be empty if not authenticated
 
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+	threaded, _ := strconv.ParseBool(ctx.DefaultQuery("threaded", "false"))
 
 	// Call the post service
-	resp, err := c.postService.GetComments(ctx, postID, page, limit)
+	resp, err := c.postService.GetComments(ctx, postID, userID, threaded, page, limit)
 
 	if err != nil {
-		c.logger.Error("Failed to get comments", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get comments",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get comments", err)
+		if status.Code(err) == codes.NotFound {
+			respondWithStatus(ctx, http.StatusNotFound, "Post not found")
+			return
+		}
+		respondWithError(ctx, err, "Failed to get comments")
 		return
 	}
 
@@ -248,10 +443,13 @@ func (c *PostController) GetComments(ctx *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "Post ID"
 // @Param request body models.CommentCreateRequest true "Comment request"
+// @Param Idempotency-Key header string false "Retries with the same key return the comment created first"
 // @Success 201 {object} models.Comment "Comment added successfully"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Post not visible, or the author and the user have blocked one another"
 // @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 409 {object} models.ErrorResponse "A request with the idempotency key is in progress"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /posts/{id}/comments [post]
 func (c *PostController) AddComment(ctx *gin.Context) {
@@ -261,26 +459,80 @@ func (c *PostController) AddComment(ctx *gin.Context) {
 	var request models.CommentCreateRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	idempotencyKey, ok := idempotencyKey(ctx)
+	if !ok {
 		return
 	}
 
 	// Call the post service
-	resp, err := c.postService.AddComment(ctx, postID, userID, request)
+	resp, err := c.postService.AddComment(ctx, postID, userID, request, idempotencyKey)
 
 	if err != nil {
-		c.logger.Error("Failed to add comment", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to add comment",
-		})
+		c.logger.WithContext(ctx).Error("Failed to add comment", err)
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		respondWithError(ctx, err, "Failed to add comment")
 		return
 	}
 
 	ctx.JSON(http.StatusCreated, resp)
 }
 
+// EditComment handles editing a comment
+// @Summary Edit a comment
+// @Description Edit the content of a comment on a post
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param commentId path string true "Comment ID"
+// @Param request body models.CommentUpdateRequest true "Comment update request"
+// @Success 200 {object} models.Comment "Comment updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not the author of the comment"
+// @Failure 404 {object} models.ErrorResponse "Post or comment not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/comments/{commentId} [put]
+func (c *PostController) EditComment(ctx *gin.Context) {
+	postID := ctx.Param("id")
+	commentID := ctx.Param("commentId")
+	userID := ctx.GetString("userID")
+
+	var request models.CommentUpdateRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Call the post service
+	resp, err := c.postService.EditComment(ctx, postID, commentID, userID, request)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to edit comment", err)
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		if status.Code(err) == codes.PermissionDenied {
+			respondWithStatus(ctx, http.StatusForbidden, "You can only edit your own comments")
+			return
+		}
+		respondWithError(ctx, err, "Failed to edit comment")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // DeleteComment handles deleting a comment
 // @Summary Delete a comment
 // @Description Delete a comment from a post
@@ -303,10 +555,8 @@ func (c *PostController) DeleteComment(ctx *gin.Context) {
 	success, err := c.postService.DeleteComment(ctx, postID, commentID, userID)
 
 	if err != nil {
-		c.logger.Error("Failed to delete comment", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to delete comment",
-		})
+		c.logger.WithContext(ctx).Error("Failed to delete comment", err)
+		respondWithError(ctx, err, "Failed to delete comment")
 		return
 	}
 
@@ -315,14 +565,17 @@ func (c *PostController) DeleteComment(ctx *gin.Context) {
 	})
 }
 
-// LikePost handles liking a post
-// @Summary Like a post
-// @Description Like a post
+// LikePost handles reacting to a post
+// @Summary React to a post
+// @Description Set the user's reaction on a post, replacing any previous reaction. Defaults to a like.
 // @Tags posts
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Post ID"
+// @Param request body models.ReactionRequest false "Reaction request"
 // @Success 200 {object} models.LikeResponse "Post liked successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 404 {object} models.ErrorResponse "Post not found"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
@@ -331,14 +584,21 @@ func (c *PostController) LikePost(ctx *gin.Context) {
 	postID := ctx.Param("id")
 	userID := ctx.GetString("userID")
 
+	// The body is optional, an empty request is a plain like
+	var request models.ReactionRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			respondWithBindError(ctx, err, &request)
+			return
+		}
+	}
+
 	// Call the post service
-	resp, err := c.postService.LikePost(ctx, postID, userID)
+	resp, err := c.postService.LikePost(ctx, postID, userID, request)
 
 	if err != nil {
-		c.logger.Error("Failed to like post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to like post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to like post", err)
+		respondWithError(ctx, err, "Failed to like post")
 		return
 	}
 
@@ -365,10 +625,286 @@ func (c *PostController) UnlikePost(ctx *gin.Context) {
 	resp, err := c.postService.UnlikePost(ctx, postID, userID)
 
 	if err != nil {
-		c.logger.Error("Failed to unlike post", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to unlike post",
-		})
+		c.logger.WithContext(ctx).Error("Failed to unlike post", err)
+		respondWithError(ctx, err, "Failed to unlike post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetReactionCounts handles retrieving reaction counts for a post
+// @Summary Get reaction counts for a post
+// @Description Get the number of each reaction type on a post
+// @Tags posts
+// @Produce json
+// @Param id path string true "Post ID"
+// @Success 200 {object} models.ReactionCountsResponse "Reaction counts"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/reactions [get]
+func (c *PostController) GetReactionCounts(ctx *gin.Context) {
+	postID := ctx.Param("id")
+
+	// Call the post service
+	resp, err := c.postService.GetReactionCounts(ctx, postID)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get reaction counts", err)
+		respondWithError(ctx, err, "Failed to get reaction counts")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// BookmarkPost handles bookmarking a post
+// @Summary Bookmark a post
+// @Description Save a post to the user's bookmarks; bookmarking a post twice has no effect
+// @Tags posts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 200 {object} models.SuccessResponse "Post bookmarked successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Post not visible to the user"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/bookmark [post]
+func (c *PostController) BookmarkPost(ctx *gin.Context) {
+	postID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+
+	// Call the post service
+	success, err := c.postService.BookmarkPost(ctx, postID, userID)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to bookmark post", err)
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "You don't have permission to view this post")
+			return
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Post not found")
+			return
+		}
+		respondWithError(ctx, err, "Failed to bookmark post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: success,
+	})
+}
+
+// UnbookmarkPost handles removing a bookmark
+// @Summary Remove a bookmark
+// @Description Remove a post from the user's bookmarks
+// @Tags posts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 200 {object} models.SuccessResponse "Bookmark removed successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Bookmark not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/bookmark [delete]
+func (c *PostController) UnbookmarkPost(ctx *gin.Context) {
+	postID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+
+	// Call the post service
+	success, err := c.postService.UnbookmarkPost(ctx, postID, userID)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to remove bookmark", err)
+		if status.Code(err) == codes.NotFound {
+			respondWithStatus(ctx, http.StatusNotFound, "Bookmark not found")
+			return
+		}
+		respondWithError(ctx, err, "Failed to remove bookmark")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: success,
+	})
+}
+
+// GetBookmarks handles retrieving the user's bookmarked posts
+// @Summary Get bookmarks
+// @Description Get the user's bookmarked posts, newest bookmark first. Posts the user can no longer see are left out.
+// @Tags posts
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of posts per page" default(10)
+// @Success 200 {object} models.PostsResponse "Bookmarked posts"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/bookmarks [get]
+func (c *PostController) GetBookmarks(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Call the post service
+	resp, err := c.postService.GetBookmarks(ctx, userID, page, limit)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get bookmarks", err)
+		respondWithError(ctx, err, "Failed to get bookmarks")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ReportPost handles reporting a post for moderation
+// @Summary Report a post
+// @Description Report a post for moderation. A user can report each post they can see once.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body models.ReportPostRequest true "Report request"
+// @Success 200 {object} models.SuccessResponse "Post reported successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Post not visible to the user"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 409 {object} models.ErrorResponse "Post already reported by the user"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /posts/{id}/report [post]
+func (c *PostController) ReportPost(ctx *gin.Context) {
+	postID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+
+	var request models.ReportPostRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Call the post service
+	success, err := c.postService.ReportPost(ctx, postID, userID, request)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to report post", err)
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "You don't have permission to view this post")
+			return
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Post not found")
+			return
+		case codes.AlreadyExists:
+			respondWithStatus(ctx, http.StatusConflict, "You have already reported this post")
+			return
+		}
+		respondWithError(ctx, err, "Failed to report post")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: success,
+	})
+}
+
+// GetReports handles retrieving the moderation queue
+// @Summary Get reports
+// @Description Get reported posts for moderation, oldest report first, with how often each post has been reported. Admins only.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Report status" Enums(open, resolved) default(open)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of reports per page" default(10)
+// @Success 200 {object} models.ReportsResponse "Reports"
+// @Failure 400 {object} models.ErrorResponse "Invalid status"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not an admin"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/reports [get]
+func (c *PostController) GetReports(ctx *gin.Context) {
+	userID := ctx.GetString("userID")
+
+	reportStatus := ctx.DefaultQuery("status", "open")
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Call the post service
+	resp, err := c.postService.GetReports(ctx, userID, reportStatus, page, limit)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get reports", err)
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			respondWithStatus(ctx, http.StatusBadRequest, "Status must be 'open' or 'resolved'")
+			return
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "Only admins can view reports")
+			return
+		}
+		respondWithError(ctx, err, "Failed to get reports")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ResolveReport handles resolving a report
+// @Summary Resolve a report
+// @Description Resolve a report together with the other open reports on the same post, optionally hiding the post from everyone. Admins only.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Param request body models.ResolveReportRequest false "Resolve request"
+// @Success 200 {object} models.Report "Report resolved successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not an admin"
+// @Failure 404 {object} models.ErrorResponse "Report not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/reports/{id}/resolve [post]
+func (c *PostController) ResolveReport(ctx *gin.Context) {
+	reportID := ctx.Param("id")
+	userID := ctx.GetString("userID")
+
+	// The body is optional, an empty request resolves without hiding the post
+	var request models.ResolveReportRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			respondWithBindError(ctx, err, &request)
+			return
+		}
+	}
+
+	// Call the post service
+	resp, err := c.postService.ResolveReport(ctx, reportID, userID, request)
+
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to resolve report", err)
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, "Only admins can resolve reports")
+			return
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "Report not found")
+			return
+		}
+		respondWithError(ctx, err, "Failed to resolve report")
 		return
 	}
 
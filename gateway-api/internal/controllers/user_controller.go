@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"gateway-api/internal/config"
 	"gateway-api/internal/models"
@@ -21,9 +24,7 @@ type UserController struct {
 }
 
 // NewUserController creates a new user controller
-func NewUserController(cfg *config.Config, logger *logger.Logger) *UserController {
-	userService := services.NewUserService(cfg, logger)
-
+func NewUserController(cfg *config.Config, logger *logger.Logger, userService services.UserService) *UserController {
 	return &UserController{
 		cfg:         cfg,
 		logger:      logger,
@@ -40,15 +41,14 @@ func NewUserController(cfg *config.Config, logger *logger.Logger) *UserControlle
 // @Param request body models.AuthRequest true "Registration request"
 // @Success 201 {object} models.AuthResponse "User registered successfully"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 403 {object} models.ErrorResponse "Email address is not verified"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /users/register [post]
 func (c *UserController) Register(ctx *gin.Context) {
 	var request models.AuthRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -56,10 +56,11 @@ func (c *UserController) Register(ctx *gin.Context) {
 	resp, err := c.userService.Register(ctx, request)
 
 	if err != nil {
-		c.logger.Error("Failed to register user", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to register user",
-		})
+		c.logger.WithContext(ctx).Error("Failed to register user", err)
+		if respondEmailNotVerified(ctx, err) {
+			return
+		}
+		respondWithError(ctx, err, "Failed to register user")
 		return
 	}
 
@@ -75,15 +76,14 @@ func (c *UserController) Register(ctx *gin.Context) {
 // @Param request body models.AuthRequest true "Login request"
 // @Success 200 {object} models.AuthResponse "User logged in successfully"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 403 {object} models.ErrorResponse "Email address is not verified"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /users/login [post]
 func (c *UserController) Login(ctx *gin.Context) {
 	var request models.AuthRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -91,10 +91,11 @@ func (c *UserController) Login(ctx *gin.Context) {
 	resp, err := c.userService.Login(ctx, request)
 
 	if err != nil {
-		c.logger.Error("Failed to login user", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to login user",
-		})
+		c.logger.WithContext(ctx).Error("Failed to login user", err)
+		if respondEmailNotVerified(ctx, err) {
+			return
+		}
+		respondWithError(ctx, err, "Failed to login user")
 		return
 	}
 
@@ -125,10 +126,153 @@ func (c *UserController) GetProfile(ctx *gin.Context) {
 	resp, err := c.userService.GetProfile(reqCtx, userID)
 
 	if err != nil {
-		c.logger.Error("Failed to get user profile", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to get user profile",
-		})
+		c.logger.WithContext(ctx).Error("Failed to get user profile", err)
+		respondWithError(ctx, err, "Failed to get user profile")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPublicProfile gets another user's public profile
+// @Summary Get a user's public profile
+// @Description Get a user's name, avatar, public post count and friendship status with the caller. The email is only included if the configured visibility allows the caller to see it.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} models.PublicUserProfile "Public profile"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/{id} [get]
+func (c *UserController) GetPublicProfile(ctx *gin.Context) {
+	// Call the user service
+	resp, err := c.userService.GetPublicProfile(ctx, ctx.Param("id"))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get public profile", err)
+		respondWithError(ctx, err, "Failed to get user profile")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SearchUsers finds users by name or email
+// @Summary Search users
+// @Description Find users by name or email, excluding the caller, with each result's friendship status
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Text to match against names and emails"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of users per page" default(10)
+// @Success 200 {object} models.UserSearchResponse "Matching users with pagination"
+// @Failure 400 {object} models.ErrorResponse "Missing search query"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/search [get]
+func (c *UserController) SearchUsers(ctx *gin.Context) {
+	query := strings.TrimSpace(ctx.Query("q"))
+	if query == "" {
+		respondWithStatus(ctx, http.StatusBadRequest, "Search query is required")
+		return
+	}
+
+	page, limit, ok := parsePagination(ctx, c.cfg, 10)
+	if !ok {
+		return
+	}
+
+	// Call the user service
+	resp, err := c.userService.SearchUsers(ctx, query, page, limit)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		c.logger.WithContext(ctx).Error("Failed to search users", err)
+		respondWithError(ctx, err, "Failed to search users")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// DeleteAccount permanently deletes the authenticated user's account
+// @Summary Delete account
+// @Description Permanently delete the account of the authenticated user
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.SuccessResponse "Account deleted successfully"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /users/me [delete]
+func (c *UserController) DeleteAccount(ctx *gin.Context) {
+	// Call the user service; the account is taken from the caller's token
+	success, err := c.userService.DeleteAccount(ctx)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			respondWithError(ctx, err, "Failed to delete account")
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "User not found")
+		default:
+			c.logger.WithContext(ctx).Error("Failed to delete account", err)
+			respondWithError(ctx, err, "Failed to delete account")
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.SuccessResponse{
+		Success: success,
+	})
+}
+
+// SetUserRole changes the role of a user
+// @Summary Set user role
+// @Description Grant or revoke the admin role of a user. Only admins can call this endpoint.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body models.UserRoleRequest true "Role request"
+// @Success 200 {object} models.UserProfile "Role updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 409 {object} models.ErrorResponse "Admins cannot change their own role"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/users/{id}/role [put]
+func (c *UserController) SetUserRole(ctx *gin.Context) {
+	userID := ctx.Param("id")
+
+	var request models.UserRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		respondWithBindError(ctx, err, &request)
+		return
+	}
+
+	// Call the user service; it checks the caller's role again
+	resp, err := c.userService.SetUserRole(ctx, userID, request)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			respondWithStatus(ctx, http.StatusBadRequest, status.Convert(err).Message())
+		case codes.PermissionDenied:
+			respondWithStatus(ctx, http.StatusForbidden, status.Convert(err).Message())
+		case codes.NotFound:
+			respondWithStatus(ctx, http.StatusNotFound, "User not found")
+		case codes.FailedPrecondition:
+			respondWithStatus(ctx, http.StatusConflict, status.Convert(err).Message())
+		default:
+			c.logger.WithContext(ctx).Error("Failed to set user role", err)
+			respondWithError(ctx, err, "Failed to set user role")
+		}
 		return
 	}
 
@@ -137,7 +281,7 @@ func (c *UserController) GetProfile(ctx *gin.Context) {
 
 // UpdateProfile updates the user's profile
 // @Summary Update user profile
-// @Description Update the profile of the authenticated user
+// @Description Update the profile of the authenticated user. Name and avatar are kept when empty; bio, location and website are kept when omitted and cleared when empty.
 // @Tags users
 // @Accept json
 // @Produce json
@@ -154,9 +298,7 @@ func (c *UserController) UpdateProfile(ctx *gin.Context) {
 	var request models.ProfileUpdateRequest
 
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error: err.Error(),
-		})
+		respondWithBindError(ctx, err, &request)
 		return
 	}
 
@@ -170,10 +312,8 @@ func (c *UserController) UpdateProfile(ctx *gin.Context) {
 	resp, err := c.userService.UpdateProfile(reqCtx, userID, request)
 
 	if err != nil {
-		c.logger.Error("Failed to update user profile", err)
-		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Error: "Failed to update user profile",
-		})
+		c.logger.WithContext(ctx).Error("Failed to update user profile", err)
+		respondWithError(ctx, err, "Failed to update user profile")
 		return
 	}
 
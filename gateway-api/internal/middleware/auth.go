@@ -1,28 +1,61 @@
 package middleware
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
 
 	"gateway-api/internal/config"
+	"gateway-api/internal/models"
 	"gateway-api/internal/utils/logger"
 )
 
+var (
+	// errTokenRevoked is returned for a valid token that has since been signed out
+	errTokenRevoked = errors.New("token has been revoked")
+	// errRevocationUnavailable is returned when a token cannot be checked against signouts
+	errRevocationUnavailable = errors.New("unable to check token revocation")
+)
+
 // AuthMiddleware handles authentication and authorization
 type AuthMiddleware struct {
-	cfg    *config.Config
-	logger *logger.Logger
+	cfg       *config.Config
+	logger    *logger.Logger
+	isRevoked func(ctx context.Context, jti, userID string) (bool, error)
+
+	mu          sync.Mutex
+	revocations map[string]revocation
 }
 
-// NewAuthMiddleware creates a new auth middleware
-func NewAuthMiddleware(cfg *config.Config, logger *logger.Logger) *AuthMiddleware {
-	return &AuthMiddleware{
-		cfg:    cfg,
-		logger: logger,
+// revocation is a cached answer of whether a token was signed out
+type revocation struct {
+	revoked   bool
+	checkedAt time.Time
+}
+
+// NewAuthMiddleware creates a new auth middleware; isRevoked reports whether the token with the
+// given ID has been signed out or the user it was issued to no longer exists
+func NewAuthMiddleware(cfg *config.Config, logger *logger.Logger, isRevoked func(ctx context.Context, jti, userID string) (bool, error)) *AuthMiddleware {
+	m := &AuthMiddleware{
+		cfg:         cfg,
+		logger:      logger,
+		isRevoked:   isRevoked,
+		revocations: make(map[string]revocation),
 	}
+
+	if cfg.TokenRevocationCacheTTL > 0 {
+		go m.cleanup()
+	}
+
+	return m
 }
 
 // Authenticate verifies the JWT token in the Authorization header
@@ -30,8 +63,9 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Authorization header is required",
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+				Error: "Authorization header is required",
+				Code:  models.ErrorCodeTokenMissing,
 			})
 			return
 		}
@@ -39,53 +73,216 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 		// Check if the Authorization header has the correct format
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Authorization header format must be Bearer {token}",
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+				Error: "Authorization header format must be Bearer {token}",
+				Code:  models.ErrorCodeTokenInvalid,
 			})
 			return
 		}
 
 		// Parse and validate the token
 		tokenString := parts[1]
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate the signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.NewValidationError("unexpected signing method", jwt.ValidationErrorSignatureInvalid)
-			}
-			return []byte(m.cfg.JWTSecret), nil
-		})
-
+		userID, role, err := m.verifyToken(c, tokenString)
 		if err != nil {
-			m.logger.Error("Failed to parse token", err)
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid or expired token",
+			m.logger.WithContext(c).Error("Failed to parse token", err)
+			if isTokenExpired(err) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error: "Token has expired",
+					Code:  models.ErrorCodeTokenExpired,
+				})
+				return
+			}
+			if errors.Is(err, errTokenRevoked) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error: "Token has been revoked",
+					Code:  models.ErrorCodeTokenInvalid,
+				})
+				return
+			}
+			if errors.Is(err, errRevocationUnavailable) {
+				// The token could not be checked against signouts, so it is refused rather
+				// than trusted
+				c.AbortWithStatusJSON(http.StatusServiceUnavailable, models.ErrorResponse{
+					Error: "Unable to verify token",
+				})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{
+				Error: "Invalid token",
+				Code:  models.ErrorCodeTokenInvalid,
 			})
 			return
 		}
 
-		// Check if the token is valid
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			// Add claims to the context
-			userID, ok := claims["sub"].(string)
-			if !ok {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-					"error": "Invalid token claims",
-				})
-				return
-			}
+		// Set user ID and role in context, and tag the request's log entries with the user ID
+		c.Set("userID", userID)
+		c.Set("role", role)
+		c.Set(logger.ContextKey, m.logger.WithContext(c).With(zap.String("user_id", userID)))
 
-			// Set user ID in context
-			c.Set("userID", userID)
+		// Also set the JWT token in context
+		c.Set("jwt_token", tokenString)
 
-			// Also set the JWT token in context
-			c.Set("jwt_token", tokenString)
+		c.Next()
+	}
+}
 
-			c.Next()
-		} else {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid token",
+// RequireRole only lets through users whose token carries the given role. It must run after
+// Authenticate, which puts the role in the context.
+func (m *AuthMiddleware) RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("role") != role {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.ErrorResponse{
+				Error: "This action requires the " + role + " role",
 			})
 			return
 		}
+
+		c.Next()
+	}
+}
+
+// OptionalAuthenticate identifies the caller on public routes. A request with a valid bearer
+// token gets the same context as with Authenticate; any other request goes on anonymously.
+func (m *AuthMiddleware) OptionalAuthenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			c.Next()
+			return
+		}
+
+		userID, role, err := m.verifyToken(c, parts[1])
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		c.Set("userID", userID)
+		c.Set("role", role)
+		c.Set(logger.ContextKey, m.logger.WithContext(c).With(zap.String("user_id", userID)))
+		c.Set("jwt_token", parts[1])
+
+		c.Next()
+	}
+}
+
+// UserIDFromRequest returns the user ID of a valid bearer token on the request, or an empty string.
+// Unlike Authenticate it never aborts, so it can identify callers on public routes.
+func (m *AuthMiddleware) UserIDFromRequest(c *gin.Context) string {
+	if userID := c.GetString("userID"); userID != "" {
+		return userID
+	}
+
+	parts := strings.Split(c.GetHeader("Authorization"), " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ""
+	}
+
+	// Only used to tell callers apart, so the token is not checked against signouts
+	userID, _, _, err := m.parseToken(parts[1])
+	if err != nil {
+		return ""
+	}
+	return userID
+}
+
+// verifyToken validates a JWT, makes sure it has not been signed out and its user still exists,
+// and returns its subject and role
+func (m *AuthMiddleware) verifyToken(ctx context.Context, tokenString string) (string, string, error) {
+	userID, role, jti, err := m.parseToken(tokenString)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Tokens issued before signout was introduced carry no ID and cannot be revoked
+	if jti != "" {
+		revoked, err := m.checkRevoked(ctx, jti, userID)
+		if err != nil {
+			return "", "", fmt.Errorf("%w: %v", errRevocationUnavailable, err)
+		}
+		if revoked {
+			return "", "", errTokenRevoked
+		}
+	}
+
+	return userID, role, nil
+}
+
+// checkRevoked reports whether the token with the given ID has been signed out or its user
+// deleted, reusing answers younger than the configured cache TTL
+func (m *AuthMiddleware) checkRevoked(ctx context.Context, jti, userID string) (bool, error) {
+	ttl := m.cfg.TokenRevocationCacheTTL
+
+	if ttl > 0 {
+		m.mu.Lock()
+		cached, ok := m.revocations[jti]
+		m.mu.Unlock()
+		if ok && time.Since(cached.checkedAt) < ttl {
+			return cached.revoked, nil
+		}
 	}
+
+	revoked, err := m.isRevoked(ctx, jti, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if ttl > 0 {
+		m.mu.Lock()
+		m.revocations[jti] = revocation{revoked: revoked, checkedAt: time.Now()}
+		m.mu.Unlock()
+	}
+
+	return revoked, nil
+}
+
+// cleanup periodically forgets revocation answers older than the cache TTL
+func (m *AuthMiddleware) cleanup() {
+	ticker := time.NewTicker(m.cfg.TokenRevocationCacheTTL)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		m.mu.Lock()
+		for jti, cached := range m.revocations {
+			if time.Since(cached.checkedAt) >= m.cfg.TokenRevocationCacheTTL {
+				delete(m.revocations, jti)
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+// parseToken validates a JWT and returns its subject, role and ID. Tokens issued before roles
+// were introduced carry no role claim and get an empty role.
+func (m *AuthMiddleware) parseToken(tokenString string) (string, string, string, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.NewValidationError("unexpected signing method", jwt.ValidationErrorSignatureInvalid)
+		}
+		return []byte(m.cfg.JWTSecret), nil
+	})
+	if err != nil {
+		return "", "", "", err
+	}
+
+	// Check if the token is valid
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return "", "", "", errors.New("invalid token")
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		return "", "", "", errors.New("invalid token claims")
+	}
+	role, _ := claims["role"].(string)
+	jti, _ := claims["jti"].(string)
+	return userID, role, jti, nil
+}
+
+// isTokenExpired reports whether a token was rejected because of its exp claim
+func isTokenExpired(err error) bool {
+	var validationErr *jwt.ValidationError
+	return errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0
 }
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"gateway-api/internal/utils/logger"
+)
+
+const testSecret = "test-secret"
+
+func newTestToken(t *testing.T, jti string) string {
+	t.Helper()
+	return newUserToken(t, "user-1", jti)
+}
+
+func newUserToken(t *testing.T, userID, jti string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": userID,
+		"jti": jti,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func newAuthRouter(cacheTTL time.Duration, isRevoked func(ctx context.Context, jti, userID string) (bool, error)) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{JWTSecret: testSecret, TokenRevocationCacheTTL: cacheTTL}
+	m := NewAuthMiddleware(cfg, &logger.Logger{Logger: zap.NewNop()}, isRevoked)
+
+	router := gin.New()
+	router.GET("/private", m.Authenticate(), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString("userID"))
+	})
+	router.GET("/public", m.OptionalAuthenticate(), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString("userID"))
+	})
+	return router
+}
+
+func doRequest(router *gin.Engine, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthenticateRejectsRevokedToken(t *testing.T) {
+	revoked := map[string]bool{"signed-out": true}
+	router := newAuthRouter(0, func(ctx context.Context, jti, userID string) (bool, error) {
+		return revoked[jti], nil
+	})
+
+	if w := doRequest(router, "/private", newTestToken(t, "signed-out")); w.Code != http.StatusUnauthorized {
+		t.Errorf("revoked token: got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if w := doRequest(router, "/private", newTestToken(t, "active")); w.Code != http.StatusOK || w.Body.String() != "user-1" {
+		t.Errorf("active token: got status %d body %q, want %d %q", w.Code, w.Body.String(), http.StatusOK, "user-1")
+	}
+
+	// A revoked token on a public route is treated as no token at all
+	if w := doRequest(router, "/public", newTestToken(t, "signed-out")); w.Code != http.StatusOK || w.Body.String() != "" {
+		t.Errorf("revoked token on public route: got status %d body %q, want anonymous", w.Code, w.Body.String())
+	}
+}
+
+func TestAuthenticateRejectsTokensOfDeletedUsers(t *testing.T) {
+	// The users service answers that a token is revoked once its user is gone, whatever its ID
+	users := map[string]bool{"user-1": true}
+	router := newAuthRouter(0, func(ctx context.Context, jti, userID string) (bool, error) {
+		return !users[userID], nil
+	})
+
+	if w := doRequest(router, "/private", newUserToken(t, "user-1", "active")); w.Code != http.StatusOK {
+		t.Errorf("token of an existing user: got status %d, want %d", w.Code, http.StatusOK)
+	}
+
+	delete(users, "user-1")
+	w := doRequest(router, "/private", newUserToken(t, "user-1", "active"))
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("token of a deleted user: got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	var body models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || body.Code != models.ErrorCodeTokenInvalid {
+		t.Errorf("token of a deleted user: got body %s, want code %s", w.Body.String(), models.ErrorCodeTokenInvalid)
+	}
+}
+
+func TestAuthenticateFailsClosedWhenRevocationCheckFails(t *testing.T) {
+	router := newAuthRouter(0, func(ctx context.Context, jti, userID string) (bool, error) {
+		return false, errors.New("users service unavailable")
+	})
+
+	if w := doRequest(router, "/private", newTestToken(t, "active")); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestAuthenticateCachesRevocationChecks(t *testing.T) {
+	var calls atomic.Int32
+	router := newAuthRouter(time.Minute, func(ctx context.Context, jti, userID string) (bool, error) {
+		calls.Add(1)
+		return false, nil
+	})
+
+	token := newTestToken(t, "active")
+	for i := 0; i < 3; i++ {
+		if w := doRequest(router, "/private", token); w.Code != http.StatusOK {
+			t.Fatalf("request %d: got status %d, want %d", i, w.Code, http.StatusOK)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("revocation checked %d times, want 1", got)
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+)
+
+// BodyLimit rejects request bodies larger than the configured limit with 413, so handlers never
+// read more than that into memory. Route groups can override the limit by path prefix; the
+// longest matching prefix wins.
+func BodyLimit(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := bodyLimitFor(cfg, c.FullPath())
+		if limit <= 0 || c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		// The declared length can be checked before reading anything
+		if c.Request.ContentLength > limit {
+			abortBodyTooLarge(c)
+			return
+		}
+
+		body := http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+
+		// Chunked bodies have no declared length; read them up front so that an oversized
+		// one is answered with 413 rather than a bind error from the handler
+		if c.Request.ContentLength < 0 {
+			data, err := io.ReadAll(body)
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					abortBodyTooLarge(c)
+					return
+				}
+				c.AbortWithStatusJSON(http.StatusBadRequest, models.ErrorResponse{
+					Error: "Failed to read request body",
+					Code:  models.ErrorCodeInvalidArgument,
+				})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(data))
+			c.Next()
+			return
+		}
+
+		c.Request.Body = body
+		c.Next()
+	}
+}
+
+// bodyLimitFor returns the body size limit of a route
+func bodyLimitFor(cfg *config.Config, route string) int64 {
+	// Viper lowercases map keys, so group overrides are matched case-insensitively
+	route = strings.ToLower(route)
+
+	limit, matched := cfg.BodyLimit.MaxBytes, ""
+	for prefix, override := range cfg.BodyLimit.Groups {
+		if len(prefix) > len(matched) && (route == prefix || strings.HasPrefix(route, strings.TrimSuffix(prefix, "/")+"/")) {
+			limit, matched = override, prefix
+		}
+	}
+	return limit
+}
+
+// abortBodyTooLarge answers a request whose body exceeds the limit
+func abortBodyTooLarge(c *gin.Context) {
+	// The rest of the body is not read, so the connection cannot be reused
+	c.Header("Connection", "close")
+	c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, models.ErrorResponse{
+		Error: "Request body too large",
+		Code:  models.ErrorCodeInvalidArgument,
+	})
+}
\ No newline at end of file
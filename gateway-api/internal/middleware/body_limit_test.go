@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+)
+
+func newBodyLimitRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.BodyLimit.MaxBytes = 16
+	cfg.BodyLimit.Groups = map[string]int64{"/media": 64}
+
+	router := gin.New()
+	router.Use(BodyLimit(cfg))
+	echo := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.String(http.StatusOK, string(body))
+	}
+	router.POST("/posts", echo)
+	router.POST("/media/upload", echo)
+	return router
+}
+
+// postBody sends body to path, with its length declared unless chunked is set
+func postBody(router *gin.Engine, path, body string, chunked bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if chunked {
+		req.ContentLength = -1
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestBodyLimitRejectsOversizedBodies(t *testing.T) {
+	router := newBodyLimitRouter()
+	oversized := strings.Repeat("x", 17)
+
+	for _, chunked := range []bool{false, true} {
+		w := postBody(router, "/posts", oversized, chunked)
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Fatalf("chunked=%v: got status %d, want %d", chunked, w.Code, http.StatusRequestEntityTooLarge)
+		}
+
+		var resp models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("chunked=%v: failed to decode response %q: %v", chunked, w.Body.String(), err)
+		}
+		if resp.Error != "Request body too large" || resp.Code != models.ErrorCodeInvalidArgument {
+			t.Errorf("chunked=%v: got %+v, want the body too large error", chunked, resp)
+		}
+	}
+}
+
+func TestBodyLimitAcceptsBodiesWithinLimit(t *testing.T) {
+	router := newBodyLimitRouter()
+
+	for _, chunked := range []bool{false, true} {
+		body := strings.Repeat("x", 16)
+		if w := postBody(router, "/posts", body, chunked); w.Code != http.StatusOK || w.Body.String() != body {
+			t.Errorf("chunked=%v: got status %d body %q, want the body echoed", chunked, w.Code, w.Body.String())
+		}
+	}
+
+	// Route groups can raise the limit
+	body := strings.Repeat("x", 64)
+	if w := postBody(router, "/media/upload", body, false); w.Code != http.StatusOK {
+		t.Errorf("group override: got status %d, want %d", w.Code, http.StatusOK)
+	}
+	if w := postBody(router, "/media/upload", body+"x", false); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("group override: got status %d, want %d", w.Code, http.StatusRequestEntityTooLarge)
+	}
+}
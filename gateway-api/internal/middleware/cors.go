@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+)
+
+// CORS sets cross-origin headers from the CORS configuration.
+// The request origin is echoed back only when it is in the allowed list; a lone "*"
+// keeps the wildcard behavior, which browsers only accept without credentials.
+func CORS(cfg *config.Config) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(cfg.CORS.AllowedOrigins))
+	for _, origin := range cfg.CORS.AllowedOrigins {
+		allowed[strings.TrimRight(origin, "/")] = true
+	}
+	wildcard := len(cfg.CORS.AllowedOrigins) == 1 && cfg.CORS.AllowedOrigins[0] == "*" && !cfg.CORS.AllowCredentials
+
+	methods := strings.Join(cfg.CORS.AllowedMethods, ", ")
+	headers := strings.Join(cfg.CORS.AllowedHeaders, ", ")
+	exposed := strings.Join(cfg.CORS.ExposedHeaders, ", ")
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		preflight := c.Request.Method == http.MethodOptions
+
+		// Requests without an Origin header are not cross-origin browser requests
+		if origin == "" {
+			if preflight {
+				c.AbortWithStatus(http.StatusNoContent)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		switch {
+		case wildcard:
+			c.Header("Access-Control-Allow-Origin", "*")
+		case allowed[origin]:
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Vary", "Origin")
+			if cfg.CORS.AllowCredentials {
+				c.Header("Access-Control-Allow-Credentials", "true")
+			}
+		default:
+			// Leave the CORS headers off so the browser blocks the response
+			if preflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if exposed != "" {
+			c.Header("Access-Control-Expose-Headers", exposed)
+		}
+
+		if preflight {
+			c.Header("Access-Control-Allow-Methods", methods)
+			c.Header("Access-Control-Allow-Headers", headers)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
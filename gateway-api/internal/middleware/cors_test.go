@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+)
+
+func newCORSRouter(origins []string, credentials bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.CORS.AllowedOrigins = origins
+	cfg.CORS.AllowedMethods = []string{"GET", "POST"}
+	cfg.CORS.AllowedHeaders = []string{"Authorization", "Content-Type"}
+	cfg.CORS.ExposedHeaders = []string{"X-Request-ID"}
+	cfg.CORS.AllowCredentials = credentials
+
+	router := gin.New()
+	router.Use(CORS(cfg))
+	router.GET("/posts", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	return router
+}
+
+// corsRequest sends a request from origin, if set
+func corsRequest(router *gin.Engine, method, origin string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/posts", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	if method == http.MethodOptions {
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestCORSEchoesAllowedOrigins(t *testing.T) {
+	router := newCORSRouter([]string{"https://app.example.com", "https://admin.example.com/"}, true)
+
+	for _, origin := range []string{"https://app.example.com", "https://admin.example.com"} {
+		w := corsRequest(router, http.MethodGet, origin)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: got status %d, want %d", origin, w.Code, http.StatusOK)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != origin {
+			t.Errorf("%s: got Access-Control-Allow-Origin %q, want the origin", origin, got)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "true" {
+			t.Errorf("%s: got Access-Control-Allow-Credentials %q, want true", origin, got)
+		}
+		if got := w.Header().Get("Vary"); got != "Origin" {
+			t.Errorf("%s: got Vary %q, want Origin", origin, got)
+		}
+		if got := w.Header().Get("Access-Control-Expose-Headers"); got != "X-Request-ID" {
+			t.Errorf("%s: got Access-Control-Expose-Headers %q, want X-Request-ID", origin, got)
+		}
+	}
+}
+
+func TestCORSLeavesDisallowedOriginsWithoutHeaders(t *testing.T) {
+	router := newCORSRouter([]string{"https://app.example.com"}, true)
+
+	w := corsRequest(router, http.MethodGet, "https://evil.example.com")
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusOK)
+	}
+	for _, header := range []string{"Access-Control-Allow-Origin", "Access-Control-Allow-Credentials"} {
+		if got := w.Header().Get(header); got != "" {
+			t.Errorf("got %s %q, want none", header, got)
+		}
+	}
+
+	if w := corsRequest(router, http.MethodOptions, "https://evil.example.com"); w.Code != http.StatusForbidden {
+		t.Errorf("preflight: got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestCORSAnswersPreflights(t *testing.T) {
+	router := newCORSRouter([]string{"https://app.example.com"}, true)
+
+	w := corsRequest(router, http.MethodOptions, "https://app.example.com")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusNoContent)
+	}
+	want := map[string]string{
+		"Access-Control-Allow-Origin":  "https://app.example.com",
+		"Access-Control-Allow-Methods": "GET, POST",
+		"Access-Control-Allow-Headers": "Authorization, Content-Type",
+	}
+	for header, value := range want {
+		if got := w.Header().Get(header); got != value {
+			t.Errorf("got %s %q, want %q", header, got, value)
+		}
+	}
+
+	// Preflights without an origin are answered without CORS headers
+	w = corsRequest(router, http.MethodOptions, "")
+	if w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("preflight without origin: got status %d with origin %q", w.Code, w.Header().Get("Access-Control-Allow-Origin"))
+	}
+}
+
+func TestCORSWildcard(t *testing.T) {
+	router := newCORSRouter([]string{"*"}, false)
+
+	w := corsRequest(router, http.MethodGet, "https://anywhere.example.com")
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("got Access-Control-Allow-Origin %q, want *", got)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+		t.Errorf("got Access-Control-Allow-Credentials %q, want none", got)
+	}
+
+	// With credentials the wildcard is not honored, as browsers would reject it
+	router = newCORSRouter([]string{"*"}, true)
+	w = corsRequest(router, http.MethodGet, "https://anywhere.example.com")
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("with credentials: got Access-Control-Allow-Origin %q, want none", got)
+	}
+}
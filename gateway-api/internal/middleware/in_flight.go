@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InFlight counts the requests being handled, so a shutdown can report how many it drains
+type InFlight struct {
+	count atomic.Int64
+}
+
+// NewInFlight creates a counter with no requests in flight
+func NewInFlight() *InFlight {
+	return &InFlight{}
+}
+
+// Track counts each request while its handlers run
+func (f *InFlight) Track() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		f.count.Add(1)
+		defer f.count.Add(-1)
+
+		c.Next()
+	}
+}
+
+// Count returns the number of requests being handled
+func (f *InFlight) Count() int64 {
+	return f.count.Load()
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/utils/logger"
+)
+
+// PresenceTracker reports authenticated users as active to the users service, at most once
+// per configured interval per user
+type PresenceTracker struct {
+	cfg      *config.Config
+	logger   *logger.Logger
+	markSeen func(ctx context.Context) error
+
+	mu         sync.Mutex
+	lastMarked map[string]time.Time
+}
+
+// NewPresenceTracker creates a new presence tracker; markSeen reports the user authenticated on ctx
+func NewPresenceTracker(cfg *config.Config, logger *logger.Logger, markSeen func(ctx context.Context) error) *PresenceTracker {
+	pt := &PresenceTracker{
+		cfg:        cfg,
+		logger:     logger,
+		markSeen:   markSeen,
+		lastMarked: make(map[string]time.Time),
+	}
+
+	if cfg.Presence.MarkInterval > 0 {
+		go pt.cleanup()
+	}
+
+	return pt
+}
+
+// Track marks the user as active once the request has been handled. It relies on the auth
+// middleware of the route having set the user, so anonymous requests are not tracked.
+func (pt *PresenceTracker) Track() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		userID := c.GetString("userID")
+		if pt.cfg.Presence.MarkInterval <= 0 || userID == "" || !pt.due(userID) {
+			return
+		}
+
+		// Report in the background so the response is not held up; the copy keeps the
+		// token and request ID once the request is done, without its cancellation
+		ctx := context.WithoutCancel(c.Copy())
+		go func() {
+			_ = pt.markSeen(ctx)
+		}()
+	}
+}
+
+// due reports whether the user has not been marked within the interval, and if so counts them
+// as marked now
+func (pt *PresenceTracker) due(userID string) bool {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	now := time.Now()
+	if last, ok := pt.lastMarked[userID]; ok && now.Sub(last) < pt.cfg.Presence.MarkInterval {
+		return false
+	}
+	pt.lastMarked[userID] = now
+
+	return true
+}
+
+// cleanup periodically forgets users who have not been marked within the interval
+func (pt *PresenceTracker) cleanup() {
+	ticker := time.NewTicker(pt.cfg.Presence.MarkInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		pt.mu.Lock()
+		for userID, last := range pt.lastMarked {
+			if time.Since(last) >= pt.cfg.Presence.MarkInterval {
+				delete(pt.lastMarked, userID)
+			}
+		}
+		pt.mu.Unlock()
+	}
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"gateway-api/internal/utils/logger"
+)
+
+const (
+	// rateLimitCleanupInterval is how often idle client buckets are evicted
+	rateLimitCleanupInterval = time.Minute
+
+	// rateLimitIdleTTL is how long a client bucket is kept after its last request
+	rateLimitIdleTTL = 5 * time.Minute
+)
+
+// clientBucket is the token bucket of a single client on a single route scope
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter limits requests per client using token buckets.
+// Clients are identified by user ID when they send a valid token and by IP otherwise.
+type RateLimiter struct {
+	cfg      *config.Config
+	logger   *logger.Logger
+	identify func(c *gin.Context) string
+
+	mu      sync.Mutex
+	buckets map[string]*clientBucket
+}
+
+// NewRateLimiter creates a new rate limiter; identify returns the user ID of the caller, if any
+func NewRateLimiter(cfg *config.Config, logger *logger.Logger, identify func(c *gin.Context) string) *RateLimiter {
+	rl := &RateLimiter{
+		cfg:      cfg,
+		logger:   logger,
+		identify: identify,
+		buckets:  make(map[string]*clientBucket),
+	}
+
+	go rl.cleanup()
+
+	return rl
+}
+
+// Limit applies the configured limit, or the route's override if one is configured
+func (rl *RateLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Viper lowercases map keys, so route overrides are matched case-insensitively
+		route := strings.ToLower(c.FullPath())
+
+		scope := ""
+		limit, burst := rate.Limit(rl.cfg.RateLimit.RequestsPerSecond), rl.cfg.RateLimit.Burst
+		if override, ok := rl.cfg.RateLimit.Routes[route]; ok {
+			scope = route
+			limit, burst = rate.Limit(override.RequestsPerSecond), override.Burst
+		}
+
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		client := "ip:" + c.ClientIP()
+		if userID := rl.identify(c); userID != "" {
+			client = "user:" + userID
+		}
+
+		reservation := rl.bucket(scope+"|"+client, limit, burst).Reserve()
+		if delay := reservation.Delay(); !reservation.OK() || delay > 0 {
+			reservation.Cancel()
+
+			retryAfter := int(math.Ceil(delay.Seconds()))
+			if !reservation.OK() || retryAfter < 1 {
+				retryAfter = 1
+			}
+
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, models.ErrorResponse{
+				Error: "Too many requests",
+				Code:  models.ErrorCodeResourceExhausted,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// bucket returns the token bucket for key, creating it on first use
+func (rl *RateLimiter) bucket(key string, limit rate.Limit, burst int) *rate.Limiter {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &clientBucket{limiter: rate.NewLimiter(limit, burst)}
+		rl.buckets[key] = b
+	}
+	b.lastSeen = time.Now()
+
+	return b.limiter
+}
+
+// cleanup periodically evicts buckets of clients that have gone idle
+func (rl *RateLimiter) cleanup() {
+	ticker := time.NewTicker(rateLimitCleanupInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		rl.mu.Lock()
+		for key, b := range rl.buckets {
+			if time.Since(b.lastSeen) > rateLimitIdleTTL {
+				delete(rl.buckets, key)
+			}
+		}
+		rl.mu.Unlock()
+	}
+}
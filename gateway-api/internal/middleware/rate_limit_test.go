@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"gateway-api/internal/utils/logger"
+)
+
+func TestRateLimitAnswersWithTheStandardErrorResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 1
+	rl := NewRateLimiter(cfg, &logger.Logger{Logger: zap.NewNop()}, func(c *gin.Context) string { return "" })
+
+	router := gin.New()
+	router.Use(rl.Limit())
+	router.GET("/posts", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/posts", nil))
+		return w
+	}
+
+	if w := get(); w.Code != http.StatusOK {
+		t.Fatalf("first request: got status %d, want %d", w.Code, http.StatusOK)
+	}
+
+	w := get()
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request: got status %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Error("limited request has no Retry-After header")
+	}
+	var body models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode body %q: %v", w.Body.String(), err)
+	}
+	if body.Code != models.ErrorCodeResourceExhausted || body.Error == "" {
+		t.Errorf("got body %+v, want an error with code %q", body, models.ErrorCodeResourceExhausted)
+	}
+}
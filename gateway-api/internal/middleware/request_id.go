@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"gateway-api/internal/utils/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// RequestIDHeader is the HTTP header carrying the request ID
+	RequestIDHeader = "X-Request-ID"
+
+	// RequestIDKey is the context key the request ID is stored under
+	RequestIDKey = "requestID"
+
+	// maxRequestIDLength caps client-supplied request IDs so they can't bloat logs
+	maxRequestIDLength = 128
+)
+
+// RequestID accepts the caller's X-Request-ID or generates a new one, stores it in the
+// context for outgoing gRPC calls and echoes it back in the response. It also stores a
+// logger scoped to the request, tagged with the request ID and route.
+func RequestID(log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = generateRequestID()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Set(logger.ContextKey, log.With(
+			zap.String("request_id", requestID),
+			zap.String("method", c.Request.Method),
+			zap.String("route", c.FullPath()),
+		))
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
+
+// isValidRequestID reports whether a client-supplied request ID is safe to propagate
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// generateRequestID returns a random 128-bit hex request ID
+func generateRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
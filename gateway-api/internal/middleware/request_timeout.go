@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"gateway-api/internal/config"
+)
+
+// RequestTimeout bounds the handling of each request by the configured timeout, so the
+// backend calls it makes are canceled once it passes. A request that runs out of time
+// before anything was written gets a 504. Export routes, whose paths end in /export, stream a
+// whole list and are bounded by the export timeout instead.
+func RequestTimeout(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := cfg.RequestTimeout
+		if strings.HasSuffix(c.FullPath(), "/export") {
+			timeout = cfg.ExportTimeout
+		}
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if ctx.Err() == context.DeadlineExceeded && !c.Writer.Written() {
+			c.AbortWithStatusJSON(http.StatusGatewayTimeout, gin.H{
+				"error": "Request timed out",
+			})
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireService fails requests with a 503 while available reports the backend serving
+// them as down, before any handler waits on it
+func RequireService(service string, available func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !available() {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error": "The " + service + " service is temporarily unavailable",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
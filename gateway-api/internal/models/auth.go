@@ -2,8 +2,10 @@ package models
 
 // AuthRequest represents an authentication request
 type AuthRequest struct {
-	Provider    string `json:"provider" binding:"required,oneof=google microsoft" example:"google"`
-	AccessToken string `json:"access_token" binding:"required" example:"ya29.a0AfB_byC..."`
+	Provider    string `json:"provider" binding:"required,oneof=google microsoft github apple" example:"google"`
+	AccessToken string `json:"access_token" binding:"required,max=4096" example:"ya29.a0AfB_byC..."`
+	// Name is only needed for apple, which shares it with the app on the first sign-in only
+	Name string `json:"name,omitempty" binding:"omitempty,max=255" example:"Jane Doe"`
 }
 
 // AuthResponse represents an authentication response
@@ -14,16 +16,69 @@ type AuthResponse struct {
 
 // ProfileUpdateRequest represents a profile update request
 type ProfileUpdateRequest struct {
-	Name   string `json:"name" example:"John Doe"`
-	Avatar string `json:"avatar" example:"https://example.com/avatar.jpg"`
+	Name   string `json:"name" binding:"omitempty,max=255" example:"John Doe"`
+	Avatar string `json:"avatar" binding:"omitempty,max=255,url" example:"https://example.com/avatar.jpg"`
+	// Bio, Location and Website are left unchanged when omitted and cleared when empty
+	Bio      *string `json:"bio,omitempty" binding:"omitempty,max=300" example:"Gopher, hiker and coffee drinker"`
+	Location *string `json:"location,omitempty" binding:"omitempty,max=100" example:"Lisbon, Portugal"`
+	Website  *string `json:"website,omitempty" binding:"omitempty,max=255,http_url|eq=" example:"https://example.com"`
+}
+
+// UserRoleRequest represents a request to change a user's role
+type UserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user admin" example:"admin"`
 }
 
 // UserProfile represents a user profile
 type UserProfile struct {
-	UserID    string `json:"user_id" example:"user123"`
-	Name      string `json:"name" example:"John Doe"`
-	Email     string `json:"email" example:"john.doe@example.com"`
-	Avatar    string `json:"avatar" example:"https://example.com/avatar.jpg"`
-	CreatedAt string `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	UpdatedAt string `json:"updated_at" example:"2023-01-02T12:00:00Z"`
+	UserID        string `json:"user_id" example:"user123"`
+	Name          string `json:"name" example:"John Doe"`
+	Username      string `json:"username" example:"john.doe"`
+	Email         string `json:"email" example:"john.doe@example.com"`
+	EmailVerified bool   `json:"email_verified" example:"true"`
+	Avatar        string `json:"avatar" example:"https://example.com/avatar.jpg"`
+	Role          string `json:"role" example:"user" enums:"user,admin"`
+	Bio           string `json:"bio" example:"Gopher, hiker and coffee drinker"`
+	Location      string `json:"location" example:"Lisbon, Portugal"`
+	Website       string `json:"website" example:"https://example.com"`
+	CreatedAt     string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt     string `json:"updated_at" example:"2023-01-02T12:00:00Z"`
+}
+
+// PublicUserProfile represents another user's profile as the caller sees it
+type PublicUserProfile struct {
+	UserID           string `json:"user_id" example:"user123"`
+	Name             string `json:"name" example:"John Doe"`
+	Username         string `json:"username" example:"john.doe"`
+	Avatar           string `json:"avatar" example:"https://example.com/avatar.jpg"`
+	Email            string `json:"email,omitempty" example:"john.doe@example.com"` // Only present when the caller may see it
+	Bio              string `json:"bio" example:"Gopher, hiker and coffee drinker"`
+	Location         string `json:"location" example:"Lisbon, Portugal"`
+	Website          string `json:"website" example:"https://example.com"`
+	CreatedAt        string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	LastSeenAt       string `json:"last_seen_at,omitempty" example:"2023-01-02T08:30:00Z"` // Absent if the user was never active
+	IsOnline         bool   `json:"is_online" example:"true"`
+	FriendshipStatus string `json:"friendship_status" example:"friends" enums:"none,pending,friends,blocked,self,unknown"`
+	PublicPostCount  *int32 `json:"public_post_count,omitempty" example:"12"` // Absent when the posts service is unavailable
+	FollowersCount   *int32 `json:"followers_count,omitempty" example:"120"`  // Absent when the friends service is unavailable
+	FollowingCount   *int32 `json:"following_count,omitempty" example:"80"`   // Absent when the friends service is unavailable
+	IsFollowing      bool   `json:"is_following" example:"false"`
+}
+
+// UserSearchResult represents a user found by a search and their relationship to the searcher
+type UserSearchResult struct {
+	UserID           string `json:"user_id" example:"user123"`
+	Name             string `json:"name" example:"John Doe"`
+	Username         string `json:"username" example:"john.doe"`
+	Avatar           string `json:"avatar" example:"https://example.com/avatar.jpg"`
+	FriendshipStatus string `json:"friendship_status" example:"none" enums:"none,pending,friends,blocked,unknown"`
+}
+
+// UserSearchResponse represents a page of user search results
+type UserSearchResponse struct {
+	Users      []UserSearchResult `json:"users"`
+	TotalCount int32              `json:"total_count" example:"42"`
+	Page       int32              `json:"page" example:"1"`
+	TotalPages int32              `json:"total_pages" example:"5"`
+	Pagination Pagination         `json:"pagination"`
 }
\ No newline at end of file
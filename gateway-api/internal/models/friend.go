@@ -2,7 +2,7 @@ package models
 
 // FriendRequest represents a friend request
 type FriendRequest struct {
-	FriendID string `json:"friend_id" binding:"required" example:"user456"`
+	FriendID string `json:"friend_id" binding:"required,max=36" example:"user456"`
 }
 
 // Friend represents a friend
@@ -12,14 +12,41 @@ type Friend struct {
 	Avatar       string `json:"avatar" example:"https://example.com/avatar.jpg"`
 	Email        string `json:"email" example:"jane.doe@example.com"`
 	FriendsSince string `json:"friends_since" example:"2023-01-01T12:00:00Z"`
+	LastSeenAt   string `json:"last_seen_at,omitempty" example:"2023-01-02T08:30:00Z"` // Absent if the friend was never active
+	IsOnline     bool   `json:"is_online" example:"true"`
 }
 
 // FriendsResponse represents a list of friends with pagination
 type FriendsResponse struct {
-	Friends     []Friend `json:"friends"`
-	TotalCount  int32    `json:"total_count" example:"42"`
-	Page        int32    `json:"page" example:"1"`
-	TotalPages  int32    `json:"total_pages" example:"5"`
+	Friends    []Friend   `json:"friends"`
+	TotalCount int32      `json:"total_count" example:"42"`
+	Page       int32      `json:"page" example:"1"`
+	TotalPages int32      `json:"total_pages" example:"5"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// BlockedUser represents a user blocked by the current user
+type BlockedUser struct {
+	UserID    string `json:"user_id" example:"user456"`
+	Name      string `json:"name" example:"Jane Doe"`
+	Avatar    string `json:"avatar" example:"https://example.com/avatar.jpg"`
+	BlockedAt string `json:"blocked_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// BlockedUsersResponse represents a list of blocked users with pagination
+type BlockedUsersResponse struct {
+	BlockedUsers []BlockedUser `json:"blocked_users"`
+	TotalCount   int32         `json:"total_count" example:"3"`
+	Page         int32         `json:"page" example:"1"`
+	TotalPages   int32         `json:"total_pages" example:"1"`
+	Pagination   Pagination    `json:"pagination"`
+}
+
+// FriendshipStatus represents the relationship between the current user and another user
+type FriendshipStatus struct {
+	AreFriends bool   `json:"are_friends" example:"false"`
+	Status     string `json:"status" example:"pending"`    // none, pending, friends, blocked or self
+	RequestID  string `json:"request_id" example:"req123"` // Set while a friend request is pending
 }
 
 // FriendRequestDetails represents a friend request with details
@@ -34,12 +61,51 @@ type FriendRequestDetails struct {
 	Status         string `json:"status" example:"pending"`
 	CreatedAt      string `json:"created_at" example:"2023-01-01T12:00:00Z"`
 	UpdatedAt      string `json:"updated_at" example:"2023-01-01T12:00:00Z"`
+	Direction      string `json:"direction,omitempty" example:"incoming"`
+}
+
+// FriendRequestBatch represents the friend requests to accept or reject at once
+type FriendRequestBatch struct {
+	RequestIDs []string `json:"request_ids" binding:"required,min=1,max=100,dive,required,max=36" example:"req123,req456"`
+}
+
+// FriendRequestResult represents the outcome for one friend request of a batch
+type FriendRequestResult struct {
+	RequestID string                `json:"request_id" example:"req123"`
+	Success   bool                  `json:"success" example:"true"`
+	Error     string                `json:"error,omitempty" example:"friend request is not pending"` // Why the request failed
+	Request   *FriendRequestDetails `json:"request,omitempty"`                                       // The updated request on success
+}
+
+// FriendRequestBatchResponse represents the outcomes for a batch of friend requests
+type FriendRequestBatchResponse struct {
+	Results        []FriendRequestResult `json:"results"`
+	SucceededCount int32                 `json:"succeeded_count" example:"2"`
+	FailedCount    int32                 `json:"failed_count" example:"1"`
 }
 
 // FriendRequestsResponse represents a list of friend requests with pagination
 type FriendRequestsResponse struct {
-	Requests    []FriendRequestDetails `json:"requests"`
-	TotalCount  int32                  `json:"total_count" example:"42"`
-	Page        int32                  `json:"page" example:"1"`
-	TotalPages  int32                  `json:"total_pages" example:"5"`
+	Requests   []FriendRequestDetails `json:"requests"`
+	TotalCount int32                  `json:"total_count" example:"42"`
+	Page       int32                  `json:"page" example:"1"`
+	TotalPages int32                  `json:"total_pages" example:"5"`
+	Pagination Pagination             `json:"pagination"`
+}
+
+// FollowUser represents a follower or a followed user
+type FollowUser struct {
+	UserID     string `json:"user_id" example:"user456"`
+	Name       string `json:"name" example:"Jane Doe"`
+	Avatar     string `json:"avatar" example:"https://example.com/avatar.jpg"`
+	FollowedAt string `json:"followed_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// FollowsResponse represents a list of followers or followed users with pagination
+type FollowsResponse struct {
+	Users      []FollowUser `json:"users"`
+	TotalCount int32        `json:"total_count" example:"42"`
+	Page       int32        `json:"page" example:"1"`
+	TotalPages int32        `json:"total_pages" example:"5"`
+	Pagination Pagination   `json:"pagination"`
 }
\ No newline at end of file
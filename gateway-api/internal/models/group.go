@@ -2,16 +2,18 @@ package models
 
 // GroupCreateRequest represents a group creation request
 type GroupCreateRequest struct {
-	Name        string `json:"name" binding:"required" example:"Tech Enthusiasts"`
-	Description string `json:"description" example:"A group for tech enthusiasts"`
-	Avatar      string `json:"avatar" example:"https://example.com/group-avatar.jpg"`
+	Name        string `json:"name" binding:"required,max=255" example:"Tech Enthusiasts"`
+	Description string `json:"description" binding:"max=2000" example:"A group for tech enthusiasts"`
+	Avatar      string `json:"avatar" binding:"omitempty,max=255,url" example:"https://example.com/group-avatar.jpg"`
+	Visibility  string `json:"visibility,omitempty" binding:"omitempty,oneof=public private" example:"private"`
 }
 
 // GroupUpdateRequest represents a group update request
 type GroupUpdateRequest struct {
-	Name        string `json:"name,omitempty" example:"Tech Enthusiasts Updated"`
-	Description string `json:"description,omitempty" example:"An updated group for tech enthusiasts"`
-	Avatar      string `json:"avatar,omitempty" example:"https://example.com/updated-group-avatar.jpg"`
+	Name        string `json:"name,omitempty" binding:"omitempty,max=255" example:"Tech Enthusiasts Updated"`
+	Description string `json:"description,omitempty" binding:"max=2000" example:"An updated group for tech enthusiasts"`
+	Avatar      string `json:"avatar,omitempty" binding:"omitempty,max=255,url" example:"https://example.com/updated-group-avatar.jpg"`
+	Visibility  string `json:"visibility,omitempty" binding:"omitempty,oneof=public private" example:"public"`
 }
 
 // Group represents a group
@@ -23,16 +25,20 @@ type Group struct {
 	CreatorID    string `json:"creator_id" example:"user123"`
 	CreatorName  string `json:"creator_name" example:"John Doe"`
 	MembersCount int32  `json:"members_count" example:"42"`
+	Visibility   string `json:"visibility" example:"public"`
 	CreatedAt    string `json:"created_at" example:"2023-01-01T12:00:00Z"`
 	UpdatedAt    string `json:"updated_at" example:"2023-01-02T12:00:00Z"`
+	// Role is the current user's role in the group, only set when listing their own groups
+	Role string `json:"role,omitempty" example:"admin" enums:"creator,admin,member"`
 }
 
 // GroupsResponse represents a list of groups with pagination
 type GroupsResponse struct {
-	Groups     []Group `json:"groups"`
-	TotalCount int32   `json:"total_count" example:"42"`
-	Page       int32   `json:"page" example:"1"`
-	TotalPages int32   `json:"total_pages" example:"5"`
+	Groups     []Group    `json:"groups"`
+	TotalCount int32      `json:"total_count" example:"42"`
+	Page       int32      `json:"page" example:"1"`
+	TotalPages int32      `json:"total_pages" example:"5"`
+	Pagination Pagination `json:"pagination"`
 }
 
 // GroupMember represents a member of a group
@@ -44,16 +50,60 @@ type GroupMember struct {
 	JoinedAt string `json:"joined_at" example:"2023-01-01T12:00:00Z"`
 }
 
+// TransferOwnershipRequest represents a request to make another member the creator of a group
+type TransferOwnershipRequest struct {
+	UserID string `json:"user_id" binding:"required" example:"user456"`
+}
+
+// MemberRoleRequest represents a request to change the role of a group member
+type MemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin member" example:"admin"`
+}
+
 // GroupMembersResponse represents a list of group members with pagination
 type GroupMembersResponse struct {
 	Members    []GroupMember `json:"members"`
 	TotalCount int32         `json:"total_count" example:"42"`
 	Page       int32         `json:"page" example:"1"`
 	TotalPages int32         `json:"total_pages" example:"5"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// JoinGroupResponse represents the result of joining a group. For private groups
+// Success is false and Pending is true until an admin approves the request.
+type JoinGroupResponse struct {
+	Success      bool `json:"success"`
+	MembersCount int  `json:"members_count"`
+	Pending      bool `json:"pending"`
+}
+
+// JoinRequest represents a request to join a private group
+type JoinRequest struct {
+	RequestID  string `json:"request_id" example:"req123"`
+	GroupID    string `json:"group_id" example:"group123"`
+	UserID     string `json:"user_id" example:"user123"`
+	Status     string `json:"status" example:"pending"`
+	ReviewedBy string `json:"reviewed_by,omitempty" example:"user456"`
+	CreatedAt  string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	ReviewedAt string `json:"reviewed_at,omitempty" example:"2023-01-02T12:00:00Z"`
+}
+
+// JoinRequestsResponse represents a list of pending join requests with pagination
+type JoinRequestsResponse struct {
+	Requests   []JoinRequest `json:"requests"`
+	TotalCount int32         `json:"total_count" example:"3"`
+	Page       int32         `json:"page" example:"1"`
+	TotalPages int32         `json:"total_pages" example:"1"`
+	Pagination Pagination    `json:"pagination"`
 }
 
 // GroupPostRequest represents a group post creation request
 type GroupPostRequest struct {
 	Content string   `json:"content" binding:"required" example:"This is a post in the group"`
 	Media   []string `json:"media,omitempty" example:"[\"https://example.com/image1.jpg\"]"`
+}
+
+// GroupPostCommentRequest represents a request to comment on a group post
+type GroupPostCommentRequest struct {
+	Content string `json:"content" binding:"required" example:"Great post!"`
 }
\ No newline at end of file
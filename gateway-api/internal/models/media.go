@@ -0,0 +1,18 @@
+package models
+
+// UploadURLRequest represents a request for a media upload URL
+type UploadURLRequest struct {
+	ContentType string `json:"content_type" binding:"required,max=255" example:"image/jpeg"`
+	Size        int64  `json:"size" binding:"required,gt=0" example:"204800"`
+}
+
+// UploadURLResponse represents a presigned media upload URL. The file must be uploaded with a PUT
+// request to UploadURL that sends the listed headers, before ExpiresAt; MediaURL can then be
+// attached to a post.
+type UploadURLResponse struct {
+	UploadURL string            `json:"upload_url" example:"https://media.example.com/uploads/123/abc.jpg?X-Amz-Signature=..."`
+	Method    string            `json:"method" example:"PUT"`
+	Headers   map[string]string `json:"headers"`
+	MediaURL  string            `json:"media_url" example:"https://cdn.example.com/uploads/123/abc.jpg"`
+	ExpiresAt string            `json:"expires_at" example:"2023-01-01T12:15:00Z"`
+}
\ No newline at end of file
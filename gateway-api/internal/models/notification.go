@@ -0,0 +1,28 @@
+package models
+
+// Notification represents something another user did that concerns the current user
+type Notification struct {
+	NotificationID string `json:"notification_id" example:"notif123"`
+	Type           string `json:"type" example:"friend_request"`
+	ActorID        string `json:"actor_id" example:"user456"`
+	ActorName      string `json:"actor_name" example:"Jane Doe"`
+	ActorAvatar    string `json:"actor_avatar" example:"https://example.com/avatar.jpg"`
+	TargetID       string `json:"target_id" example:"req123"`
+	Read           bool   `json:"read" example:"false"`
+	CreatedAt      string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+}
+
+// NotificationsResponse represents a list of notifications with pagination
+type NotificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+	TotalCount    int32          `json:"total_count" example:"42"`
+	Page          int32          `json:"page" example:"1"`
+	TotalPages    int32          `json:"total_pages" example:"5"`
+	Pagination    Pagination     `json:"pagination"`
+	UnreadCount   int32          `json:"unread_count" example:"3"`
+}
+
+// UnreadCountResponse represents the number of unread notifications
+type UnreadCountResponse struct {
+	UnreadCount int32 `json:"unread_count" example:"3"`
+}
\ No newline at end of file
@@ -0,0 +1,34 @@
+package models
+
+// Pagination describes which page of a list a response holds. The total_count, page and
+// total_pages fields next to it in list responses predate it and are kept for older clients.
+type Pagination struct {
+	Page       int   `json:"page" example:"1"`
+	Limit      int   `json:"limit" example:"10"`
+	TotalCount int64 `json:"total_count" example:"42"`
+	TotalPages int   `json:"total_pages" example:"5"`
+	HasNext    bool  `json:"has_next" example:"true"`
+	HasPrev    bool  `json:"has_prev" example:"false"`
+}
+
+// NewPagination computes the pagination of a page of a list of totalCount items,
+// served limit items at a time. An empty list has no pages.
+func NewPagination(page, limit int, totalCount int64) Pagination {
+	if page < 1 {
+		page = 1
+	}
+
+	totalPages := 0
+	if limit > 0 {
+		totalPages = int((totalCount + int64(limit) - 1) / int64(limit))
+	}
+
+	return Pagination{
+		Page:       page,
+		Limit:      limit,
+		TotalCount: totalCount,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
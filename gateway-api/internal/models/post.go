@@ -3,62 +3,137 @@ package models
 // PostCreateRequest represents a post creation request
 type PostCreateRequest struct {
 	Content    string   `json:"content" binding:"required" example:"This is a post"`
-	Visibility string   `json:"visibility" binding:"required,oneof=public private" example:"public"`
+	Visibility string   `json:"visibility" binding:"required,oneof=public friends private" example:"public"`
 	Media      []string `json:"media,omitempty" example:"[\"https://example.com/image1.jpg\"]"`
 }
 
 // PostUpdateRequest represents a post update request
 type PostUpdateRequest struct {
 	Content    string   `json:"content,omitempty" example:"This is an updated post"`
-	Visibility string   `json:"visibility,omitempty" binding:"omitempty,oneof=public private" example:"private"`
+	Visibility string   `json:"visibility,omitempty" binding:"omitempty,oneof=public friends private" example:"friends"`
 	Media      []string `json:"media,omitempty" example:"[\"https://example.com/image2.jpg\"]"`
 }
 
 // Post represents a post
 type Post struct {
-	PostID        string   `json:"post_id" example:"post123"`
-	AuthorID      string   `json:"author_id" example:"user123"`
-	AuthorName    string   `json:"author_name" example:"John Doe"`
-	AuthorAvatar  string   `json:"author_avatar" example:"https://example.com/avatar.jpg"`
-	Content       string   `json:"content" example:"This is a post"`
-	Media         []string `json:"media" example:"[\"https://example.com/image1.jpg\"]"`
-	Visibility    string   `json:"visibility" example:"public"`
-	LikesCount    int32    `json:"likes_count" example:"42"`
-	CommentsCount int32    `json:"comments_count" example:"10"`
-	IsLiked       bool     `json:"is_liked" example:"false"`
-	CreatedAt     string   `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	UpdatedAt     string   `json:"updated_at" example:"2023-01-02T12:00:00Z"`
+	PostID        string    `json:"post_id" example:"post123"`
+	AuthorID      string    `json:"author_id" example:"user123"`
+	AuthorName    string    `json:"author_name" example:"John Doe"`
+	AuthorAvatar  string    `json:"author_avatar" example:"https://example.com/avatar.jpg"`
+	Content       string    `json:"content" example:"This is a post"`
+	Media         []string  `json:"media" example:"[\"https://example.com/image1.jpg\"]"`
+	Visibility    string    `json:"visibility" example:"public"`
+	LikesCount    int32     `json:"likes_count" example:"42"`
+	CommentsCount int32     `json:"comments_count" example:"10"`
+	SharesCount   int32     `json:"shares_count" example:"3"`
+	IsLiked       bool      `json:"is_liked" example:"false"`
+	Mentions      []Mention `json:"mentions"`
+	CreatedAt     string    `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt     string    `json:"updated_at" example:"2023-01-02T12:00:00Z"`
+
+	// OriginalPostID is set when the post shares another post; OriginalPost is left out
+	// when the shared post was deleted or is hidden from the user
+	OriginalPostID string `json:"original_post_id,omitempty" example:"post122"`
+	OriginalPost   *Post  `json:"original_post,omitempty"`
+
+	// ReportsCount and Hidden are only set when an admin views reported posts
+	ReportsCount int32 `json:"reports_count,omitempty" example:"2"`
+	Hidden       bool  `json:"hidden,omitempty" example:"false"`
+}
+
+// Mention represents a user mentioned with @username in a post or comment
+type Mention struct {
+	UserID   string `json:"user_id" example:"user456"`
+	Username string `json:"username" example:"jane.doe"`
 }
 
 // PostsResponse represents a list of posts with pagination
 type PostsResponse struct {
-	Posts      []Post `json:"posts"`
-	TotalCount int32  `json:"total_count" example:"42"`
-	Page       int32  `json:"page" example:"1"`
-	TotalPages int32  `json:"total_pages" example:"5"`
+	Posts      []Post     `json:"posts"`
+	TotalCount int32      `json:"total_count" example:"42"`
+	Page       int32      `json:"page" example:"1"`
+	TotalPages int32      `json:"total_pages" example:"5"`
+	Pagination Pagination `json:"pagination"`
+	NextCursor string     `json:"next_cursor,omitempty" example:"MjAyNC0wMS0wMVQxMjowMDowMFp8MTIzZTQ1Njc"`
+}
+
+// SharePostRequest represents a share request; the comment is optional
+type SharePostRequest struct {
+	Comment string `json:"comment,omitempty" example:"Worth a read"`
+}
+
+// ReportPostRequest represents a request to report a post for moderation
+type ReportPostRequest struct {
+	Reason string `json:"reason" binding:"required,oneof=spam harassment hate_speech violence nudity misinformation other" example:"spam"`
+}
+
+// ResolveReportRequest represents a request to resolve a report
+type ResolveReportRequest struct {
+	HidePost bool `json:"hide_post" example:"true"`
+}
+
+// Report represents a post report in the moderation queue
+type Report struct {
+	ReportID   string `json:"report_id" example:"report123"`
+	PostID     string `json:"post_id" example:"post123"`
+	ReporterID string `json:"reporter_id" example:"user456"`
+	Reason     string `json:"reason" example:"spam" enums:"spam,harassment,hate_speech,violence,nudity,misinformation,other"`
+	Status     string `json:"status" example:"open" enums:"open,resolved"`
+	ResolvedBy string `json:"resolved_by,omitempty" example:"user789"`
+	ResolvedAt string `json:"resolved_at,omitempty" example:"2023-01-02T12:00:00Z"`
+	CreatedAt  string `json:"created_at" example:"2023-01-01T12:00:00Z"`
+
+	// Post is left out when the reported post has been deleted
+	Post *Post `json:"post,omitempty"`
+}
+
+// ReportsResponse represents a list of reports with pagination
+type ReportsResponse struct {
+	Reports    []Report   `json:"reports"`
+	TotalCount int32      `json:"total_count" example:"42"`
+	Page       int32      `json:"page" example:"1"`
+	TotalPages int32      `json:"total_pages" example:"5"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// ReactionRequest represents a reaction on a post
+type ReactionRequest struct {
+	ReactionType string `json:"reaction_type,omitempty" binding:"omitempty,oneof=like love laugh angry sad" example:"love"`
 }
 
 // CommentCreateRequest represents a comment creation request
 type CommentCreateRequest struct {
-	Content string `json:"content" binding:"required" example:"This is a comment"`
+	Content         string `json:"content" binding:"required" example:"This is a comment"`
+	ParentCommentID string `json:"parent_comment_id,omitempty" binding:"omitempty,max=36" example:"comment123"`
+}
+
+// CommentUpdateRequest represents a comment update request
+type CommentUpdateRequest struct {
+	Content string `json:"content" binding:"required" example:"This is an edited comment"`
 }
 
 // Comment represents a comment
 type Comment struct {
-	CommentID    string `json:"comment_id" example:"comment123"`
-	PostID       string `json:"post_id" example:"post123"`
-	AuthorID     string `json:"author_id" example:"user123"`
-	AuthorName   string `json:"author_name" example:"John Doe"`
-	AuthorAvatar string `json:"author_avatar" example:"https://example.com/avatar.jpg"`
-	Content      string `json:"content" example:"This is a comment"`
-	CreatedAt    string `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	UpdatedAt    string `json:"updated_at" example:"2023-01-02T12:00:00Z"`
+	CommentID       string    `json:"comment_id" example:"comment123"`
+	PostID          string    `json:"post_id" example:"post123"`
+	ParentCommentID string    `json:"parent_comment_id,omitempty" example:"comment122"`
+	Depth           int32     `json:"depth" example:"0"`
+	AuthorID        string    `json:"author_id" example:"user123"`
+	AuthorName      string    `json:"author_name" example:"John Doe"`
+	AuthorAvatar    string    `json:"author_avatar" example:"https://example.com/avatar.jpg"`
+	Content         string    `json:"content" example:"This is a comment"`
+	Edited          bool      `json:"edited" example:"false"`
+	Mentions        []Mention `json:"mentions"`
+	Replies         []Comment `json:"replies,omitempty"`
+	CreatedAt       string    `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	UpdatedAt       string    `json:"updated_at" example:"2023-01-02T12:00:00Z"`
 }
 
 // CommentsResponse represents a list of comments with pagination
 type CommentsResponse struct {
-	Comments   []Comment `json:"comments"`
-	TotalCount int32     `json:"total_count" example:"42"`
-	Page       int32     `json:"page" example:"1"`
-	TotalPages int32     `json:"total_pages" example:"5"`
+	Comments   []Comment  `json:"comments"`
+	TotalCount int32      `json:"total_count" example:"42"`
+	Page       int32      `json:"page" example:"1"`
+	TotalPages int32      `json:"total_pages" example:"5"`
+	Pagination Pagination `json:"pagination"`
 }
\ No newline at end of file
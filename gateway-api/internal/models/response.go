@@ -2,9 +2,34 @@ package models
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
+	// Error is a human-readable message
 	Error string `json:"error"`
+	// Code is a stable, machine-readable reason, see the ErrorCode constants
+	Code string `json:"code,omitempty" example:"not_found"`
+	// Details holds per-field messages of invalid requests, keyed by field name
+	Details map[string]string `json:"details,omitempty"`
 }
 
+// Codes of error responses, named after the gRPC status codes they usually come from
+const (
+	ErrorCodeInvalidArgument    = "invalid_argument"
+	ErrorCodePermissionDenied   = "permission_denied"
+	ErrorCodeNotFound           = "not_found"
+	ErrorCodeAlreadyExists      = "already_exists"
+	ErrorCodeFailedPrecondition = "failed_precondition"
+	ErrorCodeResourceExhausted  = "resource_exhausted"
+	ErrorCodeUnavailable        = "unavailable"
+	ErrorCodeDeadlineExceeded   = "deadline_exceeded"
+	ErrorCodeInternal           = "internal"
+)
+
+// Codes of 401 responses; only an expired token is worth replacing by signing in again
+const (
+	ErrorCodeTokenMissing = "token_missing"
+	ErrorCodeTokenInvalid = "token_invalid"
+	ErrorCodeTokenExpired = "token_expired"
+)
+
 // SuccessResponse represents a success response with a boolean flag
 type SuccessResponse struct {
 	Success bool `json:"success"`
@@ -16,8 +41,40 @@ type SuccessWithCountResponse struct {
 	MembersCount int  `json:"members_count"`
 }
 
+// RemoveMemberResponse represents a response for removing a member from a group.
+// PostsRetained is always true: the removed member's group posts are kept.
+type RemoveMemberResponse struct {
+	Success       bool `json:"success"`
+	MembersCount  int  `json:"members_count"`
+	PostsRetained bool `json:"posts_retained"`
+}
+
+// HealthResponse represents the combined health of the backend services
+type HealthResponse struct {
+	Status   string            `json:"status" example:"ok"`
+	Services map[string]string `json:"services"`
+}
+
+// MetricsResponse represents the state of the gateway's connections to the backend services
+type MetricsResponse struct {
+	CircuitBreakers map[string]CircuitBreakerStats `json:"circuit_breakers"`
+}
+
+// CircuitBreakerStats represents the circuit breaker of a backend service
+type CircuitBreakerStats struct {
+	State               string `json:"state" example:"closed"`
+	ConsecutiveFailures int    `json:"consecutive_failures" example:"0"`
+}
+
 // LikeResponse represents a response for like/unlike operations
 type LikeResponse struct {
-	Success    bool `json:"success"`
-	LikesCount int  `json:"likes_count"`
+	Success        bool             `json:"success"`
+	LikesCount     int              `json:"likes_count"`
+	ReactionCounts map[string]int32 `json:"reaction_counts,omitempty"`
+}
+
+// ReactionCountsResponse represents the number of each reaction type on a post
+type ReactionCountsResponse struct {
+	LikesCount     int              `json:"likes_count"`
+	ReactionCounts map[string]int32 `json:"reaction_counts"`
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 
+	"gateway-api/internal/clients"
 	"gateway-api/internal/config"
 	"gateway-api/internal/controllers"
 	"gateway-api/internal/middleware"
@@ -11,27 +12,50 @@ import (
 )
 
 // SetupRoutes configures all the routes for the API
-func SetupRoutes(router *gin.RouterGroup, cfg *config.Config, logger *logger.Logger) {
+func SetupRoutes(router *gin.RouterGroup, cfg *config.Config, logger *logger.Logger, conns *clients.Clients) {
+	// Create services on the shared connections
+	userService := services.NewUserService(cfg, logger, conns.Users, conns.Posts)
+	postService := services.NewPostService(cfg, logger, conns.Posts)
+	mediaService := services.NewMediaService(cfg, logger)
+
 	// Create controllers
-	userController := controllers.NewUserController(cfg, logger)
-	postController := controllers.NewPostController(cfg, logger)
-	friendController := controllers.NewFriendController(cfg, logger)
-	groupController := controllers.NewGroupController(cfg, logger)
+	userController := controllers.NewUserController(cfg, logger, userService)
+	postController := controllers.NewPostController(cfg, logger, postService)
+	mediaController := controllers.NewMediaController(cfg, logger, mediaService)
+	friendController := controllers.NewFriendController(cfg, logger, conns.Friends)
+	groupController := controllers.NewGroupController(cfg, logger, conns.Groups)
+	notificationController := controllers.NewNotificationController(cfg, logger, conns.Users)
+	healthController := controllers.NewHealthController(cfg, logger, conns.All(), conns.Breakers)
 
 	// Create auth service and controller
-	userService := services.NewUserService(cfg, logger)
-	authService := services.NewAuthService(cfg, logger, userService)
+	authService := services.NewAuthService(cfg, logger, userService, conns.Users)
 	authController := controllers.NewAuthController(cfg, logger, authService, userService)
 
 	// Create middleware
-	authMiddleware := middleware.NewAuthMiddleware(cfg, logger)
+	authMiddleware := middleware.NewAuthMiddleware(cfg, logger, userService.IsTokenRevoked)
+
+	// Fail requests fast while the service they need is known to be down
+	requireUsers := middleware.RequireService("users", conns.Breakers["users"].Available)
+	requirePosts := middleware.RequireService("posts", conns.Breakers["posts"].Available)
+	requireFriends := middleware.RequireService("friends", conns.Breakers["friends"].Available)
+	requireGroups := middleware.RequireService("groups", conns.Breakers["groups"].Available)
+	rateLimiter := middleware.NewRateLimiter(cfg, logger, authMiddleware.UserIDFromRequest)
+	presenceTracker := middleware.NewPresenceTracker(cfg, logger, userService.MarkSeen)
+
+	// Health checks
+	router.GET("/health", healthController.Health)
+	router.GET("/healthz", healthController.Healthz)
+	router.GET("/ready", healthController.Ready)
+	router.GET("/metrics", healthController.Metrics)
+
+	// Rate limit every route registered below; health checks above stay unlimited for probes
+	router.Use(rateLimiter.Limit())
+
+	// Cap request body sizes before any handler reads them
+	router.Use(middleware.BodyLimit(cfg))
 
-	// Health check
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"status": "ok",
-		})
-	})
+	// Mark signed-in users as active after their requests
+	router.Use(presenceTracker.Track())
 
 	// Auth routes
 	authRoutes := router.Group("/auth")
@@ -41,55 +65,116 @@ func SetupRoutes(router *gin.RouterGroup, cfg *config.Config, logger *logger.Log
 		authRoutes.GET("/google/callback", authController.GoogleCallback)
 		authRoutes.GET("/microsoft", authController.MicrosoftLogin)
 		authRoutes.GET("/microsoft/callback", authController.MicrosoftCallback)
+		authRoutes.GET("/github", authController.GitHubLogin)
+		authRoutes.GET("/github/callback", authController.GitHubCallback)
+		authRoutes.GET("/apple", authController.AppleLogin)
+		authRoutes.POST("/apple/callback", authController.AppleCallback)
 		authRoutes.POST("/signout", authMiddleware.Authenticate(), authController.Signout)
 	}
 
 	// User routes
-	userRoutes := router.Group("/users")
+	userRoutes := router.Group("/users", requireUsers)
 	{
 		userRoutes.POST("/register", userController.Register)
 		userRoutes.POST("/login", userController.Login)
 		userRoutes.GET("/me", authMiddleware.Authenticate(), userController.GetProfile)
 		userRoutes.PUT("/me", authMiddleware.Authenticate(), userController.UpdateProfile)
+		userRoutes.DELETE("/me", authMiddleware.Authenticate(), userController.DeleteAccount)
+		userRoutes.GET("/search", authMiddleware.Authenticate(), userController.SearchUsers)
+		userRoutes.GET("/me/groups", authMiddleware.Authenticate(), groupController.GetUserGroups)
+		userRoutes.GET("/:id", authMiddleware.Authenticate(), userController.GetPublicProfile)
+
+		// Follows
+		userRoutes.POST("/:id/follow", requireFriends, authMiddleware.Authenticate(), friendController.Follow)
+		userRoutes.DELETE("/:id/follow", requireFriends, authMiddleware.Authenticate(), friendController.Unfollow)
+		userRoutes.GET("/:id/followers", requireFriends, authMiddleware.Authenticate(), friendController.GetFollowers)
+		userRoutes.GET("/:id/following", requireFriends, authMiddleware.Authenticate(), friendController.GetFollowing)
 	}
 
+	// Feed of the user's own, friends' and public posts
+	router.GET("/feed", requirePosts, authMiddleware.Authenticate(), postController.GetFeed)
+
 	// Post routes
-	postRoutes := router.Group("/posts")
+	postRoutes := router.Group("/posts", requirePosts)
 	{
-		postRoutes.GET("", postController.GetPosts)
-		postRoutes.GET("/:id", postController.GetPost)
+		postRoutes.GET("", authMiddleware.OptionalAuthenticate(), postController.GetPosts)
+		postRoutes.GET("/tags/:tag", authMiddleware.OptionalAuthenticate(), postController.GetPostsByHashtag)
+		postRoutes.GET("/bookmarks", authMiddleware.Authenticate(), postController.GetBookmarks)
+		postRoutes.GET("/:id", authMiddleware.OptionalAuthenticate(), postController.GetPost)
 		postRoutes.POST("", authMiddleware.Authenticate(), postController.CreatePost)
 		postRoutes.PUT("/:id", authMiddleware.Authenticate(), postController.UpdatePost)
 		postRoutes.DELETE("/:id", authMiddleware.Authenticate(), postController.DeletePost)
+		postRoutes.POST("/:id/restore", authMiddleware.Authenticate(), postController.RestorePost)
+		postRoutes.POST("/:id/share", authMiddleware.Authenticate(), postController.SharePost)
 
 		// Comments
-		postRoutes.GET("/:id/comments", postController.GetComments)
+		postRoutes.GET("/:id/comments", authMiddleware.OptionalAuthenticate(), postController.GetComments)
 		postRoutes.POST("/:id/comments", authMiddleware.Authenticate(), postController.AddComment)
+		postRoutes.PUT("/:id/comments/:commentId", authMiddleware.Authenticate(), postController.EditComment)
 		postRoutes.DELETE("/:id/comments/:commentId", authMiddleware.Authenticate(), postController.DeleteComment)
 
 		// Likes
 		postRoutes.POST("/:id/like", authMiddleware.Authenticate(), postController.LikePost)
 		postRoutes.DELETE("/:id/like", authMiddleware.Authenticate(), postController.UnlikePost)
+		postRoutes.GET("/:id/reactions", postController.GetReactionCounts)
+
+		// Bookmarks
+		postRoutes.POST("/:id/bookmark", authMiddleware.Authenticate(), postController.BookmarkPost)
+		postRoutes.DELETE("/:id/bookmark", authMiddleware.Authenticate(), postController.UnbookmarkPost)
+
+		// Reports
+		postRoutes.POST("/:id/report", authMiddleware.Authenticate(), postController.ReportPost)
+	}
+
+	// Media routes
+	mediaRoutes := router.Group("/media", authMiddleware.Authenticate())
+	{
+		mediaRoutes.POST("/upload-url", mediaController.CreateUploadURL)
+	}
+
+	// Admin routes; the backing services check the role again
+	adminRoutes := router.Group("/admin", authMiddleware.Authenticate(), authMiddleware.RequireRole("admin"))
+	{
+		adminRoutes.GET("/reports", postController.GetReports)
+		adminRoutes.POST("/reports/:id/resolve", postController.ResolveReport)
+		adminRoutes.PUT("/users/:id/role", userController.SetUserRole)
 	}
 
 	// Friend routes
-	friendRoutes := router.Group("/friends")
+	friendRoutes := router.Group("/friends", requireFriends)
 	{
 		friendRoutes.GET("", authMiddleware.Authenticate(), friendController.GetFriends)
+		friendRoutes.GET("/export", authMiddleware.Authenticate(), friendController.ExportFriends)
 		friendRoutes.POST("/requests", authMiddleware.Authenticate(), friendController.SendFriendRequest)
 		friendRoutes.GET("/requests", authMiddleware.Authenticate(), friendController.GetFriendRequests)
+		friendRoutes.POST("/requests/accept", authMiddleware.Authenticate(), friendController.AcceptFriendRequests)
+		friendRoutes.POST("/requests/reject", authMiddleware.Authenticate(), friendController.RejectFriendRequests)
+		friendRoutes.POST("/requests/accept-all", authMiddleware.Authenticate(), friendController.AcceptAllFriendRequests)
 		friendRoutes.PUT("/requests/:id/accept", authMiddleware.Authenticate(), friendController.AcceptFriendRequest)
 		friendRoutes.PUT("/requests/:id/reject", authMiddleware.Authenticate(), friendController.RejectFriendRequest)
+		friendRoutes.DELETE("/requests/:id", authMiddleware.Authenticate(), friendController.CancelFriendRequest)
 		friendRoutes.DELETE("/:id", authMiddleware.Authenticate(), friendController.RemoveFriend)
+		friendRoutes.GET("/:id/mutual", authMiddleware.Authenticate(), friendController.GetMutualFriends)
+		friendRoutes.GET("/:id/status", authMiddleware.Authenticate(), friendController.CheckFriendship)
+		friendRoutes.GET("/blocked", authMiddleware.Authenticate(), friendController.GetBlockedUsers)
 		friendRoutes.POST("/block/:id", authMiddleware.Authenticate(), friendController.BlockUser)
 		friendRoutes.DELETE("/block/:id", authMiddleware.Authenticate(), friendController.UnblockUser)
 	}
 
+	// Notification routes
+	notificationRoutes := router.Group("/notifications", requireUsers, authMiddleware.Authenticate())
+	{
+		notificationRoutes.GET("", notificationController.GetNotifications)
+		notificationRoutes.GET("/unread-count", notificationController.GetUnreadCount)
+		notificationRoutes.POST("/read-all", notificationController.MarkAllRead)
+		notificationRoutes.POST("/:id/read", notificationController.MarkRead)
+	}
+
 	// Group routes
-	groupRoutes := router.Group("/groups")
+	groupRoutes := router.Group("/groups", requireGroups)
 	{
-		groupRoutes.GET("", groupController.GetGroups)
-		groupRoutes.GET("/:id", groupController.GetGroup)
+		groupRoutes.GET("", authMiddleware.OptionalAuthenticate(), groupController.GetGroups)
+		groupRoutes.GET("/:id", authMiddleware.OptionalAuthenticate(), groupController.GetGroup)
 		groupRoutes.POST("", authMiddleware.Authenticate(), groupController.CreateGroup)
 		groupRoutes.PUT("/:id", authMiddleware.Authenticate(), groupController.UpdateGroup)
 		groupRoutes.DELETE("/:id", authMiddleware.Authenticate(), groupController.DeleteGroup)
@@ -97,9 +182,24 @@ func SetupRoutes(router *gin.RouterGroup, cfg *config.Config, logger *logger.Log
 		// Group membership
 		groupRoutes.POST("/:id/members", authMiddleware.Authenticate(), groupController.JoinGroup)
 		groupRoutes.DELETE("/:id/members", authMiddleware.Authenticate(), groupController.LeaveGroup)
+		groupRoutes.GET("/:id/members/export", authMiddleware.Authenticate(), groupController.ExportGroupMembers)
+		groupRoutes.DELETE("/:id/members/:userId", authMiddleware.Authenticate(), groupController.RemoveMember)
+		groupRoutes.PUT("/:id/members/:userId/role", authMiddleware.Authenticate(), groupController.UpdateMemberRole)
+		groupRoutes.POST("/:id/transfer-ownership", authMiddleware.Authenticate(), groupController.TransferOwnership)
+
+		// Group join requests
+		groupRoutes.GET("/:id/join-requests", authMiddleware.Authenticate(), groupController.GetJoinRequests)
+		groupRoutes.POST("/:id/join-requests/:requestId/approve", authMiddleware.Authenticate(), groupController.ApproveJoinRequest)
+		groupRoutes.POST("/:id/join-requests/:requestId/reject", authMiddleware.Authenticate(), groupController.RejectJoinRequest)
 
 		// Group posts
-		groupRoutes.GET("/:id/posts", groupController.GetGroupPosts)
+		groupRoutes.GET("/:id/posts", authMiddleware.OptionalAuthenticate(), groupController.GetGroupPosts)
 		groupRoutes.POST("/:id/posts", authMiddleware.Authenticate(), groupController.CreateGroupPost)
+		groupRoutes.PUT("/:id/posts/:postId", authMiddleware.Authenticate(), groupController.UpdateGroupPost)
+		groupRoutes.DELETE("/:id/posts/:postId", authMiddleware.Authenticate(), groupController.DeleteGroupPost)
+		groupRoutes.POST("/:id/posts/:postId/like", authMiddleware.Authenticate(), groupController.LikeGroupPost)
+		groupRoutes.DELETE("/:id/posts/:postId/like", authMiddleware.Authenticate(), groupController.UnlikeGroupPost)
+		groupRoutes.GET("/:id/posts/:postId/comments", authMiddleware.OptionalAuthenticate(), groupController.GetGroupPostComments)
+		groupRoutes.POST("/:id/posts/:postId/comments", authMiddleware.Authenticate(), groupController.AddGroupPostComment)
 	}
 }
@@ -7,11 +7,10 @@ import (
 	"io"
 	"time"
 
-	"github.com/golang-jwt/jwt/v4"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
 	pb "common/pb/common/proto/users"
@@ -34,6 +33,18 @@ type AuthService interface {
 	// MicrosoftCallback handles the callback from Microsoft OAuth
 	MicrosoftCallback(ctx context.Context, state, code string) (*models.AuthResponse, error)
 
+	// GitHubLogin generates a GitHub OAuth URL with state token
+	GitHubLogin(ctx context.Context) (string, error)
+
+	// GitHubCallback handles the callback from GitHub OAuth
+	GitHubCallback(ctx context.Context, state, code string) (*models.AuthResponse, error)
+
+	// AppleLogin generates a Sign in with Apple URL with state token
+	AppleLogin(ctx context.Context) (string, error)
+
+	// AppleCallback handles the callback from Sign in with Apple
+	AppleCallback(ctx context.Context, state, code, name string) (*models.AuthResponse, error)
+
 	// ValidateStateToken validates the state token to prevent CSRF attacks
 	ValidateStateToken(state string) bool
 
@@ -41,6 +52,14 @@ type AuthService interface {
 	Signout(ctx context.Context, token string) (bool, error)
 }
 
+const (
+	// stateTokenTTL is how long an OAuth state token stays valid
+	stateTokenTTL = 10 * time.Minute
+
+	// stateCleanupInterval is how often abandoned state tokens are swept
+	stateCleanupInterval = time.Minute
+)
+
 // authService implements the AuthService interface
 type authService struct {
 	cfg             *config.Config
@@ -48,7 +67,8 @@ type authService struct {
 	userService     UserService
 	googleConfig    *oauth2.Config
 	microsoftConfig *oauth2.Config
-	stateStore      map[string]time.Time // Store state tokens for CSRF protection
+	githubConfig    *oauth2.Config
+	stateStore      *stateStore          // Store state tokens for CSRF protection
 	client          pb.UserServiceClient // gRPC client to the users-api
 }
 
@@ -75,13 +95,13 @@ func (s *authService) createAuthContext(ctx context.Context) context.Context {
 }
 
 // NewAuthService creates a new auth service
-func NewAuthService(cfg *config.Config, logger *logger.Logger, userService UserService) AuthService {
+func NewAuthService(cfg *config.Config, logger *logger.Logger, userService UserService, conn *grpc.ClientConn) AuthService {
 	// Configure Google OAuth2
 	googleConfig := &oauth2.Config{
 		ClientID:     cfg.OAuth.Google.ClientID,
 		ClientSecret: cfg.OAuth.Google.ClientSecret,
 		RedirectURL:  cfg.OAuth.Google.RedirectURL,
-		Scopes:       []string{"User.Read", "profile", "email", "openid"},
+		Scopes:       cfg.OAuth.Google.Scopes,
 		Endpoint:     google.Endpoint,
 	}
 
@@ -90,31 +110,40 @@ func NewAuthService(cfg *config.Config, logger *logger.Logger, userService UserS
 		ClientID:     cfg.OAuth.Microsoft.ClientID,
 		ClientSecret: cfg.OAuth.Microsoft.ClientSecret,
 		RedirectURL:  cfg.OAuth.Microsoft.RedirectURL,
-		Scopes:       []string{"User.Read", "profile", "email", "openid"},
+		Scopes:       cfg.OAuth.Microsoft.Scopes,
 		Endpoint: oauth2.Endpoint{
 			AuthURL:  "https://login.microsoftonline.com/consumers/oauth2/v2.0/authorize",
 			TokenURL: "https://login.microsoftonline.com/consumers/oauth2/v2.0/token",
 		},
 	}
 
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.UsersServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to users service", err)
+	// Configure GitHub OAuth2
+	githubConfig := &oauth2.Config{
+		ClientID:     cfg.OAuth.GitHub.ClientID,
+		ClientSecret: cfg.OAuth.GitHub.ClientSecret,
+		RedirectURL:  cfg.OAuth.GitHub.RedirectURL,
+		Scopes:       cfg.OAuth.GitHub.Scopes,
+		Endpoint:     github.Endpoint,
 	}
 
 	// Create a client
 	client := pb.NewUserServiceClient(conn)
 
-	return &authService{
+	service := &authService{
 		cfg:             cfg,
 		logger:          logger,
 		userService:     userService,
 		googleConfig:    googleConfig,
 		microsoftConfig: microsoftConfig,
-		stateStore:      make(map[string]time.Time),
+		githubConfig:    githubConfig,
+		stateStore:      newStateStore(stateTokenTTL),
 		client:          client,
 	}
+
+	// Periodically sweep state tokens from logins that were never completed
+	go service.stateStore.runCleanup(stateCleanupInterval)
+
+	return service
 }
 
 // generateAuthStateToken generates a random state token for CSRF protection
@@ -136,12 +165,12 @@ func (s *authService) GoogleLogin(ctx context.Context) (string, error) {
 		RedirectUrl: s.googleConfig.RedirectURL,
 	})
 	if err != nil {
-		s.logger.Error("Failed to generate Google OAuth URL", err)
+		s.logger.WithContext(ctx).Error("Failed to generate Google OAuth URL", err)
 		return "", err
 	}
 
 	// Store the state token with timestamp
-	s.stateStore[resp.State] = time.Now()
+	s.stateStore.Save(resp.State)
 
 	return resp.Url, nil
 }
@@ -155,12 +184,12 @@ func (s *authService) MicrosoftLogin(ctx context.Context) (string, error) {
 		RedirectUrl: s.microsoftConfig.RedirectURL,
 	})
 	if err != nil {
-		s.logger.Error("Failed to generate Microsoft OAuth URL", err)
+		s.logger.WithContext(ctx).Error("Failed to generate Microsoft OAuth URL", err)
 		return "", err
 	}
 
 	// Store the state token with timestamp
-	s.stateStore[resp.State] = time.Now()
+	s.stateStore.Save(resp.State)
 
 	return resp.Url, nil
 }
@@ -168,9 +197,9 @@ func (s *authService) MicrosoftLogin(ctx context.Context) (string, error) {
 // GoogleCallback handles the callback from Google OAuth
 func (s *authService) GoogleCallback(ctx context.Context, state, code string) (*models.AuthResponse, error) {
 	// Exchange authorization code for token
-	token, err := s.googleConfig.Exchange(context.Background(), code)
+	token, err := s.googleConfig.Exchange(ctx, code)
 	if err != nil {
-		s.logger.Error("Failed to exchange code for token", err)
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
 		return nil, err
 	}
 
@@ -183,7 +212,7 @@ func (s *authService) GoogleCallback(ctx context.Context, state, code string) (*
 		Token:    token.AccessToken,
 	})
 	if err != nil {
-		s.logger.Error("Failed to login user", err)
+		s.logger.WithContext(ctx).Error("Failed to login user", err)
 		return nil, err
 	}
 
@@ -196,9 +225,9 @@ func (s *authService) GoogleCallback(ctx context.Context, state, code string) (*
 // MicrosoftCallback handles the callback from Microsoft OAuth
 func (s *authService) MicrosoftCallback(ctx context.Context, state, code string) (*models.AuthResponse, error) {
 	// Exchange authorization code for token
-	token, err := s.microsoftConfig.Exchange(context.Background(), code)
+	token, err := s.microsoftConfig.Exchange(ctx, code)
 	if err != nil {
-		s.logger.Error("Failed to exchange code for token", err)
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
 		return nil, err
 	}
 
@@ -215,38 +244,116 @@ func (s *authService) MicrosoftCallback(ctx context.Context, state, code string)
 	return s.userService.Login(authCtx, authRequest)
 }
 
-// ValidateStateToken validates the state token to prevent CSRF attacks
-func (s *authService) ValidateStateToken(state string) bool {
-	timestamp, exists := s.stateStore[state]
-	if !exists {
-		return false
+// GitHubLogin generates a GitHub OAuth URL with state token
+func (s *authService) GitHubLogin(ctx context.Context) (string, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Forward the request to the users-api with auth context
+	resp, err := s.client.GitHubLogin(authCtx, &pb.GitHubLoginRequest{
+		RedirectUrl: s.githubConfig.RedirectURL,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate GitHub OAuth URL", err)
+		return "", err
+	}
+
+	// Store the state token with timestamp
+	s.stateStore.Save(resp.State)
+
+	return resp.Url, nil
+}
+
+// GitHubCallback handles the callback from GitHub OAuth
+func (s *authService) GitHubCallback(ctx context.Context, state, code string) (*models.AuthResponse, error) {
+	// Exchange authorization code for token
+	token, err := s.githubConfig.Exchange(ctx, code)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
+		return nil, err
+	}
+
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Call the users-api to login the user with the auth context
+	resp, err := s.client.Login(authCtx, &pb.LoginRequest{
+		Provider: "github",
+		Token:    token.AccessToken,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to login user", err)
+		return nil, err
 	}
 
-	// Check if the token is expired (10 minutes)
-	if time.Since(timestamp) > 10*time.Minute {
-		delete(s.stateStore, state)
-		return false
+	return &models.AuthResponse{
+		UserID:      resp.UserId,
+		AccessToken: resp.AccessToken,
+	}, nil
+}
+
+// AppleLogin generates a Sign in with Apple URL with state token
+func (s *authService) AppleLogin(ctx context.Context) (string, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Forward the request to the users-api with auth context
+	resp, err := s.client.AppleLogin(authCtx, &pb.AppleLoginRequest{
+		RedirectUrl: s.cfg.OAuth.Apple.RedirectURL,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate Apple login URL", err)
+		return "", err
+	}
+
+	// Store the state token with timestamp
+	s.stateStore.Save(resp.State)
+
+	return resp.Url, nil
+}
+
+// AppleCallback handles the callback from Sign in with Apple.
+// Unlike the other providers the code is exchanged by the users-api, which holds the signing key
+// Apple requires for it.
+func (s *authService) AppleCallback(ctx context.Context, state, code, name string) (*models.AuthResponse, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Call the users-api to login the user with the auth context
+	resp, err := s.client.AppleCallback(authCtx, &pb.AppleCallbackRequest{
+		State: state,
+		Code:  code,
+		Name:  name,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to login user", err)
+		return nil, err
 	}
 
-	// Remove the token after use
-	delete(s.stateStore, state)
-	return true
+	return &models.AuthResponse{
+		UserID:      resp.UserId,
+		AccessToken: resp.AccessToken,
+	}, nil
+}
+
+// ValidateStateToken validates the state token to prevent CSRF attacks
+func (s *authService) ValidateStateToken(state string) bool {
+	return s.stateStore.Validate(state)
 }
 
 // Signout signs out the user
 func (s *authService) Signout(ctx context.Context, token string) (bool, error) {
-	// Parse the token to get the user ID
-	claims := jwt.MapClaims{}
-	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.cfg.JWTSecret), nil
-	})
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
 
+	// Forward the request to the users-api so the token gets revoked
+	resp, err := s.client.Signout(authCtx, &pb.SignoutRequest{
+		Token: token,
+	})
 	if err != nil {
-		s.logger.Error("Failed to parse token", err)
+		s.logger.WithContext(ctx).Error("Failed to sign out user", err)
 		return false, err
 	}
 
-	// In a real application, you would add the token to a blacklist here
-
-	return true, nil
+	return resp.Success, nil
 }
@@ -9,7 +9,6 @@ import (
 	"gateway-api/internal/models"
 	"gateway-api/internal/utils/logger"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -38,6 +37,12 @@ type FriendService interface {
 
 	// UnblockUser unblocks a user
 	UnblockUser(ctx context.Context, userID, blockedUserID string) (bool, error)
+
+	// GetBlockedUsers retrieves the users a user has blocked
+	GetBlockedUsers(ctx context.Context, userID string, page, limit int) (*models.BlockedUsersResponse, error)
+
+	// CheckFriendship retrieves the relationship between a user and another user
+	CheckFriendship(ctx context.Context, userID, friendID string) (*models.FriendshipStatus, error)
 }
 
 // friendService implements the FriendService interface
@@ -65,13 +70,7 @@ func (s *friendService) createAuthContext(ctx context.Context) (context.Context,
 }
 
 // NewFriendService creates a new friend service
-func NewFriendService(cfg *config.Config, logger *logger.Logger) FriendService {
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.FriendsServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to friends service", err)
-	}
-
+func NewFriendService(cfg *config.Config, logger *logger.Logger, conn *grpc.ClientConn) FriendService {
 	// Create a client
 	client := pb.NewFriendServiceClient(conn)
 
@@ -87,7 +86,7 @@ func (s *friendService) GetFriends(ctx context.Context, userID string, page, lim
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -99,7 +98,7 @@ func (s *friendService) GetFriends(ctx context.Context, userID string, page, lim
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get friends", err)
+		s.logger.WithContext(ctx).Error("Failed to get friends", err)
 		return nil, err
 	}
 
@@ -112,6 +111,8 @@ func (s *friendService) GetFriends(ctx context.Context, userID string, page, lim
 			Avatar:       friend.Avatar,
 			Email:        friend.Email,
 			FriendsSince: friend.FriendsSince,
+			LastSeenAt:   friend.LastSeenAt,
+			IsOnline:     friend.IsOnline,
 		}
 	}
 
@@ -120,6 +121,7 @@ func (s *friendService) GetFriends(ctx context.Context, userID string, page, lim
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
@@ -128,7 +130,7 @@ func (s *friendService) SendFriendRequest(ctx context.Context, userID, friendID
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -139,7 +141,7 @@ func (s *friendService) SendFriendRequest(ctx context.Context, userID, friendID
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to send friend request", err)
+		s.logger.WithContext(ctx).Error("Failed to send friend request", err)
 		return nil, err
 	}
 
@@ -162,7 +164,7 @@ func (s *friendService) GetFriendRequests(ctx context.Context, userID, status st
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -175,7 +177,7 @@ func (s *friendService) GetFriendRequests(ctx context.Context, userID, status st
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get friend requests", err)
+		s.logger.WithContext(ctx).Error("Failed to get friend requests", err)
 		return nil, err
 	}
 
@@ -201,6 +203,7 @@ func (s *friendService) GetFriendRequests(ctx context.Context, userID, status st
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
@@ -209,7 +212,7 @@ func (s *friendService) AcceptFriendRequest(ctx context.Context, requestID, user
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -220,7 +223,7 @@ func (s *friendService) AcceptFriendRequest(ctx context.Context, requestID, user
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to accept friend request", err)
+		s.logger.WithContext(ctx).Error("Failed to accept friend request", err)
 		return nil, err
 	}
 
@@ -243,7 +246,7 @@ func (s *friendService) RejectFriendRequest(ctx context.Context, requestID, user
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -254,7 +257,7 @@ func (s *friendService) RejectFriendRequest(ctx context.Context, requestID, user
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to reject friend request", err)
+		s.logger.WithContext(ctx).Error("Failed to reject friend request", err)
 		return nil, err
 	}
 
@@ -277,7 +280,7 @@ func (s *friendService) RemoveFriend(ctx context.Context, userID, friendID strin
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return false, err
 	}
 
@@ -288,7 +291,7 @@ func (s *friendService) RemoveFriend(ctx context.Context, userID, friendID strin
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to remove friend", err)
+		s.logger.WithContext(ctx).Error("Failed to remove friend", err)
 		return false, err
 	}
 
@@ -300,7 +303,7 @@ func (s *friendService) BlockUser(ctx context.Context, userID, blockedUserID str
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return false, err
 	}
 
@@ -311,7 +314,7 @@ func (s *friendService) BlockUser(ctx context.Context, userID, blockedUserID str
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to block user", err)
+		s.logger.WithContext(ctx).Error("Failed to block user", err)
 		return false, err
 	}
 
@@ -323,7 +326,7 @@ func (s *friendService) UnblockUser(ctx context.Context, userID, blockedUserID s
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return false, err
 	}
 
@@ -334,9 +337,77 @@ func (s *friendService) UnblockUser(ctx context.Context, userID, blockedUserID s
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to unblock user", err)
+		s.logger.WithContext(ctx).Error("Failed to unblock user", err)
 		return false, err
 	}
 
 	return resp.Success, nil
 }
+
+// GetBlockedUsers retrieves the users a user has blocked
+func (s *friendService) GetBlockedUsers(ctx context.Context, userID string, page, limit int) (*models.BlockedUsersResponse, error) {
+	// Create context with authorization metadata
+	authCtx, err := s.createAuthContext(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		return nil, err
+	}
+
+	// Call the gRPC service
+	resp, err := s.client.GetBlockedUsers(authCtx, &pb.GetBlockedUsersRequest{
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get blocked users", err)
+		return nil, err
+	}
+
+	// Convert blocked users to model format
+	blockedUsers := make([]models.BlockedUser, len(resp.BlockedUsers))
+	for i, blockedUser := range resp.BlockedUsers {
+		blockedUsers[i] = models.BlockedUser{
+			UserID:    blockedUser.UserId,
+			Name:      blockedUser.Name,
+			Avatar:    blockedUser.Avatar,
+			BlockedAt: blockedUser.BlockedAt,
+		}
+	}
+
+	return &models.BlockedUsersResponse{
+		BlockedUsers: blockedUsers,
+		TotalCount:   resp.TotalCount,
+		Page:         resp.Page,
+		TotalPages:   resp.TotalPages,
+		Pagination:   models.NewPagination(page, limit, int64(resp.TotalCount)),
+	}, nil
+}
+
+// CheckFriendship retrieves the relationship between a user and another user
+func (s *friendService) CheckFriendship(ctx context.Context, userID, friendID string) (*models.FriendshipStatus, error) {
+	// Create context with authorization metadata
+	authCtx, err := s.createAuthContext(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
+		return nil, err
+	}
+
+	// Call the gRPC service
+	resp, err := s.client.CheckFriendship(authCtx, &pb.CheckFriendshipRequest{
+		UserId:   userID,
+		FriendId: friendID,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check friendship", err)
+		return nil, err
+	}
+
+	return &models.FriendshipStatus{
+		AreFriends: resp.AreFriends,
+		Status:     resp.Status,
+		RequestID:  resp.RequestId,
+	}, nil
+}
@@ -9,7 +9,6 @@ import (
 	"gateway-api/internal/models"
 	"gateway-api/internal/utils/logger"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -71,13 +70,7 @@ func (s *groupService) createAuthContext(ctx context.Context) (context.Context,
 }
 
 // NewGroupService creates a new group service
-func NewGroupService(cfg *config.Config, logger *logger.Logger) GroupService {
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.GroupsServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to groups service", err)
-	}
-
+func NewGroupService(cfg *config.Config, logger *logger.Logger, conn *grpc.ClientConn) GroupService {
 	// Create a client
 	client := pb.NewGroupServiceClient(conn)
 
@@ -93,7 +86,7 @@ func (s *groupService) CreateGroup(ctx context.Context, userID string, request m
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -106,7 +99,7 @@ func (s *groupService) CreateGroup(ctx context.Context, userID string, request m
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to create group", err)
+		s.logger.WithContext(ctx).Error("Failed to create group", err)
 		return nil, err
 	}
 
@@ -128,7 +121,7 @@ func (s *groupService) GetGroup(ctx context.Context, groupID, userID string) (*m
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -139,7 +132,7 @@ func (s *groupService) GetGroup(ctx context.Context, groupID, userID string) (*m
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
+		s.logger.WithContext(ctx).Error("Failed to get group", err)
 		return nil, err
 	}
 
@@ -161,7 +154,7 @@ func (s *groupService) GetGroups(ctx context.Context, userID, query string, page
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -174,7 +167,7 @@ func (s *groupService) GetGroups(ctx context.Context, userID, query string, page
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get groups", err)
+		s.logger.WithContext(ctx).Error("Failed to get groups", err)
 		return nil, err
 	}
 
@@ -199,6 +192,7 @@ func (s *groupService) GetGroups(ctx context.Context, userID, query string, page
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
@@ -207,7 +201,7 @@ func (s *groupService) UpdateGroup(ctx context.Context, groupID, userID string,
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -221,7 +215,7 @@ func (s *groupService) UpdateGroup(ctx context.Context, groupID, userID string,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to update group", err)
+		s.logger.WithContext(ctx).Error("Failed to update group", err)
 		return nil, err
 	}
 
@@ -243,7 +237,7 @@ func (s *groupService) DeleteGroup(ctx context.Context, groupID, userID string)
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return false, err
 	}
 
@@ -254,7 +248,7 @@ func (s *groupService) DeleteGroup(ctx context.Context, groupID, userID string)
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to delete group", err)
+		s.logger.WithContext(ctx).Error("Failed to delete group", err)
 		return false, err
 	}
 
@@ -266,7 +260,7 @@ func (s *groupService) JoinGroup(ctx context.Context, groupID, userID string) (*
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -277,7 +271,7 @@ func (s *groupService) JoinGroup(ctx context.Context, groupID, userID string) (*
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to join group", err)
+		s.logger.WithContext(ctx).Error("Failed to join group", err)
 		return nil, err
 	}
 
@@ -292,7 +286,7 @@ func (s *groupService) LeaveGroup(ctx context.Context, groupID, userID string) (
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -303,7 +297,7 @@ func (s *groupService) LeaveGroup(ctx context.Context, groupID, userID string) (
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to leave group", err)
+		s.logger.WithContext(ctx).Error("Failed to leave group", err)
 		return nil, err
 	}
 
@@ -318,7 +312,7 @@ func (s *groupService) GetGroupMembers(ctx context.Context, groupID string, page
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -330,7 +324,7 @@ func (s *groupService) GetGroupMembers(ctx context.Context, groupID string, page
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get group members", err)
+		s.logger.WithContext(ctx).Error("Failed to get group members", err)
 		return nil, err
 	}
 
@@ -351,6 +345,7 @@ func (s *groupService) GetGroupMembers(ctx context.Context, groupID string, page
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
@@ -359,7 +354,7 @@ func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID stri
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -372,7 +367,7 @@ func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID stri
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to create group post", err)
+		s.logger.WithContext(ctx).Error("Failed to create group post", err)
 		return nil, err
 	}
 
@@ -396,7 +391,7 @@ func (s *groupService) GetGroupPosts(ctx context.Context, groupID, userID string
 	// Create context with authorization metadata
 	authCtx, err := s.createAuthContext(ctx)
 	if err != nil {
-		s.logger.Error("Failed to create auth context", err)
+		s.logger.WithContext(ctx).Error("Failed to create auth context", err)
 		return nil, err
 	}
 
@@ -409,7 +404,7 @@ func (s *groupService) GetGroupPosts(ctx context.Context, groupID, userID string
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get group posts", err)
+		s.logger.WithContext(ctx).Error("Failed to get group posts", err)
 		return nil, err
 	}
 
@@ -436,5 +431,6 @@ func (s *groupService) GetGroupPosts(ctx context.Context, groupID, userID string
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gateway-api/internal/config"
+	"gateway-api/internal/models"
+	"gateway-api/internal/utils/logger"
+)
+
+var (
+	// ErrMediaUploadsDisabled is returned when no media bucket is configured
+	ErrMediaUploadsDisabled = errors.New("media uploads are not configured")
+
+	// ErrUnsupportedMediaType is returned for content types that are not allowed
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+	// ErrMediaTooLarge is returned for files larger than the configured maximum
+	ErrMediaTooLarge = errors.New("media file is too large")
+)
+
+// mediaExtensions maps common media types to the file extension of their object keys
+var mediaExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+	"video/quicktime": ".mov",
+}
+
+// MediaService defines the interface for media-related operations
+type MediaService interface {
+	// CreateUploadURL issues a presigned URL the user can upload a media file to
+	CreateUploadURL(ctx context.Context, userID string, request models.UploadURLRequest) (*models.UploadURLResponse, error)
+}
+
+// mediaService implements the MediaService interface
+type mediaService struct {
+	cfg    *config.Config
+	logger *logger.Logger
+	bucket *s3Bucket // nil when uploads are disabled
+}
+
+// NewMediaService creates a new media service. Uploads are disabled while no bucket, or
+// no valid endpoint, is configured.
+func NewMediaService(cfg *config.Config, logger *logger.Logger) MediaService {
+	service := &mediaService{
+		cfg:    cfg,
+		logger: logger,
+	}
+
+	if cfg.Media.Bucket == "" {
+		logger.Info("No media bucket configured, media uploads are disabled")
+		return service
+	}
+
+	endpoint, err := url.Parse(cfg.Media.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		logger.Error("Invalid media endpoint, media uploads are disabled", fmt.Errorf("invalid URL %q", cfg.Media.Endpoint))
+		return service
+	}
+
+	service.bucket = &s3Bucket{
+		endpoint:        endpoint,
+		region:          cfg.Media.Region,
+		name:            cfg.Media.Bucket,
+		accessKeyID:     cfg.Media.AccessKeyID,
+		secretAccessKey: cfg.Media.SecretAccessKey,
+		usePathStyle:    cfg.Media.UsePathStyle,
+	}
+
+	return service
+}
+
+// CreateUploadURL issues a presigned URL the user can upload a media file to.
+// Objects are stored under the user's ID, with a random name so uploads never overwrite each other.
+func (s *mediaService) CreateUploadURL(ctx context.Context, userID string, request models.UploadURLRequest) (*models.UploadURLResponse, error) {
+	if s.bucket == nil {
+		return nil, ErrMediaUploadsDisabled
+	}
+
+	// Ignore parameters such as the charset when matching the content type
+	contentType := strings.ToLower(strings.TrimSpace(strings.Split(request.ContentType, ";")[0]))
+	if !s.isAllowedType(contentType) {
+		return nil, ErrUnsupportedMediaType
+	}
+	if request.Size > s.cfg.Media.MaxSize {
+		return nil, ErrMediaTooLarge
+	}
+
+	name := make([]byte, 16)
+	if _, err := rand.Read(name); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate media object name", err)
+		return nil, err
+	}
+	key := "uploads/" + userID + "/" + hex.EncodeToString(name) + mediaExtensions[contentType]
+
+	now := time.Now()
+	uploadURL := s.bucket.presignPut(key, contentType, request.Size, s.cfg.Media.URLExpiry, now)
+
+	return &models.UploadURLResponse{
+		UploadURL: uploadURL,
+		Method:    "PUT",
+		Headers: map[string]string{
+			"Content-Type":   contentType,
+			"Content-Length": strconv.FormatInt(request.Size, 10),
+		},
+		MediaURL:  s.publicURL(key),
+		ExpiresAt: now.Add(s.cfg.Media.URLExpiry).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// isAllowedType reports whether uploads of the content type are allowed
+func (s *mediaService) isAllowedType(contentType string) bool {
+	for _, allowed := range s.cfg.Media.AllowedTypes {
+		if strings.EqualFold(allowed, contentType) {
+			return true
+		}
+	}
+	return false
+}
+
+// publicURL returns the URL an uploaded object is served from
+func (s *mediaService) publicURL(key string) string {
+	if s.cfg.Media.PublicURL != "" {
+		return strings.TrimSuffix(s.cfg.Media.PublicURL, "/") + "/" + key
+	}
+	return s.bucket.objectURL(key).String()
+}
\ No newline at end of file
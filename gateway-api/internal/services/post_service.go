@@ -8,20 +8,22 @@ import (
 	"gateway-api/internal/models"
 	"gateway-api/internal/utils/logger"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
 // PostService defines the interface for post-related operations
 type PostService interface {
-	// CreatePost creates a new post
-	CreatePost(ctx context.Context, userID string, request models.PostCreateRequest) (*models.Post, error)
+	// CreatePost creates a new post; retries with the same idempotency key return the post created first
+	CreatePost(ctx context.Context, userID string, request models.PostCreateRequest, idempotencyKey string) (*models.Post, error)
 
 	// GetPost retrieves a post by ID
 	GetPost(ctx context.Context, postID, userID string) (*models.Post, error)
 
 	// GetPosts retrieves posts with pagination and filtering
-	GetPosts(ctx context.Context, userID, authorID, groupID, visibility string, page, limit int) (*models.PostsResponse, error)
+	GetPosts(ctx context.Context, userID, authorID, groupID, visibility, feed, cursor string, page, limit int) (*models.PostsResponse, error)
+
+	// GetPostsByHashtag retrieves posts tagged with a hashtag
+	GetPostsByHashtag(ctx context.Context, userID, tag string, page, limit int) (*models.PostsResponse, error)
 
 	// UpdatePost updates a post
 	UpdatePost(ctx context.Context, postID, userID string, request models.PostUpdateRequest) (*models.Post, error)
@@ -29,20 +31,50 @@ type PostService interface {
 	// DeletePost deletes a post
 	DeletePost(ctx context.Context, postID, userID string) (bool, error)
 
+	// SharePost shares a post as a new post of the user's
+	SharePost(ctx context.Context, postID, userID string, request models.SharePostRequest) (*models.Post, error)
+
+	// RestorePost restores a deleted post
+	RestorePost(ctx context.Context, postID, userID string) (*models.Post, error)
+
 	// GetComments retrieves comments for a post
-	GetComments(ctx context.Context, postID string, page, limit int) (*models.CommentsResponse, error)
+	GetComments(ctx context.Context, postID, userID string, threaded bool, page, limit int) (*models.CommentsResponse, error)
+
+	// AddComment adds a comment to a post; retries with the same idempotency key return the comment created first
+	AddComment(ctx context.Context, postID, userID string, request models.CommentCreateRequest, idempotencyKey string) (*models.Comment, error)
 
-	// AddComment adds a comment to a post
-	AddComment(ctx context.Context, postID, userID string, request models.CommentCreateRequest) (*models.Comment, error)
+	// EditComment edits a comment on a post
+	EditComment(ctx context.Context, postID, commentID, userID string, request models.CommentUpdateRequest) (*models.Comment, error)
 
 	// DeleteComment deletes a comment
 	DeleteComment(ctx context.Context, postID, commentID, userID string) (bool, error)
 
-	// LikePost likes a post
-	LikePost(ctx context.Context, postID, userID string) (*models.LikeResponse, error)
+	// LikePost sets the user's reaction on a post
+	LikePost(ctx context.Context, postID, userID string, request models.ReactionRequest) (*models.LikeResponse, error)
 
-	// UnlikePost unlikes a post
+	// UnlikePost removes the user's reaction from a post
 	UnlikePost(ctx context.Context, postID, userID string) (*models.LikeResponse, error)
+
+	// GetReactionCounts retrieves the number of each reaction type on a post
+	GetReactionCounts(ctx context.Context, postID string) (*models.ReactionCountsResponse, error)
+
+	// BookmarkPost saves a post to the user's bookmarks
+	BookmarkPost(ctx context.Context, postID, userID string) (bool, error)
+
+	// UnbookmarkPost removes a post from the user's bookmarks
+	UnbookmarkPost(ctx context.Context, postID, userID string) (bool, error)
+
+	// GetBookmarks retrieves the user's bookmarked posts with pagination
+	GetBookmarks(ctx context.Context, userID string, page, limit int) (*models.PostsResponse, error)
+
+	// ReportPost reports a post for moderation
+	ReportPost(ctx context.Context, postID, userID string, request models.ReportPostRequest) (bool, error)
+
+	// GetReports retrieves the moderation queue; admins only
+	GetReports(ctx context.Context, userID, status string, page, limit int) (*models.ReportsResponse, error)
+
+	// ResolveReport resolves a report, optionally hiding the post; admins only
+	ResolveReport(ctx context.Context, reportID, userID string, request models.ResolveReportRequest) (*models.Report, error)
 }
 
 // postService implements the PostService interface
@@ -53,14 +85,8 @@ type postService struct {
 }
 
 // NewPostService creates a new post service
-func NewPostService(cfg *config.Config, logger *logger.Logger) PostService {
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.PostsServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to posts service", err)
-	}
-
-	// Create a client
+func NewPostService(cfg *config.Config, logger *logger.Logger, conn *grpc.ClientConn) PostService {
+	// Create the client
 	client := pb.NewPostServiceClient(conn)
 
 	return &postService{
@@ -71,7 +97,7 @@ func NewPostService(cfg *config.Config, logger *logger.Logger) PostService {
 }
 
 // CreatePost creates a new post
-func (s *postService) CreatePost(ctx context.Context, userID string, request models.PostCreateRequest) (*models.Post, error) {
+func (s *postService) CreatePost(ctx context.Context, userID string, request models.PostCreateRequest, idempotencyKey string) (*models.Post, error) {
 	// Get JWT token from context
 	token, _ := ctx.Value("jwt_token").(string)
 
@@ -85,107 +111,143 @@ func (s *postService) CreatePost(ctx context.Context, userID string, request mod
 
 	// Call the gRPC service with the context containing the token
 	resp, err := s.client.CreatePost(ctxWithToken, &pb.CreatePostRequest{
-		UserId:     userID,
-		Content:    request.Content,
-		Visibility: request.Visibility,
-		GroupId:    "", // GroupID is not in the PostCreateRequest model, we'll need to update the model
-		Media:      request.Media,
+		UserId:         userID,
+		Content:        request.Content,
+		Visibility:     request.Visibility,
+		GroupId:        "", // GroupID is not in the PostCreateRequest model, we'll need to update the model
+		Media:          request.Media,
+		IdempotencyKey: idempotencyKey,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to create post", err)
+		s.logger.WithContext(ctx).Error("Failed to create post", err)
 		return nil, err
 	}
 
-	return &models.Post{
-		PostID:        resp.PostId,
-		AuthorID:      resp.AuthorId,
-		AuthorName:    resp.AuthorName,
-		AuthorAvatar:  resp.AuthorAvatar,
-		Content:       resp.Content,
-		Visibility:    resp.Visibility,
-		Media:         resp.Media,
-		LikesCount:    resp.LikesCount,
-		CommentsCount: resp.CommentsCount,
-		IsLiked:       resp.IsLiked,
-		CreatedAt:     resp.CreatedAt,
-		UpdatedAt:     resp.UpdatedAt,
-	}, nil
+	post := convertPost(resp)
+	return &post, nil
 }
 
 // GetPost retrieves a post by ID
 func (s *postService) GetPost(ctx context.Context, postID, userID string) (*models.Post, error) {
 	// Call the gRPC service
-	resp, err := s.client.GetPost(context.Background(), &pb.GetPostRequest{
+	resp, err := s.client.GetPost(ctx, &pb.GetPostRequest{
 		PostId: postID,
 		UserId: userID,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return nil, err
 	}
 
-	return &models.Post{
-		PostID:        resp.PostId,
-		AuthorID:      resp.AuthorId,
-		AuthorName:    resp.AuthorName,
-		AuthorAvatar:  resp.AuthorAvatar,
-		Content:       resp.Content,
-		Visibility:    resp.Visibility,
-		Media:         resp.Media,
-		LikesCount:    resp.LikesCount,
-		CommentsCount: resp.CommentsCount,
-		IsLiked:       resp.IsLiked,
-		CreatedAt:     resp.CreatedAt,
-		UpdatedAt:     resp.UpdatedAt,
-	}, nil
+	post := convertPost(resp)
+	return &post, nil
 }
 
 // GetPosts retrieves posts with pagination and filtering
-func (s *postService) GetPosts(ctx context.Context, userID, authorID, groupID, visibility string, page, limit int) (*models.PostsResponse, error) {
+func (s *postService) GetPosts(ctx context.Context, userID, authorID, groupID, visibility, feed, cursor string, page, limit int) (*models.PostsResponse, error) {
 	// Call the gRPC service
-	resp, err := s.client.GetPosts(context.Background(), &pb.GetPostsRequest{
+	resp, err := s.client.GetPosts(ctx, &pb.GetPostsRequest{
 		UserId:     userID,
 		AuthorId:   authorID,
 		GroupId:    groupID,
 		Visibility: visibility,
 		Page:       int32(page),
 		Limit:      int32(limit),
+		Cursor:     cursor,
+		Feed:       feed,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get posts", err)
+		s.logger.WithContext(ctx).Error("Failed to get posts", err)
 		return nil, err
 	}
 
-	// Convert posts to model format
-	posts := make([]models.Post, len(resp.Posts))
-	for i, post := range resp.Posts {
-		posts[i] = models.Post{
-			PostID:        post.PostId,
-			AuthorID:      post.AuthorId,
-			AuthorName:    post.AuthorName,
-			AuthorAvatar:  post.AuthorAvatar,
-			Content:       post.Content,
-			Visibility:    post.Visibility,
-			Media:         post.Media,
-			LikesCount:    post.LikesCount,
-			CommentsCount: post.CommentsCount,
-			IsLiked:       post.IsLiked,
-			CreatedAt:     post.CreatedAt,
-			UpdatedAt:     post.UpdatedAt,
-		}
+	return &models.PostsResponse{
+		Posts:      convertPosts(resp.Posts),
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+// GetPostsByHashtag retrieves posts tagged with a hashtag
+func (s *postService) GetPostsByHashtag(ctx context.Context, userID, tag string, page, limit int) (*models.PostsResponse, error) {
+	// Call the gRPC service
+	resp, err := s.client.GetPostsByHashtag(ctx, &pb.GetPostsByHashtagRequest{
+		Tag:    tag,
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get posts by hashtag", err)
+		return nil, err
 	}
 
 	return &models.PostsResponse{
-		Posts:      posts,
+		Posts:      convertPosts(resp.Posts),
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
+// convertPost converts a gRPC post to model format
+func convertPost(post *pb.PostResponse) models.Post {
+	result := models.Post{
+		PostID:        post.PostId,
+		AuthorID:      post.AuthorId,
+		AuthorName:    post.AuthorName,
+		AuthorAvatar:  post.AuthorAvatar,
+		Content:       post.Content,
+		Visibility:    post.Visibility,
+		Media:         post.Media,
+		LikesCount:    post.LikesCount,
+		CommentsCount: post.CommentsCount,
+		SharesCount:   post.SharesCount,
+		IsLiked:       post.IsLiked,
+		Mentions:      convertMentions(post.Mentions),
+		CreatedAt:     post.CreatedAt,
+		UpdatedAt:     post.UpdatedAt,
+
+		OriginalPostID: post.OriginalPostId,
+		ReportsCount:   post.ReportsCount,
+		Hidden:         post.Hidden,
+	}
+	if post.OriginalPost != nil {
+		original := convertPost(post.OriginalPost)
+		result.OriginalPost = &original
+	}
+	return result
+}
+
+// convertPosts converts a list of gRPC posts to model format
+func convertPosts(posts []*pb.PostResponse) []models.Post {
+	result := make([]models.Post, len(posts))
+	for i, post := range posts {
+		result[i] = convertPost(post)
+	}
+	return result
+}
+
+// convertMentions converts gRPC mentions to model format
+func convertMentions(mentions []*pb.Mention) []models.Mention {
+	result := make([]models.Mention, len(mentions))
+	for i, mention := range mentions {
+		result[i] = models.Mention{
+			UserID:   mention.UserId,
+			Username: mention.Username,
+		}
+	}
+	return result
+}
+
 // UpdatePost updates a post
 func (s *postService) UpdatePost(ctx context.Context, postID, userID string, request models.PostUpdateRequest) (*models.Post, error) {
 	// Get JWT token from context
@@ -209,24 +271,12 @@ func (s *postService) UpdatePost(ctx context.Context, postID, userID string, req
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to update post", err)
+		s.logger.WithContext(ctx).Error("Failed to update post", err)
 		return nil, err
 	}
 
-	return &models.Post{
-		PostID:        resp.PostId,
-		AuthorID:      resp.AuthorId,
-		AuthorName:    resp.AuthorName,
-		AuthorAvatar:  resp.AuthorAvatar,
-		Content:       resp.Content,
-		Visibility:    resp.Visibility,
-		Media:         resp.Media,
-		LikesCount:    resp.LikesCount,
-		CommentsCount: resp.CommentsCount,
-		IsLiked:       resp.IsLiked,
-		CreatedAt:     resp.CreatedAt,
-		UpdatedAt:     resp.UpdatedAt,
-	}, nil
+	post := convertPost(resp)
+	return &post, nil
 }
 
 // DeletePost deletes a post
@@ -249,51 +299,86 @@ func (s *postService) DeletePost(ctx context.Context, postID, userID string) (bo
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to delete post", err)
+		s.logger.WithContext(ctx).Error("Failed to delete post", err)
 		return false, err
 	}
 
 	return resp.Success, nil
 }
 
-// GetComments retrieves comments for a post
-func (s *postService) GetComments(ctx context.Context, postID string, page, limit int) (*models.CommentsResponse, error) {
+// SharePost shares a post as a new post of the user's
+func (s *postService) SharePost(ctx context.Context, postID, userID string, request models.SharePostRequest) (*models.Post, error) {
 	// Call the gRPC service
-	resp, err := s.client.GetComments(context.Background(), &pb.GetCommentsRequest{
+	resp, err := s.client.SharePost(ctx, &pb.SharePostRequest{
+		PostId:  postID,
+		UserId:  userID,
+		Comment: request.Comment,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to share post", err)
+		return nil, err
+	}
+
+	post := convertPost(resp)
+	return &post, nil
+}
+
+// RestorePost restores a deleted post
+func (s *postService) RestorePost(ctx context.Context, postID, userID string) (*models.Post, error) {
+	// Get JWT token from context
+	token, _ := ctx.Value("jwt_token").(string)
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := s.client.RestorePost(ctxWithToken, &pb.RestorePostRequest{
 		PostId: postID,
-		Page:   int32(page),
-		Limit:  int32(limit),
+		UserId: userID,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get comments", err)
+		s.logger.WithContext(ctx).Error("Failed to restore post", err)
 		return nil, err
 	}
 
-	// Convert comments to model format
-	comments := make([]models.Comment, len(resp.Comments))
-	for i, comment := range resp.Comments {
-		comments[i] = models.Comment{
-			CommentID:    comment.CommentId,
-			PostID:       comment.PostId,
-			AuthorID:     comment.AuthorId,
-			AuthorName:   comment.AuthorName,
-			AuthorAvatar: comment.AuthorAvatar,
-			Content:      comment.Content,
-			CreatedAt:    comment.CreatedAt,
-		}
+	post := convertPost(resp)
+	return &post, nil
+}
+
+// GetComments retrieves comments for a post
+func (s *postService) GetComments(ctx context.Context, postID, userID string, threaded bool, page, limit int) (*models.CommentsResponse, error) {
+	// Call the gRPC service
+	resp, err := s.client.GetComments(ctx, &pb.GetCommentsRequest{
+		PostId:   postID,
+		UserId:   userID,
+		Page:     int32(page),
+		Limit:    int32(limit),
+		Threaded: threaded,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get comments", err)
+		return nil, err
 	}
 
 	return &models.CommentsResponse{
-		Comments:   comments,
+		Comments:   convertComments(resp.Comments),
 		TotalCount: resp.TotalCount,
 		Page:       resp.Page,
 		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
 // AddComment adds a comment to a post
-func (s *postService) AddComment(ctx context.Context, postID, userID string, request models.CommentCreateRequest) (*models.Comment, error) {
+func (s *postService) AddComment(ctx context.Context, postID, userID string, request models.CommentCreateRequest, idempotencyKey string) (*models.Comment, error) {
 	// Get JWT token from context
 	token, _ := ctx.Value("jwt_token").(string)
 
@@ -307,25 +392,82 @@ func (s *postService) AddComment(ctx context.Context, postID, userID string, req
 
 	// Call the gRPC service with the context containing the token
 	resp, err := s.client.AddComment(ctxWithToken, &pb.AddCommentRequest{
-		PostId:  postID,
-		UserId:  userID,
-		Content: request.Content,
+		PostId:          postID,
+		UserId:          userID,
+		Content:         request.Content,
+		ParentCommentId: request.ParentCommentID,
+		IdempotencyKey:  idempotencyKey,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to add comment", err)
+		s.logger.WithContext(ctx).Error("Failed to add comment", err)
 		return nil, err
 	}
 
-	return &models.Comment{
-		CommentID:    resp.CommentId,
-		PostID:       resp.PostId,
-		AuthorID:     resp.AuthorId,
-		AuthorName:   resp.AuthorName,
-		AuthorAvatar: resp.AuthorAvatar,
-		Content:      resp.Content,
-		CreatedAt:    resp.CreatedAt,
-	}, nil
+	comment := convertComment(resp)
+	return &comment, nil
+}
+
+// convertComment converts a gRPC comment, including its nested replies, to model format
+func convertComment(comment *pb.CommentResponse) models.Comment {
+	return models.Comment{
+		CommentID:       comment.CommentId,
+		PostID:          comment.PostId,
+		ParentCommentID: comment.ParentCommentId,
+		Depth:           comment.Depth,
+		AuthorID:        comment.AuthorId,
+		AuthorName:      comment.AuthorName,
+		AuthorAvatar:    comment.AuthorAvatar,
+		Content:         comment.Content,
+		Edited:          comment.Edited,
+		Mentions:        convertMentions(comment.Mentions),
+		Replies:         convertComments(comment.Replies),
+		CreatedAt:       comment.CreatedAt,
+		UpdatedAt:       comment.UpdatedAt,
+	}
+}
+
+// convertComments converts a list of gRPC comments to model format
+func convertComments(comments []*pb.CommentResponse) []models.Comment {
+	if comments == nil {
+		return nil
+	}
+
+	result := make([]models.Comment, len(comments))
+	for i, comment := range comments {
+		result[i] = convertComment(comment)
+	}
+	return result
+}
+
+// EditComment edits a comment on a post
+func (s *postService) EditComment(ctx context.Context, postID, commentID, userID string, request models.CommentUpdateRequest) (*models.Comment, error) {
+	// Get JWT token from context
+	token, _ := ctx.Value("jwt_token").(string)
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := s.client.EditComment(ctxWithToken, &pb.EditCommentRequest{
+		CommentId: commentID,
+		PostId:    postID,
+		UserId:    userID,
+		Content:   request.Content,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to edit comment", err)
+		return nil, err
+	}
+
+	comment := convertComment(resp)
+	return &comment, nil
 }
 
 // DeleteComment deletes a comment
@@ -349,7 +491,7 @@ func (s *postService) DeleteComment(ctx context.Context, postID, commentID, user
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to delete comment", err)
+		s.logger.WithContext(ctx).Error("Failed to delete comment", err)
 		return false, err
 	}
 
@@ -357,7 +499,7 @@ func (s *postService) DeleteComment(ctx context.Context, postID, commentID, user
 }
 
 // LikePost likes a post
-func (s *postService) LikePost(ctx context.Context, postID, userID string) (*models.LikeResponse, error) {
+func (s *postService) LikePost(ctx context.Context, postID, userID string, request models.ReactionRequest) (*models.LikeResponse, error) {
 	// Get JWT token from context
 	token, _ := ctx.Value("jwt_token").(string)
 
@@ -371,18 +513,20 @@ func (s *postService) LikePost(ctx context.Context, postID, userID string) (*mod
 
 	// Call the gRPC service with the context containing the token
 	resp, err := s.client.LikePost(ctxWithToken, &pb.LikePostRequest{
-		PostId: postID,
-		UserId: userID,
+		PostId:       postID,
+		UserId:       userID,
+		ReactionType: request.ReactionType,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to like post", err)
+		s.logger.WithContext(ctx).Error("Failed to like post", err)
 		return nil, err
 	}
 
 	return &models.LikeResponse{
-		Success:    resp.Success,
-		LikesCount: int(resp.LikesCount),
+		Success:        resp.Success,
+		LikesCount:     int(resp.LikesCount),
+		ReactionCounts: resp.ReactionCounts,
 	}, nil
 }
 
@@ -406,12 +550,202 @@ func (s *postService) UnlikePost(ctx context.Context, postID, userID string) (*m
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to unlike post", err)
+		s.logger.WithContext(ctx).Error("Failed to unlike post", err)
 		return nil, err
 	}
 
 	return &models.LikeResponse{
-		Success:    resp.Success,
-		LikesCount: int(resp.LikesCount),
+		Success:        resp.Success,
+		LikesCount:     int(resp.LikesCount),
+		ReactionCounts: resp.ReactionCounts,
 	}, nil
 }
+
+// GetReactionCounts retrieves the number of each reaction type on a post
+func (s *postService) GetReactionCounts(ctx context.Context, postID string) (*models.ReactionCountsResponse, error) {
+	// Call the gRPC service
+	resp, err := s.client.GetReactionCounts(ctx, &pb.GetReactionCountsRequest{
+		PostId: postID,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get reaction counts", err)
+		return nil, err
+	}
+
+	return &models.ReactionCountsResponse{
+		LikesCount:     int(resp.LikesCount),
+		ReactionCounts: resp.ReactionCounts,
+	}, nil
+}
+
+// BookmarkPost saves a post to the user's bookmarks
+func (s *postService) BookmarkPost(ctx context.Context, postID, userID string) (bool, error) {
+	// Call the gRPC service
+	resp, err := s.client.BookmarkPost(ctx, &pb.BookmarkPostRequest{
+		PostId: postID,
+		UserId: userID,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to bookmark post", err)
+		return false, err
+	}
+
+	return resp.Success, nil
+}
+
+// UnbookmarkPost removes a post from the user's bookmarks
+func (s *postService) UnbookmarkPost(ctx context.Context, postID, userID string) (bool, error) {
+	// Get JWT token from context
+	token, _ := ctx.Value("jwt_token").(string)
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := s.client.UnbookmarkPost(ctxWithToken, &pb.UnbookmarkPostRequest{
+		PostId: postID,
+		UserId: userID,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to remove bookmark", err)
+		return false, err
+	}
+
+	return resp.Success, nil
+}
+
+// GetBookmarks retrieves the user's bookmarked posts with pagination
+func (s *postService) GetBookmarks(ctx context.Context, userID string, page, limit int) (*models.PostsResponse, error) {
+	// Call the gRPC service
+	resp, err := s.client.GetBookmarks(ctx, &pb.GetBookmarksRequest{
+		UserId: userID,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get bookmarks", err)
+		return nil, err
+	}
+
+	return &models.PostsResponse{
+		Posts:      convertPosts(resp.Posts),
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	}, nil
+}
+
+// ReportPost reports a post for moderation
+func (s *postService) ReportPost(ctx context.Context, postID, userID string, request models.ReportPostRequest) (bool, error) {
+	// Call the gRPC service
+	resp, err := s.client.ReportPost(ctx, &pb.ReportPostRequest{
+		PostId: postID,
+		UserId: userID,
+		Reason: request.Reason,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to report post", err)
+		return false, err
+	}
+
+	return resp.Success, nil
+}
+
+// GetReports retrieves the moderation queue
+func (s *postService) GetReports(ctx context.Context, userID, status string, page, limit int) (*models.ReportsResponse, error) {
+	// Get JWT token from context
+	token, _ := ctx.Value("jwt_token").(string)
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := s.client.GetReports(ctxWithToken, &pb.GetReportsRequest{
+		UserId: userID,
+		Status: status,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get reports", err)
+		return nil, err
+	}
+
+	reports := make([]models.Report, len(resp.Reports))
+	for i, report := range resp.Reports {
+		reports[i] = convertReport(report)
+	}
+
+	return &models.ReportsResponse{
+		Reports:    reports,
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
+	}, nil
+}
+
+// ResolveReport resolves a report, optionally hiding the post
+func (s *postService) ResolveReport(ctx context.Context, reportID, userID string, request models.ResolveReportRequest) (*models.Report, error) {
+	// Get JWT token from context
+	token, _ := ctx.Value("jwt_token").(string)
+
+	// Create metadata with authorization token
+	md := metadata.New(map[string]string{
+		"authorization": "Bearer " + token,
+	})
+
+	// Create new context with metadata
+	ctxWithToken := metadata.NewOutgoingContext(ctx, md)
+
+	// Call the gRPC service with the context containing the token
+	resp, err := s.client.ResolveReport(ctxWithToken, &pb.ResolveReportRequest{
+		ReportId: reportID,
+		UserId:   userID,
+		HidePost: request.HidePost,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to resolve report", err)
+		return nil, err
+	}
+
+	report := convertReport(resp)
+	return &report, nil
+}
+
+// convertReport converts a gRPC report to model format
+func convertReport(report *pb.ReportResponse) models.Report {
+	result := models.Report{
+		ReportID:   report.ReportId,
+		PostID:     report.PostId,
+		ReporterID: report.ReporterId,
+		Reason:     report.Reason,
+		Status:     report.Status,
+		ResolvedBy: report.ResolvedBy,
+		ResolvedAt: report.ResolvedAt,
+		CreatedAt:  report.CreatedAt,
+	}
+	if report.Post != nil {
+		post := convertPost(report.Post)
+		result.Post = &post
+	}
+	return result
+}
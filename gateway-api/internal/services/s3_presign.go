@@ -0,0 +1,167 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// s3SigningAlgorithm is the AWS Signature Version 4 algorithm identifier
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+
+	// s3UnsignedPayload tells S3 that the request body is not part of the signature
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// s3Bucket addresses a bucket of an S3-compatible object store
+type s3Bucket struct {
+	endpoint        *url.URL
+	region          string
+	name            string
+	accessKeyID     string
+	secretAccessKey string
+	usePathStyle    bool
+}
+
+// objectURL returns the unsigned URL of an object in the bucket
+func (b *s3Bucket) objectURL(key string) *url.URL {
+	u := &url.URL{
+		Scheme: b.endpoint.Scheme,
+		Host:   b.host(),
+	}
+	if b.usePathStyle {
+		u.Path = "/" + b.name + "/" + key
+	} else {
+		u.Host = b.name + "." + u.Host
+		u.Path = "/" + key
+	}
+	return u
+}
+
+// host returns the endpoint's host, with the port only if it is not the scheme's default.
+// Browsers leave a default port out of the Host header, and the signed host must match it.
+func (b *s3Bucket) host() string {
+	port := b.endpoint.Port()
+	if (b.endpoint.Scheme == "https" && port == "443") || (b.endpoint.Scheme == "http" && port == "80") {
+		return b.endpoint.Hostname()
+	}
+	return b.endpoint.Host
+}
+
+// presignPut returns a URL that allows uploading the object with a single PUT until it
+// expires. Content type and length are signed, so the upload must send exactly those headers.
+func (b *s3Bucket) presignPut(key, contentType string, contentLength int64, expiry time.Duration, now time.Time) string {
+	return b.presign("PUT", key, map[string]string{
+		"content-length": strconv.FormatInt(contentLength, 10),
+		"content-type":   strings.TrimSpace(contentType),
+	}, expiry, now)
+}
+
+// presign returns a URL that allows one request with the method on the object until it
+// expires. The headers, keyed by lowercase name, are signed along with the host.
+func (b *s3Bucket) presign(method, key string, headers map[string]string, expiry time.Duration, now time.Time) string {
+	u := b.objectURL(key)
+
+	signed := map[string]string{"host": u.Host}
+	for name, value := range headers {
+		signed[name] = value
+	}
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/" + b.region + "/s3/aws4_request"
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     s3SigningAlgorithm,
+		"X-Amz-Credential":    b.accessKeyID + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       strconv.Itoa(int(expiry / time.Second)),
+		"X-Amz-SignedHeaders": strings.Join(names, ";"),
+	}
+	canonicalQuery := canonicalS3Query(query)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + signed[name] + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		s3URIEncode(u.Path, false),
+		canonicalQuery,
+		canonicalHeaders.String(),
+		query["X-Amz-SignedHeaders"],
+		s3UnsignedPayload,
+	}, "\n")
+
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	// Derive the signing key for the day, region and service
+	signingKey := hmacSHA256([]byte("AWS4"+b.secretAccessKey), now.Format("20060102"))
+	signingKey = hmacSHA256(signingKey, b.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return fmt.Sprintf("%s://%s%s?%s&X-Amz-Signature=%s",
+		u.Scheme, u.Host, s3URIEncode(u.Path, false), canonicalQuery, signature)
+}
+
+// canonicalS3Query encodes query parameters sorted by name, as the signature requires
+func canonicalS3Query(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, s3URIEncode(name, true)+"="+s3URIEncode(params[name], true))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// s3URIEncode percent-encodes everything but unreserved characters, and slashes unless
+// encodeSlash is set. url.QueryEscape differs from the encoding S3 signs, e.g. for spaces.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// hmacSHA256 signs data with key
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
\ No newline at end of file
@@ -5,17 +5,17 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"io"
-	"time"
 
+	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 
+	postspb "common/pb/common/proto/posts"
 	pb "common/pb/common/proto/users"
 	"gateway-api/internal/config"
 	"gateway-api/internal/models"
 	"gateway-api/internal/utils/logger"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -33,11 +33,31 @@ type UserService interface {
 	// UpdateProfile updates the user's profile
 	UpdateProfile(ctx context.Context, userID string, request models.ProfileUpdateRequest) (*models.UserProfile, error)
 
+	// SetUserRole changes a user's role; admins only
+	SetUserRole(ctx context.Context, userID string, request models.UserRoleRequest) (*models.UserProfile, error)
+
+	// GetPublicProfile gets another user's public profile, with the caller's friendship status
+	// and the user's public post count
+	GetPublicProfile(ctx context.Context, userID string) (*models.PublicUserProfile, error)
+
+	// SearchUsers finds users by name or email
+	SearchUsers(ctx context.Context, query string, page, limit int) (*models.UserSearchResponse, error)
+
+	// DeleteAccount permanently deletes the authenticated user's account
+	DeleteAccount(ctx context.Context) (bool, error)
+
+	// MarkSeen records that the authenticated user is active
+	MarkSeen(ctx context.Context) error
+
+	// IsTokenRevoked reports whether the token with the given ID has been signed out, or the
+	// user it was issued to has deleted their account
+	IsTokenRevoked(ctx context.Context, jti, userID string) (bool, error)
+
 	// GoogleLogin generates a Google OAuth URL with state token
-	GoogleLogin() (string, error)
+	GoogleLogin(ctx context.Context) (string, error)
 
 	// MicrosoftLogin generates a Microsoft OAuth URL with state token
-	MicrosoftLogin() (string, error)
+	MicrosoftLogin(ctx context.Context) (string, error)
 
 	// GoogleCallback handles the callback from Google OAuth
 	GoogleCallback(ctx context.Context, state, code string) (*models.AuthResponse, error)
@@ -46,7 +66,7 @@ type UserService interface {
 	MicrosoftCallback(ctx context.Context, state, code string) (*models.AuthResponse, error)
 
 	// ValidateStateToken validates the state token to prevent CSRF attacks
-	ValidateStateToken(state string) bool
+	ValidateStateToken(ctx context.Context, state string) bool
 
 	// Signout signs out the user
 	Signout(ctx context.Context, token string) (bool, error)
@@ -57,9 +77,10 @@ type userService struct {
 	cfg             *config.Config
 	logger          *logger.Logger
 	client          pb.UserServiceClient
+	posts           postspb.PostServiceClient
 	googleConfig    *oauth2.Config
 	microsoftConfig *oauth2.Config
-	stateStore      map[string]time.Time // Store state tokens for CSRF protection
+	stateStore      *stateStore // Store state tokens for CSRF protection
 }
 
 // createAuthContext creates a new context with the JWT token in the metadata
@@ -85,22 +106,17 @@ func (s *userService) createAuthContext(ctx context.Context) context.Context {
 }
 
 // NewUserService creates a new user service
-func NewUserService(cfg *config.Config, logger *logger.Logger) UserService {
-	// Set up a connection to the gRPC server
-	conn, err := grpc.Dial(cfg.UsersServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		logger.Fatal("Failed to connect to users service", err)
-	}
-
-	// Create a client
+func NewUserService(cfg *config.Config, logger *logger.Logger, conn, postsConn *grpc.ClientConn) UserService {
+	// Create the clients
 	client := pb.NewUserServiceClient(conn)
+	posts := postspb.NewPostServiceClient(postsConn)
 
 	// Configure Google OAuth2
 	googleConfig := &oauth2.Config{
 		ClientID:     cfg.OAuth.Google.ClientID,
 		ClientSecret: cfg.OAuth.Google.ClientSecret,
 		RedirectURL:  cfg.OAuth.Google.RedirectURL,
-		Scopes:       []string{"User.Read", "profile", "email", "openid"},
+		Scopes:       cfg.OAuth.Google.Scopes,
 		Endpoint:     google.Endpoint,
 	}
 
@@ -109,21 +125,27 @@ func NewUserService(cfg *config.Config, logger *logger.Logger) UserService {
 		ClientID:     cfg.OAuth.Microsoft.ClientID,
 		ClientSecret: cfg.OAuth.Microsoft.ClientSecret,
 		RedirectURL:  cfg.OAuth.Microsoft.RedirectURL,
-		Scopes:       []string{"User.Read", "profile", "email", "openid"},
+		Scopes:       cfg.OAuth.Microsoft.Scopes,
 		Endpoint: oauth2.Endpoint{
 			AuthURL:  "https://login.microsoftonline.com/consumers/oauth2/v2.0/authorize",
 			TokenURL: "https://login.microsoftonline.com/consumers/oauth2/v2.0/token",
 		},
 	}
 
-	return &userService{
+	service := &userService{
 		cfg:             cfg,
 		logger:          logger,
 		client:          client,
+		posts:           posts,
 		googleConfig:    googleConfig,
 		microsoftConfig: microsoftConfig,
-		stateStore:      make(map[string]time.Time),
+		stateStore:      newStateStore(stateTokenTTL),
 	}
+
+	// Periodically sweep state tokens from logins that were never completed
+	go service.stateStore.runCleanup(stateCleanupInterval)
+
+	return service
 }
 
 // generateStateToken generates a random state token for CSRF protection
@@ -144,10 +166,11 @@ func (s *userService) Register(ctx context.Context, request models.AuthRequest)
 	resp, err := s.client.Register(authCtx, &pb.RegisterRequest{
 		Provider: request.Provider,
 		Token:    request.AccessToken,
+		Name:     request.Name,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to register user", err)
+		s.logger.WithContext(ctx).Error("Failed to register user", err)
 		return nil, err
 	}
 
@@ -166,10 +189,11 @@ func (s *userService) Login(ctx context.Context, request models.AuthRequest) (*m
 	resp, err := s.client.Login(authCtx, &pb.LoginRequest{
 		Provider: request.Provider,
 		Token:    request.AccessToken,
+		Name:     request.Name,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to login user", err)
+		s.logger.WithContext(ctx).Error("Failed to login user", err)
 		return nil, err
 	}
 
@@ -190,18 +214,11 @@ func (s *userService) GetProfile(ctx context.Context, userID string) (*models.Us
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to get user profile", err)
+		s.logger.WithContext(ctx).Error("Failed to get user profile", err)
 		return nil, err
 	}
 
-	return &models.UserProfile{
-		UserID:    resp.UserId,
-		Name:      resp.Name,
-		Email:     resp.Email,
-		Avatar:    resp.Avatar,
-		CreatedAt: resp.CreatedAt,
-		UpdatedAt: resp.CreatedAt, // Using CreatedAt as UpdatedAt since it's not provided by the gRPC service
-	}, nil
+	return convertProfile(resp), nil
 }
 
 // UpdateProfile updates the user's profile
@@ -211,62 +228,219 @@ func (s *userService) UpdateProfile(ctx context.Context, userID string, request
 
 	// Call the gRPC service with the auth context
 	resp, err := s.client.UpdateProfile(authCtx, &pb.UpdateProfileRequest{
+		UserId:   userID,
+		Name:     request.Name,
+		Avatar:   request.Avatar,
+		Bio:      request.Bio,
+		Location: request.Location,
+		Website:  request.Website,
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to update user profile", err)
+		return nil, err
+	}
+
+	return convertProfile(resp), nil
+}
+
+// SetUserRole changes a user's role
+func (s *userService) SetUserRole(ctx context.Context, userID string, request models.UserRoleRequest) (*models.UserProfile, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Call the gRPC service with the auth context
+	resp, err := s.client.SetUserRole(authCtx, &pb.SetUserRoleRequest{
 		UserId: userID,
-		Name:   request.Name,
-		Avatar: request.Avatar,
+		Role:   request.Role,
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to update user profile", err)
+		s.logger.WithContext(ctx).Error("Failed to set user role", err)
 		return nil, err
 	}
 
+	return convertProfile(resp), nil
+}
+
+// convertProfile converts a gRPC profile to model format
+func convertProfile(resp *pb.ProfileResponse) *models.UserProfile {
 	return &models.UserProfile{
-		UserID:    resp.UserId,
-		Name:      resp.Name,
-		Email:     resp.Email,
-		Avatar:    resp.Avatar,
-		CreatedAt: resp.CreatedAt,
-		UpdatedAt: resp.CreatedAt, // Using CreatedAt as UpdatedAt since it's not provided by the gRPC service
+		UserID:        resp.UserId,
+		Name:          resp.Name,
+		Username:      resp.Username,
+		Email:         resp.Email,
+		EmailVerified: resp.EmailVerified,
+		Avatar:        resp.Avatar,
+		Role:          resp.Role,
+		Bio:           resp.Bio,
+		Location:      resp.Location,
+		Website:       resp.Website,
+		CreatedAt:     resp.CreatedAt,
+		UpdatedAt:     resp.UpdatedAt,
+	}
+}
+
+// GetPublicProfile gets another user's public profile, with the caller's friendship status
+// and the user's public post count
+func (s *userService) GetPublicProfile(ctx context.Context, userID string) (*models.PublicUserProfile, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Call the gRPC service with the auth context
+	resp, err := s.client.GetPublicProfile(authCtx, &pb.GetPublicProfileRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get public profile", err)
+		return nil, err
+	}
+
+	profile := &models.PublicUserProfile{
+		UserID:           resp.User.GetUserId(),
+		Name:             resp.User.GetName(),
+		Username:         resp.User.GetUsername(),
+		Avatar:           resp.User.GetAvatar(),
+		Email:            resp.Email,
+		Bio:              resp.Bio,
+		Location:         resp.Location,
+		Website:          resp.Website,
+		CreatedAt:        resp.CreatedAt,
+		LastSeenAt:       resp.User.GetLastSeenAt(),
+		IsOnline:         resp.User.GetIsOnline(),
+		FriendshipStatus: resp.FriendshipStatus,
+		FollowersCount:   resp.FollowersCount,
+		FollowingCount:   resp.FollowingCount,
+		IsFollowing:      resp.IsFollowing,
+	}
+
+	// The profile is still useful without the count, so a failure only leaves it out
+	count, err := s.posts.CountPublicPosts(authCtx, &postspb.CountPublicPostsRequest{
+		AuthorId: userID,
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to count public posts", zap.Error(err))
+	} else {
+		profile.PublicPostCount = &count.Count
+	}
+
+	return profile, nil
+}
+
+// SearchUsers finds users by name or email
+func (s *userService) SearchUsers(ctx context.Context, query string, page, limit int) (*models.UserSearchResponse, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Call the gRPC service with the auth context
+	resp, err := s.client.SearchUsers(authCtx, &pb.SearchUsersRequest{
+		Query: query,
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to search users", err)
+		return nil, err
+	}
+
+	users := make([]models.UserSearchResult, len(resp.Users))
+	for i, result := range resp.Users {
+		users[i] = models.UserSearchResult{
+			UserID:           result.User.GetUserId(),
+			Name:             result.User.GetName(),
+			Username:         result.User.GetUsername(),
+			Avatar:           result.User.GetAvatar(),
+			FriendshipStatus: result.FriendshipStatus,
+		}
+	}
+
+	return &models.UserSearchResponse{
+		Users:      users,
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		TotalPages: resp.TotalPages,
+		Pagination: models.NewPagination(page, limit, int64(resp.TotalCount)),
 	}, nil
 }
 
+// DeleteAccount permanently deletes the authenticated user's account
+func (s *userService) DeleteAccount(ctx context.Context) (bool, error) {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Call the gRPC service with the auth context
+	resp, err := s.client.DeleteAccount(authCtx, &pb.DeleteAccountRequest{})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to delete account", err)
+		return false, err
+	}
+
+	return resp.Success, nil
+}
+
+// MarkSeen records that the authenticated user is active
+func (s *userService) MarkSeen(ctx context.Context) error {
+	// Create context with authorization metadata
+	authCtx := s.createAuthContext(ctx)
+
+	// Presence is best effort, so a failure is only worth a warning
+	if _, err := s.client.MarkSeen(authCtx, &pb.MarkSeenRequest{}); err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to mark user as seen", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// IsTokenRevoked reports whether the token with the given ID has been signed out, or the user it
+// was issued to has deleted their account
+func (s *userService) IsTokenRevoked(ctx context.Context, jti, userID string) (bool, error) {
+	resp, err := s.client.IsTokenRevoked(ctx, &pb.IsTokenRevokedRequest{Jti: jti, UserId: userID})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check token revocation", err)
+		return false, err
+	}
+
+	return resp.Revoked, nil
+}
+
 // GoogleLogin generates a Google OAuth URL with state token
-func (s *userService) GoogleLogin() (string, error) {
+func (s *userService) GoogleLogin(ctx context.Context) (string, error) {
 	// Create context with authorization metadata
-	authCtx := s.createAuthContext(context.Background())
+	authCtx := s.createAuthContext(ctx)
 
 	// Call the gRPC service with the auth context
 	resp, err := s.client.GoogleLogin(authCtx, &pb.GoogleLoginRequest{
 		RedirectUrl: s.googleConfig.RedirectURL,
 	})
 	if err != nil {
-		s.logger.Error("Failed to generate Google OAuth URL", err)
+		s.logger.WithContext(ctx).Error("Failed to generate Google OAuth URL", err)
 		return "", err
 	}
 
 	// Store the state token with timestamp
-	s.stateStore[resp.State] = time.Now()
+	s.stateStore.Save(resp.State)
 
 	return resp.Url, nil
 }
 
 // MicrosoftLogin generates a Microsoft OAuth URL with state token
-func (s *userService) MicrosoftLogin() (string, error) {
+func (s *userService) MicrosoftLogin(ctx context.Context) (string, error) {
 	// Create context with authorization metadata
-	authCtx := s.createAuthContext(context.Background())
+	authCtx := s.createAuthContext(ctx)
 
 	// Call the gRPC service with the auth context
 	resp, err := s.client.MicrosoftLogin(authCtx, &pb.MicrosoftLoginRequest{
 		RedirectUrl: s.microsoftConfig.RedirectURL,
 	})
 	if err != nil {
-		s.logger.Error("Failed to generate Microsoft OAuth URL", err)
+		s.logger.WithContext(ctx).Error("Failed to generate Microsoft OAuth URL", err)
 		return "", err
 	}
 
 	// Store the state token with timestamp
-	s.stateStore[resp.State] = time.Now()
+	s.stateStore.Save(resp.State)
 
 	return resp.Url, nil
 }
@@ -274,9 +448,9 @@ func (s *userService) MicrosoftLogin() (string, error) {
 // GoogleCallback handles the callback from Google OAuth
 func (s *userService) GoogleCallback(ctx context.Context, state, code string) (*models.AuthResponse, error) {
 	// Exchange authorization code for token
-	token, err := s.googleConfig.Exchange(context.Background(), code)
+	token, err := s.googleConfig.Exchange(ctx, code)
 	if err != nil {
-		s.logger.Error("Failed to exchange code for token", err)
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
 		return nil, err
 	}
 
@@ -290,7 +464,7 @@ func (s *userService) GoogleCallback(ctx context.Context, state, code string) (*
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to login user", err)
+		s.logger.WithContext(ctx).Error("Failed to login user", err)
 		return nil, err
 	}
 
@@ -303,9 +477,9 @@ func (s *userService) GoogleCallback(ctx context.Context, state, code string) (*
 // MicrosoftCallback handles the callback from Microsoft OAuth
 func (s *userService) MicrosoftCallback(ctx context.Context, state, code string) (*models.AuthResponse, error) {
 	// Exchange authorization code for token
-	token, err := s.microsoftConfig.Exchange(context.Background(), code)
+	token, err := s.microsoftConfig.Exchange(ctx, code)
 	if err != nil {
-		s.logger.Error("Failed to exchange code for token", err)
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
 		return nil, err
 	}
 
@@ -319,7 +493,7 @@ func (s *userService) MicrosoftCallback(ctx context.Context, state, code string)
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to login user", err)
+		s.logger.WithContext(ctx).Error("Failed to login user", err)
 		return nil, err
 	}
 
@@ -330,9 +504,9 @@ func (s *userService) MicrosoftCallback(ctx context.Context, state, code string)
 }
 
 // ValidateStateToken validates the state token to prevent CSRF attacks
-func (s *userService) ValidateStateToken(state string) bool {
+func (s *userService) ValidateStateToken(ctx context.Context, state string) bool {
 	// Create context with authorization metadata
-	authCtx := s.createAuthContext(context.Background())
+	authCtx := s.createAuthContext(ctx)
 
 	// Call the gRPC service with the auth context
 	resp, err := s.client.ValidateStateToken(authCtx, &pb.ValidateStateTokenRequest{
@@ -340,7 +514,7 @@ func (s *userService) ValidateStateToken(state string) bool {
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to validate state token", err)
+		s.logger.WithContext(ctx).Error("Failed to validate state token", err)
 		return false
 	}
 
@@ -358,7 +532,7 @@ func (s *userService) Signout(ctx context.Context, token string) (bool, error) {
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to sign out user", err)
+		s.logger.WithContext(ctx).Error("Failed to sign out user", err)
 		return false, err
 	}
 
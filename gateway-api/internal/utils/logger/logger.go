@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -8,6 +9,9 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// ContextKey is the context key the request-scoped logger is stored under
+const ContextKey = "logger"
+
 // Logger is a wrapper around zap.Logger
 type Logger struct {
 	*zap.Logger
@@ -67,6 +71,15 @@ func (l *Logger) With(fields ...zap.Field) *Logger {
 	return &Logger{l.Logger.With(fields...)}
 }
 
+// WithContext returns the request-scoped logger carried by ctx, which the middleware tags with
+// the request ID, route and user ID, or l itself outside of a request
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	if scoped, ok := ctx.Value(ContextKey).(*Logger); ok {
+		return scoped
+	}
+	return l
+}
+
 // Sync flushes any buffered log entries
 func (l *Logger) Sync() error {
 	return l.Logger.Sync()
@@ -0,0 +1 @@
+ALTER TABLE `groups` ADD COLUMN visibility ENUM('public', 'private') NOT NULL DEFAULT 'public' AFTER creator_id;
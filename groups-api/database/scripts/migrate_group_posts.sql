@@ -0,0 +1,59 @@
+-- Copies group posts, their media, likes and comments from groups_db into the posts,
+-- likes and comments tables of posts_db, so that group posts become regular posts with
+-- a group_id. Ids are kept, and rows that already exist in posts_db are skipped, so the
+-- script can be run again to pick up group posts created while the services are rolled out.
+--
+-- Author names and avatars are denormalized in posts_db, so they are read from users_db.
+-- Adjust the database names if they differ from the defaults in config.yaml.
+
+START TRANSACTION;
+
+INSERT IGNORE INTO posts_db.posts
+    (id, author_id, author_name, author_avatar, content, visibility, group_id, group_name,
+     media, likes_count, comments_count, created_at, updated_at)
+SELECT
+    gp.id,
+    gp.author_id,
+    COALESCE(u.name, ''),
+    u.avatar,
+    gp.content,
+    'public',
+    gp.group_id,
+    g.name,
+    COALESCE((
+        SELECT CAST(JSON_ARRAYAGG(m.media_url) AS CHAR)
+        FROM groups_db.group_post_media m
+        WHERE m.post_id = gp.id AND m.deleted_at IS NULL
+    ), ''),
+    (
+        SELECT COUNT(*)
+        FROM groups_db.group_post_likes l
+        WHERE l.post_id = gp.id AND l.deleted_at IS NULL
+    ),
+    (
+        SELECT COUNT(*)
+        FROM groups_db.group_post_comments c
+        WHERE c.post_id = gp.id AND c.deleted_at IS NULL
+    ),
+    gp.created_at,
+    gp.updated_at
+FROM groups_db.group_posts gp
+JOIN groups_db.`groups` g ON g.id = gp.group_id
+LEFT JOIN users_db.users u ON u.id = gp.author_id
+WHERE gp.deleted_at IS NULL;
+
+INSERT IGNORE INTO posts_db.likes (id, post_id, user_id, reaction_type, created_at)
+SELECT l.id, l.post_id, l.user_id, 'like', l.created_at
+FROM groups_db.group_post_likes l
+JOIN posts_db.posts p ON p.id = l.post_id
+WHERE l.deleted_at IS NULL;
+
+INSERT IGNORE INTO posts_db.comments
+    (id, post_id, depth, author_id, author_name, author_avatar, content, created_at, updated_at)
+SELECT c.id, c.post_id, 0, c.user_id, COALESCE(u.name, ''), u.avatar, c.content, c.created_at, c.updated_at
+FROM groups_db.group_post_comments c
+JOIN posts_db.posts p ON p.id = c.post_id
+LEFT JOIN users_db.users u ON u.id = c.user_id
+WHERE c.deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,58 @@
+package clients
+
+import (
+	npb "common/pb/common/proto/notifications"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// Types of notification sent by this service
+const (
+	NotificationGroupJoinApproved = "group_join_approved"
+)
+
+// NotificationClient defines the interface for notifying users through the notification service
+type NotificationClient interface {
+	// Notify tells a user that the caller of the current request did something that concerns them
+	Notify(ctx context.Context, userID, notificationType, targetID string) error
+}
+
+// notificationClient implements the NotificationClient interface over gRPC
+type notificationClient struct {
+	client npb.NotificationServiceClient
+}
+
+// NewNotificationClient creates a new client for the notification service, which the users service serves
+func NewNotificationClient(usersServiceURL string) (NotificationClient, error) {
+	conn, err := grpc.Dial(usersServiceURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(propagateRequestID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationClient{
+		client: npb.NewNotificationServiceClient(conn),
+	}, nil
+}
+
+// Notify tells a user that the caller of the current request did something that concerns them.
+// The caller's token is forwarded, as the notification service takes the actor from it.
+func (c *notificationClient) Notify(ctx context.Context, userID, notificationType, targetID string) error {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auth := md.Get("authorization"); len(auth) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth[0])
+		}
+	}
+
+	_, err := c.client.CreateNotification(ctx, &npb.CreateNotificationRequest{
+		UserId:   userID,
+		Type:     notificationType,
+		TargetId: targetID,
+	})
+	return err
+}
\ No newline at end of file
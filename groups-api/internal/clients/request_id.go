@@ -0,0 +1,17 @@
+package clients
+
+import (
+	"context"
+	"groups-api/internal/utils/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// propagateRequestID forwards the request ID of the call being served to downstream services
+func propagateRequestID(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if requestID, ok := ctx.Value(logger.RequestIDKey).(string); ok && requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", requestID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
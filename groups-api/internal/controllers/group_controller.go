@@ -3,6 +3,8 @@ package controllers
 import (
 	pb "common/pb/common/proto/groups"
 	"context"
+	"groups-api/internal/clients"
+	"groups-api/internal/models"
 	"groups-api/internal/services"
 	"groups-api/internal/utils/errors"
 	"groups-api/internal/utils/logger"
@@ -15,13 +17,15 @@ import (
 type GroupController struct {
 	pb.UnimplementedGroupServiceServer
 	service services.GroupService
+	users   clients.UserClient
 	logger  *logger.Logger
 }
 
 // NewGroupController creates a new group controller
-func NewGroupController(service services.GroupService, logger *logger.Logger) *GroupController {
+func NewGroupController(service services.GroupService, users clients.UserClient, logger *logger.Logger) *GroupController {
 	return &GroupController{
 		service: service,
+		users:   users,
 		logger:  logger,
 	}
 }
@@ -31,7 +35,7 @@ func (c *GroupController) CreateGroup(ctx context.Context, req *pb.CreateGroupRe
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
@@ -41,76 +45,70 @@ func (c *GroupController) CreateGroup(ctx context.Context, req *pb.CreateGroupRe
 	}
 
 	// Create group
-	group, err := c.service.CreateGroup(ctx, userID, req.Name, req.Description, req.Avatar)
+	group, err := c.service.CreateGroup(ctx, userID, req.Name, req.Description, req.Avatar, req.Visibility)
 	if err != nil {
-		c.logger.Error("Failed to create group", err)
-		return nil, status.Error(codes.Internal, "failed to create group")
+		c.logger.WithContext(ctx).Error("Failed to create group", err)
+		return nil, statusError(err, "failed to create group")
 	}
 
 	// Create response
+	users := c.lookupUsers(ctx, group.CreatorID)
 	return &pb.GroupResponse{
 		GroupId:      group.ID,
 		Name:         group.Name,
 		Description:  group.Description,
 		Avatar:       group.Avatar,
 		CreatorId:    group.CreatorID,
-		CreatorName:  "", // Would need to fetch from users service
-		MembersCount: 1,  // Creator is the only member initially
-		PostsCount:   0,  // No posts initially
+		CreatorName:  userName(users, group.CreatorID),
+		MembersCount: 1, // Creator is the only member initially
+		PostsCount:   0, // No posts initially
 		IsMember:     true,
 		CreatedAt:    group.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:    group.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Visibility:   group.Visibility,
 	}, nil
 }
 
 // GetGroup retrieves a group by ID
 func (c *GroupController) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GroupResponse, error) {
-	// Get user ID from context or request
-	userID := req.UserId
-	if userID == "" {
-		if id, ok := ctx.Value("userID").(string); ok {
-			userID = id
-		}
-	}
+	// Get user ID from context; anonymous callers have none
+	userID, _ := ctx.Value("userID").(string)
 
 	// Get group
 	group, membersCount, postsCount, isMember, err := c.service.GetGroup(ctx, req.GroupId, userID)
 	if err != nil {
-		c.logger.Error("Failed to get group", err)
-		return nil, status.Error(codes.NotFound, "group not found")
+		c.logger.WithContext(ctx).Error("Failed to get group", err)
+		return nil, statusError(err, "failed to get group")
 	}
 
 	// Create response
+	users := c.lookupUsers(ctx, group.CreatorID)
 	return &pb.GroupResponse{
 		GroupId:      group.ID,
 		Name:         group.Name,
 		Description:  group.Description,
 		Avatar:       group.Avatar,
 		CreatorId:    group.CreatorID,
-		CreatorName:  "", // Would need to fetch from users service
+		CreatorName:  userName(users, group.CreatorID),
 		MembersCount: membersCount,
 		PostsCount:   postsCount,
 		IsMember:     isMember,
 		CreatedAt:    group.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:    group.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Visibility:   group.Visibility,
 	}, nil
 }
 
 // GetGroups retrieves groups with pagination and filtering
 func (c *GroupController) GetGroups(ctx context.Context, req *pb.GetGroupsRequest) (*pb.GetGroupsResponse, error) {
-	// Get user ID from context or request
-	userID := req.UserId
-	if userID == "" {
-		if id, ok := ctx.Value("userID").(string); ok {
-			userID = id
-		}
-	}
+	// Get user ID from context; anonymous callers have none
+	userID, _ := ctx.Value("userID").(string)
 
 	// Get groups
-	groups, totalCount, totalPages, err := c.service.GetGroups(ctx, userID, req.Query, int(req.Page), int(req.Limit))
+	groups, totalCount, totalPages, err := c.service.GetGroups(ctx, userID, req.Query, req.Sort, int(req.Page), int(req.Limit))
 	if err != nil {
-		c.logger.Error("Failed to get groups", err)
-		return nil, status.Error(codes.Internal, "failed to get groups")
+		c.logger.WithContext(ctx).Error("Failed to get groups", err)
+		return nil, statusError(err, "failed to get groups")
 	}
 
 	// Create response
@@ -121,69 +119,117 @@ func (c *GroupController) GetGroups(ctx context.Context, req *pb.GetGroupsReques
 		TotalPages: totalPages,
 	}
 
+	// Look up all creators in one call
+	creatorIDs := make([]string, len(groups))
+	for i, group := range groups {
+		creatorIDs[i] = group.CreatorID
+	}
+	users := c.lookupUsers(ctx, creatorIDs...)
+
 	// Add groups to response
 	for _, group := range groups {
-		// Get group details
-		groupDetails, membersCount, postsCount, isMember, err := c.service.GetGroup(ctx, group.ID, userID)
-		if err != nil {
-			c.logger.Error("Failed to get group details", err)
-			continue
-		}
+		response.Groups = append(response.Groups, convertGroupWithCounts(group, users))
+	}
+
+	return response, nil
+}
+
+// GetUserGroups retrieves the groups the user is a member of
+func (c *GroupController) GetUserGroups(ctx context.Context, req *pb.GetUserGroupsRequest) (*pb.GetGroupsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Get groups
+	groups, totalCount, totalPages, err := c.service.GetUserGroups(ctx, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get user groups", err)
+		return nil, statusError(err, "failed to get user groups")
+	}
 
-		response.Groups = append(response.Groups, &pb.GroupResponse{
-			GroupId:      groupDetails.ID,
-			Name:         groupDetails.Name,
-			Description:  groupDetails.Description,
-			Avatar:       groupDetails.Avatar,
-			CreatorId:    groupDetails.CreatorID,
-			CreatorName:  "", // Would need to fetch from users service
-			MembersCount: membersCount,
-			PostsCount:   postsCount,
-			IsMember:     isMember,
-			CreatedAt:    groupDetails.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:    groupDetails.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		})
+	// Create response
+	response := &pb.GetGroupsResponse{
+		Groups:     make([]*pb.GroupResponse, 0, len(groups)),
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+	}
+
+	// Look up all creators in one call
+	creatorIDs := make([]string, len(groups))
+	for i, group := range groups {
+		creatorIDs[i] = group.CreatorID
+	}
+	users := c.lookupUsers(ctx, creatorIDs...)
+
+	// Add groups to response
+	for _, group := range groups {
+		response.Groups = append(response.Groups, convertGroupWithCounts(group, users))
 	}
 
 	return response, nil
 }
 
+// convertGroupWithCounts converts a listed group to its response, taking the creator's name from users
+func convertGroupWithCounts(group *models.GroupWithCounts, users map[string]*clients.UserInfo) *pb.GroupResponse {
+	return &pb.GroupResponse{
+		GroupId:      group.ID,
+		Name:         group.Name,
+		Description:  group.Description,
+		Avatar:       group.Avatar,
+		CreatorId:    group.CreatorID,
+		CreatorName:  userName(users, group.CreatorID),
+		MembersCount: int32(group.MembersCount),
+		PostsCount:   int32(group.PostsCount),
+		IsMember:     group.IsMember,
+		CreatedAt:    group.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    group.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Visibility:   group.Visibility,
+		Role:         group.Role,
+	}
+}
+
 // UpdateGroup updates a group
 func (c *GroupController) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*pb.GroupResponse, error) {
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Update group
-	group, err := c.service.UpdateGroup(ctx, req.GroupId, userID, req.Name, req.Description, req.Avatar)
+	group, err := c.service.UpdateGroup(ctx, req.GroupId, userID, req.Name, req.Description, req.Avatar, req.Visibility)
 	if err != nil {
-		c.logger.Error("Failed to update group", err)
-		return nil, status.Error(codes.Internal, "failed to update group")
+		c.logger.WithContext(ctx).Error("Failed to update group", err)
+		return nil, statusError(err, "failed to update group")
 	}
 
 	// Get group details
 	groupDetails, membersCount, postsCount, isMember, err := c.service.GetGroup(ctx, group.ID, userID)
 	if err != nil {
-		c.logger.Error("Failed to get group details", err)
-		return nil, status.Error(codes.Internal, "failed to get group details")
+		c.logger.WithContext(ctx).Error("Failed to get group details", err)
+		return nil, statusError(err, "failed to get group details")
 	}
 
 	// Create response
+	users := c.lookupUsers(ctx, groupDetails.CreatorID)
 	return &pb.GroupResponse{
 		GroupId:      groupDetails.ID,
 		Name:         groupDetails.Name,
 		Description:  groupDetails.Description,
 		Avatar:       groupDetails.Avatar,
 		CreatorId:    groupDetails.CreatorID,
-		CreatorName:  "", // Would need to fetch from users service
+		CreatorName:  userName(users, groupDetails.CreatorID),
 		MembersCount: membersCount,
 		PostsCount:   postsCount,
 		IsMember:     isMember,
 		CreatedAt:    groupDetails.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:    groupDetails.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Visibility:   groupDetails.Visibility,
 	}, nil
 }
 
@@ -192,15 +238,15 @@ func (c *GroupController) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRe
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Delete group
 	err := c.service.DeleteGroup(ctx, req.GroupId, userID)
 	if err != nil {
-		c.logger.Error("Failed to delete group", err)
-		return nil, status.Error(codes.Internal, "failed to delete group")
+		c.logger.WithContext(ctx).Error("Failed to delete group", err)
+		return nil, statusError(err, "failed to delete group")
 	}
 
 	// Create response
@@ -214,21 +260,22 @@ func (c *GroupController) JoinGroup(ctx context.Context, req *pb.JoinGroupReques
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Join group
-	success, membersCount, err := c.service.JoinGroup(ctx, req.GroupId, userID)
+	success, pending, membersCount, err := c.service.JoinGroup(ctx, req.GroupId, userID)
 	if err != nil {
-		c.logger.Error("Failed to join group", err)
-		return nil, status.Error(codes.Internal, "failed to join group")
+		c.logger.WithContext(ctx).Error("Failed to join group", err)
+		return nil, statusError(err, "failed to join group")
 	}
 
 	// Create response
 	return &pb.JoinGroupResponse{
 		Success:      success,
 		MembersCount: membersCount,
+		Pending:      pending,
 	}, nil
 }
 
@@ -237,15 +284,15 @@ func (c *GroupController) LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequ
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Leave group
 	success, membersCount, err := c.service.LeaveGroup(ctx, req.GroupId, userID)
 	if err != nil {
-		c.logger.Error("Failed to leave group", err)
-		return nil, status.Error(codes.Internal, "failed to leave group")
+		c.logger.WithContext(ctx).Error("Failed to leave group", err)
+		return nil, statusError(err, "failed to leave group")
 	}
 
 	// Create response
@@ -257,63 +304,442 @@ func (c *GroupController) LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequ
 
 // GetGroupMembers retrieves members of a group
 func (c *GroupController) GetGroupMembers(ctx context.Context, req *pb.GetGroupMembersRequest) (*pb.GetGroupMembersResponse, error) {
+	return c.membersPage(ctx, req.GroupId, req.Page, req.Limit)
+}
+
+// Page sizes of StreamGroupMembers
+const (
+	defaultStreamPageSize = 100
+	maxStreamPageSize     = 500
+)
+
+// StreamGroupMembers streams all members of a group, one page per message. Only members can
+// export the members of a private group. The stream ends after the last page, or early when
+// the client cancels it.
+func (c *GroupController) StreamGroupMembers(req *pb.StreamGroupMembersRequest, stream pb.GroupService_StreamGroupMembersServer) error {
+	ctx := stream.Context()
+
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return errors.ErrUnauthenticated
+	}
+
+	if err := c.service.RequireMembersVisible(ctx, req.GroupId, userID); err != nil {
+		return statusError(err, "failed to get group members")
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultStreamPageSize
+	}
+	if pageSize > maxStreamPageSize {
+		pageSize = maxStreamPageSize
+	}
+
+	for page := int32(1); ; page++ {
+		// Stop loading pages for a client that went away
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		response, err := c.membersPage(ctx, req.GroupId, page, pageSize)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+		if page >= response.TotalPages {
+			return nil
+		}
+	}
+}
+
+// membersPage loads a page of the members of a group along with their profiles
+func (c *GroupController) membersPage(ctx context.Context, groupID string, page, limit int32) (*pb.GetGroupMembersResponse, error) {
 	// Get members
-	members, totalCount, totalPages, err := c.service.GetGroupMembers(ctx, req.GroupId, int(req.Page), int(req.Limit))
+	members, totalCount, totalPages, err := c.service.GetGroupMembers(ctx, groupID, int(page), int(limit))
 	if err != nil {
-		c.logger.Error("Failed to get group members", err)
-		return nil, status.Error(codes.Internal, "failed to get group members")
+		c.logger.WithContext(ctx).Error("Failed to get group members", err)
+		return nil, statusError(err, "failed to get group members")
 	}
 
 	// Create response
 	response := &pb.GetGroupMembersResponse{
 		Members:    make([]*pb.GroupMemberResponse, 0, len(members)),
 		TotalCount: int32(totalCount),
-		Page:       req.Page,
+		Page:       page,
 		TotalPages: totalPages,
 	}
 
+	// Look up all members in one call
+	memberIDs := make([]string, len(members))
+	for i, member := range members {
+		memberIDs[i] = member.UserID
+	}
+	users := c.lookupUsers(ctx, memberIDs...)
+
 	// Add members to response
 	for _, member := range members {
-		response.Members = append(response.Members, &pb.GroupMemberResponse{
-			UserId:   member.UserID,
-			Name:     "", // Would need to fetch from users service
-			Avatar:   "", // Would need to fetch from users service
-			Role:     member.Role,
-			JoinedAt: member.JoinedAt.Format("2006-01-02T15:04:05Z07:00"),
-		})
+		response.Members = append(response.Members, convertMember(member, users))
 	}
 
 	return response, nil
 }
 
+// CheckMembership reports whether a user is a member of a group
+func (c *GroupController) CheckMembership(ctx context.Context, req *pb.CheckMembershipRequest) (*pb.CheckMembershipResponse, error) {
+	// Get membership
+	group, member, err := c.service.CheckMembership(ctx, req.GroupId, req.UserId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to check membership", err)
+		return nil, err
+	}
+
+	// Create response
+	response := &pb.CheckMembershipResponse{
+		IsMember:        member != nil,
+		GroupName:       group.Name,
+		GroupVisibility: group.Visibility,
+	}
+	if member != nil {
+		response.Role = member.Role
+	}
+
+	return response, nil
+}
+
+// PromoteMember makes a group member an admin
+func (c *GroupController) PromoteMember(ctx context.Context, req *pb.ChangeMemberRoleRequest) (*pb.GroupMemberResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Promote member
+	member, err := c.service.PromoteMember(ctx, req.GroupId, userID, req.MemberId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to promote member", err)
+		return nil, err
+	}
+
+	return convertMember(member, c.lookupUsers(ctx, member.UserID)), nil
+}
+
+// DemoteMember makes a group admin a regular member
+func (c *GroupController) DemoteMember(ctx context.Context, req *pb.ChangeMemberRoleRequest) (*pb.GroupMemberResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Demote member
+	member, err := c.service.DemoteMember(ctx, req.GroupId, userID, req.MemberId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to demote member", err)
+		return nil, err
+	}
+
+	return convertMember(member, c.lookupUsers(ctx, member.UserID)), nil
+}
+
+// TransferOwnership makes another member the creator of a group
+func (c *GroupController) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.GroupMemberResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Transfer ownership
+	member, err := c.service.TransferOwnership(ctx, req.GroupId, userID, req.NewCreatorId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to transfer group ownership", err)
+		return nil, statusError(err, "failed to transfer group ownership")
+	}
+
+	return convertMember(member, c.lookupUsers(ctx, member.UserID)), nil
+}
+
+// RemoveMember removes another member from a group
+func (c *GroupController) RemoveMember(ctx context.Context, req *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Remove member
+	membersCount, err := c.service.RemoveMember(ctx, req.GroupId, userID, req.MemberId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to remove member", err)
+		return nil, err
+	}
+
+	// Create response
+	return &pb.RemoveMemberResponse{
+		Success:       true,
+		MembersCount:  membersCount,
+		PostsRetained: true,
+	}, nil
+}
+
+// GetJoinRequests retrieves the pending join requests of a group
+func (c *GroupController) GetJoinRequests(ctx context.Context, req *pb.GetJoinRequestsRequest) (*pb.GetJoinRequestsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Get join requests
+	requests, totalCount, totalPages, err := c.service.GetJoinRequests(ctx, req.GroupId, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get join requests", err)
+		return nil, err
+	}
+
+	// Create response
+	response := &pb.GetJoinRequestsResponse{
+		Requests:   make([]*pb.JoinRequestResponse, 0, len(requests)),
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+	}
+
+	// Add requests to response
+	for _, request := range requests {
+		response.Requests = append(response.Requests, convertJoinRequest(request))
+	}
+
+	return response, nil
+}
+
+// ApproveJoinRequest accepts a join request and adds the requester to the group
+func (c *GroupController) ApproveJoinRequest(ctx context.Context, req *pb.ReviewJoinRequestRequest) (*pb.JoinRequestResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Approve request
+	request, err := c.service.ApproveJoinRequest(ctx, req.GroupId, userID, req.RequestId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to approve join request", err)
+		return nil, err
+	}
+
+	return convertJoinRequest(request), nil
+}
+
+// RejectJoinRequest declines a join request
+func (c *GroupController) RejectJoinRequest(ctx context.Context, req *pb.ReviewJoinRequestRequest) (*pb.JoinRequestResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Reject request
+	request, err := c.service.RejectJoinRequest(ctx, req.GroupId, userID, req.RequestId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to reject join request", err)
+		return nil, err
+	}
+
+	return convertJoinRequest(request), nil
+}
+
+// convertJoinRequest converts a group join request model to a gRPC response
+func convertJoinRequest(request *models.GroupJoinRequest) *pb.JoinRequestResponse {
+	response := &pb.JoinRequestResponse{
+		RequestId:  request.ID,
+		GroupId:    request.GroupID,
+		UserId:     request.UserID,
+		Status:     request.Status,
+		ReviewedBy: request.ReviewedBy,
+		CreatedAt:  request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if request.ReviewedAt != nil {
+		response.ReviewedAt = request.ReviewedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
+}
+
+// convertMember converts a group member model to a gRPC response
+func convertMember(member *models.GroupMember, users map[string]*clients.UserInfo) *pb.GroupMemberResponse {
+	response := &pb.GroupMemberResponse{
+		UserId:   member.UserID,
+		Role:     member.Role,
+		JoinedAt: member.JoinedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if user, ok := users[member.UserID]; ok {
+		response.Name = user.Name
+		response.Avatar = user.Avatar
+	}
+	return response
+}
+
+// lookupUsers resolves the given user IDs with a single call to the users service.
+// Repeated IDs are only requested once, so the returned map doubles as the cache
+// for the rest of the request. A lookup failure is logged and leaves names empty.
+func (c *GroupController) lookupUsers(ctx context.Context, userIDs ...string) map[string]*clients.UserInfo {
+	seen := make(map[string]bool, len(userIDs))
+	unique := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	users, err := c.users.GetUsersByIDs(ctx, unique)
+	if err != nil {
+		c.logger.WithContext(ctx).Warn("Failed to look up users", logger.Field("error", err.Error()))
+		return map[string]*clients.UserInfo{}
+	}
+	return users
+}
+
+// userName returns the name of a looked-up user, or an empty string if unknown
+func userName(users map[string]*clients.UserInfo, userID string) string {
+	if user, ok := users[userID]; ok {
+		return user.Name
+	}
+	return ""
+}
+
+// userAvatar returns the avatar of a looked-up user, or an empty string if unknown
+func userAvatar(users map[string]*clients.UserInfo, userID string) string {
+	if user, ok := users[userID]; ok {
+		return user.Avatar
+	}
+	return ""
+}
+
 // CreateGroupPost creates a post in a group
 func (c *GroupController) CreateGroupPost(ctx context.Context, req *pb.CreateGroupPostRequest) (*pb.GroupPostResponse, error) {
 	// Get user ID from context
 	userID, ok := ctx.Value("userID").(string)
 	if !ok {
-		c.logger.Error("Failed to get user ID from context", nil)
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
 		return nil, errors.ErrUnauthenticated
 	}
 
 	// Create post
 	post, err := c.service.CreateGroupPost(ctx, req.GroupId, userID, req.Content, req.Media)
 	if err != nil {
-		c.logger.Error("Failed to create group post", err)
-		return nil, status.Error(codes.Internal, "failed to create group post")
+		c.logger.WithContext(ctx).Error("Failed to create group post", err)
+		return nil, statusError(err, "failed to create group post")
 	}
 
 	// Create response
+	return convertGroupPost(post, c.lookupUsers(ctx, post.AuthorID)), nil
+}
+
+// GetGroupPosts retrieves posts in a group
+func (c *GroupController) GetGroupPosts(ctx context.Context, req *pb.GetGroupPostsRequest) (*pb.GetGroupPostsResponse, error) {
+	// Get user ID from context, never from the request, so that the request cannot claim
+	// someone else's private group membership
+	userID, _ := ctx.Value("userID").(string)
+
+	// Get posts
+	posts, totalCount, totalPages, err := c.service.GetGroupPosts(ctx, req.GroupId, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get group posts", err)
+		return nil, statusError(err, "failed to get group posts")
+	}
+
+	// Create response
+	response := &pb.GetGroupPostsResponse{
+		Posts:      make([]*pb.GroupPostResponse, 0, len(posts)),
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+	}
+
+	// Look up all authors in one call
+	authorIDs := make([]string, len(posts))
+	for i, post := range posts {
+		authorIDs[i] = post.AuthorID
+	}
+	users := c.lookupUsers(ctx, authorIDs...)
+
+	// Add posts to response
+	for _, post := range posts {
+		response.Posts = append(response.Posts, convertGroupPost(post, users))
+	}
+
+	return response, nil
+}
+
+// UpdateGroupPost edits a post in a group
+func (c *GroupController) UpdateGroupPost(ctx context.Context, req *pb.UpdateGroupPostRequest) (*pb.GroupPostResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Update post
+	post, err := c.service.UpdateGroupPost(ctx, req.GroupId, req.PostId, userID, req.Content, req.Media)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to update group post", err)
+		return nil, statusError(err, "failed to update group post")
+	}
+
+	// Create response
+	return convertGroupPost(post, c.lookupUsers(ctx, post.AuthorID)), nil
+}
+
+// DeleteGroupPost deletes a post in a group
+func (c *GroupController) DeleteGroupPost(ctx context.Context, req *pb.DeleteGroupPostRequest) (*pb.DeleteGroupPostResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Delete post
+	err := c.service.DeleteGroupPost(ctx, req.GroupId, req.PostId, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to delete group post", err)
+		return nil, statusError(err, "failed to delete group post")
+	}
+
+	// Create response
+	return &pb.DeleteGroupPostResponse{
+		Success: true,
+	}, nil
+}
+
+// convertGroupPost converts a group post model to a response, with the author looked up in users
+func convertGroupPost(post *models.GroupPost, users map[string]*clients.UserInfo) *pb.GroupPostResponse {
 	response := &pb.GroupPostResponse{
 		PostId:        post.ID,
 		GroupId:       post.GroupID,
 		AuthorId:      post.AuthorID,
-		AuthorName:    "", // Would need to fetch from users service
-		AuthorAvatar:  "", // Would need to fetch from users service
+		AuthorName:    userName(users, post.AuthorID),
+		AuthorAvatar:  userAvatar(users, post.AuthorID),
 		Content:       post.Content,
 		Media:         make([]string, 0, len(post.Media)),
-		LikesCount:    int32(len(post.Likes)),
-		CommentsCount: int32(len(post.Comments)),
-		IsLiked:       false, // Would need to check if the user liked the post
+		LikesCount:    int32(post.LikesCount),
+		CommentsCount: int32(post.CommentsCount),
+		IsLiked:       post.IsLiked,
 		CreatedAt:     post.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     post.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -323,66 +749,127 @@ func (c *GroupController) CreateGroupPost(ctx context.Context, req *pb.CreateGro
 		response.Media = append(response.Media, media.MediaURL)
 	}
 
-	return response, nil
+	return response
 }
 
-// GetGroupPosts retrieves posts in a group
-func (c *GroupController) GetGroupPosts(ctx context.Context, req *pb.GetGroupPostsRequest) (*pb.GetGroupPostsResponse, error) {
-	// Get user ID from context or request
-	userID := req.UserId
-	if userID == "" {
-		if id, ok := ctx.Value("userID").(string); ok {
-			userID = id
-		}
+// LikeGroupPost likes a post in a group
+func (c *GroupController) LikeGroupPost(ctx context.Context, req *pb.GroupPostLikeRequest) (*pb.GroupPostLikeResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
 	}
 
-	// Get posts
-	posts, totalCount, totalPages, err := c.service.GetGroupPosts(ctx, req.GroupId, userID, int(req.Page), int(req.Limit))
+	// Like post
+	likesCount, err := c.service.LikeGroupPost(ctx, req.GroupId, req.PostId, userID)
 	if err != nil {
-		c.logger.Error("Failed to get group posts", err)
-		return nil, status.Error(codes.Internal, "failed to get group posts")
+		c.logger.WithContext(ctx).Error("Failed to like group post", err)
+		return nil, statusError(err, "failed to like group post")
+	}
+
+	return &pb.GroupPostLikeResponse{
+		Success:    true,
+		LikesCount: int32(likesCount),
+	}, nil
+}
+
+// UnlikeGroupPost removes the user's like from a post in a group
+func (c *GroupController) UnlikeGroupPost(ctx context.Context, req *pb.GroupPostLikeRequest) (*pb.GroupPostLikeResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Unlike post
+	likesCount, err := c.service.UnlikeGroupPost(ctx, req.GroupId, req.PostId, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to unlike group post", err)
+		return nil, statusError(err, "failed to unlike group post")
+	}
+
+	return &pb.GroupPostLikeResponse{
+		Success:    true,
+		LikesCount: int32(likesCount),
+	}, nil
+}
+
+// AddGroupPostComment adds a comment to a post in a group
+func (c *GroupController) AddGroupPostComment(ctx context.Context, req *pb.AddGroupPostCommentRequest) (*pb.GroupPostCommentResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		c.logger.WithContext(ctx).Error("Failed to get user ID from context", nil)
+		return nil, errors.ErrUnauthenticated
+	}
+
+	// Add comment
+	comment, err := c.service.AddGroupPostComment(ctx, req.GroupId, req.PostId, userID, req.Content)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to add group post comment", err)
+		return nil, statusError(err, "failed to add group post comment")
 	}
 
 	// Create response
-	response := &pb.GetGroupPostsResponse{
-		Posts:      make([]*pb.GroupPostResponse, 0, len(posts)),
+	users := c.lookupUsers(ctx, comment.UserID)
+	return convertGroupPostComment(comment, users), nil
+}
+
+// GetGroupPostComments retrieves the comments on a post in a group
+func (c *GroupController) GetGroupPostComments(ctx context.Context, req *pb.GetGroupPostCommentsRequest) (*pb.GetGroupPostCommentsResponse, error) {
+	// Get user ID from context, never from the request, so that the request cannot claim
+	// someone else's private group membership
+	userID, _ := ctx.Value("userID").(string)
+
+	// Get comments
+	comments, totalCount, totalPages, err := c.service.GetGroupPostComments(ctx, req.GroupId, req.PostId, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get group post comments", err)
+		return nil, statusError(err, "failed to get group post comments")
+	}
+
+	// Look up all authors in one call
+	authorIDs := make([]string, len(comments))
+	for i, comment := range comments {
+		authorIDs[i] = comment.UserID
+	}
+	users := c.lookupUsers(ctx, authorIDs...)
+
+	// Create response
+	response := &pb.GetGroupPostCommentsResponse{
+		Comments:   make([]*pb.GroupPostCommentResponse, 0, len(comments)),
 		TotalCount: int32(totalCount),
 		Page:       req.Page,
 		TotalPages: totalPages,
 	}
+	for _, comment := range comments {
+		response.Comments = append(response.Comments, convertGroupPostComment(comment, users))
+	}
 
-	// Add posts to response
-	for _, post := range posts {
-		postResponse := &pb.GroupPostResponse{
-			PostId:        post.ID,
-			GroupId:       post.GroupID,
-			AuthorId:      post.AuthorID,
-			AuthorName:    "", // Would need to fetch from users service
-			AuthorAvatar:  "", // Would need to fetch from users service
-			Content:       post.Content,
-			Media:         make([]string, 0, len(post.Media)),
-			LikesCount:    int32(len(post.Likes)),
-			CommentsCount: int32(len(post.Comments)),
-			IsLiked:       false, // Would need to check if the user liked the post
-			CreatedAt:     post.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:     post.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		}
-
-		// Add media to response
-		for _, media := range post.Media {
-			postResponse.Media = append(postResponse.Media, media.MediaURL)
-		}
-
-		// Check if the user liked the post
-		for _, like := range post.Likes {
-			if like.UserID == userID {
-				postResponse.IsLiked = true
-				break
-			}
-		}
+	return response, nil
+}
 
-		response.Posts = append(response.Posts, postResponse)
+// convertGroupPostComment converts a group post comment to its gRPC response
+func convertGroupPostComment(comment *models.GroupPostComment, users map[string]*clients.UserInfo) *pb.GroupPostCommentResponse {
+	return &pb.GroupPostCommentResponse{
+		CommentId:    comment.ID,
+		PostId:       comment.PostID,
+		AuthorId:     comment.UserID,
+		AuthorName:   userName(users, comment.UserID),
+		AuthorAvatar: userAvatar(users, comment.UserID),
+		Content:      comment.Content,
+		CreatedAt:    comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    comment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
+}
 
-	return response, nil
+// statusError passes on the gRPC status a service call failed with, so clients learn
+// why it failed. Any other error is hidden behind an internal error with the given message.
+func statusError(err error, message string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, message)
 }
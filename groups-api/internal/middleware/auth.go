@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -27,7 +28,9 @@ func NewAuthInterceptor(jwtSecret string, logger *logger.Logger) *AuthIntercepto
 		jwtSecret: jwtSecret,
 		logger:    logger,
 		publicMethods: map[string]bool{
-			"/groups.GroupService/GetGroups": true,
+			"/grpc.health.v1.Health/Check":         true,
+			"/groups.GroupService/GetGroups":       true,
+			"/groups.GroupService/CheckMembership": true, // Called by other services to authorize group actions
 		},
 	}
 }
@@ -40,8 +43,12 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Skip authentication for public methods
+		// Public methods need no authentication, but identify the caller when a token is sent
 		if i.publicMethods[info.FullMethod] {
+			ctx, err := i.identify(ctx)
+			if err != nil {
+				return nil, err
+			}
 			return handler(ctx, req)
 		}
 
@@ -52,31 +59,117 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		}
 
 		// Add user ID to context
-		ctx = context.WithValue(ctx, "userID", userID)
+		ctx, err = i.withUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
 
 		// Proceed with the request
 		return handler(ctx, req)
 	}
 }
 
+// Stream returns a stream server interceptor for authentication, which authenticates a
+// streaming call once when it starts
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Public methods need no authentication, but identify the caller when a token is sent
+		if i.publicMethods[info.FullMethod] {
+			ctx, err := i.identify(stream.Context())
+			if err != nil {
+				return err
+			}
+			return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		}
+
+		// Authenticate the stream
+		ctx := stream.Context()
+		userID, err := i.authenticate(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Add user ID to the stream's context, as Unary does for a single call
+		ctx, err = i.withUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+
+// identify adds the caller of a public method to the context when the call carries a valid
+// token. Any other call goes on anonymously, without a user ID it could claim in the metadata.
+func (i *AuthInterceptor) identify(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) == 0 || strings.TrimPrefix(values[0], "Bearer ") == "" {
+		return withoutUserID(ctx), nil
+	}
+
+	userID, err := i.authenticate(ctx)
+	if err != nil {
+		return withoutUserID(ctx), nil
+	}
+
+	return i.withUser(ctx, userID)
+}
+
+// withUser adds the authenticated user to the context, and their ID to the entries of the
+// request-scoped logger. The user_id metadata is set to the token's subject as well, and a
+// request naming another user there is refused.
+func (i *AuthInterceptor) withUser(ctx context.Context, userID string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(userIDMetadata); len(values) > 0 && values[0] != userID {
+		return nil, status.Error(codes.PermissionDenied, "user ID does not match the token")
+	}
+	md = md.Copy()
+	md.Set(userIDMetadata, userID)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	ctx = context.WithValue(ctx, "userID", userID)
+	ctx = logger.NewContext(ctx, i.logger.WithContext(ctx).With(logger.Field("user_id", userID)))
+	return ctx, nil
+}
+
+// userIDMetadata is the metadata key the gateway sends the authenticated user's ID in
+const userIDMetadata = "user_id"
+
+// withoutUserID drops the user_id metadata of an unauthenticated call
+func withoutUserID(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(userIDMetadata)) == 0 {
+		return ctx
+	}
+	md = md.Copy()
+	md.Delete(userIDMetadata)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
 // authenticate authenticates the request
 func (i *AuthInterceptor) authenticate(ctx context.Context) (string, error) {
 	// Get metadata from context
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
+		return "", unauthenticated(reasonTokenMissing, "metadata is not provided")
 	}
 
 	// Get authorization header
 	values := md["authorization"]
 	if len(values) == 0 {
-		return "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+		return "", unauthenticated(reasonTokenMissing, "authorization token is not provided")
 	}
 
 	// Extract token from authorization header
 	authHeader := values[0]
 	if !strings.HasPrefix(authHeader, "Bearer ") {
-		return "", status.Errorf(codes.Unauthenticated, "invalid authorization format")
+		return "", unauthenticated(reasonTokenInvalid, "invalid authorization format")
 	}
 	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
@@ -89,29 +182,56 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (string, error) {
 	})
 	if err != nil {
 		i.logger.Error("Failed to parse token", err)
-		return "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		return "", unauthenticated(tokenErrorReason(err), "invalid token: "+err.Error())
 	}
 
 	// Get claims from token
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid {
-		return "", status.Errorf(codes.Unauthenticated, "invalid token claims")
+		return "", unauthenticated(reasonTokenInvalid, "invalid token claims")
 	}
 
 	// Check token expiration
 	exp, ok := claims["exp"].(float64)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "invalid token expiration")
+		return "", unauthenticated(reasonTokenInvalid, "invalid token expiration")
 	}
 	if time.Now().Unix() > int64(exp) {
-		return "", status.Errorf(codes.Unauthenticated, "token expired")
+		return "", unauthenticated(reasonTokenExpired, "token expired")
 	}
 
 	// Get user ID from claims
 	userID, ok := claims["sub"].(string)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "invalid user ID in token")
+		return "", unauthenticated(reasonTokenInvalid, "invalid user ID in token")
 	}
 
 	return userID, nil
-}
\ No newline at end of file
+}
+
+// Reasons attached to authentication failures, so that clients can tell an expired token,
+// which signing in again fixes, from a missing or malformed one
+const (
+	authErrorDomain    = "auth"
+	reasonTokenMissing = "TOKEN_MISSING"
+	reasonTokenInvalid = "TOKEN_INVALID"
+	reasonTokenExpired = "TOKEN_EXPIRED"
+)
+
+// unauthenticated returns an Unauthenticated status carrying the reason in an ErrorInfo detail
+func unauthenticated(reason, msg string) error {
+	st := status.New(codes.Unauthenticated, msg)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: authErrorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// tokenErrorReason tells a token rejected for its exp claim apart from an otherwise invalid one
+func tokenErrorReason(err error) string {
+	var validationErr *jwt.ValidationError
+	if errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+		return reasonTokenExpired
+	}
+	return reasonTokenInvalid
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"groups-api/internal/utils/logger"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDInterceptor is a gRPC interceptor that carries the gateway's request ID into every call
+type RequestIDInterceptor struct {
+	logger *logger.Logger
+}
+
+// NewRequestIDInterceptor creates a new request ID interceptor
+func NewRequestIDInterceptor(logger *logger.Logger) *RequestIDInterceptor {
+	return &RequestIDInterceptor{
+		logger: logger,
+	}
+}
+
+// Unary returns a unary server interceptor that stores the x-request-id metadata in the context,
+// generating one for callers that don't send it, together with a logger scoped to the call
+func (i *RequestIDInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		requestID := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-request-id"); len(values) > 0 {
+				requestID = values[0]
+			}
+		}
+		if requestID == "" {
+			requestID = generateRequestID()
+		}
+
+		ctx = context.WithValue(ctx, logger.RequestIDKey, requestID)
+		ctx = logger.NewContext(ctx, i.logger.With(
+			zap.String("request_id", requestID),
+			zap.String("method", info.FullMethod),
+		))
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		i.logger.WithContext(ctx).Debug("Handled request",
+			zap.String("code", status.Code(err).String()),
+			zap.Duration("duration", time.Since(start)),
+		)
+
+		return resp, err
+	}
+}
+
+// Stream returns a stream server interceptor that does for a streaming call what Unary does
+// for a single one
+func (i *RequestIDInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := stream.Context()
+		requestID := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-request-id"); len(values) > 0 {
+				requestID = values[0]
+			}
+		}
+		if requestID == "" {
+			requestID = generateRequestID()
+		}
+
+		ctx = context.WithValue(ctx, logger.RequestIDKey, requestID)
+		ctx = logger.NewContext(ctx, i.logger.With(
+			zap.String("request_id", requestID),
+			zap.String("method", info.FullMethod),
+		))
+
+		start := time.Now()
+		err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		i.logger.WithContext(ctx).Debug("Handled stream",
+			zap.String("code", status.Code(err).String()),
+			zap.Duration("duration", time.Since(start)),
+		)
+
+		return err
+	}
+}
+
+// contextStream is a server stream whose context carries the values added by the interceptors
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the stream's context with the interceptors' values
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// generateRequestID returns a random 128-bit hex request ID
+func generateRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -13,6 +13,7 @@ type Group struct {
 	Description string         `gorm:"type:text" json:"description"`
 	Avatar      string         `gorm:"type:varchar(255)" json:"avatar"`
 	CreatorID   string         `gorm:"type:varchar(36);not null;index" json:"creator_id"`
+	Visibility  string         `gorm:"type:enum('public','private');default:'public';not null" json:"visibility"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
@@ -31,16 +32,33 @@ func (g *Group) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// Group list sort orders
+const (
+	GroupSortRecent      = "recent"       // Newest first
+	GroupSortOldest      = "oldest"       // Oldest first
+	GroupSortMembersDesc = "members_desc" // Most members first
+	GroupSortNameAsc     = "name_asc"     // Alphabetically by name
+)
+
+// GroupWithCounts is a group together with its aggregate counts, as returned by list queries
+type GroupWithCounts struct {
+	Group
+	MembersCount int64  `json:"members_count"`
+	PostsCount   int64  `json:"posts_count"`
+	IsMember     bool   `gorm:"-" json:"is_member"`
+	Role         string `gorm:"->" json:"role,omitempty"` // Only set when listing a user's own groups
+}
+
 // GroupMember represents a member of a group
 type GroupMember struct {
 	ID        string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
-	GroupID   string         `gorm:"type:varchar(36);not null;index" json:"group_id"`
-	UserID    string         `gorm:"type:varchar(36);not null;index" json:"user_id"`
+	GroupID   string         `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_group_members_group_user" json:"group_id"`
+	UserID    string         `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_group_members_group_user" json:"user_id"`
 	Role      string         `gorm:"type:enum('creator','admin','member');default:'member';not null" json:"role"`
 	JoinedAt  time.Time      `json:"joined_at"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	DeletedAt gorm.DeletedAt `gorm:"index;uniqueIndex:idx_group_members_group_user" json:"-"`
 	Group     *Group         `gorm:"foreignKey:GroupID" json:"-"`
 }
 
@@ -60,6 +78,33 @@ func (gm *GroupMember) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// GroupJoinRequest represents a request to join a private group
+type GroupJoinRequest struct {
+	ID         string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	GroupID    string         `gorm:"type:varchar(36);not null;index" json:"group_id"`
+	UserID     string         `gorm:"type:varchar(36);not null;index" json:"user_id"`
+	Status     string         `gorm:"type:enum('pending','approved','rejected');default:'pending';not null" json:"status"`
+	ReviewedBy string         `gorm:"type:varchar(36)" json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time     `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
+	Group      *Group         `gorm:"foreignKey:GroupID" json:"-"`
+}
+
+// TableName returns the table name for the GroupJoinRequest model
+func (GroupJoinRequest) TableName() string {
+	return "group_join_requests"
+}
+
+// BeforeCreate is a hook that is called before creating a group join request
+func (gjr *GroupJoinRequest) BeforeCreate(tx *gorm.DB) error {
+	if gjr.ID == "" {
+		gjr.ID = generateUUID()
+	}
+	return nil
+}
+
 // GroupPost represents a post in a group
 type GroupPost struct {
 	ID        string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
@@ -73,6 +118,11 @@ type GroupPost struct {
 	Media     []*GroupPostMedia `gorm:"foreignKey:PostID" json:"media,omitempty"`
 	Likes     []*GroupPostLike  `gorm:"foreignKey:PostID" json:"likes,omitempty"`
 	Comments  []*GroupPostComment `gorm:"foreignKey:PostID" json:"comments,omitempty"`
+
+	// Aggregates filled in for responses
+	LikesCount    int64 `gorm:"-" json:"likes_count"`
+	CommentsCount int64 `gorm:"-" json:"comments_count"`
+	IsLiked       bool  `gorm:"-" json:"is_liked"`
 }
 
 // TableName returns the table name for the GroupPost model
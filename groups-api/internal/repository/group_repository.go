@@ -2,17 +2,21 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"groups-api/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GroupRepository defines the interface for group-related database operations
 type GroupRepository interface {
 	// Group operations
-	CreateGroup(ctx context.Context, group *models.Group) error
+	CreateGroup(ctx context.Context, group *models.Group, creator *models.GroupMember) error
 	GetGroupByID(ctx context.Context, id string) (*models.Group, error)
 	GetGroups(ctx context.Context, query string, page, limit int) ([]*models.Group, int64, error)
+	GetGroupsWithCounts(ctx context.Context, query, sort string, page, limit int) ([]*models.GroupWithCounts, int64, error)
+	GetUserGroupsWithCounts(ctx context.Context, userID string, page, limit int) ([]*models.GroupWithCounts, int64, error)
 	UpdateGroup(ctx context.Context, group *models.Group) error
 	DeleteGroup(ctx context.Context, id string) error
 
@@ -20,20 +24,33 @@ type GroupRepository interface {
 	AddMember(ctx context.Context, member *models.GroupMember) error
 	RemoveMember(ctx context.Context, groupID, userID string) error
 	GetGroupMembers(ctx context.Context, groupID string, page, limit int) ([]*models.GroupMember, int64, error)
+	CountMembers(ctx context.Context, groupID string) (int64, error)
 	GetMemberByID(ctx context.Context, groupID, userID string) (*models.GroupMember, error)
 	UpdateMember(ctx context.Context, member *models.GroupMember) error
+	TransferOwnership(ctx context.Context, group *models.Group, formerCreator, newCreator *models.GroupMember) error
 	IsMember(ctx context.Context, groupID, userID string) (bool, error)
+	AreMembers(ctx context.Context, groupIDs []string, userID string) (map[string]bool, error)
+
+	// Group join request operations
+	CreateJoinRequest(ctx context.Context, request *models.GroupJoinRequest) error
+	GetJoinRequestByID(ctx context.Context, id string) (*models.GroupJoinRequest, error)
+	GetPendingJoinRequest(ctx context.Context, groupID, userID string) (*models.GroupJoinRequest, error)
+	GetPendingJoinRequests(ctx context.Context, groupID string, page, limit int) ([]*models.GroupJoinRequest, int64, error)
+	UpdateJoinRequest(ctx context.Context, request *models.GroupJoinRequest) error
+	ApproveJoinRequest(ctx context.Context, request *models.GroupJoinRequest, member *models.GroupMember) error
 
 	// Group post operations
 	CreatePost(ctx context.Context, post *models.GroupPost) error
 	GetPostByID(ctx context.Context, id string) (*models.GroupPost, error)
 	GetGroupPosts(ctx context.Context, groupID string, page, limit int) ([]*models.GroupPost, int64, error)
-	UpdatePost(ctx context.Context, post *models.GroupPost) error
+	CountPosts(ctx context.Context, groupID string) (int64, error)
+	UpdatePost(ctx context.Context, post *models.GroupPost, media []*models.GroupPostMedia) error
 	DeletePost(ctx context.Context, id string) error
 
 	// Group post media operations
 	AddPostMedia(ctx context.Context, media *models.GroupPostMedia) error
 	GetPostMedia(ctx context.Context, postID string) ([]*models.GroupPostMedia, error)
+	GetMediaForPosts(ctx context.Context, postIDs []string) (map[string][]*models.GroupPostMedia, error)
 	DeletePostMedia(ctx context.Context, id string) error
 
 	// Group post like operations
@@ -41,6 +58,8 @@ type GroupRepository interface {
 	UnlikePost(ctx context.Context, postID, userID string) error
 	GetPostLikes(ctx context.Context, postID string) ([]*models.GroupPostLike, error)
 	IsPostLiked(ctx context.Context, postID, userID string) (bool, error)
+	ArePostsLiked(ctx context.Context, postIDs []string, userID string) (map[string]bool, error)
+	CountPostLikes(ctx context.Context, postIDs []string) (map[string]int64, error)
 
 	// Group post comment operations
 	CreateComment(ctx context.Context, comment *models.GroupPostComment) error
@@ -48,6 +67,7 @@ type GroupRepository interface {
 	GetPostComments(ctx context.Context, postID string, page, limit int) ([]*models.GroupPostComment, int64, error)
 	UpdateComment(ctx context.Context, comment *models.GroupPostComment) error
 	DeleteComment(ctx context.Context, id string) error
+	CountPostComments(ctx context.Context, postIDs []string) (map[string]int64, error)
 }
 
 // groupRepository implements the GroupRepository interface
@@ -60,9 +80,15 @@ func NewGroupRepository(db *gorm.DB) GroupRepository {
 	return &groupRepository{db: db}
 }
 
-// CreateGroup creates a new group
-func (r *groupRepository) CreateGroup(ctx context.Context, group *models.Group) error {
-	return r.db.WithContext(ctx).Create(group).Error
+// CreateGroup creates a new group and adds its creator as a member in one transaction
+func (r *groupRepository) CreateGroup(ctx context.Context, group *models.Group, creator *models.GroupMember) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(group).Error; err != nil {
+			return err
+		}
+		creator.GroupID = group.ID
+		return tx.Create(creator).Error
+	})
 }
 
 // GetGroupByID gets a group by ID
@@ -99,14 +125,103 @@ func (r *groupRepository) GetGroups(ctx context.Context, query string, page, lim
 	return groups, count, nil
 }
 
+// groupOrders maps the group sort orders to their ORDER BY clauses. The ID breaks ties so that
+// pages do not overlap.
+var groupOrders = map[string]string{
+	models.GroupSortRecent:      "`groups`.created_at DESC, `groups`.id",
+	models.GroupSortOldest:      "`groups`.created_at ASC, `groups`.id",
+	models.GroupSortMembersDesc: "members_count DESC, `groups`.id",
+	models.GroupSortNameAsc:     "`groups`.name ASC, `groups`.id",
+}
+
+// GetGroupsWithCounts gets groups with pagination and filtering, including member and post counts in the same query.
+// An empty sort keeps the storage order.
+func (r *groupRepository) GetGroupsWithCounts(ctx context.Context, query, sort string, page, limit int) ([]*models.GroupWithCounts, int64, error) {
+	var groups []*models.GroupWithCounts
+	var count int64
+
+	db := r.db.WithContext(ctx).Model(&models.Group{})
+	if query != "" {
+		db = db.Where("`groups`.name LIKE ? OR `groups`.description LIKE ?", "%"+query+"%", "%"+query+"%")
+	}
+
+	err := db.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if sort != "" {
+		order, ok := groupOrders[sort]
+		if !ok {
+			return nil, 0, fmt.Errorf("unsupported group sort %q", sort)
+		}
+		db = db.Order(order)
+	}
+
+	offset := (page - 1) * limit
+	err = db.Select("`groups`.*, " +
+		"(SELECT COUNT(*) FROM group_members WHERE group_members.group_id = `groups`.id AND group_members.deleted_at IS NULL) AS members_count, " +
+		"(SELECT COUNT(*) FROM group_posts WHERE group_posts.group_id = `groups`.id AND group_posts.deleted_at IS NULL) AS posts_count").
+		Offset(offset).Limit(limit).Scan(&groups).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, count, nil
+}
+
+// GetUserGroupsWithCounts gets the groups a user is a member of, most recently joined first, with the
+// user's role and the member and post counts of each group
+func (r *groupRepository) GetUserGroupsWithCounts(ctx context.Context, userID string, page, limit int) ([]*models.GroupWithCounts, int64, error) {
+	var groups []*models.GroupWithCounts
+	var count int64
+
+	db := r.db.WithContext(ctx).Model(&models.Group{}).
+		Joins("JOIN group_members ON group_members.group_id = `groups`.id AND group_members.deleted_at IS NULL").
+		Where("group_members.user_id = ?", userID)
+
+	err := db.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err = db.Select("`groups`.*, group_members.role AS role, " +
+		"(SELECT COUNT(*) FROM group_members m WHERE m.group_id = `groups`.id AND m.deleted_at IS NULL) AS members_count, " +
+		"(SELECT COUNT(*) FROM group_posts WHERE group_posts.group_id = `groups`.id AND group_posts.deleted_at IS NULL) AS posts_count").
+		Order("group_members.joined_at DESC, `groups`.id").
+		Offset(offset).Limit(limit).Scan(&groups).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, count, nil
+}
+
 // UpdateGroup updates a group
 func (r *groupRepository) UpdateGroup(ctx context.Context, group *models.Group) error {
 	return r.db.WithContext(ctx).Save(group).Error
 }
 
-// DeleteGroup deletes a group
+// DeleteGroup deletes a group with its members, join requests and posts, including the posts'
+// media, likes and comments, in one transaction. Rows are soft deleted, so the foreign key
+// cascades of the schema never apply and every table has to be cleaned up here.
 func (r *groupRepository) DeleteGroup(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&models.Group{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		postIDs := tx.Model(&models.GroupPost{}).Select("id").Where("group_id = ?", id)
+		for _, model := range []interface{}{&models.GroupPostMedia{}, &models.GroupPostLike{}, &models.GroupPostComment{}} {
+			if err := tx.Where("post_id IN (?)", postIDs).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, model := range []interface{}{&models.GroupPost{}, &models.GroupMember{}, &models.GroupJoinRequest{}} {
+			if err := tx.Where("group_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&models.Group{}, "id = ?", id).Error
+	})
 }
 
 // AddMember adds a member to a group
@@ -119,7 +234,8 @@ func (r *groupRepository) RemoveMember(ctx context.Context, groupID, userID stri
 	return r.db.WithContext(ctx).Where("group_id = ? AND user_id = ?", groupID, userID).Delete(&models.GroupMember{}).Error
 }
 
-// GetGroupMembers gets members of a group with pagination
+// GetGroupMembers gets members of a group with pagination, longest-standing first so that pages
+// do not overlap
 func (r *groupRepository) GetGroupMembers(ctx context.Context, groupID string, page, limit int) ([]*models.GroupMember, int64, error) {
 	var members []*models.GroupMember
 	var count int64
@@ -130,7 +246,7 @@ func (r *groupRepository) GetGroupMembers(ctx context.Context, groupID string, p
 	}
 
 	offset := (page - 1) * limit
-	err = r.db.WithContext(ctx).Where("group_id = ?", groupID).Offset(offset).Limit(limit).Find(&members).Error
+	err = r.db.WithContext(ctx).Where("group_id = ?", groupID).Order("joined_at ASC, id ASC").Offset(offset).Limit(limit).Find(&members).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -138,6 +254,13 @@ func (r *groupRepository) GetGroupMembers(ctx context.Context, groupID string, p
 	return members, count, nil
 }
 
+// CountMembers counts the members of a group
+func (r *groupRepository) CountMembers(ctx context.Context, groupID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.GroupMember{}).Where("group_id = ?", groupID).Count(&count).Error
+	return count, err
+}
+
 // GetMemberByID gets a member by group ID and user ID
 func (r *groupRepository) GetMemberByID(ctx context.Context, groupID, userID string) (*models.GroupMember, error) {
 	var member models.GroupMember
@@ -153,6 +276,20 @@ func (r *groupRepository) UpdateMember(ctx context.Context, member *models.Group
 	return r.db.WithContext(ctx).Save(member).Error
 }
 
+// TransferOwnership saves a group with its new creator together with the changed roles of the
+// former and the new creator in one transaction
+func (r *groupRepository) TransferOwnership(ctx context.Context, group *models.Group, formerCreator, newCreator *models.GroupMember) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(group).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit(clause.Associations).Save(formerCreator).Error; err != nil {
+			return err
+		}
+		return tx.Omit(clause.Associations).Save(newCreator).Error
+	})
+}
+
 // IsMember checks if a user is a member of a group
 func (r *groupRepository) IsMember(ctx context.Context, groupID, userID string) (bool, error) {
 	var count int64
@@ -163,6 +300,88 @@ func (r *groupRepository) IsMember(ctx context.Context, groupID, userID string)
 	return count > 0, nil
 }
 
+// AreMembers checks which of the given groups a user is a member of
+func (r *groupRepository) AreMembers(ctx context.Context, groupIDs []string, userID string) (map[string]bool, error) {
+	memberships := make(map[string]bool, len(groupIDs))
+	if len(groupIDs) == 0 || userID == "" {
+		return memberships, nil
+	}
+
+	var memberGroupIDs []string
+	err := r.db.WithContext(ctx).Model(&models.GroupMember{}).
+		Where("group_id IN ? AND user_id = ?", groupIDs, userID).
+		Pluck("group_id", &memberGroupIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, groupID := range memberGroupIDs {
+		memberships[groupID] = true
+	}
+	return memberships, nil
+}
+
+// CreateJoinRequest creates a new group join request
+func (r *groupRepository) CreateJoinRequest(ctx context.Context, request *models.GroupJoinRequest) error {
+	return r.db.WithContext(ctx).Create(request).Error
+}
+
+// GetJoinRequestByID gets a group join request by ID
+func (r *groupRepository) GetJoinRequestByID(ctx context.Context, id string) (*models.GroupJoinRequest, error) {
+	var request models.GroupJoinRequest
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// GetPendingJoinRequest gets a user's pending request to join a group
+func (r *groupRepository) GetPendingJoinRequest(ctx context.Context, groupID, userID string) (*models.GroupJoinRequest, error) {
+	var request models.GroupJoinRequest
+	err := r.db.WithContext(ctx).Where("group_id = ? AND user_id = ? AND status = ?", groupID, userID, "pending").First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// GetPendingJoinRequests gets the pending join requests of a group with pagination, oldest first
+func (r *groupRepository) GetPendingJoinRequests(ctx context.Context, groupID string, page, limit int) ([]*models.GroupJoinRequest, int64, error) {
+	var requests []*models.GroupJoinRequest
+	var count int64
+
+	db := r.db.WithContext(ctx).Model(&models.GroupJoinRequest{}).Where("group_id = ? AND status = ?", groupID, "pending")
+
+	err := db.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err = db.Order("created_at ASC").Offset(offset).Limit(limit).Find(&requests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, count, nil
+}
+
+// UpdateJoinRequest updates a group join request
+func (r *groupRepository) UpdateJoinRequest(ctx context.Context, request *models.GroupJoinRequest) error {
+	return r.db.WithContext(ctx).Save(request).Error
+}
+
+// ApproveJoinRequest saves an approved join request and adds the new member in one transaction
+func (r *groupRepository) ApproveJoinRequest(ctx context.Context, request *models.GroupJoinRequest, member *models.GroupMember) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(request).Error; err != nil {
+			return err
+		}
+		return tx.Create(member).Error
+	})
+}
+
 // CreatePost creates a new post in a group
 func (r *groupRepository) CreatePost(ctx context.Context, post *models.GroupPost) error {
 	return r.db.WithContext(ctx).Create(post).Error
@@ -197,14 +416,43 @@ func (r *groupRepository) GetGroupPosts(ctx context.Context, groupID string, pag
 	return posts, count, nil
 }
 
-// UpdatePost updates a post
-func (r *groupRepository) UpdatePost(ctx context.Context, post *models.GroupPost) error {
-	return r.db.WithContext(ctx).Save(post).Error
+// CountPosts counts the posts in a group
+func (r *groupRepository) CountPosts(ctx context.Context, groupID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.GroupPost{}).Where("group_id = ?", groupID).Count(&count).Error
+	return count, err
+}
+
+// UpdatePost updates a post, replacing its media with media unless media is nil, in one transaction
+func (r *groupRepository) UpdatePost(ctx context.Context, post *models.GroupPost, media []*models.GroupPostMedia) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(post).Error; err != nil {
+			return err
+		}
+		if media == nil {
+			return nil
+		}
+
+		if err := tx.Where("post_id = ?", post.ID).Delete(&models.GroupPostMedia{}).Error; err != nil {
+			return err
+		}
+		if len(media) == 0 {
+			return nil
+		}
+		return tx.Create(media).Error
+	})
 }
 
-// DeletePost deletes a post
+// DeletePost deletes a post with its media, likes and comments in one transaction
 func (r *groupRepository) DeletePost(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&models.GroupPost{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{&models.GroupPostMedia{}, &models.GroupPostLike{}, &models.GroupPostComment{}} {
+			if err := tx.Where("post_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&models.GroupPost{}, "id = ?", id).Error
+	})
 }
 
 // AddPostMedia adds media to a post
@@ -222,6 +470,25 @@ func (r *groupRepository) GetPostMedia(ctx context.Context, postID string) ([]*m
 	return media, nil
 }
 
+// GetMediaForPosts gets the media of several posts in one query, keyed by post ID
+func (r *groupRepository) GetMediaForPosts(ctx context.Context, postIDs []string) (map[string][]*models.GroupPostMedia, error) {
+	mediaByPost := make(map[string][]*models.GroupPostMedia, len(postIDs))
+	if len(postIDs) == 0 {
+		return mediaByPost, nil
+	}
+
+	var media []*models.GroupPostMedia
+	err := r.db.WithContext(ctx).Where("post_id IN ?", postIDs).Find(&media).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range media {
+		mediaByPost[m.PostID] = append(mediaByPost[m.PostID], m)
+	}
+	return mediaByPost, nil
+}
+
 // DeletePostMedia deletes media from a post
 func (r *groupRepository) DeletePostMedia(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Delete(&models.GroupPostMedia{}, "id = ?", id).Error
@@ -257,6 +524,32 @@ func (r *groupRepository) IsPostLiked(ctx context.Context, postID, userID string
 	return count > 0, nil
 }
 
+// ArePostsLiked checks which of the given posts a user has liked
+func (r *groupRepository) ArePostsLiked(ctx context.Context, postIDs []string, userID string) (map[string]bool, error) {
+	liked := make(map[string]bool, len(postIDs))
+	if len(postIDs) == 0 || userID == "" {
+		return liked, nil
+	}
+
+	var likedPostIDs []string
+	err := r.db.WithContext(ctx).Model(&models.GroupPostLike{}).
+		Where("post_id IN ? AND user_id = ?", postIDs, userID).
+		Pluck("post_id", &likedPostIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, postID := range likedPostIDs {
+		liked[postID] = true
+	}
+	return liked, nil
+}
+
+// CountPostLikes counts the likes of each of the given posts
+func (r *groupRepository) CountPostLikes(ctx context.Context, postIDs []string) (map[string]int64, error) {
+	return r.countByPost(ctx, &models.GroupPostLike{}, postIDs)
+}
+
 // CreateComment creates a new comment on a post
 func (r *groupRepository) CreateComment(ctx context.Context, comment *models.GroupPostComment) error {
 	return r.db.WithContext(ctx).Create(comment).Error
@@ -272,7 +565,7 @@ func (r *groupRepository) GetCommentByID(ctx context.Context, id string) (*model
 	return &comment, nil
 }
 
-// GetPostComments gets comments for a post with pagination
+// GetPostComments gets comments for a post with pagination, oldest first
 func (r *groupRepository) GetPostComments(ctx context.Context, postID string, page, limit int) ([]*models.GroupPostComment, int64, error) {
 	var comments []*models.GroupPostComment
 	var count int64
@@ -283,7 +576,7 @@ func (r *groupRepository) GetPostComments(ctx context.Context, postID string, pa
 	}
 
 	offset := (page - 1) * limit
-	err = r.db.WithContext(ctx).Where("post_id = ?", postID).Offset(offset).Limit(limit).Find(&comments).Error
+	err = r.db.WithContext(ctx).Where("post_id = ?", postID).Order("created_at ASC, id").Offset(offset).Limit(limit).Find(&comments).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -299,4 +592,36 @@ func (r *groupRepository) UpdateComment(ctx context.Context, comment *models.Gro
 // DeleteComment deletes a comment
 func (r *groupRepository) DeleteComment(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Delete(&models.GroupPostComment{}, "id = ?", id).Error
+}
+
+// CountPostComments counts the comments of each of the given posts
+func (r *groupRepository) CountPostComments(ctx context.Context, postIDs []string) (map[string]int64, error) {
+	return r.countByPost(ctx, &models.GroupPostComment{}, postIDs)
+}
+
+// countByPost counts the rows of a per-post table for each of the given posts with a single query.
+// Posts without rows are left out of the map.
+func (r *groupRepository) countByPost(ctx context.Context, model interface{}, postIDs []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		PostID string
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Model(model).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Group("post_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
 }
\ No newline at end of file
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaxPostLength is used when no maximum group post length is configured
+const defaultMaxPostLength = 5000
+
+// defaultMaxCommentLength is used when no maximum group post comment length is configured
+const defaultMaxCommentLength = 2000
+
+// strippedElements are removed together with their content, which is code rather than text
+var strippedElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"iframe":   true,
+	"object":   true,
+	"embed":    true,
+	"noscript": true,
+	"template": true,
+	"svg":      true,
+	"math":     true,
+}
+
+// sanitizeContent strips HTML from user-written text, which is stored and rendered as plain text.
+// Tags, comments and doctypes are dropped, as is the content of elements such as script. The
+// remaining text is kept as written, entities included, so nothing is unescaped into markup.
+func sanitizeContent(content string) string {
+	// Skip the tokenizer for the common case of text without markup
+	if !strings.Contains(content, "<") {
+		return strings.TrimSpace(content)
+	}
+
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(content))
+	skipping, depth := "", 0
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// The reader never fails, so this is the end of the content
+			return strings.TrimSpace(b.String())
+		case html.TextToken:
+			if skipping == "" {
+				b.Write(z.Raw())
+			}
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if skipping == "" && strippedElements[tag] {
+				skipping, depth = tag, 1
+			} else if tag == skipping {
+				depth++
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if string(name) == skipping {
+				depth--
+				if depth == 0 {
+					skipping = ""
+				}
+			}
+		}
+	}
+}
+
+// validateContent sanitizes user-written text and checks its length. Text that is empty once
+// sanitized is only accepted when it is optional.
+func validateContent(content string, maxLength int, required bool) (string, error) {
+	content = sanitizeContent(content)
+	if required && content == "" {
+		return "", status.Error(codes.InvalidArgument, "content is required")
+	}
+	if utf8.RuneCountInString(content) > maxLength {
+		return "", status.Errorf(codes.InvalidArgument, "content must be at most %d characters", maxLength)
+	}
+	return content, nil
+}
\ No newline at end of file
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGroupPostContentIsLimitedAndSanitized(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "public")
+
+	if _, err := s.CreateGroupPost(ctx, group.ID, "alice", strings.Repeat("x", defaultMaxPostLength+1), nil); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateGroupPost over the length limit: got %v, want InvalidArgument", err)
+	}
+
+	post, err := s.CreateGroupPost(ctx, group.ID, "alice", "hello <script>alert('xss')</script>world", nil)
+	if err != nil {
+		t.Fatalf("CreateGroupPost with a script tag: %v", err)
+	}
+	if post.Content != "hello world" {
+		t.Errorf("got content %q, want the script stripped", post.Content)
+	}
+	if _, err := s.CreateGroupPost(ctx, group.ID, "alice", "<script>alert('xss')</script>", nil); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateGroupPost with only a script tag: got %v, want InvalidArgument", err)
+	}
+
+	comment, err := s.AddGroupPostComment(ctx, group.ID, post.ID, "alice", "nice<script>steal()</script>")
+	if err != nil {
+		t.Fatalf("AddGroupPostComment with a script tag: %v", err)
+	}
+	if comment.Content != "nice" {
+		t.Errorf("got comment %q, want the script stripped", comment.Content)
+	}
+	if _, err := s.AddGroupPostComment(ctx, group.ID, post.ID, "alice", strings.Repeat("x", defaultMaxCommentLength+1)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AddGroupPostComment over the length limit: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"groups-api/internal/models"
+	"groups-api/internal/testutil"
+)
+
+func TestGroupPostReadsAreRestrictedToMembersOfPrivateGroups(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	private := s.createGroup(t, "alice", "private", "bob")
+	post := s.createPost(t, private.ID, "alice")
+
+	tests := []struct {
+		name   string
+		userID string
+		want   codes.Code
+	}{
+		{"creator", "alice", codes.OK},
+		{"member", "bob", codes.OK},
+		{"non-member", "mallory", codes.PermissionDenied},
+		{"anonymous", "", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts, _, _, err := s.GetGroupPosts(ctx, private.ID, tt.userID, 1, 10)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("GetGroupPosts: got %v, want %v", err, tt.want)
+			}
+			if tt.want == codes.OK && (len(posts) != 1 || posts[0].ID != post.ID) {
+				t.Errorf("GetGroupPosts: got %d posts, want the group's post", len(posts))
+			}
+
+			_, _, _, err = s.GetGroupPostComments(ctx, private.ID, post.ID, tt.userID, 1, 10)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("GetGroupPostComments: got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupPostReadsOfPublicGroupsAreOpen(t *testing.T) {
+	s := newTestService(t)
+	public := s.createGroup(t, "alice", "public")
+	s.createPost(t, public.ID, "alice")
+
+	for _, userID := range []string{"mallory", ""} {
+		posts, _, _, err := s.GetGroupPosts(context.Background(), public.ID, userID, 1, 10)
+		if err != nil {
+			t.Fatalf("GetGroupPosts as %q: %v", userID, err)
+		}
+		if len(posts) != 1 {
+			t.Errorf("GetGroupPosts as %q: got %d posts, want 1", userID, len(posts))
+		}
+	}
+}
+
+func TestGroupPostReadsOfMissingGroupsAreNotFound(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "public")
+
+	if _, _, _, err := s.GetGroupPosts(ctx, "missing", "alice", 1, 10); status.Code(err) != codes.NotFound {
+		t.Errorf("GetGroupPosts: got %v, want NotFound", err)
+	}
+	if _, _, _, err := s.GetGroupPostComments(ctx, "missing", "post", "alice", 1, 10); status.Code(err) != codes.NotFound {
+		t.Errorf("GetGroupPostComments of a missing group: got %v, want NotFound", err)
+	}
+	if _, _, _, err := s.GetGroupPostComments(ctx, group.ID, "missing", "alice", 1, 10); status.Code(err) != codes.NotFound {
+		t.Errorf("GetGroupPostComments of a missing post: got %v, want NotFound", err)
+	}
+}
+
+// postStats is what a listed post is expected to carry
+type postStats struct {
+	media    []string
+	likes    int64
+	comments int64
+	liked    bool
+}
+
+// fillPosts creates posts in the group, each with a different number of media, likes and
+// comments, and returns what each post should be listed with for alice
+func (s *testService) fillPosts(t *testing.T, groupID string, count int) map[string]postStats {
+	t.Helper()
+
+	ctx := context.Background()
+	likers := []string{"alice", "bob", "carol"}
+	want := make(map[string]postStats, count)
+	for i := 0; i < count; i++ {
+		post := s.createPost(t, groupID, likers[i%len(likers)])
+
+		stats := postStats{}
+		for k := 0; k < i%3; k++ {
+			url := fmt.Sprintf("https://cdn.example.com/%s/%d", post.ID, k)
+			if err := s.repo.AddPostMedia(ctx, &models.GroupPostMedia{PostID: post.ID, MediaURL: url}); err != nil {
+				t.Fatalf("AddPostMedia: %v", err)
+			}
+			stats.media = append(stats.media, url)
+		}
+		for _, liker := range likers[:i%len(likers)] {
+			if _, err := s.LikeGroupPost(ctx, groupID, post.ID, liker); err != nil {
+				t.Fatalf("LikeGroupPost: %v", err)
+			}
+			stats.likes++
+			stats.liked = stats.liked || liker == "alice"
+		}
+		for k := 0; k < i; k++ {
+			if _, err := s.AddGroupPostComment(ctx, groupID, post.ID, "bob", "comment"); err != nil {
+				t.Fatalf("AddGroupPostComment: %v", err)
+			}
+			stats.comments++
+		}
+		want[post.ID] = stats
+	}
+	return want
+}
+
+func TestGroupPostsCarryTheirOwnMediaAndStats(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "public", "bob", "carol")
+	want := s.fillPosts(t, group.ID, 6)
+
+	// Another group's post, with media of its own, is never attached to these posts
+	other := s.createGroup(t, "alice", "public", "bob", "carol")
+	s.fillPosts(t, other.ID, 3)
+
+	listed := 0
+	for page := 1; page <= 2; page++ {
+		posts, count, _, err := s.GetGroupPosts(ctx, group.ID, "alice", page, 3)
+		if err != nil {
+			t.Fatalf("GetGroupPosts page %d: %v", page, err)
+		}
+		if count != 6 {
+			t.Errorf("page %d: got a count of %d, want 6", page, count)
+		}
+		for _, post := range posts {
+			w, ok := want[post.ID]
+			if !ok {
+				t.Errorf("page %d: got post %s of another group", page, post.ID)
+				continue
+			}
+			listed++
+
+			var media []string
+			for _, m := range post.Media {
+				if m.PostID != post.ID {
+					t.Errorf("post %s has media %s of post %s", post.ID, m.ID, m.PostID)
+				}
+				media = append(media, m.MediaURL)
+			}
+			sort.Strings(media)
+			if strings.Join(media, " ") != strings.Join(w.media, " ") {
+				t.Errorf("post %s: got media %v, want %v", post.ID, media, w.media)
+			}
+			if post.LikesCount != w.likes || post.CommentsCount != w.comments || post.IsLiked != w.liked {
+				t.Errorf("post %s: got %d likes, %d comments, liked %v, want %d, %d, %v",
+					post.ID, post.LikesCount, post.CommentsCount, post.IsLiked, w.likes, w.comments, w.liked)
+			}
+		}
+	}
+	if listed != len(want) {
+		t.Errorf("listed %d posts over both pages, want %d", listed, len(want))
+	}
+}
+
+func TestGroupPostsQueryCountDoesNotGrowWithPageSize(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "public", "bob", "carol")
+	s.fillPosts(t, group.ID, 9)
+
+	queries := testutil.CountQueries(t, s.db)
+	counts := make(map[int]int)
+	for _, limit := range []int{1, 3, 9} {
+		*queries = 0
+		posts, _, _, err := s.GetGroupPosts(ctx, group.ID, "alice", 1, limit)
+		if err != nil {
+			t.Fatalf("GetGroupPosts: %v", err)
+		}
+		if len(posts) != limit {
+			t.Fatalf("got %d posts, want %d", len(posts), limit)
+		}
+		counts[limit] = *queries
+	}
+	if counts[1] != counts[3] || counts[1] != counts[9] {
+		t.Errorf("queries by page size: %v, want the same number for every size", counts)
+	}
+}
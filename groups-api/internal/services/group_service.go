@@ -3,52 +3,99 @@ package services
 import (
 	"context"
 	"errors"
+	"groups-api/internal/clients"
 	"groups-api/internal/models"
 	"groups-api/internal/repository"
 	"groups-api/internal/utils/logger"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 // GroupService defines the interface for group-related business logic
 type GroupService interface {
 	// Group operations
-	CreateGroup(ctx context.Context, userID, name, description, avatar string) (*models.Group, error)
+	CreateGroup(ctx context.Context, userID, name, description, avatar, visibility string) (*models.Group, error)
 	GetGroup(ctx context.Context, id string, userID string) (*models.Group, int32, int32, bool, error)
-	GetGroups(ctx context.Context, userID, query string, page, limit int) ([]*models.Group, int64, int32, error)
-	UpdateGroup(ctx context.Context, id, userID, name, description, avatar string) (*models.Group, error)
+	GetGroups(ctx context.Context, userID, query, sort string, page, limit int) ([]*models.GroupWithCounts, int64, int32, error)
+	GetUserGroups(ctx context.Context, userID string, page, limit int) ([]*models.GroupWithCounts, int64, int32, error)
+	UpdateGroup(ctx context.Context, id, userID, name, description, avatar, visibility string) (*models.Group, error)
 	DeleteGroup(ctx context.Context, id, userID string) error
 
 	// Group member operations
-	JoinGroup(ctx context.Context, groupID, userID string) (bool, int32, error)
+	JoinGroup(ctx context.Context, groupID, userID string) (bool, bool, int32, error)
 	LeaveGroup(ctx context.Context, groupID, userID string) (bool, int32, error)
 	GetGroupMembers(ctx context.Context, groupID string, page, limit int) ([]*models.GroupMember, int64, int32, error)
+	RequireMembersVisible(ctx context.Context, groupID, userID string) error
+	CheckMembership(ctx context.Context, groupID, userID string) (*models.Group, *models.GroupMember, error)
+	PromoteMember(ctx context.Context, groupID, userID, memberID string) (*models.GroupMember, error)
+	DemoteMember(ctx context.Context, groupID, userID, memberID string) (*models.GroupMember, error)
+	RemoveMember(ctx context.Context, groupID, adminUserID, targetUserID string) (int32, error)
+	TransferOwnership(ctx context.Context, groupID, currentCreatorID, newCreatorID string) (*models.GroupMember, error)
+
+	// Group join request operations
+	GetJoinRequests(ctx context.Context, groupID, userID string, page, limit int) ([]*models.GroupJoinRequest, int64, int32, error)
+	ApproveJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.GroupJoinRequest, error)
+	RejectJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.GroupJoinRequest, error)
 
 	// Group post operations
 	CreateGroupPost(ctx context.Context, groupID, userID, content string, mediaURLs []string) (*models.GroupPost, error)
 	GetGroupPosts(ctx context.Context, groupID, userID string, page, limit int) ([]*models.GroupPost, int64, int32, error)
+	UpdateGroupPost(ctx context.Context, groupID, postID, userID, content string, mediaURLs []string) (*models.GroupPost, error)
+	DeleteGroupPost(ctx context.Context, groupID, postID, userID string) error
+
+	// Group post like and comment operations
+	LikeGroupPost(ctx context.Context, groupID, postID, userID string) (int64, error)
+	UnlikeGroupPost(ctx context.Context, groupID, postID, userID string) (int64, error)
+	AddGroupPostComment(ctx context.Context, groupID, postID, userID, content string) (*models.GroupPostComment, error)
+	GetGroupPostComments(ctx context.Context, groupID, postID, userID string, page, limit int) ([]*models.GroupPostComment, int64, int32, error)
 }
 
 // groupService implements the GroupService interface
 type groupService struct {
-	repo   repository.GroupRepository
-	logger *logger.Logger
+	repo             repository.GroupRepository
+	mediaLimits      MediaLimits
+	maxPostLength    int
+	maxCommentLength int
+	notifications    clients.NotificationClient
+	logger           *logger.Logger
 }
 
 // NewGroupService creates a new group service
-func NewGroupService(repo repository.GroupRepository, logger *logger.Logger) GroupService {
+func NewGroupService(repo repository.GroupRepository, mediaLimits MediaLimits, maxPostLength, maxCommentLength int, notifications clients.NotificationClient, logger *logger.Logger) GroupService {
+	if maxPostLength <= 0 {
+		maxPostLength = defaultMaxPostLength
+	}
+	if maxCommentLength <= 0 {
+		maxCommentLength = defaultMaxCommentLength
+	}
+
 	return &groupService{
-		repo:   repo,
-		logger: logger,
+		repo:             repo,
+		mediaLimits:      mediaLimits.withDefaults(),
+		maxPostLength:    maxPostLength,
+		maxCommentLength: maxCommentLength,
+		notifications:    notifications,
+		logger:           logger,
 	}
 }
 
 // CreateGroup creates a new group
-func (s *groupService) CreateGroup(ctx context.Context, userID, name, description, avatar string) (*models.Group, error) {
+func (s *groupService) CreateGroup(ctx context.Context, userID, name, description, avatar, visibility string) (*models.Group, error) {
 	// Validate input
 	if userID == "" {
-		return nil, errors.New("user ID is required")
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
 	}
 	if name == "" {
-		return nil, errors.New("group name is required")
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+	if visibility == "" {
+		visibility = "public"
+	}
+	if !isValidVisibility(visibility) {
+		return nil, status.Error(codes.InvalidArgument, "visibility must be public or private")
 	}
 
 	// Create group
@@ -57,26 +104,20 @@ func (s *groupService) CreateGroup(ctx context.Context, userID, name, descriptio
 		Description: description,
 		Avatar:      avatar,
 		CreatorID:   userID,
+		Visibility:  visibility,
 	}
 
-	// Save group to database
-	err := s.repo.CreateGroup(ctx, group)
-	if err != nil {
-		s.logger.Error("Failed to create group", err)
-		return nil, err
-	}
-
-	// Add creator as a member with creator role
+	// The creator joins the group with the creator role
 	member := &models.GroupMember{
-		GroupID: group.ID,
-		UserID:  userID,
-		Role:    "creator",
+		UserID: userID,
+		Role:   "creator",
 	}
 
-	err = s.repo.AddMember(ctx, member)
+	// Save group and membership to database, so that no group is left without its creator
+	err := s.repo.CreateGroup(ctx, group, member)
 	if err != nil {
-		s.logger.Error("Failed to add creator as member", err)
-		// Don't return error here, as the group was created successfully
+		s.logger.WithContext(ctx).Error("Failed to create group", err)
+		return nil, status.Error(codes.Internal, "failed to create group")
 	}
 
 	return group, nil
@@ -85,23 +126,22 @@ func (s *groupService) CreateGroup(ctx context.Context, userID, name, descriptio
 // GetGroup gets a group by ID
 func (s *groupService) GetGroup(ctx context.Context, id string, userID string) (*models.Group, int32, int32, bool, error) {
 	// Get group from database
-	group, err := s.repo.GetGroupByID(ctx, id)
+	group, err := s.getGroup(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
 		return nil, 0, 0, false, err
 	}
 
 	// Get member count
-	_, count, err := s.repo.GetGroupMembers(ctx, id, 1, 1)
+	count, err := s.repo.CountMembers(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to get group members", err)
+		s.logger.WithContext(ctx).Error("Failed to count group members", err)
 		// Don't return error here, as we can still return the group
 	}
 
 	// Get post count
-	_, postCount, err := s.repo.GetGroupPosts(ctx, id, 1, 1)
+	postCount, err := s.repo.CountPosts(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to get group posts", err)
+		s.logger.WithContext(ctx).Error("Failed to count group posts", err)
 		// Don't return error here, as we can still return the group
 	}
 
@@ -110,7 +150,7 @@ func (s *groupService) GetGroup(ctx context.Context, id string, userID string) (
 	if userID != "" {
 		isMember, err = s.repo.IsMember(ctx, id, userID)
 		if err != nil {
-			s.logger.Error("Failed to check if user is a member", err)
+			s.logger.WithContext(ctx).Error("Failed to check if user is a member", err)
 			// Don't return error here, as we can still return the group
 		}
 	}
@@ -118,15 +158,64 @@ func (s *groupService) GetGroup(ctx context.Context, id string, userID string) (
 	return group, int32(count), int32(postCount), isMember, nil
 }
 
-// GetGroups gets groups with pagination and filtering
-func (s *groupService) GetGroups(ctx context.Context, userID, query string, page, limit int) ([]*models.Group, int64, int32, error) {
-	// Get groups from database
-	groups, count, err := s.repo.GetGroups(ctx, query, page, limit)
+// GetGroups gets groups with pagination, filtering and the given sort order
+func (s *groupService) GetGroups(ctx context.Context, userID, query, sort string, page, limit int) ([]*models.GroupWithCounts, int64, int32, error) {
+	switch sort {
+	case "", models.GroupSortRecent, models.GroupSortOldest, models.GroupSortMembersDesc, models.GroupSortNameAsc:
+	default:
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "sort must be recent, oldest, members_desc or name_asc")
+	}
+
+	// Get groups with their member and post counts from database
+	groups, count, err := s.repo.GetGroupsWithCounts(ctx, query, sort, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get groups", err)
+		return nil, 0, 0, err
+	}
+
+	// Check membership for the whole page at once
+	if userID != "" && len(groups) > 0 {
+		groupIDs := make([]string, len(groups))
+		for i, group := range groups {
+			groupIDs[i] = group.ID
+		}
+
+		memberships, err := s.repo.AreMembers(ctx, groupIDs, userID)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to check group memberships", err)
+			// Don't return error here, as we can still return the groups
+		} else {
+			for _, group := range groups {
+				group.IsMember = memberships[group.ID]
+			}
+		}
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return groups, count, totalPages, nil
+}
+
+// GetUserGroups gets the groups a user is a member of, with their role in each
+func (s *groupService) GetUserGroups(ctx context.Context, userID string, page, limit int) ([]*models.GroupWithCounts, int64, int32, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	groups, count, err := s.repo.GetUserGroupsWithCounts(ctx, userID, page, limit)
 	if err != nil {
-		s.logger.Error("Failed to get groups", err)
+		s.logger.WithContext(ctx).Error("Failed to get user groups", err)
 		return nil, 0, 0, err
 	}
 
+	for _, group := range groups {
+		group.IsMember = true
+	}
+
 	// Calculate total pages
 	totalPages := int32((count + int64(limit) - 1) / int64(limit))
 
@@ -134,23 +223,27 @@ func (s *groupService) GetGroups(ctx context.Context, userID, query string, page
 }
 
 // UpdateGroup updates a group
-func (s *groupService) UpdateGroup(ctx context.Context, id, userID, name, description, avatar string) (*models.Group, error) {
+func (s *groupService) UpdateGroup(ctx context.Context, id, userID, name, description, avatar, visibility string) (*models.Group, error) {
+	// Validate input
+	if visibility != "" && !isValidVisibility(visibility) {
+		return nil, status.Error(codes.InvalidArgument, "visibility must be public or private")
+	}
+
 	// Get group from database
-	group, err := s.repo.GetGroupByID(ctx, id)
+	group, err := s.getGroup(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
 		return nil, err
 	}
 
 	// Check if user is the creator or an admin
 	member, err := s.repo.GetMemberByID(ctx, id, userID)
 	if err != nil {
-		s.logger.Error("Failed to get member", err)
-		return nil, errors.New("not authorized to update this group")
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return nil, status.Error(codes.PermissionDenied, "not authorized to update this group")
 	}
 
 	if member.Role != "creator" && member.Role != "admin" {
-		return nil, errors.New("not authorized to update this group")
+		return nil, status.Error(codes.PermissionDenied, "not authorized to update this group")
 	}
 
 	// Update group
@@ -163,11 +256,14 @@ func (s *groupService) UpdateGroup(ctx context.Context, id, userID, name, descri
 	if avatar != "" {
 		group.Avatar = avatar
 	}
+	if visibility != "" {
+		group.Visibility = visibility
+	}
 
 	// Save group to database
 	err = s.repo.UpdateGroup(ctx, group)
 	if err != nil {
-		s.logger.Error("Failed to update group", err)
+		s.logger.WithContext(ctx).Error("Failed to update group", err)
 		return nil, err
 	}
 
@@ -177,45 +273,73 @@ func (s *groupService) UpdateGroup(ctx context.Context, id, userID, name, descri
 // DeleteGroup deletes a group
 func (s *groupService) DeleteGroup(ctx context.Context, id, userID string) error {
 	// Get group from database
-	group, err := s.repo.GetGroupByID(ctx, id)
+	group, err := s.getGroup(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
 		return err
 	}
 
 	// Check if user is the creator
 	if group.CreatorID != userID {
-		return errors.New("not authorized to delete this group")
+		return status.Error(codes.PermissionDenied, "not authorized to delete this group")
 	}
 
-	// Delete group from database
+	// Delete group with its members, join requests and posts from database
 	err = s.repo.DeleteGroup(ctx, id)
 	if err != nil {
-		s.logger.Error("Failed to delete group", err)
-		return err
+		s.logger.WithContext(ctx).Error("Failed to delete group", err)
+		return status.Error(codes.Internal, "failed to delete group")
 	}
 
 	return nil
 }
 
-// JoinGroup adds a user to a group
-func (s *groupService) JoinGroup(ctx context.Context, groupID, userID string) (bool, int32, error) {
+// JoinGroup adds a user to a public group, or files a join request for a private group.
+// It reports whether the user joined right away and whether a request is now pending approval.
+func (s *groupService) JoinGroup(ctx context.Context, groupID, userID string) (bool, bool, int32, error) {
 	// Check if group exists
-	_, err := s.repo.GetGroupByID(ctx, groupID)
+	group, err := s.getGroup(ctx, groupID)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
-		return false, 0, err
+		return false, false, 0, err
 	}
 
 	// Check if user is already a member
 	isMember, err := s.repo.IsMember(ctx, groupID, userID)
 	if err != nil {
-		s.logger.Error("Failed to check if user is a member", err)
-		return false, 0, err
+		s.logger.WithContext(ctx).Error("Failed to check if user is a member", err)
+		return false, false, 0, err
 	}
 
 	if isMember {
-		return false, 0, errors.New("already a member of this group")
+		return false, false, 0, status.Error(codes.AlreadyExists, "already a member of this group")
+	}
+
+	// Private groups need an admin to approve new members
+	if group.Visibility == "private" {
+		if _, err := s.repo.GetPendingJoinRequest(ctx, groupID, userID); err == nil {
+			return false, false, 0, status.Error(codes.AlreadyExists, "a join request for this group is already pending")
+		}
+
+		request := &models.GroupJoinRequest{
+			GroupID: groupID,
+			UserID:  userID,
+			Status:  "pending",
+		}
+
+		err = s.repo.CreateJoinRequest(ctx, request)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to create join request", err)
+			return false, false, 0, err
+		}
+
+		// Get current member count
+		count, err := s.repo.CountMembers(ctx, groupID)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to count group members", err)
+			// Don't return error here, as the request was created successfully
+			return false, true, 0, nil
+		}
+
+		return false, true, int32(count), nil
 	}
 
 	// Add user as a member
@@ -227,57 +351,56 @@ func (s *groupService) JoinGroup(ctx context.Context, groupID, userID string) (b
 
 	err = s.repo.AddMember(ctx, member)
 	if err != nil {
-		s.logger.Error("Failed to add member", err)
-		return false, 0, err
+		s.logger.WithContext(ctx).Error("Failed to add member", err)
+		return false, false, 0, err
 	}
 
 	// Get updated member count
-	_, count, err := s.repo.GetGroupMembers(ctx, groupID, 1, 1)
+	count, err := s.repo.CountMembers(ctx, groupID)
 	if err != nil {
-		s.logger.Error("Failed to get group members", err)
+		s.logger.WithContext(ctx).Error("Failed to count group members", err)
 		// Don't return error here, as the user was added successfully
-		return true, 0, nil
+		return true, false, 0, nil
 	}
 
-	return true, int32(count), nil
+	return true, false, int32(count), nil
 }
 
 // LeaveGroup removes a user from a group
 func (s *groupService) LeaveGroup(ctx context.Context, groupID, userID string) (bool, int32, error) {
 	// Check if group exists
-	group, err := s.repo.GetGroupByID(ctx, groupID)
+	group, err := s.getGroup(ctx, groupID)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
 		return false, 0, err
 	}
 
 	// Check if user is a member
 	isMember, err := s.repo.IsMember(ctx, groupID, userID)
 	if err != nil {
-		s.logger.Error("Failed to check if user is a member", err)
+		s.logger.WithContext(ctx).Error("Failed to check if user is a member", err)
 		return false, 0, err
 	}
 
 	if !isMember {
-		return false, 0, errors.New("not a member of this group")
+		return false, 0, status.Error(codes.FailedPrecondition, "not a member of this group")
 	}
 
 	// Check if user is the creator
 	if group.CreatorID == userID {
-		return false, 0, errors.New("creator cannot leave the group")
+		return false, 0, status.Error(codes.FailedPrecondition, "creator cannot leave the group, transfer the ownership first")
 	}
 
 	// Remove user from group
 	err = s.repo.RemoveMember(ctx, groupID, userID)
 	if err != nil {
-		s.logger.Error("Failed to remove member", err)
+		s.logger.WithContext(ctx).Error("Failed to remove member", err)
 		return false, 0, err
 	}
 
 	// Get updated member count
-	_, count, err := s.repo.GetGroupMembers(ctx, groupID, 1, 1)
+	count, err := s.repo.CountMembers(ctx, groupID)
 	if err != nil {
-		s.logger.Error("Failed to get group members", err)
+		s.logger.WithContext(ctx).Error("Failed to count group members", err)
 		// Don't return error here, as the user was removed successfully
 		return true, 0, nil
 	}
@@ -288,16 +411,15 @@ func (s *groupService) LeaveGroup(ctx context.Context, groupID, userID string) (
 // GetGroupMembers gets members of a group with pagination
 func (s *groupService) GetGroupMembers(ctx context.Context, groupID string, page, limit int) ([]*models.GroupMember, int64, int32, error) {
 	// Check if group exists
-	_, err := s.repo.GetGroupByID(ctx, groupID)
+	_, err := s.getGroup(ctx, groupID)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
 		return nil, 0, 0, err
 	}
 
 	// Get members from database
 	members, count, err := s.repo.GetGroupMembers(ctx, groupID, page, limit)
 	if err != nil {
-		s.logger.Error("Failed to get group members", err)
+		s.logger.WithContext(ctx).Error("Failed to get group members", err)
 		return nil, 0, 0, err
 	}
 
@@ -307,24 +429,381 @@ func (s *groupService) GetGroupMembers(ctx context.Context, groupID string, page
 	return members, count, totalPages, nil
 }
 
+// RequireMembersVisible fails unless the user may see who the members of a group are, which
+// anyone may for a public group and only members for a private one
+func (s *groupService) RequireMembersVisible(ctx context.Context, groupID, userID string) error {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	return s.requireGroupVisible(ctx, group, userID)
+}
+
+// CheckMembership gets a group along with the user's membership in it.
+// The returned member is nil if the user does not belong to the group.
+func (s *groupService) CheckMembership(ctx context.Context, groupID, userID string) (*models.Group, *models.GroupMember, error) {
+	// Validate input
+	if groupID == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "group ID is required")
+	}
+
+	// Check if group exists
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Anonymous users are never members
+	if userID == "" {
+		return group, nil, nil
+	}
+
+	// Get the user's membership
+	member, err := s.repo.GetMemberByID(ctx, groupID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return group, nil, nil
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return nil, nil, status.Error(codes.Internal, "failed to check membership")
+	}
+
+	return group, member, nil
+}
+
+// PromoteMember makes a group member an admin
+func (s *groupService) PromoteMember(ctx context.Context, groupID, userID, memberID string) (*models.GroupMember, error) {
+	return s.changeMemberRole(ctx, groupID, userID, memberID, "admin")
+}
+
+// DemoteMember makes a group admin a regular member
+func (s *groupService) DemoteMember(ctx context.Context, groupID, userID, memberID string) (*models.GroupMember, error) {
+	return s.changeMemberRole(ctx, groupID, userID, memberID, "member")
+}
+
+// changeMemberRole sets the role of another member of a group. Creators and admins may
+// manage roles, but only the creator can grant or revoke admin, and the creator's own
+// role is fixed.
+func (s *groupService) changeMemberRole(ctx context.Context, groupID, userID, memberID, role string) (*models.GroupMember, error) {
+	// Validate input
+	if groupID == "" || memberID == "" {
+		return nil, status.Error(codes.InvalidArgument, "group ID and member ID are required")
+	}
+	if userID == memberID {
+		return nil, status.Error(codes.PermissionDenied, "cannot change your own role")
+	}
+
+	// Check if user is the creator or an admin
+	actor, err := s.requireGroupAdmin(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the member whose role is changed
+	member, err := s.repo.GetMemberByID(ctx, groupID, memberID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return nil, status.Error(codes.NotFound, "member not found")
+	}
+
+	if member.Role == "creator" {
+		return nil, status.Error(codes.PermissionDenied, "the creator's role cannot be changed")
+	}
+
+	// Granting or revoking admin is reserved for the creator
+	if (role == "admin" || member.Role == "admin") && actor.Role != "creator" {
+		return nil, status.Error(codes.PermissionDenied, "only the group creator can manage admins")
+	}
+
+	if member.Role == role {
+		return member, nil
+	}
+
+	// Save the new role
+	member.Role = role
+	err = s.repo.UpdateMember(ctx, member)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to update member", err)
+		return nil, status.Error(codes.Internal, "failed to update member role")
+	}
+
+	return member, nil
+}
+
+// RemoveMember removes another member from a group and returns the updated member count.
+// Creators and admins may remove regular members, only the creator can remove admins, and
+// the creator can never be removed. The removed member's group posts are kept.
+func (s *groupService) RemoveMember(ctx context.Context, groupID, adminUserID, targetUserID string) (int32, error) {
+	// Validate input
+	if groupID == "" || targetUserID == "" {
+		return 0, status.Error(codes.InvalidArgument, "group ID and member ID are required")
+	}
+	if adminUserID == targetUserID {
+		return 0, status.Error(codes.InvalidArgument, "use leave group to remove yourself")
+	}
+
+	// Check if user is the creator or an admin
+	admin, err := s.requireGroupAdmin(ctx, groupID, adminUserID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Get the member to remove
+	target, err := s.repo.GetMemberByID(ctx, groupID, targetUserID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return 0, status.Error(codes.NotFound, "member not found")
+	}
+
+	if target.Role == "creator" {
+		return 0, status.Error(codes.PermissionDenied, "the creator cannot be removed")
+	}
+	if target.Role == "admin" && admin.Role != "creator" {
+		return 0, status.Error(codes.PermissionDenied, "only the group creator can remove admins")
+	}
+
+	// Remove member from group
+	err = s.repo.RemoveMember(ctx, groupID, targetUserID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to remove member", err)
+		return 0, status.Error(codes.Internal, "failed to remove member")
+	}
+
+	// Get updated member count
+	count, err := s.repo.CountMembers(ctx, groupID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count group members", err)
+		// Don't return error here, as the member was removed successfully
+		return 0, nil
+	}
+
+	return int32(count), nil
+}
+
+// TransferOwnership makes another member the creator of a group and returns their membership.
+// Only the creator can hand over the group; they stay on as an admin and are free to leave afterwards.
+func (s *groupService) TransferOwnership(ctx context.Context, groupID, currentCreatorID, newCreatorID string) (*models.GroupMember, error) {
+	// Validate input
+	if groupID == "" || newCreatorID == "" {
+		return nil, status.Error(codes.InvalidArgument, "group ID and new creator ID are required")
+	}
+	if currentCreatorID == newCreatorID {
+		return nil, status.Error(codes.InvalidArgument, "already the creator of this group")
+	}
+
+	// Check if user is the creator
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.CreatorID != currentCreatorID {
+		return nil, status.Error(codes.PermissionDenied, "only the group creator can transfer the ownership")
+	}
+
+	formerCreator, err := s.repo.GetMemberByID(ctx, groupID, currentCreatorID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return nil, status.Error(codes.Internal, "failed to get creator membership")
+	}
+
+	// The new creator has to be a member already
+	newCreator, err := s.repo.GetMemberByID(ctx, groupID, newCreatorID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.FailedPrecondition, "the new creator must be a member of the group")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return nil, status.Error(codes.Internal, "failed to get member")
+	}
+
+	// Swap the roles and save them with the group
+	group.CreatorID = newCreatorID
+	formerCreator.Role = "admin"
+	newCreator.Role = "creator"
+	if err := s.repo.TransferOwnership(ctx, group, formerCreator, newCreator); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to transfer ownership", err)
+		return nil, status.Error(codes.Internal, "failed to transfer ownership")
+	}
+
+	return newCreator, nil
+}
+
+// GetJoinRequests gets the pending join requests of a group with pagination
+func (s *groupService) GetJoinRequests(ctx context.Context, groupID, userID string, page, limit int) ([]*models.GroupJoinRequest, int64, int32, error) {
+	// Check if user is the creator or an admin
+	if _, err := s.requireGroupAdmin(ctx, groupID, userID); err != nil {
+		return nil, 0, 0, err
+	}
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	// Get pending requests from database
+	requests, count, err := s.repo.GetPendingJoinRequests(ctx, groupID, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get join requests", err)
+		return nil, 0, 0, status.Error(codes.Internal, "failed to get join requests")
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return requests, count, totalPages, nil
+}
+
+// ApproveJoinRequest accepts a pending join request and adds the requester as a member
+func (s *groupService) ApproveJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.GroupJoinRequest, error) {
+	request, err := s.pendingJoinRequest(ctx, groupID, userID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the request approved and add the member together
+	now := time.Now()
+	request.Status = "approved"
+	request.ReviewedBy = userID
+	request.ReviewedAt = &now
+
+	member := &models.GroupMember{
+		GroupID: groupID,
+		UserID:  request.UserID,
+		Role:    "member",
+	}
+
+	err = s.repo.ApproveJoinRequest(ctx, request, member)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to approve join request", err)
+		return nil, status.Error(codes.Internal, "failed to approve join request")
+	}
+
+	// Tell the requester they are now a member. The approval has already been saved,
+	// so a failure is only logged.
+	if err := s.notifications.Notify(ctx, request.UserID, clients.NotificationGroupJoinApproved, groupID); err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to create notification",
+			logger.Field("requester_id", request.UserID),
+			logger.Field("group_id", groupID),
+			logger.Field("error", err.Error()))
+	}
+
+	return request, nil
+}
+
+// RejectJoinRequest declines a pending join request
+func (s *groupService) RejectJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.GroupJoinRequest, error) {
+	request, err := s.pendingJoinRequest(ctx, groupID, userID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the request rejected
+	now := time.Now()
+	request.Status = "rejected"
+	request.ReviewedBy = userID
+	request.ReviewedAt = &now
+
+	err = s.repo.UpdateJoinRequest(ctx, request)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to reject join request", err)
+		return nil, status.Error(codes.Internal, "failed to reject join request")
+	}
+
+	return request, nil
+}
+
+// pendingJoinRequest loads a join request that the user, as a creator or admin of the group, may review
+func (s *groupService) pendingJoinRequest(ctx context.Context, groupID, userID, requestID string) (*models.GroupJoinRequest, error) {
+	// Validate input
+	if groupID == "" || requestID == "" {
+		return nil, status.Error(codes.InvalidArgument, "group ID and request ID are required")
+	}
+
+	// Check if user is the creator or an admin
+	if _, err := s.requireGroupAdmin(ctx, groupID, userID); err != nil {
+		return nil, err
+	}
+
+	// Get the request and make sure it belongs to this group
+	request, err := s.repo.GetJoinRequestByID(ctx, requestID)
+	if err != nil || request.GroupID != groupID {
+		return nil, status.Error(codes.NotFound, "join request not found")
+	}
+
+	if request.Status != "pending" {
+		return nil, status.Error(codes.FailedPrecondition, "join request has already been reviewed")
+	}
+
+	return request, nil
+}
+
+// requireGroupAdmin checks that the group exists and that the user is its creator or an admin
+func (s *groupService) requireGroupAdmin(ctx context.Context, groupID, userID string) (*models.GroupMember, error) {
+	// Check if group exists
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+
+	// Check the user's role in the group
+	member, err := s.repo.GetMemberByID(ctx, groupID, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return nil, status.Error(codes.PermissionDenied, "not authorized to manage members of this group")
+	}
+
+	if member.Role != "creator" && member.Role != "admin" {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to manage members of this group")
+	}
+
+	return member, nil
+}
+
+// getGroup loads a group, reporting a missing one as not found
+func (s *groupService) getGroup(ctx context.Context, id string) (*models.Group, error) {
+	group, err := s.repo.GetGroupByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get group", err)
+		return nil, status.Error(codes.Internal, "failed to get group")
+	}
+	return group, nil
+}
+
+// isValidVisibility checks if a group visibility value is supported
+func isValidVisibility(visibility string) bool {
+	return visibility == "public" || visibility == "private"
+}
+
 // CreateGroupPost creates a new post in a group
 func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID, content string, mediaURLs []string) (*models.GroupPost, error) {
-	// Check if group exists
-	_, err := s.repo.GetGroupByID(ctx, groupID)
+	// Validate input
+	content, err := validateContent(content, s.maxPostLength, true)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
+		return nil, err
+	}
+	if err := validateMedia(mediaURLs, s.mediaLimits); err != nil {
+		return nil, err
+	}
+
+	// Check if group exists
+	if _, err := s.getGroup(ctx, groupID); err != nil {
 		return nil, err
 	}
 
 	// Check if user is a member
 	isMember, err := s.repo.IsMember(ctx, groupID, userID)
 	if err != nil {
-		s.logger.Error("Failed to check if user is a member", err)
+		s.logger.WithContext(ctx).Error("Failed to check if user is a member", err)
 		return nil, err
 	}
 
 	if !isMember {
-		return nil, errors.New("not a member of this group")
+		return nil, status.Error(codes.PermissionDenied, "not a member of this group")
 	}
 
 	// Create post
@@ -337,7 +816,7 @@ func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID, con
 	// Save post to database
 	err = s.repo.CreatePost(ctx, post)
 	if err != nil {
-		s.logger.Error("Failed to create post", err)
+		s.logger.WithContext(ctx).Error("Failed to create post", err)
 		return nil, err
 	}
 
@@ -350,7 +829,7 @@ func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID, con
 
 		err = s.repo.AddPostMedia(ctx, media)
 		if err != nil {
-			s.logger.Error("Failed to add media to post", err)
+			s.logger.WithContext(ctx).Error("Failed to add media to post", err)
 			// Don't return error here, as the post was created successfully
 		}
 	}
@@ -358,7 +837,7 @@ func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID, con
 	// Get media for post
 	media, err := s.repo.GetPostMedia(ctx, post.ID)
 	if err != nil {
-		s.logger.Error("Failed to get post media", err)
+		s.logger.WithContext(ctx).Error("Failed to get post media", err)
 		// Don't return error here, as the post was created successfully
 	} else {
 		post.Media = media
@@ -370,66 +849,122 @@ func (s *groupService) CreateGroupPost(ctx context.Context, groupID, userID, con
 // GetGroupPosts gets posts in a group with pagination
 func (s *groupService) GetGroupPosts(ctx context.Context, groupID, userID string, page, limit int) ([]*models.GroupPost, int64, int32, error) {
 	// Check if group exists
-	_, err := s.repo.GetGroupByID(ctx, groupID)
+	group, err := s.getGroup(ctx, groupID)
 	if err != nil {
-		s.logger.Error("Failed to get group", err)
 		return nil, 0, 0, err
 	}
 
-	// Check if user is a member
-	isMember := false
-	if userID != "" {
-		isMember, err = s.repo.IsMember(ctx, groupID, userID)
-		if err != nil {
-			s.logger.Error("Failed to check if user is a member", err)
-			return nil, 0, 0, err
-		}
-	}
-
-	// Only members can see posts
-	if !isMember {
-		return nil, 0, 0, errors.New("not a member of this group")
+	// Only members can see posts in private groups
+	if err := s.requireGroupVisible(ctx, group, userID); err != nil {
+		return nil, 0, 0, err
 	}
 
 	// Get posts from database
 	posts, count, err := s.repo.GetGroupPosts(ctx, groupID, page, limit)
 	if err != nil {
-		s.logger.Error("Failed to get group posts", err)
+		s.logger.WithContext(ctx).Error("Failed to get group posts", err)
 		return nil, 0, 0, err
 	}
 
-	// Get media, likes, and comments for each post
-	for _, post := range posts {
-		// Get media
-		media, err := s.repo.GetPostMedia(ctx, post.ID)
-		if err != nil {
-			s.logger.Error("Failed to get post media", err)
-			// Don't return error here, as we can still return the posts
-		} else {
-			post.Media = media
+	// Get the media of the posts, which is one query for the whole page
+	postIDs := make([]string, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+	}
+	media, err := s.repo.GetMediaForPosts(ctx, postIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post media", err)
+		// Don't return error here, as we can still return the posts
+	} else {
+		for _, post := range posts {
+			post.Media = media[post.ID]
 		}
+	}
 
-		// Get likes
-		likes, err := s.repo.GetPostLikes(ctx, post.ID)
-		if err != nil {
-			s.logger.Error("Failed to get post likes", err)
-			// Don't return error here, as we can still return the posts
-		} else {
-			post.Likes = likes
+	// Count likes and comments, and mark the posts the user liked
+	s.attachPostStats(ctx, posts, userID)
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return posts, count, totalPages, nil
+}
+
+// UpdateGroupPost edits the content of a post in a group, and its media when mediaURLs is not
+// empty. Only the author and the group's creator and admins may edit a post.
+func (s *groupService) UpdateGroupPost(ctx context.Context, groupID, postID, userID, content string, mediaURLs []string) (*models.GroupPost, error) {
+	// Validate input
+	content, err := validateContent(content, s.maxPostLength, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMedia(mediaURLs, s.mediaLimits); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	post, err := s.getGroupPost(ctx, groupID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requirePostModerator(ctx, post, userID, "not authorized to edit this post"); err != nil {
+		return nil, err
+	}
+
+	// Replace the media only when new media is given
+	var media []*models.GroupPostMedia
+	if len(mediaURLs) > 0 {
+		media = make([]*models.GroupPostMedia, len(mediaURLs))
+		for i, mediaURL := range mediaURLs {
+			media[i] = &models.GroupPostMedia{
+				PostID:   post.ID,
+				MediaURL: mediaURL,
+			}
 		}
+	}
+
+	post.Content = content
+	if err := s.repo.UpdatePost(ctx, post, media); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to update post", err)
+		return nil, status.Error(codes.Internal, "failed to update post")
+	}
 
-		// Get comments
-		comments, _, err := s.repo.GetPostComments(ctx, post.ID, 1, 100)
+	// Get media for post
+	if media == nil {
+		media, err = s.repo.GetPostMedia(ctx, post.ID)
 		if err != nil {
-			s.logger.Error("Failed to get post comments", err)
-			// Don't return error here, as we can still return the posts
-		} else {
-			post.Comments = comments
+			s.logger.WithContext(ctx).Error("Failed to get post media", err)
+			// Don't return error here, as the post was updated successfully
 		}
 	}
+	post.Media = media
 
-	// Calculate total pages
-	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+	// Count likes and comments, and mark whether the user liked the post
+	s.attachPostStats(ctx, []*models.GroupPost{post}, userID)
 
-	return posts, count, totalPages, nil
+	return post, nil
+}
+
+// DeleteGroupPost deletes a post in a group together with its media, likes and comments.
+// Only the author and the group's creator and admins may delete a post.
+func (s *groupService) DeleteGroupPost(ctx context.Context, groupID, postID, userID string) error {
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return err
+	}
+	post, err := s.getGroupPost(ctx, groupID, postID)
+	if err != nil {
+		return err
+	}
+	if err := s.requirePostModerator(ctx, post, userID, "not authorized to delete this post"); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeletePost(ctx, post.ID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to delete post", err)
+		return status.Error(codes.Internal, "failed to delete post")
+	}
+
+	return nil
 }
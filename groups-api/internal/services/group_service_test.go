@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"groups-api/internal/models"
+)
+
+// countRows returns how many rows of the model match the condition
+func (s *testService) countRows(t *testing.T, model interface{}, query string, args ...interface{}) int64 {
+	t.Helper()
+
+	var count int64
+	if err := s.db.Model(model).Where(query, args...).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	return count
+}
+
+func TestCreateGroupAddsCreatorAsMember(t *testing.T) {
+	s := newTestService(t)
+
+	group := s.createGroup(t, "alice", "public")
+	if role := s.roleOf(t, group.ID, "alice"); role != "creator" {
+		t.Errorf("creator has role %q, want %q", role, "creator")
+	}
+}
+
+func TestCreateGroupIsRolledBackWhenCreatorCannotBeAdded(t *testing.T) {
+	s := newTestService(t)
+
+	name := "test:fail_member_inserts"
+	err := s.db.Callback().Create().Before("gorm:create").Register(name, func(tx *gorm.DB) {
+		if tx.Statement.Table == "group_members" {
+			_ = tx.AddError(errors.New("disk full"))
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to register insert failure: %v", err)
+	}
+	t.Cleanup(func() { _ = s.db.Callback().Create().Remove(name) })
+
+	_, err = s.CreateGroup(context.Background(), "alice", "Group of alice", "", "", "public")
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("got %v, want Internal", err)
+	}
+	if got := s.countRows(t, &models.Group{}, "creator_id = ?", "alice"); got != 0 {
+		t.Errorf("%d groups were left without their creator, want 0", got)
+	}
+}
+
+// fillGroup gives the group a post of each member, with media, a like and a comment, and a pending join request
+func (s *testService) fillGroup(t *testing.T, group *models.Group, memberIDs ...string) {
+	t.Helper()
+
+	ctx := context.Background()
+	for _, memberID := range memberIDs {
+		post := s.createPost(t, group.ID, memberID)
+		if err := s.repo.AddPostMedia(ctx, &models.GroupPostMedia{PostID: post.ID, MediaURL: "https://cdn.example.com/" + post.ID}); err != nil {
+			t.Fatalf("AddPostMedia: %v", err)
+		}
+		if _, err := s.LikeGroupPost(ctx, group.ID, post.ID, memberID); err != nil {
+			t.Fatalf("LikeGroupPost: %v", err)
+		}
+		if _, err := s.AddGroupPostComment(ctx, group.ID, post.ID, memberID, "comment by "+memberID); err != nil {
+			t.Fatalf("AddGroupPostComment: %v", err)
+		}
+	}
+	if err := s.repo.CreateJoinRequest(ctx, &models.GroupJoinRequest{GroupID: group.ID, UserID: "outsider"}); err != nil {
+		t.Fatalf("CreateJoinRequest: %v", err)
+	}
+}
+
+func TestDeleteGroupRemovesChildRows(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	group := s.createGroup(t, "alice", "public", "bob")
+	s.fillGroup(t, group, "alice", "bob")
+	other := s.createGroup(t, "carol", "public")
+	s.fillGroup(t, other, "carol")
+
+	if err := s.DeleteGroup(ctx, group.ID, "alice"); err != nil {
+		t.Fatalf("DeleteGroup: %v", err)
+	}
+
+	// The posts are soft deleted along with the group, so their child rows are looked up unscoped
+	postsOf := func(groupID string) interface{} {
+		return s.db.Unscoped().Model(&models.GroupPost{}).Select("id").Where("group_id = ?", groupID)
+	}
+	tests := []struct {
+		name  string
+		model interface{}
+		query string
+		arg   func(groupID string) interface{}
+	}{
+		{"groups", &models.Group{}, "id = ?", func(id string) interface{} { return id }},
+		{"members", &models.GroupMember{}, "group_id = ?", func(id string) interface{} { return id }},
+		{"join requests", &models.GroupJoinRequest{}, "group_id = ?", func(id string) interface{} { return id }},
+		{"posts", &models.GroupPost{}, "group_id = ?", func(id string) interface{} { return id }},
+		{"media", &models.GroupPostMedia{}, "post_id IN (?)", postsOf},
+		{"likes", &models.GroupPostLike{}, "post_id IN (?)", postsOf},
+		{"comments", &models.GroupPostComment{}, "post_id IN (?)", postsOf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.countRows(t, tt.model, tt.query, tt.arg(group.ID)); got != 0 {
+				t.Errorf("%d %s of the deleted group remain, want 0", got, tt.name)
+			}
+			// The rows of other groups are kept
+			if got := s.countRows(t, tt.model, tt.query, tt.arg(other.ID)); got == 0 {
+				t.Errorf("the %s of another group were deleted", tt.name)
+			}
+		})
+	}
+}
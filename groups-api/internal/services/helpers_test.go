@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"groups-api/internal/models"
+	"groups-api/internal/repository"
+	"groups-api/internal/testutil"
+	"groups-api/internal/utils/logger"
+)
+
+// fakeNotifications drops every notification
+type fakeNotifications struct{}
+
+func (fakeNotifications) Notify(ctx context.Context, userID, notificationType, targetID string) error {
+	return nil
+}
+
+// testService is a group service over a fresh database
+type testService struct {
+	*groupService
+	db *gorm.DB
+}
+
+// newTestService returns a group service over a fresh, migrated database
+func newTestService(tb testing.TB) *testService {
+	tb.Helper()
+
+	db := testutil.NewDB(tb)
+	if _, err := repository.AutoMigrate(db); err != nil {
+		tb.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	service := NewGroupService(
+		repository.NewGroupRepository(db),
+		MediaLimits{},
+		0, 0,
+		fakeNotifications{},
+		&logger.Logger{Logger: zap.NewNop()},
+	)
+
+	return &testService{groupService: service.(*groupService), db: db}
+}
+
+// createGroup creates a group of the creator, with the other users as plain members
+func (s *testService) createGroup(tb testing.TB, creatorID, visibility string, memberIDs ...string) *models.Group {
+	tb.Helper()
+
+	ctx := context.Background()
+	group, err := s.CreateGroup(ctx, creatorID, "Group of "+creatorID, "", "", visibility)
+	if err != nil {
+		tb.Fatalf("CreateGroup: %v", err)
+	}
+	for _, memberID := range memberIDs {
+		member := &models.GroupMember{GroupID: group.ID, UserID: memberID, Role: "member"}
+		if err := s.db.Create(member).Error; err != nil {
+			tb.Fatalf("failed to add member %s: %v", memberID, err)
+		}
+	}
+	return group
+}
+
+// createPost posts in a group as one of its members
+func (s *testService) createPost(tb testing.TB, groupID, authorID string) *models.GroupPost {
+	tb.Helper()
+
+	post, err := s.CreateGroupPost(context.Background(), groupID, authorID, "post by "+authorID, nil)
+	if err != nil {
+		tb.Fatalf("CreateGroupPost: %v", err)
+	}
+	return post
+}
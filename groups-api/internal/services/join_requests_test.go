@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestJoiningPrivateGroupNeedsApproval(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "private")
+	s.createPost(t, group.ID, "alice")
+
+	// Joining files a request instead of a membership
+	joined, pending, _, err := s.JoinGroup(ctx, group.ID, "bob")
+	if err != nil {
+		t.Fatalf("JoinGroup: %v", err)
+	}
+	if joined || !pending {
+		t.Fatalf("JoinGroup: got joined=%v pending=%v, want a pending request", joined, pending)
+	}
+	if _, _, _, err := s.JoinGroup(ctx, group.ID, "bob"); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second JoinGroup: got %v, want AlreadyExists", err)
+	}
+	if _, _, _, err := s.GetGroupPosts(ctx, group.ID, "bob", 1, 10); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetGroupPosts while pending: got %v, want PermissionDenied", err)
+	}
+
+	// Only admins see and review requests
+	if _, _, _, err := s.GetJoinRequests(ctx, group.ID, "bob", 1, 10); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetJoinRequests by requester: got %v, want PermissionDenied", err)
+	}
+	requests, count, _, err := s.GetJoinRequests(ctx, group.ID, "alice", 1, 10)
+	if err != nil {
+		t.Fatalf("GetJoinRequests: %v", err)
+	}
+	if count != 1 || len(requests) != 1 || requests[0].UserID != "bob" {
+		t.Fatalf("GetJoinRequests: got %d requests, want bob's", count)
+	}
+	if _, err := s.ApproveJoinRequest(ctx, group.ID, "bob", requests[0].ID); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ApproveJoinRequest by requester: got %v, want PermissionDenied", err)
+	}
+
+	// Approval makes the requester a member who can read the group
+	approved, err := s.ApproveJoinRequest(ctx, group.ID, "alice", requests[0].ID)
+	if err != nil {
+		t.Fatalf("ApproveJoinRequest: %v", err)
+	}
+	if approved.Status != "approved" || approved.ReviewedBy != "alice" {
+		t.Errorf("ApproveJoinRequest: got status %q reviewed by %q", approved.Status, approved.ReviewedBy)
+	}
+	if role := s.roleOf(t, group.ID, "bob"); role != "member" {
+		t.Errorf("bob is %q after approval, want member", role)
+	}
+	if posts, _, _, err := s.GetGroupPosts(ctx, group.ID, "bob", 1, 10); err != nil || len(posts) != 1 {
+		t.Errorf("GetGroupPosts after approval: got %d posts, %v", len(posts), err)
+	}
+
+	// A request is reviewed once
+	if _, err := s.ApproveJoinRequest(ctx, group.ID, "alice", requests[0].ID); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("second ApproveJoinRequest: got %v, want FailedPrecondition", err)
+	}
+	if _, count, _, _ = s.GetJoinRequests(ctx, group.ID, "alice", 1, 10); count != 0 {
+		t.Errorf("GetJoinRequests after approval: got %d requests, want 0", count)
+	}
+}
+
+func TestRejectedJoinRequestAddsNoMember(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "private")
+	other := s.createGroup(t, "mallory", "private")
+
+	if _, _, _, err := s.JoinGroup(ctx, group.ID, "carol"); err != nil {
+		t.Fatalf("JoinGroup: %v", err)
+	}
+	requests, _, _, err := s.GetJoinRequests(ctx, group.ID, "alice", 1, 10)
+	if err != nil || len(requests) != 1 {
+		t.Fatalf("GetJoinRequests: got %d requests, %v", len(requests), err)
+	}
+
+	// The admin of another group cannot review it through their own group
+	if _, err := s.ApproveJoinRequest(ctx, other.ID, "mallory", requests[0].ID); status.Code(err) != codes.NotFound {
+		t.Errorf("ApproveJoinRequest through another group: got %v, want NotFound", err)
+	}
+
+	rejected, err := s.RejectJoinRequest(ctx, group.ID, "alice", requests[0].ID)
+	if err != nil {
+		t.Fatalf("RejectJoinRequest: %v", err)
+	}
+	if rejected.Status != "rejected" {
+		t.Errorf("RejectJoinRequest: got status %q, want rejected", rejected.Status)
+	}
+	if isMember, err := s.repo.IsMember(ctx, group.ID, "carol"); err != nil || isMember {
+		t.Errorf("carol is a member after rejection: %v, %v", isMember, err)
+	}
+}
+
+func TestJoiningPublicGroupIsImmediate(t *testing.T) {
+	s := newTestService(t)
+	group := s.createGroup(t, "alice", "public")
+
+	joined, pending, count, err := s.JoinGroup(context.Background(), group.ID, "bob")
+	if err != nil {
+		t.Fatalf("JoinGroup: %v", err)
+	}
+	if !joined || pending || count != 2 {
+		t.Errorf("JoinGroup: got joined=%v pending=%v count=%d, want joined with 2 members", joined, pending, count)
+	}
+}
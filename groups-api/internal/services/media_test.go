@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGroupPostsWithInvalidMediaAreRefused(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "alice", "public")
+
+	tooMany := make([]string, defaultMaxMediaCount+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("https://cdn.example.com/%d.png", i)
+	}
+	tests := []struct {
+		name  string
+		media []string
+		want  string
+	}{
+		{"too many", tooMany, "at most"},
+		{"malformed", []string{"cdn.example.com/a.png"}, "not a valid http(s) URL"},
+		{"empty", []string{"https://cdn.example.com/a.png", ""}, "media 2: URL is empty"},
+		{"longer than the column", []string{"https://cdn.example.com/" + strings.Repeat("a", maxMediaURLLength)}, "longer than 255 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.CreateGroupPost(ctx, group.ID, "alice", "hello", tt.media)
+			if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want InvalidArgument mentioning %q", err, tt.want)
+			}
+		})
+	}
+
+	post, err := s.CreateGroupPost(ctx, group.ID, "alice", "hello", []string{"https://cdn.example.com/a.png"})
+	if err != nil {
+		t.Fatalf("CreateGroupPost: %v", err)
+	}
+	if _, err := s.UpdateGroupPost(ctx, group.ID, post.ID, "alice", "hello", []string{"not a url"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateGroupPost with malformed media: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roleOf returns the role of a user in a group
+func (s *testService) roleOf(t *testing.T, groupID, userID string) string {
+	t.Helper()
+
+	member, err := s.repo.GetMemberByID(context.Background(), groupID, userID)
+	if err != nil {
+		t.Fatalf("failed to get member %s: %v", userID, err)
+	}
+	return member.Role
+}
+
+func TestPromoteAndDemoteMembers(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "creator", "public", "alice", "bob", "carol")
+
+	// The creator can grant and revoke admin
+	if _, err := s.PromoteMember(ctx, group.ID, "creator", "alice"); err != nil {
+		t.Fatalf("PromoteMember by creator: %v", err)
+	}
+	if role := s.roleOf(t, group.ID, "alice"); role != "admin" {
+		t.Fatalf("alice is %q after promotion, want admin", role)
+	}
+	if _, err := s.PromoteMember(ctx, group.ID, "creator", "bob"); err != nil {
+		t.Fatalf("PromoteMember by creator: %v", err)
+	}
+	if _, err := s.DemoteMember(ctx, group.ID, "creator", "bob"); err != nil {
+		t.Fatalf("DemoteMember by creator: %v", err)
+	}
+	if role := s.roleOf(t, group.ID, "bob"); role != "member" {
+		t.Errorf("bob is %q after demotion, want member", role)
+	}
+}
+
+func TestMemberRolePermissionBoundaries(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "creator", "public", "admin", "other-admin", "alice", "bob")
+	for _, id := range []string{"admin", "other-admin"} {
+		if _, err := s.PromoteMember(ctx, group.ID, "creator", id); err != nil {
+			t.Fatalf("PromoteMember: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		change   func(ctx context.Context, groupID, userID, memberID string) error
+		userID   string
+		memberID string
+		groupID  string
+		want     codes.Code
+	}{
+		{"admin promotes member to admin", s.promote, "admin", "alice", "", codes.PermissionDenied},
+		{"admin demotes another admin", s.demote, "admin", "other-admin", "", codes.PermissionDenied},
+		{"member promotes another member", s.promote, "alice", "bob", "", codes.PermissionDenied},
+		{"member promotes themselves", s.promote, "alice", "alice", "", codes.PermissionDenied},
+		{"admin demotes themselves", s.demote, "admin", "admin", "", codes.PermissionDenied},
+		{"admin demotes the creator", s.demote, "admin", "creator", "", codes.PermissionDenied},
+		{"creator demotes themselves", s.demote, "creator", "creator", "", codes.PermissionDenied},
+		{"non-member promotes a member", s.promote, "stranger", "alice", "", codes.PermissionDenied},
+		{"creator promotes a non-member", s.promote, "creator", "stranger", "", codes.NotFound},
+		{"creator promotes in a missing group", s.promote, "creator", "alice", "missing", codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupID := tt.groupID
+			if groupID == "" {
+				groupID = group.ID
+			}
+			if err := tt.change(ctx, groupID, tt.userID, tt.memberID); status.Code(err) != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// None of the refused changes went through
+	for id, want := range map[string]string{"creator": "creator", "admin": "admin", "other-admin": "admin", "alice": "member", "bob": "member"} {
+		if role := s.roleOf(t, group.ID, id); role != want {
+			t.Errorf("%s is %q, want %q", id, role, want)
+		}
+	}
+}
+
+func (s *testService) promote(ctx context.Context, groupID, userID, memberID string) error {
+	_, err := s.PromoteMember(ctx, groupID, userID, memberID)
+	return err
+}
+
+func (s *testService) demote(ctx context.Context, groupID, userID, memberID string) error {
+	_, err := s.DemoteMember(ctx, groupID, userID, memberID)
+	return err
+}
+
+func TestTransferOwnershipPermissions(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "creator", "public", "admin", "alice")
+	if _, err := s.PromoteMember(ctx, group.ID, "creator", "admin"); err != nil {
+		t.Fatalf("PromoteMember: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		groupID      string
+		userID       string
+		newCreatorID string
+		want         codes.Code
+	}{
+		{"admin transfers the group", "", "admin", "alice", codes.PermissionDenied},
+		{"member transfers the group to themselves", "", "alice", "alice", codes.InvalidArgument},
+		{"member transfers the group to another member", "", "alice", "admin", codes.PermissionDenied},
+		{"non-member transfers the group", "", "stranger", "alice", codes.PermissionDenied},
+		{"creator transfers to themselves", "", "creator", "creator", codes.InvalidArgument},
+		{"creator transfers to a non-member", "", "creator", "stranger", codes.FailedPrecondition},
+		{"creator transfers to nobody", "", "creator", "", codes.InvalidArgument},
+		{"creator transfers a missing group", "missing", "creator", "alice", codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupID := tt.groupID
+			if groupID == "" {
+				groupID = group.ID
+			}
+			if _, err := s.TransferOwnership(ctx, groupID, tt.userID, tt.newCreatorID); status.Code(err) != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// None of the refused transfers went through
+	for id, want := range map[string]string{"creator": "creator", "admin": "admin", "alice": "member"} {
+		if role := s.roleOf(t, group.ID, id); role != want {
+			t.Errorf("%s is %q, want %q", id, role, want)
+		}
+	}
+	if stored, err := s.repo.GetGroupByID(ctx, group.ID); err != nil || stored.CreatorID != "creator" {
+		t.Errorf("group creator is %v (%v), want creator", stored, err)
+	}
+}
+
+func TestTransferOwnershipLetsFormerCreatorLeave(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	group := s.createGroup(t, "creator", "public", "alice")
+
+	if _, _, err := s.LeaveGroup(ctx, group.ID, "creator"); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("creator leaving before the transfer: got %v, want FailedPrecondition", err)
+	}
+
+	if _, err := s.TransferOwnership(ctx, group.ID, "creator", "alice"); err != nil {
+		t.Fatalf("TransferOwnership: %v", err)
+	}
+	if role := s.roleOf(t, group.ID, "alice"); role != "creator" {
+		t.Errorf("alice is %q after the transfer, want creator", role)
+	}
+	if role := s.roleOf(t, group.ID, "creator"); role != "admin" {
+		t.Errorf("former creator is %q after the transfer, want admin", role)
+	}
+	stored, err := s.repo.GetGroupByID(ctx, group.ID)
+	if err != nil {
+		t.Fatalf("GetGroupByID: %v", err)
+	}
+	if stored.CreatorID != "alice" {
+		t.Errorf("group creator is %q, want alice", stored.CreatorID)
+	}
+
+	if _, _, err := s.LeaveGroup(ctx, group.ID, "creator"); err != nil {
+		t.Errorf("former creator leaving: %v", err)
+	}
+}
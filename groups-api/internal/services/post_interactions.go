@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"groups-api/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// LikeGroupPost likes a post in a group on behalf of a member and returns the post's likes count.
+// Liking a post the user already likes changes nothing.
+func (s *groupService) LikeGroupPost(ctx context.Context, groupID, postID, userID string) (int64, error) {
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return 0, err
+	}
+	if err := s.requireMember(ctx, groupID, userID, "only group members can like posts in this group"); err != nil {
+		return 0, err
+	}
+	if _, err := s.getGroupPost(ctx, groupID, postID); err != nil {
+		return 0, err
+	}
+
+	liked, err := s.repo.IsPostLiked(ctx, postID, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check if post is liked", err)
+		return 0, status.Error(codes.Internal, "failed to like post")
+	}
+	if !liked {
+		if err := s.repo.LikePost(ctx, &models.GroupPostLike{PostID: postID, UserID: userID}); err != nil {
+			s.logger.WithContext(ctx).Error("Failed to like post", err)
+			return 0, status.Error(codes.Internal, "failed to like post")
+		}
+	}
+
+	return s.countPostLikes(ctx, postID)
+}
+
+// UnlikeGroupPost removes the user's like from a post in a group and returns the post's likes count.
+// Former members can still take back their likes.
+func (s *groupService) UnlikeGroupPost(ctx context.Context, groupID, postID, userID string) (int64, error) {
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return 0, err
+	}
+	if _, err := s.getGroupPost(ctx, groupID, postID); err != nil {
+		return 0, err
+	}
+
+	if err := s.repo.UnlikePost(ctx, postID, userID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to unlike post", err)
+		return 0, status.Error(codes.Internal, "failed to unlike post")
+	}
+
+	return s.countPostLikes(ctx, postID)
+}
+
+// AddGroupPostComment adds a member's comment to a post in a group
+func (s *groupService) AddGroupPostComment(ctx context.Context, groupID, postID, userID, content string) (*models.GroupPostComment, error) {
+	// Validate input
+	content, err := validateContent(content, s.maxCommentLength, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	if err := s.requireMember(ctx, groupID, userID, "only group members can comment on posts in this group"); err != nil {
+		return nil, err
+	}
+	if _, err := s.getGroupPost(ctx, groupID, postID); err != nil {
+		return nil, err
+	}
+
+	comment := &models.GroupPostComment{
+		PostID:  postID,
+		UserID:  userID,
+		Content: content,
+	}
+	if err := s.repo.CreateComment(ctx, comment); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create comment", err)
+		return nil, status.Error(codes.Internal, "failed to add comment")
+	}
+
+	return comment, nil
+}
+
+// GetGroupPostComments gets the comments on a post in a group with pagination, oldest first
+func (s *groupService) GetGroupPostComments(ctx context.Context, groupID, postID, userID string, page, limit int) ([]*models.GroupPostComment, int64, int32, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	// Comments are visible to whoever can see the group's posts
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if err := s.requireGroupVisible(ctx, group, userID); err != nil {
+		return nil, 0, 0, err
+	}
+	if _, err := s.getGroupPost(ctx, groupID, postID); err != nil {
+		return nil, 0, 0, err
+	}
+
+	comments, count, err := s.repo.GetPostComments(ctx, postID, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post comments", err)
+		return nil, 0, 0, status.Error(codes.Internal, "failed to get comments")
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return comments, count, totalPages, nil
+}
+
+// attachPostStats sets the likes and comments counts of posts and whether the user liked them,
+// with one query each for the whole page. Failures are logged and leave the values unset.
+func (s *groupService) attachPostStats(ctx context.Context, posts []*models.GroupPost, userID string) {
+	if len(posts) == 0 {
+		return
+	}
+
+	postIDs := make([]string, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+	}
+
+	likes, err := s.repo.CountPostLikes(ctx, postIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count post likes", err)
+	}
+	comments, err := s.repo.CountPostComments(ctx, postIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count post comments", err)
+	}
+	liked, err := s.repo.ArePostsLiked(ctx, postIDs, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check liked posts", err)
+	}
+
+	for _, post := range posts {
+		post.LikesCount = likes[post.ID]
+		post.CommentsCount = comments[post.ID]
+		post.IsLiked = liked[post.ID]
+	}
+}
+
+// countPostLikes counts the likes of a single post
+func (s *groupService) countPostLikes(ctx context.Context, postID string) (int64, error) {
+	counts, err := s.repo.CountPostLikes(ctx, []string{postID})
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count post likes", err)
+		return 0, status.Error(codes.Internal, "failed to count likes")
+	}
+	return counts[postID], nil
+}
+
+// getGroupPost loads a post of a group, reporting a missing one, or one of another group, as not found
+func (s *groupService) getGroupPost(ctx context.Context, groupID, postID string) (*models.GroupPost, error) {
+	post, err := s.repo.GetPostByID(ctx, postID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && post.GroupID != groupID) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return nil, status.Error(codes.Internal, "failed to get post")
+	}
+	return post, nil
+}
+
+// requireMember checks that the user is a member of the group, denying permission with message otherwise
+func (s *groupService) requireMember(ctx context.Context, groupID, userID, message string) error {
+	isMember, err := s.repo.IsMember(ctx, groupID, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check if user is a member", err)
+		return status.Error(codes.Internal, "failed to check membership")
+	}
+	if !isMember {
+		return status.Error(codes.PermissionDenied, message)
+	}
+	return nil
+}
+
+// requirePostModerator checks that the user wrote the post or is the creator or an admin of its group,
+// denying permission with message otherwise
+func (s *groupService) requirePostModerator(ctx context.Context, post *models.GroupPost, userID, message string) error {
+	if userID != "" && post.AuthorID == userID {
+		return nil
+	}
+
+	member, err := s.repo.GetMemberByID(ctx, post.GroupID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Error(codes.PermissionDenied, message)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get member", err)
+		return status.Error(codes.Internal, "failed to check membership")
+	}
+	if member.Role != "creator" && member.Role != "admin" {
+		return status.Error(codes.PermissionDenied, message)
+	}
+	return nil
+}
+
+// requireGroupVisible checks that the user can see the content of the group. Public groups are
+// open to everyone, including anonymous users, private groups only to their members.
+func (s *groupService) requireGroupVisible(ctx context.Context, group *models.Group, userID string) error {
+	if group.Visibility != "private" {
+		return nil
+	}
+	if userID == "" {
+		return status.Error(codes.PermissionDenied, "not a member of this group")
+	}
+	return s.requireMember(ctx, group.ID, userID, "not a member of this group")
+}
\ No newline at end of file
@@ -1,19 +1,51 @@
 package logger
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+const (
+	// RequestIDKey is the context key the request ID of the current call is stored under
+	RequestIDKey = "requestID"
+
+	// ContextKey is the context key the request-scoped logger is stored under
+	ContextKey = "logger"
+)
+
+// defaultSamplingTick is the sampling window used when none is configured
+const defaultSamplingTick = time.Second
+
+// RotationConfig controls the rotation of the log file. Zero values keep the lumberjack
+// defaults: rotate at 100 megabytes and keep every rotated file.
+type RotationConfig struct {
+	MaxSize    int // Megabytes
+	MaxAge     int // Days
+	MaxBackups int
+	Compress   bool
+}
+
+// SamplingConfig throttles repeated entries. Of the entries with the same level and message
+// written within a tick, the first Initial are logged and then every Thereafter-th one.
+// Sampling is disabled when Initial is zero.
+type SamplingConfig struct {
+	Tick       time.Duration
+	Initial    int
+	Thereafter int
+}
+
 // Logger is a wrapper around zap.Logger
 type Logger struct {
 	*zap.Logger
 }
 
 // NewLogger creates a new logger
-func NewLogger(level, format, output, file string) (*Logger, error) {
+func NewLogger(level, format, output, file string, rotation RotationConfig, sampling SamplingConfig) (*Logger, error) {
 	var logLevel zapcore.Level
 	if err := logLevel.UnmarshalText([]byte(level)); err != nil {
 		logLevel = zapcore.InfoLevel
@@ -31,16 +63,25 @@ func NewLogger(level, format, output, file string) (*Logger, error) {
 
 	var writeSyncer zapcore.WriteSyncer
 	if output == "file" && file != "" {
-		f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-		if err != nil {
-			return nil, err
-		}
-		writeSyncer = zapcore.AddSync(f)
+		writeSyncer = zapcore.AddSync(&lumberjack.Logger{
+			Filename:   file,
+			MaxSize:    rotation.MaxSize,
+			MaxAge:     rotation.MaxAge,
+			MaxBackups: rotation.MaxBackups,
+			Compress:   rotation.Compress,
+		})
 	} else {
 		writeSyncer = zapcore.AddSync(os.Stdout)
 	}
 
 	core := zapcore.NewCore(encoder, writeSyncer, logLevel)
+	if sampling.Initial > 0 {
+		tick := sampling.Tick
+		if tick <= 0 {
+			tick = defaultSamplingTick
+		}
+		core = zapcore.NewSamplerWithOptions(core, tick, sampling.Initial, sampling.Thereafter)
+	}
 	zapLogger := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1))
 
 	return &Logger{zapLogger}, nil
@@ -85,4 +126,24 @@ func (l *Logger) With(fields ...zap.Field) *Logger {
 // Field creates a field for structured logging
 func Field(key string, value interface{}) zap.Field {
 	return zap.Any(key, value)
+}
+
+// NewContext returns a copy of ctx that carries l as the request-scoped logger
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, ContextKey, l)
+}
+
+// WithContext returns the request-scoped logger carried by ctx, which the server interceptors
+// tag with the request ID, RPC method and user ID. Outside of a request it falls back to l,
+// tagged with the request ID if ctx has one.
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	if scoped, ok := ctx.Value(ContextKey).(*Logger); ok {
+		return scoped
+	}
+
+	requestID, ok := ctx.Value(RequestIDKey).(string)
+	if !ok || requestID == "" {
+		return l
+	}
+	return l.With(zap.String("request_id", requestID))
 }
\ No newline at end of file
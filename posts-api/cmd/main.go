@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"post-api/internal/clients"
 	"post-api/internal/config"
 	"post-api/internal/controllers"
 	"post-api/internal/middleware"
@@ -13,8 +14,11 @@ import (
 	"post-api/internal/services"
 	"post-api/internal/utils/logger"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -28,7 +32,8 @@ func main() {
 	}
 
 	// Initialize logger
-	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output, cfg.Logging.File)
+	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output, cfg.Logging.File,
+		logger.RotationConfig(cfg.Logging.Rotation), logger.SamplingConfig(cfg.Logging.Sampling))
 	if err != nil {
 		fmt.Printf("Failed to initialize logger: %v\n", err)
 		os.Exit(1)
@@ -37,8 +42,8 @@ func main() {
 
 	log.Info("Starting Post API")
 
-	// Connect to database
-	db, err := gorm.Open(mysql.Open(cfg.Database.GetDSN()), &gorm.Config{})
+	// Connect to database, waiting for it to come up
+	db, err := connectDatabase(cfg.Database, log)
 	if err != nil {
 		log.Fatal("Failed to connect to database", err)
 	}
@@ -52,28 +57,103 @@ func main() {
 	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
 	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
 
+	// Create missing tables and columns
+	if cfg.Database.AutoMigrate {
+		tables, err := repository.AutoMigrate(db)
+		if err != nil {
+			log.Fatal("Failed to migrate database", err)
+		}
+		log.Info("Migrated database", "tables", tables)
+	}
+
+	// Limit how long a statement may run, after the migrations so that they are not limited
+	if cfg.Database.QueryTimeout > 0 {
+		if err := db.Use(repository.NewQueryTimeout(cfg.Database.QueryTimeout)); err != nil {
+			log.Fatal("Failed to register query timeout", err)
+		}
+		log.Info("Query timeout enabled", "timeout", cfg.Database.QueryTimeout.String())
+	}
+
 	// Initialize repositories
 	postRepo := repository.NewPostRepository(db)
 	commentRepo := repository.NewCommentRepository(db)
 	likeRepo := repository.NewLikeRepository(db)
+	hashtagRepo := repository.NewHashtagRepository(db)
+	mentionRepo := repository.NewMentionRepository(db)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	idempotencyRepo := repository.NewIdempotencyRepository(db)
+
+	// Initialize clients for other services
+	userClient, err := clients.NewUserClient(cfg.Services.UsersServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to users service", err)
+	}
+	userClient = clients.NewCachedUserClient(userClient, cfg.Services.UserCache.Size, cfg.Services.UserCache.TTL)
+	groupClient, err := clients.NewGroupClient(cfg.Services.GroupsServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to groups service", err)
+	}
+	friendClient, err := clients.NewFriendClient(cfg.Services.FriendsServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to friends service", err)
+	}
+	notificationClient, err := clients.NewNotificationClient(cfg.Services.UsersServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to notification service", err)
+	}
 
 	// Initialize services
-	postService := services.NewPostService(postRepo, commentRepo, likeRepo, log)
+	postService := services.NewPostService(
+		postRepo,
+		commentRepo,
+		likeRepo,
+		hashtagRepo,
+		mentionRepo,
+		bookmarkRepo,
+		reportRepo,
+		idempotencyRepo,
+		userClient,
+		groupClient,
+		friendClient,
+		services.NewNotificationMentionNotifier(notificationClient, log),
+		notificationClient,
+		cfg.Comments.MaxDepth,
+		cfg.Posts.RestoreWindow,
+		cfg.Posts.IdempotencyTTL,
+		services.MediaLimits{
+			MaxCount:        cfg.Posts.Media.MaxCount,
+			MaxLength:       cfg.Posts.Media.MaxLength,
+			AllowedPrefixes: cfg.Posts.Media.AllowedPrefixes,
+		},
+		services.ContentLimits{
+			MaxPostLength:    cfg.Posts.MaxContentLength,
+			MaxCommentLength: cfg.Comments.MaxContentLength,
+		},
+		log,
+	)
 
 	// Initialize controllers
 	postController := controllers.NewPostController(postService, log)
 
-	// Initialize auth interceptor
+	// Initialize interceptors
+	requestIDInterceptor := middleware.NewRequestIDInterceptor(log)
 	authInterceptor := middleware.NewAuthInterceptor(cfg.JWT.Secret, log)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainUnaryInterceptor(requestIDInterceptor.Unary(), authInterceptor.Unary()),
 	)
 
 	// Register services
 	pb.RegisterPostServiceServer(grpcServer, postController)
 
+	// Register health service; the database is connected at this point
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("posts.PostService", healthpb.HealthCheckResponse_SERVING)
+
 	// Start gRPC server
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port))
 	if err != nil {
@@ -93,6 +173,55 @@ func main() {
 	<-quit
 
 	log.Info("Shutting down server...")
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
 	log.Info("Server exited properly")
 }
+
+const (
+	// defaultDBConnectAttempts is how many times the database connection is tried at startup
+	defaultDBConnectAttempts = 10
+
+	// defaultDBConnectMaxDelay caps the wait between two connection attempts
+	defaultDBConnectMaxDelay = 30 * time.Second
+
+	// dbConnectInitialDelay is the wait after the first failed attempt, doubled after each failure
+	dbConnectInitialDelay = time.Second
+)
+
+// connectDatabase connects to the database, retrying with exponential backoff since the
+// database may still be starting alongside the service
+func connectDatabase(cfg config.DatabaseConfig, log *logger.Logger) (*gorm.DB, error) {
+	attempts := cfg.ConnectAttempts
+	if attempts <= 0 {
+		attempts = defaultDBConnectAttempts
+	}
+	maxDelay := cfg.ConnectMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultDBConnectMaxDelay
+	}
+
+	delay := dbConnectInitialDelay
+	for attempt := 1; ; attempt++ {
+		// gorm.Open pings the database, so this fails until it accepts connections
+		db, err := gorm.Open(mysql.Open(cfg.GetDSN()), &gorm.Config{})
+		if err == nil {
+			log.Info("Connected to database", "attempt", attempt)
+			return db, nil
+		}
+		if attempt >= attempts {
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempts, err)
+		}
+
+		log.Warn("Failed to connect to database",
+			"attempt", attempt,
+			"retry_in", delay.String(),
+			"error", err)
+		time.Sleep(delay)
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
@@ -0,0 +1,4 @@
+ALTER TABLE comments
+    DROP INDEX idx_comments_parent_comment_id,
+    DROP COLUMN depth,
+    DROP COLUMN parent_comment_id;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE comments
+    ADD COLUMN parent_comment_id VARCHAR(36) NULL AFTER post_id,
+    ADD COLUMN depth INT NOT NULL DEFAULT 0 AFTER parent_comment_id,
+    ADD INDEX idx_comments_parent_comment_id (parent_comment_id);
\ No newline at end of file
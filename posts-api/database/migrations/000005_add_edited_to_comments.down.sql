@@ -0,0 +1,2 @@
+ALTER TABLE comments
+    DROP COLUMN edited;
\ No newline at end of file
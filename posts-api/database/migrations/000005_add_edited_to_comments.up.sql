@@ -0,0 +1,2 @@
+ALTER TABLE comments
+    ADD COLUMN edited BOOLEAN NOT NULL DEFAULT FALSE AFTER content;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE likes
+    DROP INDEX idx_likes_post_reaction,
+    DROP COLUMN reaction_type;
\ No newline at end of file
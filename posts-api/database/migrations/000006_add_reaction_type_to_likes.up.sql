@@ -0,0 +1,3 @@
+ALTER TABLE likes
+    ADD COLUMN reaction_type VARCHAR(16) NOT NULL DEFAULT 'like' AFTER user_id,
+    ADD INDEX idx_likes_post_reaction (post_id, reaction_type);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS post_hashtags;
\ No newline at end of file
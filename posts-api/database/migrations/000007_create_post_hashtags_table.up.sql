@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS post_hashtags (
+    id VARCHAR(36) PRIMARY KEY,
+    post_id VARCHAR(36) NOT NULL,
+    tag VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_post_hashtags_tag (tag),
+    UNIQUE INDEX idx_post_hashtags_post_tag (post_id, tag),
+    CONSTRAINT fk_post_hashtags_post FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
\ No newline at end of file
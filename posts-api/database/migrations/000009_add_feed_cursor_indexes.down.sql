@@ -0,0 +1,2 @@
+DROP INDEX idx_posts_visibility_created_at_id ON posts;
+DROP INDEX idx_posts_created_at_id ON posts;
\ No newline at end of file
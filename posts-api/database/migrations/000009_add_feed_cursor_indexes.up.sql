@@ -0,0 +1,2 @@
+CREATE INDEX idx_posts_created_at_id ON posts (created_at, id);
+CREATE INDEX idx_posts_visibility_created_at_id ON posts (visibility, created_at, id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS post_bookmarks;
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS post_bookmarks (
+    id VARCHAR(36) PRIMARY KEY,
+    post_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_post_bookmarks_post_user (post_id, user_id),
+    INDEX idx_post_bookmarks_user_created_at (user_id, created_at),
+    CONSTRAINT fk_post_bookmarks_post FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
\ No newline at end of file
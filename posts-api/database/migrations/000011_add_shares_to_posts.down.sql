@@ -0,0 +1,5 @@
+ALTER TABLE posts
+    DROP FOREIGN KEY fk_posts_original_post,
+    DROP INDEX idx_posts_original_post_id,
+    DROP COLUMN shares_count,
+    DROP COLUMN original_post_id;
\ No newline at end of file
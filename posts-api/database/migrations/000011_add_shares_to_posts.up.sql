@@ -0,0 +1,5 @@
+ALTER TABLE posts
+    ADD COLUMN original_post_id VARCHAR(36) NULL AFTER group_name,
+    ADD COLUMN shares_count INT NOT NULL DEFAULT 0 AFTER comments_count,
+    ADD INDEX idx_posts_original_post_id (original_post_id),
+    ADD CONSTRAINT fk_posts_original_post FOREIGN KEY (original_post_id) REFERENCES posts(id) ON DELETE SET NULL;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS post_reports (
+    id VARCHAR(36) PRIMARY KEY,
+    post_id VARCHAR(36) NOT NULL,
+    reporter_id VARCHAR(36) NOT NULL,
+    reason VARCHAR(16) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'open',
+    resolved_by VARCHAR(36) NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_post_reports_post_reporter (post_id, reporter_id),
+    INDEX idx_post_reports_status_created_at (status, created_at),
+    CONSTRAINT fk_post_reports_post FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
\ No newline at end of file
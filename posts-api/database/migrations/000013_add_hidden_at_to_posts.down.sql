@@ -0,0 +1,2 @@
+ALTER TABLE posts
+    DROP COLUMN hidden_at;
\ No newline at end of file
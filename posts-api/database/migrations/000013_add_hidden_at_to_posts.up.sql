@@ -0,0 +1,2 @@
+ALTER TABLE posts
+    ADD COLUMN hidden_at TIMESTAMP NULL AFTER updated_at;
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id VARCHAR(36) NOT NULL,
+    operation VARCHAR(16) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    resource_id VARCHAR(36) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, operation, idempotency_key),
+    INDEX idx_idempotency_keys_created_at (created_at)
+);
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Friends-only posts fall back to 'private', which included the author's friends before
+UPDATE posts SET visibility = 'private' WHERE visibility = 'friends';
+
+ALTER TABLE posts
+    MODIFY COLUMN visibility ENUM('public', 'private') NOT NULL DEFAULT 'public';
\ No newline at end of file
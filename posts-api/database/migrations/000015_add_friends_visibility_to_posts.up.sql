@@ -0,0 +1,6 @@
+-- 'private' used to include the author's friends; it now means the author only, so existing
+-- private posts become friends-only to keep who can see them
+ALTER TABLE posts
+    MODIFY COLUMN visibility ENUM('public', 'friends', 'private') NOT NULL DEFAULT 'public';
+
+UPDATE posts SET visibility = 'friends' WHERE visibility = 'private';
\ No newline at end of file
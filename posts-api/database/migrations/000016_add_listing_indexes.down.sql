@@ -0,0 +1,3 @@
+DROP INDEX idx_comments_post_id_created_at ON comments;
+DROP INDEX idx_posts_group_id_created_at ON posts;
+DROP INDEX idx_posts_author_id_created_at ON posts;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Listings by author, by group and of a post's comments filter on one column and order by
+-- created_at, so the composite indexes serve both without sorting
+CREATE INDEX idx_posts_author_id_created_at ON posts (author_id, created_at);
+CREATE INDEX idx_posts_group_id_created_at ON posts (group_id, created_at);
+CREATE INDEX idx_comments_post_id_created_at ON comments (post_id, created_at);
\ No newline at end of file
@@ -0,0 +1,108 @@
+package clients
+
+import (
+	pb "common/pb/common/proto/friends"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// FriendshipInfo holds the relationship between two users
+type FriendshipInfo struct {
+	AreFriends bool
+	Status     string
+}
+
+// FriendClient defines the interface for looking up friendships in the friends service
+type FriendClient interface {
+	// CheckFriendship reports whether two users are friends, along with the status of their relationship
+	CheckFriendship(ctx context.Context, userID, friendID string) (*FriendshipInfo, error)
+
+	// GetFollowingIDs retrieves the IDs of the users a user follows
+	GetFollowingIDs(ctx context.Context, userID string) ([]string, error)
+
+	// GetBlockedIDs retrieves the IDs of the users a user has blocked or been blocked by
+	GetBlockedIDs(ctx context.Context, userID string) ([]string, error)
+
+	// GetFriendIDs retrieves the IDs of the friends of the caller of the current request
+	GetFriendIDs(ctx context.Context) ([]string, error)
+}
+
+// friendClient implements the FriendClient interface over gRPC
+type friendClient struct {
+	client pb.FriendServiceClient
+}
+
+// NewFriendClient creates a new client for the friends service
+func NewFriendClient(friendsServiceURL string) (FriendClient, error) {
+	conn, err := grpc.Dial(friendsServiceURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(propagateRequestID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &friendClient{
+		client: pb.NewFriendServiceClient(conn),
+	}, nil
+}
+
+// CheckFriendship reports whether two users are friends, along with the status of their relationship
+func (c *friendClient) CheckFriendship(ctx context.Context, userID, friendID string) (*FriendshipInfo, error) {
+	resp, err := c.client.CheckFriendship(ctx, &pb.CheckFriendshipRequest{
+		UserId:   userID,
+		FriendId: friendID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &FriendshipInfo{
+		AreFriends: resp.AreFriends,
+		Status:     resp.Status,
+	}, nil
+}
+
+// GetFollowingIDs retrieves the IDs of the users a user follows
+func (c *friendClient) GetFollowingIDs(ctx context.Context, userID string) ([]string, error) {
+	resp, err := c.client.GetFollowingIDs(ctx, &pb.GetFollowingIDsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.UserIds, nil
+}
+
+// GetBlockedIDs retrieves the IDs of the users a user has blocked or been blocked by
+func (c *friendClient) GetBlockedIDs(ctx context.Context, userID string) ([]string, error) {
+	resp, err := c.client.GetBlockedIDs(ctx, &pb.GetBlockedIDsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.UserIds, nil
+}
+
+// GetFriendIDs retrieves the IDs of the friends of the caller of the current request. The
+// caller's token is forwarded, as the friends service takes the user from it.
+func (c *friendClient) GetFriendIDs(ctx context.Context) ([]string, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auth := md.Get("authorization"); len(auth) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth[0])
+		}
+	}
+
+	resp, err := c.client.GetFriendIDs(ctx, &pb.GetFriendIDsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.FriendIds, nil
+}
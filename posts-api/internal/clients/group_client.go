@@ -0,0 +1,61 @@
+package clients
+
+import (
+	pb "common/pb/common/proto/groups"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// MembershipInfo holds a user's membership in a group
+type MembershipInfo struct {
+	IsMember        bool
+	Role            string
+	GroupName       string
+	GroupVisibility string
+}
+
+// GroupClient defines the interface for looking up groups in the groups service
+type GroupClient interface {
+	// CheckMembership reports whether a user belongs to a group, along with the group's name
+	CheckMembership(ctx context.Context, groupID, userID string) (*MembershipInfo, error)
+}
+
+// groupClient implements the GroupClient interface over gRPC
+type groupClient struct {
+	client pb.GroupServiceClient
+}
+
+// NewGroupClient creates a new client for the groups service
+func NewGroupClient(groupsServiceURL string) (GroupClient, error) {
+	conn, err := grpc.Dial(groupsServiceURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(propagateRequestID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupClient{
+		client: pb.NewGroupServiceClient(conn),
+	}, nil
+}
+
+// CheckMembership reports whether a user belongs to a group, along with the group's name
+func (c *groupClient) CheckMembership(ctx context.Context, groupID, userID string) (*MembershipInfo, error) {
+	resp, err := c.client.CheckMembership(ctx, &pb.CheckMembershipRequest{
+		GroupId: groupID,
+		UserId:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MembershipInfo{
+		IsMember:        resp.IsMember,
+		Role:            resp.Role,
+		GroupName:       resp.GroupName,
+		GroupVisibility: resp.GroupVisibility,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingUsers is a users service that counts the calls made to it and the users fetched
+type countingUsers struct {
+	calls   atomic.Int64
+	fetched atomic.Int64
+}
+
+func (u *countingUsers) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*UserInfo, error) {
+	u.calls.Add(1)
+	u.fetched.Add(int64(len(userIDs)))
+	users := make(map[string]*UserInfo, len(userIDs))
+	for _, id := range userIDs {
+		users[id] = &UserInfo{ID: id, Name: "Name of " + id}
+	}
+	return users, nil
+}
+
+func (u *countingUsers) GetUsersByUsernames(ctx context.Context, usernames []string) (map[string]*UserInfo, error) {
+	u.calls.Add(1)
+	return map[string]*UserInfo{}, nil
+}
+
+func TestCachedUserClientServesRepeatedLookupsFromCache(t *testing.T) {
+	next := &countingUsers{}
+	client := NewCachedUserClient(next, 2, time.Minute)
+	ctx := context.Background()
+
+	if _, err := client.GetUsersByIDs(ctx, []string{"a", "b"}); err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	users, err := client.GetUsersByIDs(ctx, []string{"a", "b", "a"})
+	if err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	if len(users) != 2 || users["a"].Name != "Name of a" {
+		t.Errorf("got %v, want users a and b", users)
+	}
+	if got := next.calls.Load(); got != 1 {
+		t.Errorf("users service called %d times, want 1", got)
+	}
+
+	// Caching a third user evicts the least recently used one
+	if _, err := client.GetUsersByIDs(ctx, []string{"a", "c"}); err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	next.calls.Store(0)
+	if _, err := client.GetUsersByIDs(ctx, []string{"a", "c"}); err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	if _, err := client.GetUsersByIDs(ctx, []string{"b"}); err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	if got := next.calls.Load(); got != 1 {
+		t.Errorf("users service called %d times after eviction, want 1 for the evicted user", got)
+	}
+}
+
+func TestCachedUserClientExpiresUsers(t *testing.T) {
+	next := &countingUsers{}
+	client := NewCachedUserClient(next, 10, time.Millisecond)
+	ctx := context.Background()
+
+	if _, err := client.GetUsersByIDs(ctx, []string{"a"}); err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := client.GetUsersByIDs(ctx, []string{"a"}); err != nil {
+		t.Fatalf("GetUsersByIDs: %v", err)
+	}
+	if got := next.calls.Load(); got != 2 {
+		t.Errorf("users service called %d times, want 2", got)
+	}
+}
+
+// BenchmarkUserLookups looks up the authors of pages of 20 posts, whose authors are mostly the
+// same few popular users, and reports the users fetched from the users service per page
+func BenchmarkUserLookups(b *testing.B) {
+	for _, size := range []int{0, 100, 1000} {
+		b.Run(fmt.Sprintf("cache=%d", size), func(b *testing.B) {
+			next := &countingUsers{}
+			client := NewCachedUserClient(next, size, time.Minute)
+			ctx := context.Background()
+
+			rng := rand.New(rand.NewSource(1))
+			authors := rand.NewZipf(rng, 1.1, 1, 10000)
+			page := make([]string, 20)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := range page {
+					page[j] = fmt.Sprintf("user-%d", authors.Uint64())
+				}
+				if _, err := client.GetUsersByIDs(ctx, page); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(next.calls.Load())/float64(b.N), "calls/op")
+			b.ReportMetric(float64(next.fetched.Load())/float64(b.N), "users/op")
+		})
+	}
+}
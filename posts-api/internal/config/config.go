@@ -13,6 +13,8 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	Services ServicesConfig
+	Comments CommentsConfig
+	Posts    PostsConfig
 	Logging  LoggingConfig
 }
 
@@ -36,6 +38,20 @@ type DatabaseConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+
+	// ConnectAttempts is how many times the database connection is tried at startup
+	ConnectAttempts int
+
+	// ConnectMaxDelay caps the exponential backoff between connection attempts
+	ConnectMaxDelay time.Duration
+
+	// AutoMigrate creates missing tables and columns on startup; disable it where the
+	// SQL migrations are run instead
+	AutoMigrate bool
+
+	// QueryTimeout cancels a statement that runs longer, so that a slow query cannot hold a
+	// connection for as long as the request lives; statements are not limited when zero
+	QueryTimeout time.Duration
 }
 
 // JWTConfig holds JWT-related configuration
@@ -49,14 +65,87 @@ type ServicesConfig struct {
 	UsersServiceURL   string
 	FriendsServiceURL string
 	GroupsServiceURL  string
+
+	// UserCache caches the names and avatars of the users looked up in the users service
+	UserCache UserCacheConfig
+}
+
+// UserCacheConfig holds the settings of the cache of users looked up in the users service
+type UserCacheConfig struct {
+	// Size is how many users are cached; users are not cached when zero
+	Size int
+
+	// TTL is how long a cached user is kept, 30 seconds when zero
+	TTL time.Duration
+}
+
+// CommentsConfig holds comment-related configuration
+type CommentsConfig struct {
+	MaxDepth int
+
+	// MaxContentLength is the maximum length of a comment in characters
+	MaxContentLength int
+}
+
+// PostsConfig holds post-related configuration
+type PostsConfig struct {
+	// RestoreWindow is how long a deleted post can be restored before it is purged
+	RestoreWindow time.Duration
+
+	// MaxContentLength is the maximum length of a post in characters
+	MaxContentLength int
+
+	// IdempotencyTTL is how long an Idempotency-Key of a created post or comment is remembered
+	IdempotencyTTL time.Duration
+
+	// Media limits the media URLs attached to a post
+	Media MediaConfig
+}
+
+// MediaConfig holds the limits for media attached to posts
+type MediaConfig struct {
+	MaxCount        int
+	MaxLength       int
+	AllowedPrefixes []string
 }
 
 // LoggingConfig holds logging-related configuration
 type LoggingConfig struct {
-	Level  string
-	Format string
-	Output string
-	File   string
+	Level    string
+	Format   string
+	Output   string
+	File     string
+	Rotation LogRotationConfig
+	Sampling LogSamplingConfig
+}
+
+// LogRotationConfig controls the rotation of the log file when output is a file
+type LogRotationConfig struct {
+	// MaxSize is the size in megabytes the file may reach before it is rotated, 100 when zero
+	MaxSize int
+
+	// MaxAge is how many days rotated files are kept; they are never removed for age when zero
+	MaxAge int
+
+	// MaxBackups is how many rotated files are kept; all are kept when zero
+	MaxBackups int
+
+	// Compress gzips rotated files
+	Compress bool
+}
+
+// LogSamplingConfig throttles repeated log entries, so that an error repeated under load
+// does not flood the log
+type LogSamplingConfig struct {
+	// Tick is the window entries are counted in, one second when zero
+	Tick time.Duration
+
+	// Initial is how many entries with the same level and message are logged per tick before
+	// sampling starts; sampling is disabled when zero
+	Initial int
+
+	// Thereafter logs every Thereafter-th of the remaining entries; they are all dropped when zero
+	Thereafter int
 }
 
 // LoadConfig loads the configuration from the config file
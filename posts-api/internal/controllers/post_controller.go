@@ -28,7 +28,8 @@ func NewPostController(postService services.PostService, logger *logger.Logger)
 	}
 }
 
-// getUserIDFromContext extracts the user ID from the context metadata
+// getUserIDFromContext extracts the caller's user ID from the context metadata, where the auth
+// interceptor has checked it against the token
 func (c *PostController) getUserIDFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -43,29 +44,20 @@ func (c *PostController) getUserIDFromContext(ctx context.Context) (string, erro
 	return values[0], nil
 }
 
-// getFriendIDsFromContext extracts the friend IDs from the context metadata
-func (c *PostController) getFriendIDsFromContext(ctx context.Context) []string {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil
-	}
-
-	values := md.Get("friend_ids")
-	if len(values) == 0 {
-		return nil
-	}
-
-	return values
-}
-
 // CreatePost creates a new post
 func (c *PostController) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb.PostResponse, error) {
-	c.logger.Info("CreatePost request received", "user_id", req.UserId, "visibility", req.Visibility)
+	c.logger.WithContext(ctx).Info("CreatePost request received", "visibility", req.Visibility)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create post using the service
-	post, err := c.postService.CreatePost(ctx, req.UserId, req.Content, req.Visibility, req.GroupId, req.Media)
+	post, err := c.postService.CreatePost(ctx, userID, req.Content, req.Visibility, req.GroupId, req.Media, req.IdempotencyKey)
 	if err != nil {
-		c.logger.Error("Failed to create post", err)
+		c.logger.WithContext(ctx).Error("Failed to create post", err)
 		return nil, err
 	}
 
@@ -75,12 +67,15 @@ func (c *PostController) CreatePost(ctx context.Context, req *pb.CreatePostReque
 
 // GetPost retrieves a post by ID
 func (c *PostController) GetPost(ctx context.Context, req *pb.GetPostRequest) (*pb.PostResponse, error) {
-	c.logger.Info("GetPost request received", "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("GetPost request received", "post_id", req.PostId)
+
+	// Anonymous callers of public methods have no user ID
+	userID, _ := c.getUserIDFromContext(ctx)
 
 	// Get post using the service
-	post, isLiked, err := c.postService.GetPost(ctx, req.PostId, req.UserId)
+	post, isLiked, err := c.postService.GetPost(ctx, req.PostId, userID)
 	if err != nil {
-		c.logger.Error("Failed to get post", err)
+		c.logger.WithContext(ctx).Error("Failed to get post", err)
 		return nil, err
 	}
 
@@ -90,42 +85,71 @@ func (c *PostController) GetPost(ctx context.Context, req *pb.GetPostRequest) (*
 
 // GetPosts retrieves posts with pagination and filtering
 func (c *PostController) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb.GetPostsResponse, error) {
-	c.logger.Info("GetPosts request received",
-		"user_id", req.UserId,
+	c.logger.WithContext(ctx).Info("GetPosts request received",
 		"author_id", req.AuthorId,
 		"group_id", req.GroupId,
 		"visibility", req.Visibility,
+		"feed", req.Feed,
+		"cursor", req.Cursor,
 		"page", req.Page,
 		"limit", req.Limit)
 
-	// Get friend IDs from context
-	friendIDs := c.getFriendIDsFromContext(ctx)
+	// Anonymous callers of public methods have no user ID
+	userID, _ := c.getUserIDFromContext(ctx)
 
 	// Get posts using the service
-	posts, totalCount, totalPages, err := c.postService.GetPosts(
+	posts, totalCount, totalPages, nextCursor, err := c.postService.GetPosts(
 		ctx,
-		req.UserId,
+		userID,
 		req.AuthorId,
 		req.GroupId,
 		req.Visibility,
+		req.Feed,
+		req.Cursor,
 		int(req.Page),
 		int(req.Limit),
-		friendIDs,
 	)
 	if err != nil {
-		c.logger.Error("Failed to get posts", err)
+		c.logger.WithContext(ctx).Error("Failed to get posts", err)
 		return nil, err
 	}
 
 	// Convert post models to gRPC responses
 	postResponses := make([]*pb.PostResponse, len(posts))
 	for i, post := range posts {
-		// Check if the post is liked by the user
-		isLiked := false
-		if req.UserId != "" {
-			isLiked, _ = c.postService.IsLiked(ctx, post.ID, req.UserId)
-		}
-		postResponses[i] = c.convertPostToResponse(post, isLiked)
+		postResponses[i] = c.convertPostToResponse(post, post.IsLiked)
+	}
+
+	return &pb.GetPostsResponse{
+		Posts:      postResponses,
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetPostsByHashtag retrieves posts tagged with a hashtag
+func (c *PostController) GetPostsByHashtag(ctx context.Context, req *pb.GetPostsByHashtagRequest) (*pb.GetPostsResponse, error) {
+	c.logger.WithContext(ctx).Info("GetPostsByHashtag request received",
+		"tag", req.Tag,
+		"page", req.Page,
+		"limit", req.Limit)
+
+	// Anonymous callers of public methods have no user ID
+	userID, _ := c.getUserIDFromContext(ctx)
+
+	// Get posts using the service
+	posts, totalCount, totalPages, err := c.postService.GetPostsByHashtag(ctx, userID, req.Tag, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get posts by hashtag", err)
+		return nil, err
+	}
+
+	// Convert post models to gRPC responses
+	postResponses := make([]*pb.PostResponse, len(posts))
+	for i, post := range posts {
+		postResponses[i] = c.convertPostToResponse(post, post.IsLiked)
 	}
 
 	return &pb.GetPostsResponse{
@@ -138,17 +162,23 @@ func (c *PostController) GetPosts(ctx context.Context, req *pb.GetPostsRequest)
 
 // UpdatePost updates a post
 func (c *PostController) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest) (*pb.PostResponse, error) {
-	c.logger.Info("UpdatePost request received", "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("UpdatePost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Update post using the service
-	post, err := c.postService.UpdatePost(ctx, req.PostId, req.UserId, req.Content, req.Visibility, req.Media)
+	post, err := c.postService.UpdatePost(ctx, req.PostId, userID, req.Content, req.Visibility, req.Media)
 	if err != nil {
-		c.logger.Error("Failed to update post", err)
+		c.logger.WithContext(ctx).Error("Failed to update post", err)
 		return nil, err
 	}
 
 	// Check if the post is liked by the user
-	isLiked, _ := c.postService.IsLiked(ctx, post.ID, req.UserId)
+	isLiked, _ := c.postService.IsLiked(ctx, post.ID, userID)
 
 	// Convert post model to gRPC response
 	return c.convertPostToResponse(post, isLiked), nil
@@ -156,12 +186,18 @@ func (c *PostController) UpdatePost(ctx context.Context, req *pb.UpdatePostReque
 
 // DeletePost deletes a post
 func (c *PostController) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
-	c.logger.Info("DeletePost request received", "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("DeletePost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Delete post using the service
-	err := c.postService.DeletePost(ctx, req.PostId, req.UserId)
+	err = c.postService.DeletePost(ctx, req.PostId, userID)
 	if err != nil {
-		c.logger.Error("Failed to delete post", err)
+		c.logger.WithContext(ctx).Error("Failed to delete post", err)
 		return nil, err
 	}
 
@@ -170,18 +206,65 @@ func (c *PostController) DeletePost(ctx context.Context, req *pb.DeletePostReque
 	}, nil
 }
 
+// SharePost shares a post as a new post of the user's
+func (c *PostController) SharePost(ctx context.Context, req *pb.SharePostRequest) (*pb.PostResponse, error) {
+	c.logger.WithContext(ctx).Info("SharePost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Share post using the service
+	post, err := c.postService.SharePost(ctx, req.PostId, userID, req.Comment)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to share post", err)
+		return nil, err
+	}
+
+	// Convert post model to gRPC response
+	return c.convertPostToResponse(post, false), nil
+}
+
+// RestorePost restores a deleted post
+func (c *PostController) RestorePost(ctx context.Context, req *pb.RestorePostRequest) (*pb.PostResponse, error) {
+	c.logger.WithContext(ctx).Info("RestorePost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore post using the service
+	post, err := c.postService.RestorePost(ctx, req.PostId, userID)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to restore post", err)
+		return nil, err
+	}
+
+	// Check if the post is liked by the user
+	isLiked, _ := c.postService.IsLiked(ctx, post.ID, userID)
+
+	// Convert post model to gRPC response
+	return c.convertPostToResponse(post, isLiked), nil
+}
+
 // AddComment adds a comment to a post
 func (c *PostController) AddComment(ctx context.Context, req *pb.AddCommentRequest) (*pb.CommentResponse, error) {
-	c.logger.Info("AddComment request received", "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("AddComment request received", "post_id", req.PostId)
 
-	// TODO: Get user info from users service
-	authorName := "User " + req.UserId // Placeholder
-	authorAvatar := ""                 // Placeholder
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Add comment using the service
-	comment, err := c.postService.AddComment(ctx, req.PostId, req.UserId, authorName, authorAvatar, req.Content)
+	comment, err := c.postService.AddComment(ctx, req.PostId, userID, req.Content, req.ParentCommentId, req.IdempotencyKey)
 	if err != nil {
-		c.logger.Error("Failed to add comment", err)
+		c.logger.WithContext(ctx).Error("Failed to add comment", err)
 		return nil, err
 	}
 
@@ -191,12 +274,15 @@ func (c *PostController) AddComment(ctx context.Context, req *pb.AddCommentReque
 
 // GetComments retrieves comments for a post
 func (c *PostController) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (*pb.GetCommentsResponse, error) {
-	c.logger.Info("GetComments request received", "post_id", req.PostId, "page", req.Page, "limit", req.Limit)
+	c.logger.WithContext(ctx).Info("GetComments request received", "post_id", req.PostId, "threaded", req.Threaded, "page", req.Page, "limit", req.Limit)
+
+	// Anonymous callers of public methods have no user ID
+	userID, _ := c.getUserIDFromContext(ctx)
 
 	// Get comments using the service
-	comments, totalCount, totalPages, err := c.postService.GetComments(ctx, req.PostId, int(req.Page), int(req.Limit))
+	comments, totalCount, totalPages, err := c.postService.GetComments(ctx, req.PostId, userID, req.Threaded, int(req.Page), int(req.Limit))
 	if err != nil {
-		c.logger.Error("Failed to get comments", err)
+		c.logger.WithContext(ctx).Error("Failed to get comments", err)
 		return nil, err
 	}
 
@@ -214,14 +300,41 @@ func (c *PostController) GetComments(ctx context.Context, req *pb.GetCommentsReq
 	}, nil
 }
 
+// EditComment updates the content of a comment
+func (c *PostController) EditComment(ctx context.Context, req *pb.EditCommentRequest) (*pb.CommentResponse, error) {
+	c.logger.WithContext(ctx).Info("EditComment request received", "comment_id", req.CommentId, "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Edit comment using the service
+	comment, err := c.postService.EditComment(ctx, req.CommentId, req.PostId, userID, req.Content)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to edit comment", err)
+		return nil, err
+	}
+
+	// Convert comment model to gRPC response
+	return c.convertCommentToResponse(comment), nil
+}
+
 // DeleteComment deletes a comment
 func (c *PostController) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
-	c.logger.Info("DeleteComment request received", "comment_id", req.CommentId, "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("DeleteComment request received", "comment_id", req.CommentId, "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Delete comment using the service
-	err := c.postService.DeleteComment(ctx, req.CommentId, req.PostId, req.UserId)
+	err = c.postService.DeleteComment(ctx, req.CommentId, req.PostId, userID)
 	if err != nil {
-		c.logger.Error("Failed to delete comment", err)
+		c.logger.WithContext(ctx).Error("Failed to delete comment", err)
 		return nil, err
 	}
 
@@ -230,43 +343,242 @@ func (c *PostController) DeleteComment(ctx context.Context, req *pb.DeleteCommen
 	}, nil
 }
 
-// LikePost likes a post
+// LikePost sets the user's reaction on a post
 func (c *PostController) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.LikePostResponse, error) {
-	c.logger.Info("LikePost request received", "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("LikePost request received", "post_id", req.PostId, "reaction_type", req.ReactionType)
 
-	// Like post using the service
-	likesCount, err := c.postService.LikePost(ctx, req.PostId, req.UserId)
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
 	if err != nil {
-		c.logger.Error("Failed to like post", err)
+		return nil, err
+	}
+
+	// React to post using the service
+	likesCount, err := c.postService.React(ctx, req.PostId, userID, req.ReactionType)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to like post", err)
 		return nil, err
 	}
 
 	return &pb.LikePostResponse{
-		Success:    true,
-		LikesCount: likesCount,
+		Success:        true,
+		LikesCount:     likesCount,
+		ReactionCounts: c.reactionCounts(ctx, req.PostId),
 	}, nil
 }
 
 // UnlikePost unlikes a post
 func (c *PostController) UnlikePost(ctx context.Context, req *pb.UnlikePostRequest) (*pb.UnlikePostResponse, error) {
-	c.logger.Info("UnlikePost request received", "post_id", req.PostId, "user_id", req.UserId)
+	c.logger.WithContext(ctx).Info("UnlikePost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Unlike post using the service
-	likesCount, err := c.postService.UnlikePost(ctx, req.PostId, req.UserId)
+	likesCount, err := c.postService.UnlikePost(ctx, req.PostId, userID)
 	if err != nil {
-		c.logger.Error("Failed to unlike post", err)
+		c.logger.WithContext(ctx).Error("Failed to unlike post", err)
 		return nil, err
 	}
 
 	return &pb.UnlikePostResponse{
-		Success:    true,
-		LikesCount: likesCount,
+		Success:        true,
+		LikesCount:     likesCount,
+		ReactionCounts: c.reactionCounts(ctx, req.PostId),
+	}, nil
+}
+
+// GetReactionCounts retrieves the number of each reaction type on a post
+func (c *PostController) GetReactionCounts(ctx context.Context, req *pb.GetReactionCountsRequest) (*pb.GetReactionCountsResponse, error) {
+	c.logger.WithContext(ctx).Info("GetReactionCounts request received", "post_id", req.PostId)
+
+	// Get reaction counts using the service
+	reactionCounts, err := c.postService.GetReactionCounts(ctx, req.PostId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get reaction counts", err)
+		return nil, err
+	}
+
+	var likesCount int32
+	for _, count := range reactionCounts {
+		likesCount += count
+	}
+
+	return &pb.GetReactionCountsResponse{
+		LikesCount:     likesCount,
+		ReactionCounts: reactionCounts,
+	}, nil
+}
+
+// CountPublicPosts counts the posts of an author that everyone can see
+func (c *PostController) CountPublicPosts(ctx context.Context, req *pb.CountPublicPostsRequest) (*pb.CountPublicPostsResponse, error) {
+	c.logger.WithContext(ctx).Info("CountPublicPosts request received", "author_id", req.AuthorId)
+
+	count, err := c.postService.CountPublicPosts(ctx, req.AuthorId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to count public posts", err)
+		return nil, err
+	}
+
+	return &pb.CountPublicPostsResponse{Count: count}, nil
+}
+
+// BookmarkPost saves a post for the user to revisit later
+func (c *PostController) BookmarkPost(ctx context.Context, req *pb.BookmarkPostRequest) (*pb.BookmarkPostResponse, error) {
+	c.logger.WithContext(ctx).Info("BookmarkPost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bookmark post using the service
+	if err := c.postService.BookmarkPost(ctx, req.PostId, userID); err != nil {
+		c.logger.WithContext(ctx).Error("Failed to bookmark post", err)
+		return nil, err
+	}
+
+	return &pb.BookmarkPostResponse{
+		Success: true,
+	}, nil
+}
+
+// UnbookmarkPost removes a post from the user's bookmarks
+func (c *PostController) UnbookmarkPost(ctx context.Context, req *pb.UnbookmarkPostRequest) (*pb.UnbookmarkPostResponse, error) {
+	c.logger.WithContext(ctx).Info("UnbookmarkPost request received", "post_id", req.PostId)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove bookmark using the service
+	if err := c.postService.UnbookmarkPost(ctx, req.PostId, userID); err != nil {
+		c.logger.WithContext(ctx).Error("Failed to remove bookmark", err)
+		return nil, err
+	}
+
+	return &pb.UnbookmarkPostResponse{
+		Success: true,
+	}, nil
+}
+
+// GetBookmarks retrieves the user's bookmarked posts
+func (c *PostController) GetBookmarks(ctx context.Context, req *pb.GetBookmarksRequest) (*pb.GetPostsResponse, error) {
+	c.logger.WithContext(ctx).Info("GetBookmarks request received", "page", req.Page, "limit", req.Limit)
+
+	// Bookmarks are private, so the authenticated user can only list their own
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get bookmarked posts using the service
+	posts, totalCount, totalPages, err := c.postService.GetBookmarks(ctx, userID, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get bookmarks", err)
+		return nil, err
+	}
+
+	// Convert post models to gRPC responses
+	postResponses := make([]*pb.PostResponse, len(posts))
+	for i, post := range posts {
+		postResponses[i] = c.convertPostToResponse(post, post.IsLiked)
+	}
+
+	return &pb.GetPostsResponse{
+		Posts:      postResponses,
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ReportPost reports a post for moderation
+func (c *PostController) ReportPost(ctx context.Context, req *pb.ReportPostRequest) (*pb.ReportPostResponse, error) {
+	c.logger.WithContext(ctx).Info("ReportPost request received", "post_id", req.PostId, "reason", req.Reason)
+
+	// The caller is the authenticated user, whoever the request names
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report post using the service
+	if err := c.postService.ReportPost(ctx, req.PostId, userID, req.Reason); err != nil {
+		c.logger.WithContext(ctx).Error("Failed to report post", err)
+		return nil, err
+	}
+
+	return &pb.ReportPostResponse{
+		Success: true,
 	}, nil
 }
 
+// GetReports retrieves the moderation queue
+func (c *PostController) GetReports(ctx context.Context, req *pb.GetReportsRequest) (*pb.GetReportsResponse, error) {
+	c.logger.WithContext(ctx).Info("GetReports request received", "status", req.Status, "page", req.Page, "limit", req.Limit)
+
+	// Get reports using the service
+	reports, totalCount, totalPages, err := c.postService.GetReports(ctx, req.Status, int(req.Page), int(req.Limit))
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get reports", err)
+		return nil, err
+	}
+
+	// Convert report models to gRPC responses
+	reportResponses := make([]*pb.ReportResponse, len(reports))
+	for i, report := range reports {
+		reportResponses[i] = c.convertReportToResponse(report)
+	}
+
+	return &pb.GetReportsResponse{
+		Reports:    reportResponses,
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ResolveReport resolves a report and optionally hides the reported post
+func (c *PostController) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.ReportResponse, error) {
+	c.logger.WithContext(ctx).Info("ResolveReport request received", "report_id", req.ReportId, "hide_post", req.HidePost)
+
+	// Admin rights belong to the authenticated user, not to whoever is named in the request
+	userID, err := c.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve report using the service
+	report, err := c.postService.ResolveReport(ctx, req.ReportId, userID, req.HidePost)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to resolve report", err)
+		return nil, err
+	}
+
+	// Convert report model to gRPC response
+	return c.convertReportToResponse(report), nil
+}
+
+// reactionCounts returns the reaction counts for a post, or nil if they cannot be loaded
+func (c *PostController) reactionCounts(ctx context.Context, postID string) map[string]int32 {
+	reactionCounts, err := c.postService.GetReactionCounts(ctx, postID)
+	if err != nil {
+		c.logger.WithContext(ctx).Warn("Failed to get reaction counts", "error", err, "post_id", postID)
+		return nil
+	}
+	return reactionCounts
+}
+
 // convertPostToResponse converts a post model to a gRPC response
 func (c *PostController) convertPostToResponse(post *models.Post, isLiked bool) *pb.PostResponse {
-	return &pb.PostResponse{
+	response := &pb.PostResponse{
 		PostId:        post.ID,
 		AuthorId:      post.AuthorID,
 		AuthorName:    post.AuthorName,
@@ -281,12 +593,51 @@ func (c *PostController) convertPostToResponse(post *models.Post, isLiked bool)
 		IsLiked:       isLiked,
 		CreatedAt:     post.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:     post.UpdatedAt.Format(time.RFC3339),
+		Mentions:      c.convertPostMentions(post.Mentions),
+		SharesCount:   int32(post.SharesCount),
+		ReportsCount:  int32(post.ReportsCount),
+		Hidden:        post.HiddenAt != nil,
+	}
+	if post.OriginalPostID != nil {
+		response.OriginalPostId = *post.OriginalPostID
+	}
+
+	// Convert the shared post
+	if post.OriginalPost != nil {
+		response.OriginalPost = c.convertPostToResponse(post.OriginalPost, false)
 	}
+
+	return response
+}
+
+// convertReportToResponse converts a report model to a gRPC response
+func (c *PostController) convertReportToResponse(report *models.PostReport) *pb.ReportResponse {
+	response := &pb.ReportResponse{
+		ReportId:   report.ID,
+		PostId:     report.PostID,
+		ReporterId: report.ReporterID,
+		Reason:     report.Reason,
+		Status:     report.Status,
+		CreatedAt:  report.CreatedAt.Format(time.RFC3339),
+	}
+	if report.ResolvedBy != nil {
+		response.ResolvedBy = *report.ResolvedBy
+	}
+	if report.ResolvedAt != nil {
+		response.ResolvedAt = report.ResolvedAt.Format(time.RFC3339)
+	}
+
+	// Convert the reported post
+	if report.Post != nil {
+		response.Post = c.convertPostToResponse(report.Post, false)
+	}
+
+	return response
 }
 
 // convertCommentToResponse converts a comment model to a gRPC response
 func (c *PostController) convertCommentToResponse(comment *models.Comment) *pb.CommentResponse {
-	return &pb.CommentResponse{
+	response := &pb.CommentResponse{
 		CommentId:    comment.ID,
 		PostId:       comment.PostID,
 		AuthorId:     comment.AuthorID,
@@ -294,5 +645,43 @@ func (c *PostController) convertCommentToResponse(comment *models.Comment) *pb.C
 		AuthorAvatar: comment.AuthorAvatar,
 		Content:      comment.Content,
 		CreatedAt:    comment.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    comment.UpdatedAt.Format(time.RFC3339),
+		Edited:       comment.Edited,
+		Depth:        int32(comment.Depth),
+		Mentions:     c.convertCommentMentions(comment.Mentions),
+	}
+	if comment.ParentCommentID != nil {
+		response.ParentCommentId = *comment.ParentCommentID
+	}
+
+	// Convert nested replies
+	for _, reply := range comment.Replies {
+		response.Replies = append(response.Replies, c.convertCommentToResponse(reply))
+	}
+
+	return response
+}
+
+// convertPostMentions converts post mentions to gRPC mentions
+func (c *PostController) convertPostMentions(mentions []*models.PostMention) []*pb.Mention {
+	result := make([]*pb.Mention, len(mentions))
+	for i, mention := range mentions {
+		result[i] = &pb.Mention{
+			UserId:   mention.UserID,
+			Username: mention.Username,
+		}
+	}
+	return result
+}
+
+// convertCommentMentions converts comment mentions to gRPC mentions
+func (c *PostController) convertCommentMentions(mentions []*models.CommentMention) []*pb.Mention {
+	result := make([]*pb.Mention, len(mentions))
+	for i, mention := range mentions {
+		result[i] = &pb.Mention{
+			UserId:   mention.UserID,
+			Username: mention.Username,
+		}
 	}
+	return result
 }
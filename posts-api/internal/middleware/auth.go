@@ -2,11 +2,13 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 	"post-api/internal/utils/logger"
 
 	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -18,6 +20,9 @@ type AuthInterceptor struct {
 	jwtSecret     string
 	logger        *logger.Logger
 	publicMethods map[string]bool
+
+	// methodRoles lists the methods that require a role beyond being signed in
+	methodRoles map[string]string
 }
 
 // NewAuthInterceptor creates a new auth interceptor
@@ -26,13 +31,24 @@ func NewAuthInterceptor(jwtSecret string, logger *logger.Logger) *AuthIntercepto
 		jwtSecret: jwtSecret,
 		logger:    logger,
 		publicMethods: map[string]bool{
-			"/posts.PostService/GetPost":     true,
-			"/posts.PostService/GetPosts":    true,
-			"/posts.PostService/GetComments": true,
+			"/grpc.health.v1.Health/Check":         true,
+			"/posts.PostService/GetPost":           true,
+			"/posts.PostService/GetPosts":          true,
+			"/posts.PostService/GetPostsByHashtag": true,
+			"/posts.PostService/GetComments":       true,
+			"/posts.PostService/GetReactionCounts": true,
+			"/posts.PostService/CountPublicPosts":  true,
+		},
+		methodRoles: map[string]string{
+			"/posts.PostService/GetReports":    roleAdmin,
+			"/posts.PostService/ResolveReport": roleAdmin,
 		},
 	}
 }
 
+// roleAdmin is the role users-api gives to admins in the role claim
+const roleAdmin = "admin"
+
 // Unary returns a unary server interceptor for authentication
 func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(
@@ -41,21 +57,30 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Skip authentication for public methods
+		// Public methods need no authentication, but identify the caller when a token is sent
 		if i.publicMethods[info.FullMethod] {
+			ctx, err := i.identify(ctx)
+			if err != nil {
+				return nil, err
+			}
 			return handler(ctx, req)
 		}
 
 		// Authenticate the request
-		userID, friendIDs, err := i.authenticate(ctx)
+		userID, role, err := i.authenticate(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		// Add user ID and friend IDs to context
-		ctx = context.WithValue(ctx, "user_id", userID)
-		if len(friendIDs) > 0 {
-			ctx = context.WithValue(ctx, "friend_ids", friendIDs)
+		// Check the role required by the method
+		if required, ok := i.methodRoles[info.FullMethod]; ok && role != required {
+			return nil, status.Errorf(codes.PermissionDenied, "%s role required", required)
+		}
+
+		// Add user ID to context
+		ctx, err = i.withUser(ctx, userID)
+		if err != nil {
+			return nil, err
 		}
 
 		// Proceed with the request
@@ -63,24 +88,73 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	}
 }
 
-// authenticate authenticates the request
-func (i *AuthInterceptor) authenticate(ctx context.Context) (string, []string, error) {
+// identify adds the caller of a public method to the context when the call carries a valid
+// token. Any other call goes on anonymously, without a user ID it could claim in the metadata.
+func (i *AuthInterceptor) identify(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) == 0 || strings.TrimPrefix(values[0], "Bearer ") == "" {
+		return withoutUserID(ctx), nil
+	}
+
+	userID, _, err := i.authenticate(ctx)
+	if err != nil {
+		return withoutUserID(ctx), nil
+	}
+
+	return i.withUser(ctx, userID)
+}
+
+// withUser adds the authenticated user to the context, and the user ID to the entries of the
+// request-scoped logger. The user_id metadata, where handlers read the
+// caller from, is set to the token's subject; a request naming another user there is refused.
+func (i *AuthInterceptor) withUser(ctx context.Context, userID string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(userIDMetadata); len(values) > 0 && values[0] != userID {
+		return nil, status.Error(codes.PermissionDenied, "user ID does not match the token")
+	}
+	md = md.Copy()
+	md.Set(userIDMetadata, userID)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	ctx = context.WithValue(ctx, "user_id", userID)
+	ctx = logger.NewContext(ctx, i.logger.WithContext(ctx).With("user_id", userID))
+
+	return ctx, nil
+}
+
+// userIDMetadata is the metadata key the gateway sends the authenticated user's ID in
+const userIDMetadata = "user_id"
+
+// withoutUserID drops the user_id metadata of an unauthenticated call
+func withoutUserID(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(userIDMetadata)) == 0 {
+		return ctx
+	}
+	md = md.Copy()
+	md.Delete(userIDMetadata)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// authenticate authenticates the request and returns the user ID and role.
+// The role comes from the token, so a role change applies once the user gets a new token.
+func (i *AuthInterceptor) authenticate(ctx context.Context) (string, string, error) {
 	// Get metadata from context
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return "", nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+		return "", "", unauthenticated(reasonTokenMissing, "metadata is not provided")
 	}
 
 	// Get authorization header
 	values := md.Get("authorization")
 	if len(values) == 0 {
-		return "", nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+		return "", "", unauthenticated(reasonTokenMissing, "authorization token is not provided")
 	}
 
 	// Extract token from authorization header
 	authHeader := values[0]
 	if !strings.HasPrefix(authHeader, "Bearer ") {
-		return "", nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid authorization format")
 	}
 	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
@@ -88,37 +162,64 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (string, []string, e
 	claims := jwt.MapClaims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, status.Error(codes.Unauthenticated, "unexpected signing method")
+			return nil, unauthenticated(reasonTokenInvalid, "unexpected signing method")
 		}
 		return []byte(i.jwtSecret), nil
 	})
 	if err != nil {
 		i.logger.Error("Failed to parse token", err)
-		return "", nil, status.Error(codes.Unauthenticated, "invalid token: "+err.Error())
+		return "", "", unauthenticated(tokenErrorReason(err), "invalid token: "+err.Error())
 	}
 
 	// Check if token is valid
 	if !token.Valid {
-		return "", nil, status.Error(codes.Unauthenticated, "invalid token claims")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid token claims")
 	}
 
 	// Check token expiration
 	exp, ok := claims["exp"].(float64)
 	if !ok {
-		return "", nil, status.Error(codes.Unauthenticated, "invalid token expiration")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid token expiration")
 	}
 	if time.Now().Unix() > int64(exp) {
-		return "", nil, status.Error(codes.Unauthenticated, "token expired")
+		return "", "", unauthenticated(reasonTokenExpired, "token expired")
 	}
 
 	// Get user ID from claims
 	userID, ok := claims["sub"].(string)
 	if !ok {
-		return "", nil, status.Error(codes.Unauthenticated, "invalid user ID in token")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid user ID in token")
 	}
 
-	// Get friend IDs from metadata
-	friendIDs := md.Get("friend_ids")
+	// Tokens issued before roles were introduced carry no role claim
+	role, _ := claims["role"].(string)
 
-	return userID, friendIDs, nil
+	return userID, role, nil
+}
+
+// Reasons attached to authentication failures, so that clients can tell an expired token,
+// which signing in again fixes, from a missing or malformed one
+const (
+	authErrorDomain    = "auth"
+	reasonTokenMissing = "TOKEN_MISSING"
+	reasonTokenInvalid = "TOKEN_INVALID"
+	reasonTokenExpired = "TOKEN_EXPIRED"
+)
+
+// unauthenticated returns an Unauthenticated status carrying the reason in an ErrorInfo detail
+func unauthenticated(reason, msg string) error {
+	st := status.New(codes.Unauthenticated, msg)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: authErrorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// tokenErrorReason tells a token rejected for its exp claim apart from an otherwise invalid one
+func tokenErrorReason(err error) string {
+	var validationErr *jwt.ValidationError
+	if errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+		return reasonTokenExpired
+	}
+	return reasonTokenInvalid
 }
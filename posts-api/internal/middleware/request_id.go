@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"post-api/internal/utils/logger"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDInterceptor is a gRPC interceptor that carries the gateway's request ID into every call
+type RequestIDInterceptor struct {
+	logger *logger.Logger
+}
+
+// NewRequestIDInterceptor creates a new request ID interceptor
+func NewRequestIDInterceptor(logger *logger.Logger) *RequestIDInterceptor {
+	return &RequestIDInterceptor{
+		logger: logger,
+	}
+}
+
+// Unary returns a unary server interceptor that stores the x-request-id metadata in the context,
+// generating one for callers that don't send it, together with a logger scoped to the call
+func (i *RequestIDInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		requestID := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-request-id"); len(values) > 0 {
+				requestID = values[0]
+			}
+		}
+		if requestID == "" {
+			requestID = generateRequestID()
+		}
+
+		ctx = context.WithValue(ctx, logger.RequestIDKey, requestID)
+		ctx = logger.NewContext(ctx, i.logger.With("request_id", requestID, "method", info.FullMethod))
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		i.logger.WithContext(ctx).Debug("Handled request",
+			"code", status.Code(err).String(),
+			"duration", time.Since(start).String(),
+		)
+
+		return resp, err
+	}
+}
+
+// generateRequestID returns a random 128-bit hex request ID
+func generateRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
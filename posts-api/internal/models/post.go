@@ -8,21 +8,28 @@ import (
 
 // Post represents a post in the system
 type Post struct {
-	ID            string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
-	AuthorID      string         `gorm:"type:varchar(36);not null;index" json:"author_id"`
-	AuthorName    string         `gorm:"type:varchar(255);not null" json:"author_name"`
-	AuthorAvatar  string         `gorm:"type:varchar(255)" json:"author_avatar"`
-	Content       string         `gorm:"type:text;not null" json:"content"`
-	Visibility    string         `gorm:"type:enum('public','private');not null;default:'public'" json:"visibility"`
-	GroupID       string         `gorm:"type:varchar(36);index" json:"group_id"`
-	GroupName     string         `gorm:"type:varchar(255)" json:"group_name"`
-	Media         string         `gorm:"type:text" json:"-"` // Stored as JSON array in database
-	MediaArray    []string       `gorm:"-" json:"media"`     // Used in application
-	LikesCount    int            `gorm:"default:0" json:"likes_count"`
-	CommentsCount int            `gorm:"default:0" json:"comments_count"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+	ID             string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	AuthorID       string         `gorm:"type:varchar(36);not null;index;index:idx_posts_author_id_created_at" json:"author_id"`
+	AuthorName     string         `gorm:"type:varchar(255);not null" json:"author_name"`
+	AuthorAvatar   string         `gorm:"type:varchar(255)" json:"author_avatar"`
+	Content        string         `gorm:"type:text;not null" json:"content"`
+	Visibility     string         `gorm:"type:enum('public','friends','private');not null;default:'public'" json:"visibility"`
+	GroupID        string         `gorm:"type:varchar(36);index;index:idx_posts_group_id_created_at" json:"group_id"`
+	GroupName      string         `gorm:"type:varchar(255)" json:"group_name"`
+	OriginalPostID *string        `gorm:"type:varchar(36);index" json:"original_post_id"` // Nil unless the post is a share
+	Media          string         `gorm:"type:text" json:"-"`                             // Stored as JSON array in database
+	MediaArray     []string       `gorm:"-" json:"media"`                                 // Used in application
+	LikesCount     int            `gorm:"default:0" json:"likes_count"`
+	CommentsCount  int            `gorm:"default:0" json:"comments_count"`
+	SharesCount    int            `gorm:"default:0" json:"shares_count"`
+	IsLiked        bool           `gorm:"-" json:"is_liked"` // Set per requesting user, not stored
+	Mentions       []*PostMention `gorm:"-" json:"mentions"`
+	OriginalPost   *Post          `gorm:"-" json:"original_post"` // Set when the shared post is visible to the requesting user
+	ReportsCount   int            `gorm:"-" json:"reports_count"` // Only set in moderation views
+	CreatedAt      time.Time      `gorm:"index:idx_posts_author_id_created_at;index:idx_posts_group_id_created_at" json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	HiddenAt       *time.Time     `json:"hidden_at"` // Set when a moderator hides the post
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName returns the table name for the Post model
@@ -40,15 +47,20 @@ func (p *Post) BeforeCreate(tx *gorm.DB) error {
 
 // Comment represents a comment on a post
 type Comment struct {
-	ID           string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
-	PostID       string         `gorm:"type:varchar(36);not null;index" json:"post_id"`
-	AuthorID     string         `gorm:"type:varchar(36);not null;index" json:"author_id"`
-	AuthorName   string         `gorm:"type:varchar(255);not null" json:"author_name"`
-	AuthorAvatar string         `gorm:"type:varchar(255)" json:"author_avatar"`
-	Content      string         `gorm:"type:text;not null" json:"content"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+	ID              string            `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	PostID          string            `gorm:"type:varchar(36);not null;index;index:idx_comments_post_id_created_at" json:"post_id"`
+	ParentCommentID *string           `gorm:"type:varchar(36);index" json:"parent_comment_id"` // Nil for top-level comments
+	Depth           int               `gorm:"not null;default:0" json:"depth"`
+	AuthorID        string            `gorm:"type:varchar(36);not null;index" json:"author_id"`
+	AuthorName      string            `gorm:"type:varchar(255);not null" json:"author_name"`
+	AuthorAvatar    string            `gorm:"type:varchar(255)" json:"author_avatar"`
+	Content         string            `gorm:"type:text;not null" json:"content"`
+	Edited          bool              `gorm:"not null;default:false" json:"edited"`
+	Replies         []*Comment        `gorm:"-" json:"replies,omitempty"` // Populated for threaded listings
+	Mentions        []*CommentMention `gorm:"-" json:"mentions"`
+	CreatedAt       time.Time         `gorm:"index:idx_comments_post_id_created_at" json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt    `gorm:"index" json:"-"`
 }
 
 // TableName returns the table name for the Comment model
@@ -64,13 +76,32 @@ func (c *Comment) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
-// Like represents a like on a post
+// Reaction types a user can leave on a post
+const (
+	ReactionLike  = "like"
+	ReactionLove  = "love"
+	ReactionLaugh = "laugh"
+	ReactionAngry = "angry"
+	ReactionSad   = "sad"
+)
+
+// IsValidReaction reports whether reaction is one of the supported reaction types
+func IsValidReaction(reaction string) bool {
+	switch reaction {
+	case ReactionLike, ReactionLove, ReactionLaugh, ReactionAngry, ReactionSad:
+		return true
+	}
+	return false
+}
+
+// Like represents a reaction on a post
 type Like struct {
-	ID        string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
-	PostID    string         `gorm:"type:varchar(36);not null;index" json:"post_id"`
-	UserID    string         `gorm:"type:varchar(36);not null;index" json:"user_id"`
-	CreatedAt time.Time      `json:"created_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID           string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	PostID       string         `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_likes_post_user" json:"post_id"`
+	UserID       string         `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_likes_post_user" json:"user_id"`
+	ReactionType string         `gorm:"type:varchar(16);not null;default:'like'" json:"reaction_type"`
+	CreatedAt    time.Time      `json:"created_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName returns the table name for the Like model
@@ -86,6 +117,166 @@ func (l *Like) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// PostHashtag links a post to a hashtag found in its content
+type PostHashtag struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	PostID    string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_post_hashtags_post_tag" json:"post_id"`
+	Tag       string    `gorm:"type:varchar(100);not null;index;uniqueIndex:idx_post_hashtags_post_tag" json:"tag"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for the PostHashtag model
+func (PostHashtag) TableName() string {
+	return "post_hashtags"
+}
+
+// BeforeCreate is a hook that is called before creating a post hashtag
+func (h *PostHashtag) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == "" {
+		h.ID = generateUUID()
+	}
+	return nil
+}
+
+// PostMention records a user mentioned with @username in a post
+type PostMention struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	PostID    string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_post_mentions_post_user" json:"post_id"`
+	UserID    string    `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_post_mentions_post_user" json:"user_id"`
+	Username  string    `gorm:"type:varchar(64);not null" json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for the PostMention model
+func (PostMention) TableName() string {
+	return "post_mentions"
+}
+
+// BeforeCreate is a hook that is called before creating a post mention
+func (m *PostMention) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == "" {
+		m.ID = generateUUID()
+	}
+	return nil
+}
+
+// CommentMention records a user mentioned with @username in a comment
+type CommentMention struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	CommentID string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_comment_mentions_comment_user" json:"comment_id"`
+	UserID    string    `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_comment_mentions_comment_user" json:"user_id"`
+	Username  string    `gorm:"type:varchar(64);not null" json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for the CommentMention model
+func (CommentMention) TableName() string {
+	return "comment_mentions"
+}
+
+// BeforeCreate is a hook that is called before creating a comment mention
+func (m *CommentMention) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == "" {
+		m.ID = generateUUID()
+	}
+	return nil
+}
+
+// PostBookmark records a post the user saved to revisit later
+type PostBookmark struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	PostID    string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_post_bookmarks_post_user" json:"post_id"`
+	UserID    string    `gorm:"type:varchar(36);not null;index:idx_post_bookmarks_user_created_at;uniqueIndex:idx_post_bookmarks_post_user" json:"user_id"`
+	CreatedAt time.Time `gorm:"index:idx_post_bookmarks_user_created_at" json:"created_at"`
+}
+
+// TableName returns the table name for the PostBookmark model
+func (PostBookmark) TableName() string {
+	return "post_bookmarks"
+}
+
+// BeforeCreate is a hook that is called before creating a post bookmark
+func (b *PostBookmark) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == "" {
+		b.ID = generateUUID()
+	}
+	return nil
+}
+
+// Reasons a user can give when reporting a post
+const (
+	ReportReasonSpam           = "spam"
+	ReportReasonHarassment     = "harassment"
+	ReportReasonHateSpeech     = "hate_speech"
+	ReportReasonViolence       = "violence"
+	ReportReasonNudity         = "nudity"
+	ReportReasonMisinformation = "misinformation"
+	ReportReasonOther          = "other"
+)
+
+// IsValidReportReason reports whether reason is one of the supported report reasons
+func IsValidReportReason(reason string) bool {
+	switch reason {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonHateSpeech, ReportReasonViolence,
+		ReportReasonNudity, ReportReasonMisinformation, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// Statuses of a post report
+const (
+	ReportStatusOpen     = "open"
+	ReportStatusResolved = "resolved"
+)
+
+// PostReport records a user reporting a post for moderation
+type PostReport struct {
+	ID         string     `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	PostID     string     `gorm:"type:varchar(36);not null;uniqueIndex:idx_post_reports_post_reporter" json:"post_id"`
+	ReporterID string     `gorm:"type:varchar(36);not null;uniqueIndex:idx_post_reports_post_reporter" json:"reporter_id"`
+	Reason     string     `gorm:"type:varchar(16);not null" json:"reason"`
+	Status     string     `gorm:"type:varchar(16);not null;default:'open';index:idx_post_reports_status_created_at" json:"status"`
+	ResolvedBy *string    `gorm:"type:varchar(36)" json:"resolved_by"` // Nil while the report is open
+	ResolvedAt *time.Time `json:"resolved_at"`
+	Post       *Post      `gorm:"-" json:"post"` // Populated for the moderation queue
+	CreatedAt  time.Time  `gorm:"index:idx_post_reports_status_created_at" json:"created_at"`
+}
+
+// TableName returns the table name for the PostReport model
+func (PostReport) TableName() string {
+	return "post_reports"
+}
+
+// BeforeCreate is a hook that is called before creating a post report
+func (r *PostReport) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = generateUUID()
+	}
+	return nil
+}
+
+// Operations an idempotency key can be used for
+const (
+	IdempotentCreatePost = "create_post"
+	IdempotentAddComment = "add_comment"
+)
+
+// IdempotencyKey remembers the resource a user's request created, so a retry of the request
+// with the same key returns that resource instead of creating another one
+type IdempotencyKey struct {
+	UserID     string    `gorm:"primaryKey;type:varchar(36)" json:"user_id"`
+	Operation  string    `gorm:"primaryKey;type:varchar(16)" json:"operation"`
+	Key        string    `gorm:"primaryKey;column:idempotency_key;type:varchar(255)" json:"key"`
+	ResourceID string    `gorm:"type:varchar(36);not null;default:''" json:"resource_id"` // Empty while the first request is still running
+	CreatedAt  time.Time `gorm:"index:idx_idempotency_keys_created_at" json:"created_at"`
+}
+
+// TableName returns the table name for the IdempotencyKey model
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
 // generateUUID generates a UUID
 func generateUUID() string {
 	// This is a simple implementation for demonstration purposes
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"post-api/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BookmarkRepository defines the interface for bookmark repository operations
+type BookmarkRepository interface {
+	// Create bookmarks a post for a user; bookmarking a post twice is a no-op
+	Create(ctx context.Context, bookmark *models.PostBookmark) error
+
+	// Delete removes a user's bookmark of a post, returning gorm.ErrRecordNotFound if there is none
+	Delete(ctx context.Context, postID, userID string) error
+
+	// FindPostsByUser finds the bookmarked posts a user can still see, newest bookmark first, with pagination
+	FindPostsByUser(ctx context.Context, userID string, friendIDs []string, page, limit int) ([]*models.Post, int64, error)
+}
+
+// bookmarkRepository implements the BookmarkRepository interface
+type bookmarkRepository struct {
+	db *gorm.DB
+}
+
+// NewBookmarkRepository creates a new bookmark repository
+func NewBookmarkRepository(db *gorm.DB) BookmarkRepository {
+	return &bookmarkRepository{db: db}
+}
+
+// Create bookmarks a post for a user; bookmarking a post twice is a no-op
+func (r *bookmarkRepository) Create(ctx context.Context, bookmark *models.PostBookmark) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(bookmark).Error
+}
+
+// Delete removes a user's bookmark of a post, returning gorm.ErrRecordNotFound if there is none
+func (r *bookmarkRepository) Delete(ctx context.Context, postID, userID string) error {
+	result := r.db.WithContext(ctx).Where("post_id = ? AND user_id = ?", postID, userID).Delete(&models.PostBookmark{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// FindPostsByUser finds the bookmarked posts a user can still see, newest bookmark first, with pagination.
+// Deleted posts and posts that have since become private to someone who is not a friend are left out.
+func (r *bookmarkRepository) FindPostsByUser(ctx context.Context, userID string, friendIDs []string, page, limit int) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.WithContext(ctx).Model(&models.Post{}).
+		Joins("JOIN post_bookmarks ON post_bookmarks.post_id = posts.id").
+		Where("post_bookmarks.user_id = ?", userID).
+		Where(visibleToUser, "public", userID, "friends", friendIDs)
+
+	// Count bookmarked posts
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get bookmarked posts with pagination
+	if err := query.Select("posts.*").Order("post_bookmarks.created_at DESC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Parse media JSON string to array for each post
+	for _, post := range posts {
+		if post.Media != "" {
+			var mediaArray []string
+			if err := json.Unmarshal([]byte(post.Media), &mediaArray); err != nil {
+				return nil, 0, err
+			}
+			post.MediaArray = mediaArray
+		}
+	}
+
+	return posts, count, nil
+}
\ No newline at end of file
@@ -9,7 +9,7 @@ import (
 
 // CommentRepository defines the interface for comment repository operations
 type CommentRepository interface {
-	// Create creates a new comment
+	// Create creates a new comment and increments the post's comments count
 	Create(ctx context.Context, comment *models.Comment) error
 
 	// FindByID finds a comment by ID
@@ -18,10 +18,16 @@ type CommentRepository interface {
 	// FindByPost finds comments for a post with pagination
 	FindByPost(ctx context.Context, postID string, page, limit int) ([]*models.Comment, int64, error)
 
+	// FindTopLevelByPost finds top-level comments for a post with pagination
+	FindTopLevelByPost(ctx context.Context, postID string, page, limit int) ([]*models.Comment, int64, error)
+
+	// FindReplies finds the direct replies to the given comments of a post
+	FindReplies(ctx context.Context, postID string, parentIDs []string) ([]*models.Comment, error)
+
 	// Update updates a comment
 	Update(ctx context.Context, comment *models.Comment) error
 
-	// Delete deletes a comment
+	// Delete deletes a comment and decrements the post's comments count
 	Delete(ctx context.Context, id string) error
 }
 
@@ -35,9 +41,14 @@ func NewCommentRepository(db *gorm.DB) CommentRepository {
 	return &commentRepository{db: db}
 }
 
-// Create creates a new comment
+// Create creates a new comment and increments the post's comments count in the same transaction
 func (r *commentRepository) Create(ctx context.Context, comment *models.Comment) error {
-	return r.db.WithContext(ctx).Create(comment).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Post{}).Where("id = ?", comment.PostID).Update("comments_count", gorm.Expr("comments_count + ?", 1)).Error
+	})
 }
 
 // FindByID finds a comment by ID
@@ -70,12 +81,57 @@ func (r *commentRepository) FindByPost(ctx context.Context, postID string, page,
 	return comments, count, nil
 }
 
+// FindTopLevelByPost finds top-level comments for a post with pagination
+func (r *commentRepository) FindTopLevelByPost(ctx context.Context, postID string, page, limit int) ([]*models.Comment, int64, error) {
+	var comments []*models.Comment
+	var count int64
+
+	offset := (page - 1) * limit
+
+	// Count top-level comments for the post
+	if err := r.db.WithContext(ctx).Model(&models.Comment{}).Where("post_id = ? AND parent_comment_id IS NULL", postID).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get top-level comments for the post with pagination
+	if err := r.db.WithContext(ctx).Where("post_id = ? AND parent_comment_id IS NULL", postID).Order("created_at DESC").Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, count, nil
+}
+
+// FindReplies finds the direct replies to the given comments of a post
+func (r *commentRepository) FindReplies(ctx context.Context, postID string, parentIDs []string) ([]*models.Comment, error) {
+	var replies []*models.Comment
+	if len(parentIDs) == 0 {
+		return replies, nil
+	}
+	err := r.db.WithContext(ctx).Where("post_id = ? AND parent_comment_id IN ?", postID, parentIDs).Order("created_at ASC").Find(&replies).Error
+	if err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
+
 // Update updates a comment
 func (r *commentRepository) Update(ctx context.Context, comment *models.Comment) error {
 	return r.db.WithContext(ctx).Save(comment).Error
 }
 
-// Delete deletes a comment
+// Delete deletes a comment and decrements the post's comments count in the same transaction.
+// Deleting a comment that is already gone leaves the count alone.
 func (r *commentRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&models.Comment{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var comment models.Comment
+		if err := tx.Select("id", "post_id").Where("id = ?", id).First(&comment).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&models.Comment{}, "id = ?", id)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Model(&models.Post{}).Where("id = ?", comment.PostID).Update("comments_count", gorm.Expr("comments_count - ?", 1)).Error
+	})
 }
\ No newline at end of file
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"post-api/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// HashtagRepository defines the interface for hashtag repository operations
+type HashtagRepository interface {
+	// FindTagsByPost finds the hashtags attached to a post
+	FindTagsByPost(ctx context.Context, postID string) ([]string, error)
+
+	// SyncPostTags makes the hashtags stored for a post match the given tags,
+	// adding new tags and removing ones that are no longer present
+	SyncPostTags(ctx context.Context, postID string, tags []string) error
+}
+
+// hashtagRepository implements the HashtagRepository interface
+type hashtagRepository struct {
+	db *gorm.DB
+}
+
+// NewHashtagRepository creates a new hashtag repository
+func NewHashtagRepository(db *gorm.DB) HashtagRepository {
+	return &hashtagRepository{db: db}
+}
+
+// FindTagsByPost finds the hashtags attached to a post
+func (r *hashtagRepository) FindTagsByPost(ctx context.Context, postID string) ([]string, error) {
+	var tags []string
+	err := r.db.WithContext(ctx).Model(&models.PostHashtag{}).Where("post_id = ?", postID).Pluck("tag", &tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// SyncPostTags makes the hashtags stored for a post match the given tags,
+// adding new tags and removing ones that are no longer present
+func (r *hashtagRepository) SyncPostTags(ctx context.Context, postID string, tags []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []string
+		if err := tx.Model(&models.PostHashtag{}).Where("post_id = ?", postID).Pluck("tag", &existing).Error; err != nil {
+			return err
+		}
+
+		wanted := make(map[string]bool, len(tags))
+		for _, tag := range tags {
+			wanted[tag] = true
+		}
+		stored := make(map[string]bool, len(existing))
+		for _, tag := range existing {
+			stored[tag] = true
+		}
+
+		// Remove tags that are no longer in the content
+		var removed []string
+		for _, tag := range existing {
+			if !wanted[tag] {
+				removed = append(removed, tag)
+			}
+		}
+		if len(removed) > 0 {
+			if err := tx.Where("post_id = ? AND tag IN ?", postID, removed).Delete(&models.PostHashtag{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// Add tags that are not stored yet
+		var added []*models.PostHashtag
+		for _, tag := range tags {
+			if !stored[tag] {
+				added = append(added, &models.PostHashtag{PostID: postID, Tag: tag})
+			}
+		}
+		if len(added) > 0 {
+			if err := tx.Create(&added).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"post-api/internal/models"
+	"post-api/internal/testutil"
+)
+
+// newTestDB returns a migrated, empty database
+func newTestDB(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	db := testutil.NewDB(tb)
+	if _, err := AutoMigrate(db); err != nil {
+		tb.Fatalf("failed to migrate test database: %v", err)
+	}
+	return db
+}
+
+// createPost stores a public post of the author
+func createPost(tb testing.TB, db *gorm.DB, authorID string) *models.Post {
+	tb.Helper()
+
+	post := &models.Post{
+		AuthorID:   authorID,
+		AuthorName: authorID,
+		Content:    "post by " + authorID,
+		Visibility: "public",
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := db.Create(post).Error; err != nil {
+		tb.Fatalf("failed to create post: %v", err)
+	}
+	return post
+}
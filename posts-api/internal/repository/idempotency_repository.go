@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"post-api/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyRepository defines the interface for idempotency key repository operations
+type IdempotencyRepository interface {
+	// Reserve records a key before its request runs, returning gorm.ErrDuplicatedKey if the user already used it
+	Reserve(ctx context.Context, key *models.IdempotencyKey) error
+
+	// Find finds a user's key for an operation
+	Find(ctx context.Context, userID, operation, key string) (*models.IdempotencyKey, error)
+
+	// Complete stores the ID of the resource the key's request created
+	Complete(ctx context.Context, userID, operation, key, resourceID string) error
+
+	// Release removes a key, so the request can be made again with it
+	Release(ctx context.Context, userID, operation, key string) error
+
+	// ReleaseStale removes a key that was created before completedBefore, or that has no resource
+	// yet and was created before pendingBefore, and reports whether it did
+	ReleaseStale(ctx context.Context, userID, operation, key string, completedBefore, pendingBefore time.Time) (bool, error)
+
+	// PurgeExpired removes keys created before the given time and returns how many were removed
+	PurgeExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// idempotencyRepository implements the IdempotencyRepository interface
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyRepository creates a new idempotency key repository
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+// Reserve records a key before its request runs, returning gorm.ErrDuplicatedKey if the user already used it.
+// The primary key makes sure only one of several concurrent requests with the same key gets to run.
+func (r *idempotencyRepository) Reserve(ctx context.Context, key *models.IdempotencyKey) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrDuplicatedKey
+	}
+	return nil
+}
+
+// Find finds a user's key for an operation
+func (r *idempotencyRepository) Find(ctx context.Context, userID, operation, key string) (*models.IdempotencyKey, error) {
+	var record models.IdempotencyKey
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND operation = ? AND idempotency_key = ?", userID, operation, key).
+		First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Complete stores the ID of the resource the key's request created
+func (r *idempotencyRepository) Complete(ctx context.Context, userID, operation, key, resourceID string) error {
+	return r.db.WithContext(ctx).Model(&models.IdempotencyKey{}).
+		Where("user_id = ? AND operation = ? AND idempotency_key = ?", userID, operation, key).
+		Update("resource_id", resourceID).Error
+}
+
+// Release removes a key, so the request can be made again with it
+func (r *idempotencyRepository) Release(ctx context.Context, userID, operation, key string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND operation = ? AND idempotency_key = ?", userID, operation, key).
+		Delete(&models.IdempotencyKey{}).Error
+}
+
+// ReleaseStale removes a key that was created before completedBefore, or that has no resource
+// yet and was created before pendingBefore, and reports whether it did. The conditions are
+// checked by the delete itself, so a key another request has just reserved again is left alone.
+func (r *idempotencyRepository) ReleaseStale(ctx context.Context, userID, operation, key string, completedBefore, pendingBefore time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND operation = ? AND idempotency_key = ?", userID, operation, key).
+		Where("created_at < ? OR (resource_id = '' AND created_at < ?)", completedBefore, pendingBefore).
+		Delete(&models.IdempotencyKey{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// PurgeExpired removes keys created before the given time and returns how many were removed
+func (r *idempotencyRepository) PurgeExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("created_at < ?", before).Delete(&models.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
\ No newline at end of file
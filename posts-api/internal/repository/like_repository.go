@@ -2,14 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"post-api/internal/models"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // LikeRepository defines the interface for like repository operations
 type LikeRepository interface {
-	// Create creates a new like
+	// Create creates a new like; a like the user already has on the post is left as it is
 	Create(ctx context.Context, like *models.Like) error
 
 	// FindByID finds a like by ID
@@ -21,14 +24,29 @@ type LikeRepository interface {
 	// FindByPost finds likes for a post
 	FindByPost(ctx context.Context, postID string) ([]*models.Like, error)
 
+	// WhichLiked reports which of the given posts a user has liked, in a single query
+	WhichLiked(ctx context.Context, userID string, postIDs []string) (map[string]bool, error)
+
 	// CountByPost counts likes for a post
 	CountByPost(ctx context.Context, postID string) (int64, error)
 
+	// CountReactionsByPost counts likes for a post grouped by reaction type
+	CountReactionsByPost(ctx context.Context, postID string) (map[string]int64, error)
+
+	// Update updates a like
+	Update(ctx context.Context, like *models.Like) error
+
 	// Delete deletes a like
 	Delete(ctx context.Context, id string) error
 
 	// DeleteByPostAndUser deletes a like by post ID and user ID
 	DeleteByPostAndUser(ctx context.Context, postID, userID string) error
+
+	// SetReaction creates or replaces the user's reaction on a post and returns the post's likes count
+	SetReaction(ctx context.Context, postID, userID, reaction string) (int64, error)
+
+	// RemoveReaction removes the user's reaction from a post and returns the post's likes count
+	RemoveReaction(ctx context.Context, postID, userID string) (int64, error)
 }
 
 // likeRepository implements the LikeRepository interface
@@ -41,9 +59,9 @@ func NewLikeRepository(db *gorm.DB) LikeRepository {
 	return &likeRepository{db: db}
 }
 
-// Create creates a new like
+// Create creates a new like; a like the user already has on the post is left as it is
 func (r *likeRepository) Create(ctx context.Context, like *models.Like) error {
-	return r.db.WithContext(ctx).Create(like).Error
+	return insertLike(r.db.WithContext(ctx), like)
 }
 
 // FindByID finds a like by ID
@@ -76,6 +94,28 @@ func (r *likeRepository) FindByPost(ctx context.Context, postID string) ([]*mode
 	return likes, nil
 }
 
+// WhichLiked reports which of the given posts a user has liked, in a single query. Posts the
+// user has not liked are absent from the map.
+func (r *likeRepository) WhichLiked(ctx context.Context, userID string, postIDs []string) (map[string]bool, error) {
+	liked := make(map[string]bool)
+	if len(postIDs) == 0 {
+		return liked, nil
+	}
+
+	var likedIDs []string
+	err := r.db.WithContext(ctx).Model(&models.Like{}).
+		Where("user_id = ? AND post_id IN ?", userID, postIDs).
+		Pluck("post_id", &likedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range likedIDs {
+		liked[id] = true
+	}
+	return liked, nil
+}
+
 // CountByPost counts likes for a post
 func (r *likeRepository) CountByPost(ctx context.Context, postID string) (int64, error) {
 	var count int64
@@ -86,6 +126,33 @@ func (r *likeRepository) CountByPost(ctx context.Context, postID string) (int64,
 	return count, nil
 }
 
+// CountReactionsByPost counts likes for a post grouped by reaction type
+func (r *likeRepository) CountReactionsByPost(ctx context.Context, postID string) (map[string]int64, error) {
+	var rows []struct {
+		ReactionType string
+		Count        int64
+	}
+	err := r.db.WithContext(ctx).Model(&models.Like{}).
+		Select("reaction_type, COUNT(*) AS count").
+		Where("post_id = ?", postID).
+		Group("reaction_type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.ReactionType] = row.Count
+	}
+	return counts, nil
+}
+
+// Update updates a like
+func (r *likeRepository) Update(ctx context.Context, like *models.Like) error {
+	return r.db.WithContext(ctx).Save(like).Error
+}
+
 // Delete deletes a like
 func (r *likeRepository) Delete(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Delete(&models.Like{}, "id = ?", id).Error
@@ -94,4 +161,104 @@ func (r *likeRepository) Delete(ctx context.Context, id string) error {
 // DeleteByPostAndUser deletes a like by post ID and user ID
 func (r *likeRepository) DeleteByPostAndUser(ctx context.Context, postID, userID string) error {
 	return r.db.WithContext(ctx).Delete(&models.Like{}, "post_id = ? AND user_id = ?", postID, userID).Error
+}
+
+// SetReaction creates or replaces the user's reaction on a post and returns the post's likes count.
+// The post row is locked for the duration, so concurrent reactions on a post are applied one at a
+// time and the stored count always matches the like rows. Returns gorm.ErrRecordNotFound if the
+// post does not exist.
+func (r *likeRepository) SetReaction(ctx context.Context, postID, userID, reaction string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockPost(tx, postID); err != nil {
+			return err
+		}
+
+		// (post_id, user_id) is unique, so a soft-deleted like is revived rather than recreated
+		var like models.Like
+		err := tx.Unscoped().Where("post_id = ? AND user_id = ?", postID, userID).First(&like).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			err = insertLike(tx, &models.Like{
+				PostID:       postID,
+				UserID:       userID,
+				ReactionType: reaction,
+				CreatedAt:    time.Now(),
+			})
+		case err != nil:
+			return err
+		case like.DeletedAt.Valid:
+			err = tx.Unscoped().Model(&like).Updates(map[string]interface{}{
+				"reaction_type": reaction,
+				"created_at":    time.Now(),
+				"deleted_at":    nil,
+			}).Error
+		case like.ReactionType != reaction:
+			err = tx.Model(&like).Update("reaction_type", reaction).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		count, err = recountLikes(tx, postID)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// RemoveReaction removes the user's reaction from a post and returns the post's likes count.
+// Returns gorm.ErrRecordNotFound if the post does not exist or the user has not reacted to it.
+func (r *likeRepository) RemoveReaction(ctx context.Context, postID, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockPost(tx, postID); err != nil {
+			return err
+		}
+
+		result := tx.Where("post_id = ? AND user_id = ?", postID, userID).Delete(&models.Like{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var err error
+		count, err = recountLikes(tx, postID)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// insertLike inserts a like, doing nothing if the user already has one on the post.
+// The unique (post_id, user_id) index turns a duplicate into a no-op instead of an error.
+func insertLike(db *gorm.DB, like *models.Like) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(like).Error
+}
+
+// lockPost locks a post row until the end of the transaction
+func lockPost(tx *gorm.DB, postID string) error {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", postID).First(&models.Post{}).Error
+}
+
+// recountLikes sets a post's likes count from its like rows and returns it, which also corrects
+// any drift left by earlier updates
+func recountLikes(tx *gorm.DB, postID string) (int64, error) {
+	var count int64
+	if err := tx.Model(&models.Like{}).Where("post_id = ?", postID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Model(&models.Post{}).Where("id = ?", postID).Update("likes_count", count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
 }
\ No newline at end of file
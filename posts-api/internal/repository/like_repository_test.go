@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"post-api/internal/models"
+	"post-api/internal/testutil"
+)
+
+// likeCounts returns the stored likes count of a post and the number of its like rows
+func likeCounts(t *testing.T, r *likeRepository, postID string) (stored, rows int64) {
+	t.Helper()
+
+	var post models.Post
+	if err := r.db.Where("id = ?", postID).First(&post).Error; err != nil {
+		t.Fatalf("failed to get post: %v", err)
+	}
+	if err := r.db.Model(&models.Like{}).Where("post_id = ?", postID).Count(&rows).Error; err != nil {
+		t.Fatalf("failed to count likes: %v", err)
+	}
+	return int64(post.LikesCount), rows
+}
+
+func TestConcurrentReactionsKeepLikesCountInSync(t *testing.T) {
+	db := newTestDB(t)
+	r := NewLikeRepository(db).(*likeRepository)
+	post := createPost(t, db, "author")
+	ctx := context.Background()
+
+	// Every user reacts twice at once, with different reactions, and every third user then
+	// takes their reaction back, racing with the reactions of everyone else
+	const users = 30
+	var wg sync.WaitGroup
+	errs := make(chan error, users*3)
+	for i := 0; i < users; i++ {
+		userID := fmt.Sprintf("user-%d", i)
+		for _, reaction := range []string{"like", "love"} {
+			wg.Add(1)
+			go func(reaction string) {
+				defer wg.Done()
+				if _, err := r.SetReaction(ctx, post.ID, userID, reaction); err != nil {
+					errs <- fmt.Errorf("SetReaction %s: %w", userID, err)
+				}
+			}(reaction)
+		}
+	}
+	wg.Wait()
+
+	for i := 0; i < users; i += 3 {
+		userID := fmt.Sprintf("user-%d", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.RemoveReaction(ctx, post.ID, userID); err != nil {
+				errs <- fmt.Errorf("RemoveReaction %s: %w", userID, err)
+			}
+		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.SetReaction(ctx, post.ID, fmt.Sprintf("late-%s", userID), "like"); err != nil {
+				errs <- fmt.Errorf("SetReaction late-%s: %w", userID, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	stored, rows := likeCounts(t, r, post.ID)
+	if stored != rows {
+		t.Errorf("likes_count is %d, but the post has %d likes", stored, rows)
+	}
+	// As many late users reacted as users took their reaction back
+	if want := int64(users); rows != want {
+		t.Errorf("post has %d likes, want %d", rows, want)
+	}
+}
+
+func TestSetReactionReturnsLikesCount(t *testing.T) {
+	db := newTestDB(t)
+	r := NewLikeRepository(db)
+	post := createPost(t, db, "author")
+	ctx := context.Background()
+
+	steps := []struct {
+		name   string
+		do     func() (int64, error)
+		wanted int64
+	}{
+		{"first like", func() (int64, error) { return r.SetReaction(ctx, post.ID, "a", "like") }, 1},
+		{"second user", func() (int64, error) { return r.SetReaction(ctx, post.ID, "b", "like") }, 2},
+		{"changed reaction", func() (int64, error) { return r.SetReaction(ctx, post.ID, "a", "love") }, 2},
+		{"removed", func() (int64, error) { return r.RemoveReaction(ctx, post.ID, "a") }, 1},
+		{"reacted again", func() (int64, error) { return r.SetReaction(ctx, post.ID, "a", "like") }, 2},
+	}
+	for _, step := range steps {
+		count, err := step.do()
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if count != step.wanted {
+			t.Errorf("%s: got count %d, want %d", step.name, count, step.wanted)
+		}
+	}
+}
+
+func TestWhichLikedUsesOneQueryForAPage(t *testing.T) {
+	db := newTestDB(t)
+	r := NewLikeRepository(db)
+	ctx := context.Background()
+
+	// A page of posts, every other one liked by the user; a reaction taken back doesn't count
+	var postIDs []string
+	want := make(map[string]bool)
+	for i := 0; i < 10; i++ {
+		post := createPost(t, db, "author")
+		postIDs = append(postIDs, post.ID)
+		if i%2 == 0 {
+			if _, err := r.SetReaction(ctx, post.ID, "user", "like"); err != nil {
+				t.Fatalf("SetReaction: %v", err)
+			}
+			want[post.ID] = true
+		}
+		if _, err := r.SetReaction(ctx, post.ID, "someone-else", "like"); err != nil {
+			t.Fatalf("SetReaction: %v", err)
+		}
+	}
+	if _, err := r.SetReaction(ctx, postIDs[1], "user", "like"); err != nil {
+		t.Fatalf("SetReaction: %v", err)
+	}
+	if _, err := r.RemoveReaction(ctx, postIDs[1], "user"); err != nil {
+		t.Fatalf("RemoveReaction: %v", err)
+	}
+
+	queries := testutil.CountQueries(t, db)
+	liked, err := r.WhichLiked(ctx, "user", postIDs)
+	if err != nil {
+		t.Fatalf("WhichLiked: %v", err)
+	}
+
+	if *queries != 1 {
+		t.Errorf("WhichLiked ran %d queries, want 1", *queries)
+	}
+	if len(liked) != len(want) {
+		t.Errorf("got %d liked posts, want %d", len(liked), len(want))
+	}
+	for _, id := range postIDs {
+		if liked[id] != want[id] {
+			t.Errorf("post %s: got liked %v, want %v", id, liked[id], want[id])
+		}
+	}
+
+	// An empty page needs no query at all
+	*queries = 0
+	if liked, err := r.WhichLiked(ctx, "user", nil); err != nil || len(liked) != 0 {
+		t.Errorf("WhichLiked of no posts: got %v, %v", liked, err)
+	}
+	if *queries != 0 {
+		t.Errorf("WhichLiked of no posts ran %d queries, want 0", *queries)
+	}
+}
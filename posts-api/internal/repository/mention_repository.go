@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"post-api/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// MentionRepository defines the interface for mention repository operations
+type MentionRepository interface {
+	// SyncPostMentions makes the mentions stored for a post match the given mentions
+	// and returns the ones that were not stored before
+	SyncPostMentions(ctx context.Context, postID string, mentions []*models.PostMention) ([]*models.PostMention, error)
+
+	// SyncCommentMentions makes the mentions stored for a comment match the given mentions
+	// and returns the ones that were not stored before
+	SyncCommentMentions(ctx context.Context, commentID string, mentions []*models.CommentMention) ([]*models.CommentMention, error)
+
+	// FindByPosts finds the mentions in several posts
+	FindByPosts(ctx context.Context, postIDs []string) ([]*models.PostMention, error)
+
+	// FindByComments finds the mentions in several comments
+	FindByComments(ctx context.Context, commentIDs []string) ([]*models.CommentMention, error)
+}
+
+// mentionRepository implements the MentionRepository interface
+type mentionRepository struct {
+	db *gorm.DB
+}
+
+// NewMentionRepository creates a new mention repository
+func NewMentionRepository(db *gorm.DB) MentionRepository {
+	return &mentionRepository{db: db}
+}
+
+// SyncPostMentions makes the mentions stored for a post match the given mentions
+// and returns the ones that were not stored before
+func (r *mentionRepository) SyncPostMentions(ctx context.Context, postID string, mentions []*models.PostMention) ([]*models.PostMention, error) {
+	var added []*models.PostMention
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []string
+		if err := tx.Model(&models.PostMention{}).Where("post_id = ?", postID).Pluck("user_id", &existing).Error; err != nil {
+			return err
+		}
+
+		wanted := make([]string, len(mentions))
+		for i, mention := range mentions {
+			wanted[i] = mention.UserID
+		}
+		removed, stored := diffUserIDs(existing, wanted)
+
+		// Remove users that are no longer mentioned
+		if len(removed) > 0 {
+			if err := tx.Where("post_id = ? AND user_id IN ?", postID, removed).Delete(&models.PostMention{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// Add newly mentioned users
+		for _, mention := range mentions {
+			if !stored[mention.UserID] {
+				mention.PostID = postID
+				added = append(added, mention)
+			}
+		}
+		if len(added) > 0 {
+			return tx.Create(&added).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// SyncCommentMentions makes the mentions stored for a comment match the given mentions
+// and returns the ones that were not stored before
+func (r *mentionRepository) SyncCommentMentions(ctx context.Context, commentID string, mentions []*models.CommentMention) ([]*models.CommentMention, error) {
+	var added []*models.CommentMention
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []string
+		if err := tx.Model(&models.CommentMention{}).Where("comment_id = ?", commentID).Pluck("user_id", &existing).Error; err != nil {
+			return err
+		}
+
+		wanted := make([]string, len(mentions))
+		for i, mention := range mentions {
+			wanted[i] = mention.UserID
+		}
+		removed, stored := diffUserIDs(existing, wanted)
+
+		// Remove users that are no longer mentioned
+		if len(removed) > 0 {
+			if err := tx.Where("comment_id = ? AND user_id IN ?", commentID, removed).Delete(&models.CommentMention{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// Add newly mentioned users
+		for _, mention := range mentions {
+			if !stored[mention.UserID] {
+				mention.CommentID = commentID
+				added = append(added, mention)
+			}
+		}
+		if len(added) > 0 {
+			return tx.Create(&added).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// FindByPosts finds the mentions in several posts
+func (r *mentionRepository) FindByPosts(ctx context.Context, postIDs []string) ([]*models.PostMention, error) {
+	var mentions []*models.PostMention
+	if len(postIDs) == 0 {
+		return mentions, nil
+	}
+	err := r.db.WithContext(ctx).Where("post_id IN ?", postIDs).Find(&mentions).Error
+	if err != nil {
+		return nil, err
+	}
+	return mentions, nil
+}
+
+// FindByComments finds the mentions in several comments
+func (r *mentionRepository) FindByComments(ctx context.Context, commentIDs []string) ([]*models.CommentMention, error) {
+	var mentions []*models.CommentMention
+	if len(commentIDs) == 0 {
+		return mentions, nil
+	}
+	err := r.db.WithContext(ctx).Where("comment_id IN ?", commentIDs).Find(&mentions).Error
+	if err != nil {
+		return nil, err
+	}
+	return mentions, nil
+}
+
+// diffUserIDs returns the existing IDs missing from wanted, and the set of existing IDs
+func diffUserIDs(existing, wanted []string) ([]string, map[string]bool) {
+	keep := make(map[string]bool, len(wanted))
+	for _, id := range wanted {
+		keep[id] = true
+	}
+
+	var removed []string
+	stored := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		stored[id] = true
+		if !keep[id] {
+			removed = append(removed, id)
+		}
+	}
+	return removed, stored
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"post-api/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// migratedModels are the models whose tables AutoMigrate manages, parents before children
+var migratedModels = []interface{}{
+	&models.Post{},
+	&models.Comment{},
+	&models.Like{},
+	&models.PostHashtag{},
+	&models.PostMention{},
+	&models.CommentMention{},
+	&models.PostBookmark{},
+	&models.PostReport{},
+	&models.IdempotencyKey{},
+}
+
+// AutoMigrate creates the missing tables, columns and indexes of the service's models and
+// returns the names of the tables it migrated. It never drops or changes existing columns,
+// so the SQL migrations stay the reference schema.
+func AutoMigrate(db *gorm.DB) ([]string, error) {
+	if err := db.AutoMigrate(migratedModels...); err != nil {
+		return nil, err
+	}
+
+	tables := make([]string, 0, len(migratedModels))
+	for _, model := range migratedModels {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, err
+		}
+		tables = append(tables, stmt.Schema.Table)
+	}
+	return tables, nil
+}
\ No newline at end of file
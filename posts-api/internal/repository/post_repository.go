@@ -4,47 +4,83 @@ import (
 	"context"
 	"encoding/json"
 	"post-api/internal/models"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+// FeedCursor marks a position in a feed ordered newest first. Posts are ordered by
+// creation time with the ID as a tie-breaker, so the position stays stable when new
+// posts are inserted ahead of it.
+type FeedCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
 // PostRepository defines the interface for post repository operations
 type PostRepository interface {
 	// Create creates a new post
 	Create(ctx context.Context, post *models.Post) error
 
+	// CreateShare creates a post that shares another post and counts the share on the original
+	CreateShare(ctx context.Context, post *models.Post) error
+
 	// FindByID finds a post by ID
 	FindByID(ctx context.Context, id string) (*models.Post, error)
 
+	// FindByIDs finds several posts by ID; IDs of missing or deleted posts are skipped
+	FindByIDs(ctx context.Context, ids []string) ([]*models.Post, error)
+
 	// FindByAuthor finds posts by author ID with pagination
 	FindByAuthor(ctx context.Context, authorID string, page, limit int) ([]*models.Post, int64, error)
 
+	// CountPublicByAuthor counts the public, non-group posts of an author that are not hidden
+	CountPublicByAuthor(ctx context.Context, authorID string) (int64, error)
+
 	// FindByGroup finds posts by group ID with pagination
 	FindByGroup(ctx context.Context, groupID string, page, limit int) ([]*models.Post, int64, error)
 
 	// FindPublic finds public posts with pagination
 	FindPublic(ctx context.Context, page, limit int) ([]*models.Post, int64, error)
 
-	// FindVisible finds posts visible to a user (public or authored by friends) with pagination
+	// FindVisible finds posts visible to a user (public, authored by the user or by friends) with pagination
 	FindVisible(ctx context.Context, userID string, friendIDs []string, page, limit int) ([]*models.Post, int64, error)
 
+	// FindFollowed finds the posts of followed users that are public or, for friends, friends-only with pagination
+	FindFollowed(ctx context.Context, followeeIDs, friendIDs []string, page, limit int) ([]*models.Post, int64, error)
+
+	// FindPublicAfter finds up to limit public posts older than the cursor, or the newest ones if the cursor is nil
+	FindPublicAfter(ctx context.Context, cursor *FeedCursor, limit int) ([]*models.Post, error)
+
+	// FindVisibleAfter finds up to limit posts visible to a user older than the cursor, or the newest ones if the cursor is nil
+	FindVisibleAfter(ctx context.Context, userID string, friendIDs []string, cursor *FeedCursor, limit int) ([]*models.Post, error)
+
+	// FindFollowedAfter finds up to limit posts of followed users older than the cursor, or the newest ones if the cursor is nil
+	FindFollowedAfter(ctx context.Context, followeeIDs, friendIDs []string, cursor *FeedCursor, limit int) ([]*models.Post, error)
+
+	// FindByHashtag finds posts tagged with a hashtag that are visible to a user with pagination
+	FindByHashtag(ctx context.Context, tag, userID string, friendIDs []string, page, limit int) ([]*models.Post, int64, error)
+
 	// Update updates a post
 	Update(ctx context.Context, post *models.Post) error
 
-	// Delete deletes a post
+	// Delete soft-deletes a post
 	Delete(ctx context.Context, id string) error
 
-	// IncrementLikesCount increments the likes count for a post
-	IncrementLikesCount(ctx context.Context, id string) error
+	// FindDeletedByID finds a soft-deleted post by ID
+	FindDeletedByID(ctx context.Context, id string) (*models.Post, error)
 
-	// DecrementLikesCount decrements the likes count for a post
-	DecrementLikesCount(ctx context.Context, id string) error
+	// Restore undoes the soft delete of a post
+	Restore(ctx context.Context, id string) error
 
-	// IncrementCommentsCount increments the comments count for a post
-	IncrementCommentsCount(ctx context.Context, id string) error
+	// PurgeDeleted permanently removes posts soft-deleted before the given time and returns how many were removed
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
 
-	// DecrementCommentsCount decrements the comments count for a post
-	DecrementCommentsCount(ctx context.Context, id string) error
+	// RecountPost recomputes the likes and comments counts of a post from the likes and comments tables
+	RecountPost(ctx context.Context, id string) error
+
+	// RecountDrifted recomputes the counts of every post whose stored counts are wrong and returns how many were fixed
+	RecountDrifted(ctx context.Context) (int64, error)
 }
 
 // postRepository implements the PostRepository interface
@@ -71,6 +107,41 @@ func (r *postRepository) Create(ctx context.Context, post *models.Post) error {
 	return r.db.WithContext(ctx).Create(post).Error
 }
 
+// CreateShare creates a post that shares another post and counts the share on the original
+// in the same transaction
+func (r *postRepository) CreateShare(ctx context.Context, post *models.Post) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(post).Error; err != nil {
+			return err
+		}
+		return updateSharesCount(tx, *post.OriginalPostID, 1)
+	})
+}
+
+// FindByIDs finds several posts by ID; IDs of missing or deleted posts are skipped
+func (r *postRepository) FindByIDs(ctx context.Context, ids []string) ([]*models.Post, error) {
+	var posts []*models.Post
+	if len(ids) == 0 {
+		return posts, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+
+	// Parse media JSON string to array for each post
+	for _, post := range posts {
+		if post.Media != "" {
+			var mediaArray []string
+			if err := json.Unmarshal([]byte(post.Media), &mediaArray); err != nil {
+				return nil, err
+			}
+			post.MediaArray = mediaArray
+		}
+	}
+
+	return posts, nil
+}
+
 // FindByID finds a post by ID
 func (r *postRepository) FindByID(ctx context.Context, id string) (*models.Post, error) {
 	var post models.Post
@@ -122,6 +193,15 @@ func (r *postRepository) FindByAuthor(ctx context.Context, authorID string, page
 	return posts, count, nil
 }
 
+// CountPublicByAuthor counts the public, non-group posts of an author that are not hidden
+func (r *postRepository) CountPublicByAuthor(ctx context.Context, authorID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Post{}).
+		Where("author_id = ? AND visibility = ? AND (group_id IS NULL OR group_id = '') AND hidden_at IS NULL", authorID, "public").
+		Count(&count).Error
+	return count, err
+}
+
 // FindByGroup finds posts by group ID with pagination
 func (r *postRepository) FindByGroup(ctx context.Context, groupID string, page, limit int) ([]*models.Post, int64, error) {
 	var posts []*models.Post
@@ -184,7 +264,10 @@ func (r *postRepository) FindPublic(ctx context.Context, page, limit int) ([]*mo
 	return posts, count, nil
 }
 
-// FindVisible finds posts visible to a user (public or authored by friends) with pagination
+// visibleToUser matches posts that are public, authored by the user or friends-only posts of the user's friends
+const visibleToUser = "visibility = ? OR author_id = ? OR (visibility = ? AND author_id IN ?)"
+
+// FindVisible finds posts visible to a user (public, authored by the user or by friends) with pagination
 func (r *postRepository) FindVisible(ctx context.Context, userID string, friendIDs []string, page, limit int) ([]*models.Post, int64, error) {
 	var posts []*models.Post
 	var count int64
@@ -192,13 +275,132 @@ func (r *postRepository) FindVisible(ctx context.Context, userID string, friendI
 	offset := (page - 1) * limit
 
 	// Count total visible posts
-	query := r.db.WithContext(ctx).Model(&models.Post{}).Where("visibility = ? OR (visibility = ? AND author_id IN ?)", "public", "private", friendIDs)
+	query := r.db.WithContext(ctx).Model(&models.Post{}).Where(visibleToUser, "public", userID, "friends", friendIDs)
 	if err := query.Count(&count).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// Get visible posts with pagination
-	if err := r.db.WithContext(ctx).Where("visibility = ? OR (visibility = ? AND author_id IN ?)", "public", "private", friendIDs).Order("created_at DESC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where(visibleToUser, "public", userID, "friends", friendIDs).Order("created_at DESC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Parse media JSON string to array for each post
+	for _, post := range posts {
+		if post.Media != "" {
+			var mediaArray []string
+			if err := json.Unmarshal([]byte(post.Media), &mediaArray); err != nil {
+				return nil, 0, err
+			}
+			post.MediaArray = mediaArray
+		}
+	}
+
+	return posts, count, nil
+}
+
+// followedByUser matches the posts of followed users that are public, or friends-only if the author is also a friend
+const followedByUser = "author_id IN ? AND (visibility = ? OR (visibility = ? AND author_id IN ?))"
+
+// FindFollowed finds the posts of followed users that are public or, for friends, friends-only with pagination
+func (r *postRepository) FindFollowed(ctx context.Context, followeeIDs, friendIDs []string, page, limit int) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var count int64
+
+	offset := (page - 1) * limit
+
+	// Count total posts of followed users
+	query := r.db.WithContext(ctx).Model(&models.Post{}).Where(followedByUser, followeeIDs, "public", "friends", friendIDs)
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get posts of followed users with pagination
+	if err := r.db.WithContext(ctx).Where(followedByUser, followeeIDs, "public", "friends", friendIDs).Order("created_at DESC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Parse media JSON string to array for each post
+	for _, post := range posts {
+		if post.Media != "" {
+			var mediaArray []string
+			if err := json.Unmarshal([]byte(post.Media), &mediaArray); err != nil {
+				return nil, 0, err
+			}
+			post.MediaArray = mediaArray
+		}
+	}
+
+	return posts, count, nil
+}
+
+// FindPublicAfter finds up to limit public posts older than the cursor, or the newest ones if the cursor is nil
+func (r *postRepository) FindPublicAfter(ctx context.Context, cursor *FeedCursor, limit int) ([]*models.Post, error) {
+	query := r.db.WithContext(ctx).Where("visibility = ?", "public")
+
+	return r.findAfter(query, cursor, limit)
+}
+
+// FindVisibleAfter finds up to limit posts visible to a user older than the cursor, or the newest ones if the cursor is nil
+func (r *postRepository) FindVisibleAfter(ctx context.Context, userID string, friendIDs []string, cursor *FeedCursor, limit int) ([]*models.Post, error) {
+	query := r.db.WithContext(ctx).Where(visibleToUser, "public", userID, "friends", friendIDs)
+
+	return r.findAfter(query, cursor, limit)
+}
+
+// FindFollowedAfter finds up to limit posts of followed users older than the cursor, or the newest ones if the cursor is nil
+func (r *postRepository) FindFollowedAfter(ctx context.Context, followeeIDs, friendIDs []string, cursor *FeedCursor, limit int) ([]*models.Post, error) {
+	query := r.db.WithContext(ctx).Where(followedByUser, followeeIDs, "public", "friends", friendIDs)
+
+	return r.findAfter(query, cursor, limit)
+}
+
+// findAfter applies keyset pagination to a feed query. Rows are read in (created_at, id)
+// order so the seek condition can be served by the composite indexes on those columns.
+func (r *postRepository) findAfter(query *gorm.DB, cursor *FeedCursor, limit int) ([]*models.Post, error) {
+	var posts []*models.Post
+
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+
+	// Parse media JSON string to array for each post
+	for _, post := range posts {
+		if post.Media != "" {
+			var mediaArray []string
+			if err := json.Unmarshal([]byte(post.Media), &mediaArray); err != nil {
+				return nil, err
+			}
+			post.MediaArray = mediaArray
+		}
+	}
+
+	return posts, nil
+}
+
+// FindByHashtag finds posts tagged with a hashtag that are visible to a user with pagination
+func (r *postRepository) FindByHashtag(ctx context.Context, tag, userID string, friendIDs []string, page, limit int) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.WithContext(ctx).Model(&models.Post{}).
+		Joins("JOIN post_hashtags ON post_hashtags.post_id = posts.id").
+		Where("post_hashtags.tag = ?", tag).
+		Where("posts.visibility = ? OR posts.author_id = ? OR (posts.visibility = ? AND posts.author_id IN ?)", "public", userID, "friends", friendIDs)
+
+	// Count total tagged posts
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get tagged posts with pagination
+	if err := query.Select("posts.*").Order("posts.created_at DESC").Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -230,27 +432,93 @@ func (r *postRepository) Update(ctx context.Context, post *models.Post) error {
 	return r.db.WithContext(ctx).Save(post).Error
 }
 
-// Delete deletes a post
+// Delete soft-deletes a post. Its comments, likes, hashtags and mentions are kept so that
+// restoring the post brings them back. A deleted share no longer counts on the original.
 func (r *postRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&models.Post{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var post models.Post
+		if err := tx.Select("id", "original_post_id").Where("id = ?", id).First(&post).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&models.Post{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		if post.OriginalPostID == nil {
+			return nil
+		}
+		return updateSharesCount(tx, *post.OriginalPostID, -1)
+	})
+}
+
+// FindDeletedByID finds a soft-deleted post by ID
+func (r *postRepository) FindDeletedByID(ctx context.Context, id string) (*models.Post, error) {
+	var post models.Post
+	err := r.db.WithContext(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&post).Error
+	if err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// Restore undoes the soft delete of a post, counting a restored share on the original again
+func (r *postRepository) Restore(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var post models.Post
+		if err := tx.Unscoped().Select("id", "original_post_id").Where("id = ?", id).First(&post).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&models.Post{}).Where("id = ?", id).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if post.OriginalPostID == nil {
+			return nil
+		}
+		return updateSharesCount(tx, *post.OriginalPostID, 1)
+	})
 }
 
-// IncrementLikesCount increments the likes count for a post
-func (r *postRepository) IncrementLikesCount(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Model(&models.Post{}).Where("id = ?", id).Update("likes_count", gorm.Expr("likes_count + ?", 1)).Error
+// updateSharesCount adds delta to the shares count of a post. Deleted posts are included
+// so that their count is still right if they are restored.
+func updateSharesCount(tx *gorm.DB, id string, delta int) error {
+	return tx.Unscoped().Model(&models.Post{}).Where("id = ?", id).Update("shares_count", gorm.Expr("shares_count + ?", delta)).Error
 }
 
-// DecrementLikesCount decrements the likes count for a post
-func (r *postRepository) DecrementLikesCount(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Model(&models.Post{}).Where("id = ?", id).Update("likes_count", gorm.Expr("likes_count - ?", 1)).Error
+// PurgeDeleted permanently removes posts soft-deleted before the given time and returns how many were removed.
+// The foreign keys cascade the removal to the posts' comments, likes, hashtags and mentions.
+func (r *postRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&models.Post{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
 }
 
-// IncrementCommentsCount increments the comments count for a post
-func (r *postRepository) IncrementCommentsCount(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Model(&models.Post{}).Where("id = ?", id).Update("comments_count", gorm.Expr("comments_count + ?", 1)).Error
+// Correlated subqueries counting the live likes and comments of the post being updated
+const (
+	likesCountQuery    = "(SELECT COUNT(*) FROM likes WHERE likes.post_id = posts.id AND likes.deleted_at IS NULL)"
+	commentsCountQuery = "(SELECT COUNT(*) FROM comments WHERE comments.post_id = posts.id AND comments.deleted_at IS NULL)"
+)
+
+// recountedCounts sets both counts of the updated posts from their source tables
+var recountedCounts = map[string]interface{}{
+	"likes_count":    gorm.Expr(likesCountQuery),
+	"comments_count": gorm.Expr(commentsCountQuery),
+}
+
+// RecountPost recomputes the likes and comments counts of a post from the likes and comments tables
+func (r *postRepository) RecountPost(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Model(&models.Post{}).Where("id = ?", id).Updates(recountedCounts).Error
 }
 
-// DecrementCommentsCount decrements the comments count for a post
-func (r *postRepository) DecrementCommentsCount(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Model(&models.Post{}).Where("id = ?", id).Update("comments_count", gorm.Expr("comments_count - ?", 1)).Error
+// RecountDrifted recomputes the counts of every post whose stored counts are wrong and returns how many were fixed
+func (r *postRepository) RecountDrifted(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.Post{}).
+		Where("likes_count <> " + likesCountQuery + " OR comments_count <> " + commentsCountQuery).
+		Updates(recountedCounts)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
 }
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// queryTimeoutStateKey is the statement setting the query timeout plugin keeps its state under
+const queryTimeoutStateKey = "query_timeout:state"
+
+// queryTimeout is a GORM plugin that gives every statement a deadline, so that a slow query
+// is canceled and its connection returned to the pool even if the caller's context lives on
+type queryTimeout struct {
+	timeout time.Duration
+}
+
+// queryTimeoutState is the context a statement was started with and the cancel function of
+// the deadline it runs under
+type queryTimeoutState struct {
+	parent context.Context
+	cancel context.CancelFunc
+}
+
+// NewQueryTimeout creates a plugin that cancels each statement after timeout. A deadline
+// the caller's context already has is kept if it is earlier.
+func NewQueryTimeout(timeout time.Duration) gorm.Plugin {
+	return &queryTimeout{timeout: timeout}
+}
+
+// Name returns the name of the plugin
+func (p *queryTimeout) Name() string {
+	return "query_timeout"
+}
+
+// Initialize registers the callbacks that start and stop the deadline around each statement
+func (p *queryTimeout) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("*").Register("query_timeout:start", p.start),
+		callbacks.Create().After("*").Register("query_timeout:stop", p.stop),
+		callbacks.Query().Before("*").Register("query_timeout:start", p.start),
+		callbacks.Query().After("*").Register("query_timeout:stop", p.stop),
+		callbacks.Update().Before("*").Register("query_timeout:start", p.start),
+		callbacks.Update().After("*").Register("query_timeout:stop", p.stop),
+		callbacks.Delete().Before("*").Register("query_timeout:start", p.start),
+		callbacks.Delete().After("*").Register("query_timeout:stop", p.stop),
+		callbacks.Raw().Before("*").Register("query_timeout:start", p.start),
+		callbacks.Raw().After("*").Register("query_timeout:stop", p.stop),
+
+		// Rows and Scan read the result after the callbacks return, so the deadline of a
+		// row query is not canceled early; it is released when it expires
+		callbacks.Row().Before("*").Register("query_timeout:start", p.start),
+		callbacks.Row().After("*").Register("query_timeout:stop", p.restore),
+	)
+}
+
+// start runs the statement under a deadline
+func (p *queryTimeout) start(db *gorm.DB) {
+	ctx, cancel := context.WithTimeout(db.Statement.Context, p.timeout)
+	db.InstanceSet(queryTimeoutStateKey, &queryTimeoutState{parent: db.Statement.Context, cancel: cancel})
+	db.Statement.Context = ctx
+}
+
+// stop releases the deadline of the statement and gives it back the caller's context, so that
+// a query built once and run several times, e.g. for a count and a page, starts afresh each time
+func (p *queryTimeout) stop(db *gorm.DB) {
+	if state, ok := queryTimeoutStateOf(db); ok {
+		db.Statement.Context = state.parent
+		state.cancel()
+	}
+}
+
+// restore gives the statement back the caller's context but leaves the deadline running
+func (p *queryTimeout) restore(db *gorm.DB) {
+	if state, ok := queryTimeoutStateOf(db); ok {
+		db.Statement.Context = state.parent
+	}
+}
+
+// queryTimeoutStateOf returns the state start stored on the statement
+func queryTimeoutStateOf(db *gorm.DB) (*queryTimeoutState, bool) {
+	value, ok := db.InstanceGet(queryTimeoutStateKey)
+	if !ok {
+		return nil, false
+	}
+	state, ok := value.(*queryTimeoutState)
+	return state, ok
+}
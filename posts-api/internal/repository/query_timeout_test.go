@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"post-api/internal/testutil"
+)
+
+// slowQuery counts to a billion, which takes far longer than any deadline in these tests
+const slowQuery = `WITH RECURSIVE numbers(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM numbers WHERE n < 1000000000) SELECT count(*) FROM numbers`
+
+func TestQueryTimeoutCancelsSlowQueries(t *testing.T) {
+	db := testutil.NewDB(t)
+	if err := db.Use(NewQueryTimeout(50 * time.Millisecond)); err != nil {
+		t.Fatalf("failed to register query timeout: %v", err)
+	}
+
+	// The caller's context outlives the query timeout by far, and only fails the test if the
+	// query is not canceled at all
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name string
+		run  func() error
+	}{
+		{"scan", func() error {
+			var count int64
+			return db.WithContext(ctx).Raw(slowQuery).Scan(&count).Error
+		}},
+		{"exec", func() error {
+			return db.WithContext(ctx).Exec(slowQuery).Error
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			started := time.Now()
+			err := tt.run()
+			if err == nil {
+				t.Fatal("the slow query succeeded, want it canceled")
+			}
+			if elapsed := time.Since(started); elapsed > 2*time.Second {
+				t.Errorf("the slow query was canceled after %v, want about 50ms", elapsed)
+			}
+			if ctx.Err() != nil {
+				t.Fatalf("the slow query ran until the caller's deadline: %v", err)
+			}
+
+			// The caller's context is unaffected, so the next statement runs normally
+			var one int
+			if err := db.WithContext(ctx).Raw("SELECT 1").Scan(&one).Error; err != nil || one != 1 {
+				t.Errorf("query after the canceled one: got %d, %v", one, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"post-api/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReportRepository defines the interface for post report repository operations
+type ReportRepository interface {
+	// Create files a report, returning gorm.ErrDuplicatedKey if the user already reported the post
+	Create(ctx context.Context, report *models.PostReport) error
+
+	// FindByID finds a report by ID
+	FindByID(ctx context.Context, id string) (*models.PostReport, error)
+
+	// FindByStatus finds reports with the given status, oldest first, with pagination
+	FindByStatus(ctx context.Context, status string, page, limit int) ([]*models.PostReport, int64, error)
+
+	// CountByPosts counts the reports filed against each of the given posts
+	CountByPosts(ctx context.Context, postIDs []string) (map[string]int, error)
+
+	// Resolve resolves every open report on a post, optionally hiding the post
+	Resolve(ctx context.Context, postID, resolverID string, hidePost bool) error
+}
+
+// reportRepository implements the ReportRepository interface
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// Create files a report, returning gorm.ErrDuplicatedKey if the user already reported the post
+func (r *reportRepository) Create(ctx context.Context, report *models.PostReport) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "reporter_id"}},
+		DoNothing: true,
+	}).Create(report)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrDuplicatedKey
+	}
+	return nil
+}
+
+// FindByID finds a report by ID
+func (r *reportRepository) FindByID(ctx context.Context, id string) (*models.PostReport, error) {
+	var report models.PostReport
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&report).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// FindByStatus finds reports with the given status, oldest first so the queue is worked in order,
+// with pagination
+func (r *reportRepository) FindByStatus(ctx context.Context, status string, page, limit int) ([]*models.PostReport, int64, error) {
+	var reports []*models.PostReport
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.WithContext(ctx).Model(&models.PostReport{}).Where("status = ?", status)
+
+	// Count reports
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get reports with pagination
+	if err := query.Order("created_at ASC").Offset(offset).Limit(limit).Find(&reports).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reports, count, nil
+}
+
+// CountByPosts counts the reports filed against each of the given posts, open or resolved
+func (r *reportRepository) CountByPosts(ctx context.Context, postIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(postIDs))
+	if len(postIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		PostID string
+		Count  int
+	}
+	if err := r.db.WithContext(ctx).Model(&models.PostReport{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Group("post_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
+// Resolve resolves every open report on a post, so a post reported by many users is dealt with
+// once, and hides the post in the same transaction if asked to
+func (r *reportRepository) Resolve(ctx context.Context, postID, resolverID string, hidePost bool) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.PostReport{}).
+			Where("post_id = ? AND status = ?", postID, models.ReportStatusOpen).
+			Updates(map[string]interface{}{
+				"status":      models.ReportStatusResolved,
+				"resolved_by": resolverID,
+				"resolved_at": now,
+			}).Error; err != nil {
+			return err
+		}
+
+		if !hidePost {
+			return nil
+		}
+		return tx.Unscoped().Model(&models.Post{}).
+			Where("id = ? AND hidden_at IS NULL", postID).
+			Update("hidden_at", now).Error
+	})
+}
\ No newline at end of file
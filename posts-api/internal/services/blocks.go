@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"post-api/internal/models"
+)
+
+// getBlockedIDs retrieves the users whose content is hidden from a user: those the user has
+// blocked and those who have blocked the user. Nothing is hidden from signed-out users, and
+// if the friends service cannot be reached the content is shown rather than failing the request.
+func (s *postService) getBlockedIDs(ctx context.Context, userID string) map[string]bool {
+	if userID == "" {
+		return nil
+	}
+
+	ids, err := s.friendClient.GetBlockedIDs(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get blocked users", "error", err)
+		return nil
+	}
+
+	blocked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		blocked[id] = true
+	}
+	return blocked
+}
+
+// withoutBlockedComments drops the comments by blocked users, along with the replies beneath them
+func withoutBlockedComments(comments []*models.Comment, blocked map[string]bool) []*models.Comment {
+	if len(blocked) == 0 {
+		return comments
+	}
+
+	kept := make([]*models.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if blocked[comment.AuthorID] {
+			continue
+		}
+		comment.Replies = withoutBlockedComments(comment.Replies, blocked)
+		kept = append(kept, comment)
+	}
+	return kept
+}
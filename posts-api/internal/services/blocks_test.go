@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"post-api/internal/clients"
+	"post-api/internal/models"
+)
+
+// addComment comments on a post as the user, expecting it to go through
+func (s *testService) addComment(t *testing.T, postID, userID, parentID string) *models.Comment {
+	t.Helper()
+
+	comment, err := s.AddComment(asUser(userID), postID, userID, "comment by "+userID, parentID, "")
+	if err != nil {
+		t.Fatalf("AddComment by %s: %v", userID, err)
+	}
+	return comment
+}
+
+// commentIDs returns the IDs of the comments and, depth first, of their replies
+func commentIDs(comments []*models.Comment) []string {
+	var ids []string
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+		ids = append(ids, commentIDs(comment.Replies)...)
+	}
+	return ids
+}
+
+func TestBlockedUsersContentIsHidden(t *testing.T) {
+	s := newTestService(t)
+	alicePost := s.createPost(t, "alice", "public", "")
+	bobPost := s.createPost(t, "bob", "public", "")
+	carolPost := s.createPost(t, "carol", "public", "")
+
+	aliceComment := s.addComment(t, carolPost.ID, "alice", "")
+	bobComment := s.addComment(t, carolPost.ID, "bob", "")
+	replyToBob := s.addComment(t, carolPost.ID, "carol", bobComment.ID)
+	bobReply := s.addComment(t, carolPost.ID, "bob", aliceComment.ID)
+
+	// Alice blocks Bob, which hides each one's content from the other
+	s.friends.block("alice", "bob")
+
+	// Replies beneath a blocked user's comment are hidden with it when comments are threaded
+	tests := []struct {
+		viewer         string
+		hiddenPost     *models.Post
+		shownPost      *models.Post
+		byBlocked      []string
+		beneathBlocked []string
+		shownIDs       []string
+	}{
+		{"alice", bobPost, alicePost, []string{bobComment.ID, bobReply.ID}, []string{replyToBob.ID}, []string{aliceComment.ID}},
+		{"bob", alicePost, bobPost, []string{aliceComment.ID}, []string{bobReply.ID}, []string{bobComment.ID, replyToBob.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.viewer, func(t *testing.T) {
+			ctx := asUser(tt.viewer)
+
+			feed, _, _, _, err := s.GetPosts(ctx, tt.viewer, "", "", "", "", "", 1, 10)
+			if err != nil {
+				t.Fatalf("GetPosts: %v", err)
+			}
+			if containsID(feed, tt.hiddenPost.ID) {
+				t.Error("the feed has a post of a blocked user")
+			}
+			if !containsID(feed, tt.shownPost.ID) || !containsID(feed, carolPost.ID) {
+				t.Errorf("the feed %v is missing posts of users who are not blocked", postIDs(feed))
+			}
+
+			byAuthor, _, _, _, err := s.GetPosts(ctx, tt.viewer, tt.hiddenPost.AuthorID, "", "", "", "", 1, 10)
+			if err != nil {
+				t.Fatalf("GetPosts by author: %v", err)
+			}
+			if len(byAuthor) != 0 {
+				t.Errorf("the posts of a blocked author were listed: %v", postIDs(byAuthor))
+			}
+
+			if _, _, err := s.GetPost(ctx, tt.hiddenPost.ID, tt.viewer); status.Code(err) != codes.NotFound {
+				t.Errorf("GetPost: got %v, want NotFound", err)
+			}
+			if _, _, _, err := s.GetComments(ctx, tt.hiddenPost.ID, tt.viewer, true, 1, 10); status.Code(err) != codes.NotFound {
+				t.Errorf("GetComments of a blocked user's post: got %v, want NotFound", err)
+			}
+
+			for _, threaded := range []bool{true, false} {
+				comments, _, _, err := s.GetComments(ctx, carolPost.ID, tt.viewer, threaded, 1, 10)
+				if err != nil {
+					t.Fatalf("GetComments: %v", err)
+				}
+				ids := commentIDs(comments)
+				hidden := append([]string{}, tt.byBlocked...)
+				if threaded {
+					hidden = append(hidden, tt.beneathBlocked...)
+				}
+				for _, id := range hidden {
+					if contains(ids, id) {
+						t.Errorf("threaded=%v: comment %s is shown, want it hidden", threaded, id)
+					}
+				}
+				for _, id := range tt.shownIDs {
+					if !contains(ids, id) {
+						t.Errorf("threaded=%v: comment %s is hidden, want it shown", threaded, id)
+					}
+				}
+			}
+		})
+	}
+
+	// Carol has blocked no one and sees everything
+	comments, _, _, err := s.GetComments(asUser("carol"), carolPost.ID, "carol", true, 1, 10)
+	if err != nil {
+		t.Fatalf("GetComments as carol: %v", err)
+	}
+	if got := len(commentIDs(comments)); got != 4 {
+		t.Errorf("carol sees %d comments, want 4", got)
+	}
+}
+
+// countingFriends counts the friendship checks made against the fake friends service
+type countingFriends struct {
+	*fakeFriends
+	checks int
+}
+
+func (f *countingFriends) CheckFriendship(ctx context.Context, userID, friendID string) (*clients.FriendshipInfo, error) {
+	f.checks++
+	return f.fakeFriends.CheckFriendship(ctx, userID, friendID)
+}
+
+func TestIsFriendOfAuthor(t *testing.T) {
+	s := newTestService(t)
+	friends := &countingFriends{fakeFriends: s.friends}
+	s.friendClient = friends
+	s.friends.befriend("alice", "carol")
+	s.groups.addGroup("group", "public", "alice", "carol")
+
+	friendsOnly := s.createPost(t, "alice", "friends", "")
+	public := s.createPost(t, "alice", "public", "")
+	inGroup := s.createPost(t, "alice", "friends", "group")
+
+	tests := []struct {
+		name       string
+		post       *models.Post
+		userID     string
+		err        error
+		want       bool
+		wantChecks int
+	}{
+		{"friend", friendsOnly, "carol", nil, true, 1},
+		{"stranger", friendsOnly, "bob", nil, false, 1},
+		{"author", friendsOnly, "alice", nil, false, 0},
+		{"anonymous user", friendsOnly, "", nil, false, 0},
+		{"public post", public, "carol", nil, false, 0},
+		{"group post", inGroup, "carol", nil, false, 0},
+		{"friends service unavailable", friendsOnly, "carol", status.Error(codes.Unavailable, "unavailable"), false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends.checks = 0
+			s.friends.err = tt.err
+			if got := s.isFriendOfAuthor(asUser(tt.userID), tt.post, tt.userID); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if friends.checks != tt.wantChecks {
+				t.Errorf("the friends service was asked %d times, want %d", friends.checks, tt.wantChecks)
+			}
+		})
+	}
+}
+
+// contains reports whether the IDs include id
+func contains(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
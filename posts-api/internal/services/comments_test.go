@@ -0,0 +1,78 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"post-api/internal/models"
+)
+
+func TestAddCommentRequiresVisiblePostAndNoBlock(t *testing.T) {
+	s := newTestService(t)
+	s.friends.befriend("alice", "carol")
+	s.friends.befriend("alice", "dave")
+	s.friends.block("alice", "dave")
+	s.friends.block("erin", "alice")
+	s.groups.addGroup("private-group", "private", "alice", "carol")
+
+	public := s.createPost(t, "alice", "public", "")
+	friendsOnly := s.createPost(t, "alice", "friends", "")
+	private := s.createPost(t, "alice", "private", "")
+	inGroup := s.createPost(t, "alice", "public", "private-group")
+
+	tests := []struct {
+		name   string
+		post   *models.Post
+		userID string
+		want   codes.Code
+	}{
+		{"stranger on a public post", public, "bob", codes.OK},
+		{"user the author blocked on a public post", public, "dave", codes.PermissionDenied},
+		{"user who blocked the author on a public post", public, "erin", codes.PermissionDenied},
+		{"friend on a friends-only post", friendsOnly, "carol", codes.OK},
+		{"blocked friend on a friends-only post", friendsOnly, "dave", codes.PermissionDenied},
+		{"stranger on a friends-only post", friendsOnly, "bob", codes.PermissionDenied},
+		{"friend on a private post", private, "carol", codes.PermissionDenied},
+		{"author on a private post", private, "alice", codes.OK},
+		{"member on a private group post", inGroup, "carol", codes.OK},
+		{"non-member on a private group post", inGroup, "bob", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.AddComment(asUser(tt.userID), tt.post.ID, tt.userID, "comment", "", "")
+			if status.Code(err) != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+
+			var count int64
+			if err := s.db.Model(&models.Comment{}).Where("post_id = ? AND author_id = ?", tt.post.ID, tt.userID).Count(&count).Error; err != nil {
+				t.Fatalf("failed to count comments: %v", err)
+			}
+			want := int64(0)
+			if tt.want == codes.OK {
+				want = 1
+			}
+			if count != want {
+				t.Errorf("%d comments were stored, want %d", count, want)
+			}
+		})
+	}
+}
+
+func TestAddCommentIsRefusedWhenRelationshipCannotBeChecked(t *testing.T) {
+	s := newTestService(t)
+	post := s.createPost(t, "alice", "public", "")
+	s.friends.err = errors.New("connection refused")
+
+	if _, err := s.AddComment(asUser("bob"), post.ID, "bob", "comment", "", ""); status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v, want Unavailable", err)
+	}
+
+	// The author needs no check to comment on their own post
+	if _, err := s.AddComment(asUser("alice"), post.ID, "alice", "comment", "", ""); err != nil {
+		t.Errorf("author: %v", err)
+	}
+}
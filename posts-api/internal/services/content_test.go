@@ -0,0 +1,71 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSanitizeContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"plain text", "  hello world  ", "hello world"},
+		{"script tag", "hi<script>alert('xss')</script> there", "hi there"},
+		{"nested script", "<div><script>document.cookie</script>safe</div>", "safe"},
+		{"event handler", `<img src=x onerror="alert(1)">caption`, "caption"},
+		{"formatting tags", "<b>bold</b> and <i>italic</i>", "bold and italic"},
+		{"comment", "a<!-- <script>x</script> -->b", "ab"},
+		{"entities kept", "1 &lt; 2 &amp;&amp; 3 > 2", "1 &lt; 2 &amp;&amp; 3 > 2"},
+		{"lone less-than", "x < y", "x < y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeContent(tt.content); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostContentIsLimitedAndSanitized(t *testing.T) {
+	s := newTestService(t)
+	ctx := asUser("alice")
+
+	// The limit counts characters rather than bytes
+	atLimit := strings.Repeat("é", defaultMaxPostLength)
+	if _, err := s.CreatePost(ctx, "alice", atLimit, "public", "", nil, ""); err != nil {
+		t.Errorf("CreatePost at the length limit: %v", err)
+	}
+	if _, err := s.CreatePost(ctx, "alice", atLimit+"x", "public", "", nil, ""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreatePost over the length limit: got %v, want InvalidArgument", err)
+	}
+
+	post, err := s.CreatePost(ctx, "alice", "hello <script>alert('xss')</script>world", "public", "", nil, "")
+	if err != nil {
+		t.Fatalf("CreatePost with a script tag: %v", err)
+	}
+	if post.Content != "hello world" {
+		t.Errorf("got content %q, want the script stripped", post.Content)
+	}
+
+	// Content that is only markup is empty once sanitized
+	if _, err := s.CreatePost(ctx, "alice", "<script>alert('xss')</script>", "public", "", nil, ""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreatePost with only a script tag: got %v, want InvalidArgument", err)
+	}
+
+	comment, err := s.AddComment(ctx, post.ID, "alice", "nice<script>steal()</script>", "", "")
+	if err != nil {
+		t.Fatalf("AddComment with a script tag: %v", err)
+	}
+	if comment.Content != "nice" {
+		t.Errorf("got comment %q, want the script stripped", comment.Content)
+	}
+	if _, err := s.AddComment(ctx, post.ID, "alice", strings.Repeat("x", defaultMaxCommentLength+1), "", ""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AddComment over the length limit: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"post-api/internal/models"
+	"post-api/internal/repository"
+	"strings"
+	"time"
+)
+
+// errInvalidCursor is returned when a feed cursor cannot be decoded
+var errInvalidCursor = errors.New("invalid cursor")
+
+// cursorSeparator separates the creation time from the post ID inside a cursor
+const cursorSeparator = "|"
+
+// encodeFeedCursor returns an opaque cursor pointing just past the given post
+func encodeFeedCursor(post *models.Post) string {
+	raw := post.CreatedAt.UTC().Format(time.RFC3339Nano) + cursorSeparator + post.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeFeedCursor parses a cursor produced by encodeFeedCursor
+func decodeFeedCursor(cursor string) (*repository.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	createdAt, id, found := strings.Cut(string(raw), cursorSeparator)
+	if !found || id == "" {
+		return nil, errInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &repository.FeedCursor{CreatedAt: t, ID: id}, nil
+}
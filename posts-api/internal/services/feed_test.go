@@ -0,0 +1,70 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"post-api/internal/models"
+)
+
+// postedAt backdates a post, so feeds have a known order
+func (s *testService) postedAt(t *testing.T, post *models.Post, ago time.Duration) *models.Post {
+	t.Helper()
+
+	if err := s.db.Model(post).Update("created_at", time.Now().Add(-ago)).Error; err != nil {
+		t.Fatalf("failed to backdate post: %v", err)
+	}
+	return post
+}
+
+func TestFeedMixesOwnFriendsAndPublicPosts(t *testing.T) {
+	s := newTestService(t)
+	s.friends.befriend("alice", "bob")
+
+	friendsPost := s.postedAt(t, s.createPost(t, "bob", "friends", ""), time.Minute)
+	ownPost := s.postedAt(t, s.createPost(t, "alice", "private", ""), 2*time.Minute)
+	publicPost := s.postedAt(t, s.createPost(t, "carol", "public", ""), 3*time.Minute)
+	strangerPost := s.postedAt(t, s.createPost(t, "carol", "friends", ""), 4*time.Minute)
+	friendsPrivatePost := s.postedAt(t, s.createPost(t, "bob", "private", ""), 5*time.Minute)
+
+	feed, _, _, _, err := s.GetPosts(asUser("alice"), "alice", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+
+	// Newest first, leaving out the friends-only post of a stranger and a friend's private post
+	want := []string{friendsPost.ID, ownPost.ID, publicPost.ID}
+	if got := postIDs(feed); !reflect.DeepEqual(got, want) {
+		t.Errorf("got feed %v, want %v", got, want)
+	}
+	if containsID(feed, strangerPost.ID) || containsID(feed, friendsPrivatePost.ID) {
+		t.Error("the feed has posts the user may not see")
+	}
+
+	// A stranger sees only the public post of the friends
+	feed, _, _, _, err = s.GetPosts(asUser("dave"), "dave", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts as stranger: %v", err)
+	}
+	if got := postIDs(feed); !reflect.DeepEqual(got, []string{publicPost.ID}) {
+		t.Errorf("got stranger feed %v, want only the public post", got)
+	}
+}
+
+func TestFeedFallsBackToPublicPostsWhenFriendsAreUnavailable(t *testing.T) {
+	s := newTestService(t)
+	s.friends.befriend("alice", "bob")
+	friendsPost := s.createPost(t, "bob", "friends", "")
+	publicPost := s.createPost(t, "bob", "public", "")
+	s.friends.err = errors.New("connection refused")
+
+	feed, _, _, _, err := s.GetPosts(asUser("alice"), "alice", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if containsID(feed, friendsPost.ID) || !containsID(feed, publicPost.ID) {
+		t.Errorf("got feed %v, want only the public post", postIDs(feed))
+	}
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreatePostInGroupRequiresMembership(t *testing.T) {
+	s := newTestService(t)
+	s.groups.addGroup("group-1", "public", "alice")
+
+	post, err := s.CreatePost(asUser("alice"), "alice", "hello group", "public", "group-1", nil, "")
+	if err != nil {
+		t.Fatalf("CreatePost by member: %v", err)
+	}
+	if post.GroupID != "group-1" || post.GroupName != "Group group-1" {
+		t.Errorf("CreatePost by member: got group %q named %q", post.GroupID, post.GroupName)
+	}
+
+	tests := []struct {
+		name    string
+		groupID string
+		err     error
+		want    codes.Code
+	}{
+		{"non-member", "group-1", nil, codes.PermissionDenied},
+		{"missing group", "group-2", nil, codes.NotFound},
+		{"groups service down", "group-1", errors.New("connection refused"), codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.groups.err = tt.err
+			defer func() { s.groups.err = nil }()
+
+			if _, err := s.CreatePost(asUser("bob"), "bob", "let me in", "public", tt.groupID, nil, ""); status.Code(err) != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// Only the member's post was stored
+	var count int64
+	if err := s.db.Table("posts").Where("group_id <> ''").Count(&count).Error; err != nil {
+		t.Fatalf("failed to count group posts: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("got %d group posts, want 1", count)
+	}
+}
+
+func TestGroupPostsAreHiddenFromNonMembers(t *testing.T) {
+	s := newTestService(t)
+	s.groups.addGroup("private-group", "private", "alice")
+	s.groups.addGroup("public-group", "public", "alice")
+	private := s.createPost(t, "alice", "public", "private-group")
+	public := s.createPost(t, "alice", "public", "public-group")
+
+	tests := []struct {
+		name        string
+		userID      string
+		seesPrivate bool
+	}{
+		{"member", "alice", true},
+		{"non-member", "bob", false},
+		{"anonymous", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := asUser(tt.userID)
+			feed, _, _, _, err := s.GetPosts(ctx, tt.userID, "", "", "", "", "", 1, 10)
+			if err != nil {
+				t.Fatalf("GetPosts: %v", err)
+			}
+			if containsID(feed, private.ID) != tt.seesPrivate {
+				t.Errorf("feed has the private group post: %v, want %v", !tt.seesPrivate, tt.seesPrivate)
+			}
+			if !containsID(feed, public.ID) {
+				t.Error("feed is missing the public group post")
+			}
+
+			want := codes.PermissionDenied
+			if tt.seesPrivate {
+				want = codes.OK
+			}
+			if _, _, err := s.GetPost(ctx, private.ID, tt.userID); status.Code(err) != want {
+				t.Errorf("GetPost of the private group post: got %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+func TestGroupPostsAreHiddenWhenMembershipCannotBeChecked(t *testing.T) {
+	s := newTestService(t)
+	s.groups.addGroup("public-group", "public", "alice")
+	post := s.createPost(t, "alice", "public", "public-group")
+	s.groups.err = errors.New("connection refused")
+
+	feed, _, _, _, err := s.GetPosts(asUser("bob"), "bob", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if containsID(feed, post.ID) {
+		t.Error("feed has a group post whose group could not be checked")
+	}
+	if _, _, err := s.GetPost(asUser("bob"), post.ID, "bob"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetPost: got %v, want PermissionDenied", err)
+	}
+}
+
+func TestGroupPostListRequiresReadAccess(t *testing.T) {
+	s := newTestService(t)
+	s.groups.addGroup("private-group", "private", "alice")
+	s.groups.addGroup("public-group", "public", "alice")
+	private := s.createPost(t, "alice", "public", "private-group")
+	public := s.createPost(t, "alice", "public", "public-group")
+
+	tests := []struct {
+		name    string
+		groupID string
+		userID  string
+		want    codes.Code
+		postID  string
+	}{
+		{"member lists a private group", "private-group", "alice", codes.OK, private.ID},
+		{"non-member lists a private group", "private-group", "bob", codes.PermissionDenied, ""},
+		{"anonymous user lists a private group", "private-group", "", codes.PermissionDenied, ""},
+		{"non-member lists a public group", "public-group", "bob", codes.OK, public.ID},
+		{"member lists a missing group", "missing-group", "alice", codes.NotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts, count, _, _, err := s.GetPosts(asUser(tt.userID), tt.userID, "", tt.groupID, "", "", "", 1, 10)
+			if status.Code(err) != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if err != nil {
+				// Nothing about the group's posts is given away, not even their count
+				if len(posts) != 0 || count != 0 {
+					t.Errorf("got %d posts and a count of %d with the error, want none", len(posts), count)
+				}
+				return
+			}
+			if ids := postIDs(posts); len(ids) != 1 || ids[0] != tt.postID {
+				t.Errorf("got posts %v, want [%s]", ids, tt.postID)
+			}
+		})
+	}
+}
+
+func TestGroupPostListIsUnavailableWhenMembershipCannotBeChecked(t *testing.T) {
+	s := newTestService(t)
+	s.groups.addGroup("public-group", "public", "alice")
+	s.createPost(t, "alice", "public", "public-group")
+	s.groups.err = errors.New("connection refused")
+
+	if _, _, _, _, err := s.GetPosts(asUser("bob"), "bob", "", "public-group", "", "", "", 1, 10); status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v, want Unavailable", err)
+	}
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxHashtagLength matches the size of the tag column in the post_hashtags table
+const maxHashtagLength = 100
+
+// extractHashtags returns the unique, lowercased hashtags found in content.
+// Trailing punctuation is stripped so "#golang!" and "#golang" yield the same tag,
+// and tokens containing anything other than letters, digits or underscores are ignored.
+func extractHashtags(content string) []string {
+	seen := make(map[string]bool)
+	var tags []string
+
+	for _, token := range strings.Fields(content) {
+		if !strings.HasPrefix(token, "#") {
+			continue
+		}
+
+		tag := strings.TrimRightFunc(token[1:], func(r rune) bool {
+			return unicode.IsPunct(r) || unicode.IsSymbol(r)
+		})
+		tag = strings.ToLower(tag)
+		if !isValidHashtag(tag) || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// isValidHashtag checks that a tag is non-empty, fits in storage and only uses word characters
+func isValidHashtag(tag string) bool {
+	if tag == "" || len(tag) > maxHashtagLength {
+		return false
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"post-api/internal/clients"
+	"post-api/internal/models"
+	"post-api/internal/repository"
+	"post-api/internal/testutil"
+	"post-api/internal/utils/logger"
+)
+
+// fakeFriends is a friends service holding friendships and blocks between users
+type fakeFriends struct {
+	mu      sync.Mutex
+	friends map[[2]string]bool
+	blocked map[[2]string]bool
+	err     error
+}
+
+func newFakeFriends() *fakeFriends {
+	return &fakeFriends{friends: make(map[[2]string]bool), blocked: make(map[[2]string]bool)}
+}
+
+func (f *fakeFriends) befriend(a, b string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.friends[[2]string{a, b}] = true
+	f.friends[[2]string{b, a}] = true
+}
+
+func (f *fakeFriends) block(a, b string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.blocked[[2]string{a, b}] = true
+	f.blocked[[2]string{b, a}] = true
+}
+
+func (f *fakeFriends) CheckFriendship(ctx context.Context, userID, friendID string) (*clients.FriendshipInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	switch {
+	case f.blocked[[2]string{userID, friendID}]:
+		return &clients.FriendshipInfo{Status: "blocked"}, nil
+	case f.friends[[2]string{userID, friendID}]:
+		return &clients.FriendshipInfo{AreFriends: true, Status: "friends"}, nil
+	}
+	return &clients.FriendshipInfo{Status: "none"}, nil
+}
+
+func (f *fakeFriends) GetFollowingIDs(ctx context.Context, userID string) ([]string, error) {
+	return nil, f.err
+}
+
+func (f *fakeFriends) GetBlockedIDs(ctx context.Context, userID string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	var ids []string
+	for pair := range f.blocked {
+		if pair[0] == userID {
+			ids = append(ids, pair[1])
+		}
+	}
+	return ids, nil
+}
+
+// GetFriendIDs answers for the caller the auth interceptor put on the context, as the real
+// friends service answers for the subject of the forwarded token
+func (f *fakeFriends) GetFriendIDs(ctx context.Context) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	caller, _ := ctx.Value("user_id").(string)
+	var ids []string
+	for pair := range f.friends {
+		if pair[0] == caller {
+			ids = append(ids, pair[1])
+		}
+	}
+	return ids, nil
+}
+
+// fakeGroups is a groups service holding group visibility and members
+type fakeGroups struct {
+	mu         sync.Mutex
+	visibility map[string]string
+	members    map[[2]string]string
+	err        error
+}
+
+func newFakeGroups() *fakeGroups {
+	return &fakeGroups{visibility: make(map[string]string), members: make(map[[2]string]string)}
+}
+
+func (g *fakeGroups) addGroup(groupID, visibility string, members ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.visibility[groupID] = visibility
+	for _, member := range members {
+		g.members[[2]string{groupID, member}] = "member"
+	}
+}
+
+func (g *fakeGroups) CheckMembership(ctx context.Context, groupID, userID string) (*clients.MembershipInfo, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.err != nil {
+		return nil, g.err
+	}
+	if _, ok := g.visibility[groupID]; !ok {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+	role, isMember := g.members[[2]string{groupID, userID}]
+	return &clients.MembershipInfo{
+		IsMember:        isMember,
+		Role:            role,
+		GroupName:       "Group " + groupID,
+		GroupVisibility: g.visibility[groupID],
+	}, nil
+}
+
+// fakeUsers is a users service where every user exists, named after their ID
+type fakeUsers struct{}
+
+func (fakeUsers) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*clients.UserInfo, error) {
+	users := make(map[string]*clients.UserInfo, len(userIDs))
+	for _, id := range userIDs {
+		users[id] = &clients.UserInfo{ID: id, Name: id, Username: id}
+	}
+	return users, nil
+}
+
+func (fakeUsers) GetUsersByUsernames(ctx context.Context, usernames []string) (map[string]*clients.UserInfo, error) {
+	users := make(map[string]*clients.UserInfo, len(usernames))
+	for _, name := range usernames {
+		users[name] = &clients.UserInfo{ID: name, Name: name, Username: name}
+	}
+	return users, nil
+}
+
+// fakeNotifications drops every notification
+type fakeNotifications struct{}
+
+func (fakeNotifications) Notify(ctx context.Context, userID, notificationType, targetID string) error {
+	return nil
+}
+
+func (fakeNotifications) NotifyMention(ctx context.Context, event MentionEvent) {}
+
+// testService is a post service over a fresh database and fake neighbouring services
+type testService struct {
+	*postService
+	db      *gorm.DB
+	friends *fakeFriends
+	groups  *fakeGroups
+}
+
+// newTestService returns a post service over a fresh, migrated database
+func newTestService(tb testing.TB) *testService {
+	tb.Helper()
+
+	db := testutil.NewDB(tb)
+	if _, err := repository.AutoMigrate(db); err != nil {
+		tb.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	friends := newFakeFriends()
+	groups := newFakeGroups()
+	service := NewPostService(
+		repository.NewPostRepository(db),
+		repository.NewCommentRepository(db),
+		repository.NewLikeRepository(db),
+		repository.NewHashtagRepository(db),
+		repository.NewMentionRepository(db),
+		repository.NewBookmarkRepository(db),
+		repository.NewReportRepository(db),
+		repository.NewIdempotencyRepository(db),
+		fakeUsers{},
+		groups,
+		friends,
+		fakeNotifications{},
+		fakeNotifications{},
+		0, 0, 0,
+		MediaLimits{},
+		ContentLimits{},
+		&logger.Logger{Logger: zap.NewNop()},
+	)
+
+	return &testService{postService: service.(*postService), db: db, friends: friends, groups: groups}
+}
+
+// asUser returns a context of a call authenticated as the user
+func asUser(userID string) context.Context {
+	return context.WithValue(context.Background(), "user_id", userID)
+}
+
+// createPost stores a post directly, bypassing the checks of CreatePost
+func (s *testService) createPost(tb testing.TB, authorID, visibility, groupID string) *models.Post {
+	tb.Helper()
+
+	post := &models.Post{
+		AuthorID:   authorID,
+		AuthorName: authorID,
+		Content:    "post by " + authorID,
+		Visibility: visibility,
+		GroupID:    groupID,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := s.db.Create(post).Error; err != nil {
+		tb.Fatalf("failed to create post: %v", err)
+	}
+	return post
+}
+
+// postIDs returns the IDs of posts in order
+func postIDs(posts []*models.Post) []string {
+	ids := make([]string, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	return ids
+}
+
+// containsID reports whether the posts include one with the given ID
+func containsID(posts []*models.Post, id string) bool {
+	for _, post := range posts {
+		if post.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"post-api/internal/models"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// defaultIdempotencyTTL is used when no lifetime for idempotency keys is configured
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyPurgeInterval is how often expired idempotency keys are purged
+const idempotencyPurgeInterval = time.Hour
+
+// maxIdempotencyKeyLength is the longest idempotency key that can be stored
+const maxIdempotencyKeyLength = 255
+
+// idempotencyLease is how long a reserved key blocks retries while its request has not created
+// anything. It is well beyond the gateway's request timeout, so a key still without a resource
+// after it belongs to a request that died before it could complete or release the key.
+const idempotencyLease = 2 * time.Minute
+
+// reserveIdempotencyKey claims the user's idempotency key for an operation before the operation
+// runs. If the key was already used within the TTL, the ID of the resource created then is returned
+// and the operation must not run again. An empty key skips the check.
+func (s *postService) reserveIdempotencyKey(ctx context.Context, userID, operation, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+
+	err := s.idempotencyRepo.Reserve(ctx, &models.IdempotencyKey{
+		UserID:    userID,
+		Operation: operation,
+		Key:       key,
+		CreatedAt: time.Now(),
+	})
+	if err == nil {
+		return "", nil
+	}
+	if !errors.Is(err, gorm.ErrDuplicatedKey) {
+		s.logger.WithContext(ctx).Error("Failed to reserve idempotency key", err, "operation", operation)
+		return "", status.Error(codes.Internal, "failed to check idempotency key")
+	}
+
+	existing, err := s.idempotencyRepo.Find(ctx, userID, operation, key)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// The first request failed and released the key in the meantime
+		return "", status.Error(codes.FailedPrecondition, "a request with this idempotency key is still in progress")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get idempotency key", err, "operation", operation)
+		return "", status.Error(codes.Internal, "failed to check idempotency key")
+	}
+
+	// An expired key that was not purged yet can be used again, and so can a key whose request
+	// was abandoned
+	expiredBefore := time.Now().Add(-s.idempotencyTTL)
+	abandonedBefore := time.Now().Add(-idempotencyLease)
+	if existing.CreatedAt.Before(expiredBefore) || (existing.ResourceID == "" && existing.CreatedAt.Before(abandonedBefore)) {
+		released, err := s.idempotencyRepo.ReleaseStale(ctx, userID, operation, key, expiredBefore, abandonedBefore)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to release stale idempotency key", err, "operation", operation)
+			return "", status.Error(codes.Internal, "failed to check idempotency key")
+		}
+		if released {
+			return s.reserveIdempotencyKey(ctx, userID, operation, key)
+		}
+		// Another retry took the key over first
+		return "", status.Error(codes.FailedPrecondition, "a request with this idempotency key is still in progress")
+	}
+
+	if existing.ResourceID == "" {
+		return "", status.Error(codes.FailedPrecondition, "a request with this idempotency key is still in progress")
+	}
+	return existing.ResourceID, nil
+}
+
+// completeIdempotencyKey stores the resource the operation created under the reserved key
+func (s *postService) completeIdempotencyKey(ctx context.Context, userID, operation, key, resourceID string) {
+	if key == "" {
+		return
+	}
+	if err := s.idempotencyRepo.Complete(ctx, userID, operation, key, resourceID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to complete idempotency key", err, "operation", operation, "resource_id", resourceID)
+	}
+}
+
+// releaseIdempotencyKey gives up the reserved key after the operation failed, so it can be retried
+func (s *postService) releaseIdempotencyKey(ctx context.Context, userID, operation, key string) {
+	if key == "" {
+		return
+	}
+	if err := s.idempotencyRepo.Release(ctx, userID, operation, key); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to release idempotency key", err, "operation", operation)
+	}
+}
+
+// replayPost returns the post an earlier request with the same idempotency key created
+func (s *postService) replayPost(ctx context.Context, postID string) (*models.Post, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err, "post_id", postID)
+		return nil, status.Error(codes.FailedPrecondition, "the post created with this idempotency key no longer exists")
+	}
+	s.attachPostMentions(ctx, []*models.Post{post})
+	return post, nil
+}
+
+// replayComment returns the comment an earlier request with the same idempotency key created.
+// Keys are not scoped to a post, so a key that created a comment on another post is refused.
+func (s *postService) replayComment(ctx context.Context, postID, commentID string) (*models.Comment, error) {
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get comment", err, "comment_id", commentID)
+		return nil, status.Error(codes.FailedPrecondition, "the comment created with this idempotency key no longer exists")
+	}
+	if comment.PostID != postID {
+		return nil, status.Error(codes.FailedPrecondition, "this idempotency key was already used for a comment on another post")
+	}
+	s.attachCommentMentions(ctx, []*models.Comment{comment})
+	return comment, nil
+}
+
+// purgeIdempotencyKeys removes idempotency keys older than the TTL on every tick
+func (s *postService) purgeIdempotencyKeys(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		purged, err := s.idempotencyRepo.PurgeExpired(context.Background(), time.Now().Add(-s.idempotencyTTL))
+		if err != nil {
+			s.logger.Error("Failed to purge idempotency keys", err)
+			continue
+		}
+		if purged > 0 {
+			s.logger.Debug("Purged idempotency keys", "count", purged)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"post-api/internal/models"
+)
+
+// countPosts returns how many posts the author has
+func (s *testService) countPosts(t *testing.T, authorID string) int64 {
+	t.Helper()
+
+	var count int64
+	if err := s.db.Model(&models.Post{}).Where("author_id = ?", authorID).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count posts: %v", err)
+	}
+	return count
+}
+
+// failPostInserts makes every insert into the posts table fail until the returned function is called
+func (s *testService) failPostInserts(t *testing.T) func() {
+	t.Helper()
+
+	name := "test:fail_post_inserts"
+	err := s.db.Callback().Create().Before("gorm:create").Register(name, func(tx *gorm.DB) {
+		if tx.Statement.Table == "posts" {
+			_ = tx.AddError(errors.New("disk full"))
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to register insert failure: %v", err)
+	}
+	restore := func() { _ = s.db.Callback().Create().Remove(name) }
+	t.Cleanup(restore)
+	return restore
+}
+
+func TestCreatePostReplaysIdempotencyKey(t *testing.T) {
+	s := newTestService(t)
+
+	first, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	replayed, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("CreatePost replayed: %v", err)
+	}
+	if replayed.ID != first.ID {
+		t.Errorf("replayed request created post %q, want the first post %q", replayed.ID, first.ID)
+	}
+	if got := s.countPosts(t, "alice"); got != 1 {
+		t.Errorf("alice has %d posts, want 1", got)
+	}
+
+	// Keys are scoped to the user, so another user's request with the same key runs
+	other, err := s.CreatePost(asUser("bob"), "bob", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("CreatePost as another user: %v", err)
+	}
+	if other.ID == first.ID {
+		t.Error("another user's request with the same key replayed alice's post")
+	}
+}
+
+func TestCreatePostRunsConcurrentDuplicatesOnce(t *testing.T) {
+	s := newTestService(t)
+
+	const requests = 8
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		ids  = make(map[string]bool)
+		errs []error
+	)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			post, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			ids[post.ID] = true
+		}()
+	}
+	wg.Wait()
+
+	// A duplicate either waits for the first request's post or is told the request is still running
+	for _, err := range errs {
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("concurrent duplicate: got %v, want FailedPrecondition", err)
+		}
+	}
+	if len(ids) != 1 {
+		t.Errorf("concurrent duplicates returned %d different posts, want 1", len(ids))
+	}
+	if got := s.countPosts(t, "alice"); got != 1 {
+		t.Errorf("alice has %d posts, want 1", got)
+	}
+}
+
+func TestCreatePostInProgressKeyIsRefused(t *testing.T) {
+	s := newTestService(t)
+
+	// A request holding the key has not created its post yet
+	if _, err := s.reserveIdempotencyKey(asUser("alice"), "alice", models.IdempotentCreatePost, "key-1"); err != nil {
+		t.Fatalf("reserveIdempotencyKey: %v", err)
+	}
+
+	_, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("got %v, want FailedPrecondition", err)
+	}
+	if got := s.countPosts(t, "alice"); got != 0 {
+		t.Errorf("alice has %d posts, want 0", got)
+	}
+}
+
+func TestCreatePostReleasesIdempotencyKeyAfterFailure(t *testing.T) {
+	s := newTestService(t)
+
+	restore := s.failPostInserts(t)
+	if _, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1"); status.Code(err) != codes.Internal {
+		t.Fatalf("failed insert: got %v, want Internal", err)
+	}
+	restore()
+
+	// The retry with the same key runs instead of being refused as in progress
+	post, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("retry after failure: %v", err)
+	}
+	if got := s.countPosts(t, "alice"); got != 1 {
+		t.Errorf("alice has %d posts, want 1", got)
+	}
+
+	replayed, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("CreatePost replayed: %v", err)
+	}
+	if replayed.ID != post.ID {
+		t.Errorf("replayed request created post %q, want the retried post %q", replayed.ID, post.ID)
+	}
+}
+
+func TestCreatePostRejectsOverlongIdempotencyKey(t *testing.T) {
+	s := newTestService(t)
+
+	key := strings.Repeat("k", maxIdempotencyKeyLength+1)
+	if _, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, key); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v, want InvalidArgument", err)
+	}
+}
+
+func TestCreatePostTakesOverAbandonedKey(t *testing.T) {
+	s := newTestService(t)
+
+	// The request that reserved the key died before creating its post
+	if _, err := s.reserveIdempotencyKey(asUser("alice"), "alice", models.IdempotentCreatePost, "key-1"); err != nil {
+		t.Fatalf("reserveIdempotencyKey: %v", err)
+	}
+	err := s.db.Model(&models.IdempotencyKey{}).
+		Where("user_id = ? AND idempotency_key = ?", "alice", "key-1").
+		Update("created_at", time.Now().Add(-idempotencyLease-time.Second)).Error
+	if err != nil {
+		t.Fatalf("failed to age idempotency key: %v", err)
+	}
+
+	post, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("retry of an abandoned request: %v", err)
+	}
+	if got := s.countPosts(t, "alice"); got != 1 {
+		t.Errorf("alice has %d posts, want 1", got)
+	}
+
+	// The retry now owns the key, so later repeats replay its post
+	replayed, err := s.CreatePost(asUser("alice"), "alice", "hello", "public", "", nil, "key-1")
+	if err != nil {
+		t.Fatalf("CreatePost replayed: %v", err)
+	}
+	if replayed.ID != post.ID {
+		t.Errorf("replayed request created post %q, want the retried post %q", replayed.ID, post.ID)
+	}
+}
+
+func TestAddCommentRefusesKeyOfAnotherPost(t *testing.T) {
+	s := newTestService(t)
+	first := s.createPost(t, "alice", "public", "")
+	second := s.createPost(t, "alice", "public", "")
+
+	comment, err := s.AddComment(asUser("bob"), first.ID, "bob", "nice", "", "key-1")
+	if err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+
+	if _, err := s.AddComment(asUser("bob"), second.ID, "bob", "nice", "", "key-1"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("key reused on another post: got %v, want FailedPrecondition", err)
+	}
+
+	replayed, err := s.AddComment(asUser("bob"), first.ID, "bob", "nice", "", "key-1")
+	if err != nil {
+		t.Fatalf("AddComment replayed: %v", err)
+	}
+	if replayed.ID != comment.ID {
+		t.Errorf("replayed request created comment %q, want the first comment %q", replayed.ID, comment.ID)
+	}
+
+	var count int64
+	if err := s.db.Model(&models.Comment{}).Where("author_id = ?", "bob").Count(&count).Error; err != nil {
+		t.Fatalf("failed to count comments: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("bob has %d comments, want 1", count)
+	}
+}
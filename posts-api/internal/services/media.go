@@ -0,0 +1,87 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultMaxMediaCount is used when no maximum number of media per post is configured
+	defaultMaxMediaCount = 10
+
+	// defaultMaxMediaLength is used when no maximum combined length of a post's media is configured
+	defaultMaxMediaLength = 8192
+)
+
+// MediaLimits holds the rules for media attached to a post
+type MediaLimits struct {
+	// MaxCount is the maximum number of media entries on a post
+	MaxCount int
+
+	// MaxLength is the maximum combined length of a post's media entries
+	MaxLength int
+
+	// AllowedPrefixes restricts media to URLs starting with one of these prefixes, such as
+	// the media storage bucket. Any http(s) URL is accepted when it is empty.
+	AllowedPrefixes []string
+}
+
+// withDefaults fills in the limits that are not configured
+func (l MediaLimits) withDefaults() MediaLimits {
+	if l.MaxCount <= 0 {
+		l.MaxCount = defaultMaxMediaCount
+	}
+	if l.MaxLength <= 0 {
+		l.MaxLength = defaultMaxMediaLength
+	}
+	return l
+}
+
+// validateMedia checks the media of a post against the limits and returns an InvalidArgument
+// error describing the first violation
+func validateMedia(media []string, limits MediaLimits) error {
+	if len(media) > limits.MaxCount {
+		return status.Errorf(codes.InvalidArgument, "a post can have at most %d media", limits.MaxCount)
+	}
+
+	totalLength := 0
+	for i, entry := range media {
+		if err := validateMediaURL(entry, limits.AllowedPrefixes); err != nil {
+			return status.Errorf(codes.InvalidArgument, "media %d: %v", i+1, err)
+		}
+		totalLength += len(entry)
+	}
+
+	if totalLength > limits.MaxLength {
+		return status.Errorf(codes.InvalidArgument, "media URLs exceed %d characters in total", limits.MaxLength)
+	}
+
+	return nil
+}
+
+// validateMediaURL checks that a media entry is an absolute http(s) URL, under one of the
+// allowed prefixes if there are any
+func validateMediaURL(entry string, allowedPrefixes []string) error {
+	if strings.TrimSpace(entry) == "" {
+		return fmt.Errorf("URL is empty")
+	}
+
+	u, err := url.Parse(entry)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q is not a valid http(s) URL", entry)
+	}
+
+	if len(allowedPrefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range allowedPrefixes {
+		if strings.HasPrefix(entry, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is not hosted on an allowed media storage", entry)
+}
\ No newline at end of file
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateMedia(t *testing.T) {
+	limits := MediaLimits{MaxCount: 3, MaxLength: 100}.withDefaults()
+	storage := MediaLimits{MaxCount: 3, MaxLength: 100, AllowedPrefixes: []string{"https://media.example.com/"}}.withDefaults()
+
+	tests := []struct {
+		name   string
+		media  []string
+		limits MediaLimits
+		want   string
+	}{
+		{"none", nil, limits, ""},
+		{"valid", []string{"https://cdn.example.com/a.png", "http://cdn.example.com/b.png"}, limits, ""},
+		{"too many", []string{"https://a.example.com/1", "https://a.example.com/2", "https://a.example.com/3", "https://a.example.com/4"}, limits, "at most 3 media"},
+		{"empty entry", []string{"https://cdn.example.com/a.png", ""}, limits, "media 2: URL is empty"},
+		{"blank entry", []string{"   "}, limits, "media 1: URL is empty"},
+		{"not a URL", []string{"not a url"}, limits, "is not a valid http(s) URL"},
+		{"relative URL", []string{"/uploads/a.png"}, limits, "is not a valid http(s) URL"},
+		{"other scheme", []string{"javascript:alert(1)"}, limits, "is not a valid http(s) URL"},
+		{"too long in total", []string{"https://cdn.example.com/" + strings.Repeat("a", 40), "https://cdn.example.com/" + strings.Repeat("b", 40)}, limits, "exceed 100 characters"},
+		{"allowed storage", []string{"https://media.example.com/a.png"}, storage, ""},
+		{"other storage", []string{"https://cdn.example.com/a.png"}, storage, "not hosted on an allowed media storage"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMedia(tt.media, tt.limits)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("got %v, want no error", err)
+				}
+				return
+			}
+			if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want InvalidArgument mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostsWithInvalidMediaAreRefused(t *testing.T) {
+	s := newTestService(t)
+	ctx := asUser("alice")
+
+	tooMany := make([]string, defaultMaxMediaCount+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("https://cdn.example.com/%d.png", i)
+	}
+	for name, media := range map[string][]string{
+		"too many":  tooMany,
+		"malformed": {"cdn.example.com/a.png"},
+		"empty":     {""},
+	} {
+		if _, err := s.CreatePost(ctx, "alice", "hello", "public", "", media, ""); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("CreatePost with %s media: got %v, want InvalidArgument", name, err)
+		}
+	}
+
+	var count int64
+	if err := s.db.Table("posts").Count(&count).Error; err != nil {
+		t.Fatalf("failed to count posts: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("got %d posts stored, want 0", count)
+	}
+
+	post, err := s.CreatePost(ctx, "alice", "hello", "public", "", []string{"https://cdn.example.com/a.png"}, "")
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	if _, err := s.UpdatePost(ctx, post.ID, "alice", "hello", "public", []string{"ftp://cdn.example.com/a.png"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdatePost with malformed media: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"post-api/internal/clients"
+	"post-api/internal/models"
+	"post-api/internal/utils/logger"
+	"strings"
+)
+
+// maxMentionsPerContent caps how many users a single post or comment can mention
+const maxMentionsPerContent = 20
+
+// MentionEvent describes a user being mentioned in a post or comment
+type MentionEvent struct {
+	UserID    string
+	Username  string
+	AuthorID  string
+	PostID    string
+	CommentID string // Empty when the mention is in the post itself
+}
+
+// MentionNotifier is the hook through which mentioned users are notified
+type MentionNotifier interface {
+	// NotifyMention is called once for every user newly mentioned in a post or comment
+	NotifyMention(ctx context.Context, event MentionEvent)
+}
+
+// notificationMentionNotifier is a MentionNotifier that sends mentions to the notification service
+type notificationMentionNotifier struct {
+	notifications clients.NotificationClient
+	logger        *logger.Logger
+}
+
+// NewNotificationMentionNotifier creates a mention notifier that notifies each mentioned user
+// through the notification service
+func NewNotificationMentionNotifier(notifications clients.NotificationClient, logger *logger.Logger) MentionNotifier {
+	return &notificationMentionNotifier{
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// NotifyMention notifies the mentioned user. Failures are only logged, since the post or
+// comment has already been saved.
+func (n *notificationMentionNotifier) NotifyMention(ctx context.Context, event MentionEvent) {
+	if err := n.notifications.Notify(ctx, event.UserID, clients.NotificationMention, event.PostID); err != nil {
+		n.logger.WithContext(ctx).Warn("Failed to notify mentioned user",
+			"error", err,
+			"recipient_id", event.UserID,
+			"post_id", event.PostID,
+			"comment_id", event.CommentID)
+	}
+}
+
+// extractMentions returns the unique, lowercased usernames mentioned with @ in content.
+// Trailing punctuation is stripped so "@alice," mentions alice.
+func extractMentions(content string) []string {
+	seen := make(map[string]bool)
+	var usernames []string
+
+	for _, token := range strings.Fields(content) {
+		if !strings.HasPrefix(token, "@") {
+			continue
+		}
+
+		username := strings.ToLower(token[1:])
+		if end := strings.IndexFunc(username, func(r rune) bool { return !isUsernameRune(r) }); end >= 0 {
+			username = username[:end]
+		}
+		username = strings.TrimRight(username, ".")
+		if username == "" || seen[username] {
+			continue
+		}
+
+		seen[username] = true
+		usernames = append(usernames, username)
+		if len(usernames) == maxMentionsPerContent {
+			break
+		}
+	}
+
+	return usernames
+}
+
+// isUsernameRune reports whether r may appear in a username
+func isUsernameRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '.'
+}
+
+// resolveMentions looks up the users mentioned in content. Usernames that do not belong
+// to a user are dropped, and lookup failures are logged and result in no mentions.
+func (s *postService) resolveMentions(ctx context.Context, content string) []*clients.UserInfo {
+	usernames := extractMentions(content)
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	users, err := s.userClient.GetUsersByUsernames(ctx, usernames)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to resolve mentions", "error", err, "count", len(usernames))
+		return nil
+	}
+
+	resolved := make([]*clients.UserInfo, 0, len(users))
+	for _, username := range usernames {
+		if user, ok := users[username]; ok {
+			resolved = append(resolved, user)
+		}
+	}
+	return resolved
+}
+
+// syncPostMentions stores the users mentioned in a post, attaches them to the post
+// and notifies the newly mentioned ones. Failures are logged so they never block the post.
+func (s *postService) syncPostMentions(ctx context.Context, post *models.Post) {
+	users := s.resolveMentions(ctx, post.Content)
+	mentions := make([]*models.PostMention, len(users))
+	for i, user := range users {
+		mentions[i] = &models.PostMention{UserID: user.ID, Username: user.Username}
+	}
+
+	added, err := s.mentionRepo.SyncPostMentions(ctx, post.ID, mentions)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to sync post mentions", err, "post_id", post.ID)
+		return
+	}
+	post.Mentions = mentions
+
+	for _, mention := range added {
+		if mention.UserID == post.AuthorID {
+			continue
+		}
+		s.notifier.NotifyMention(ctx, MentionEvent{
+			UserID:   mention.UserID,
+			Username: mention.Username,
+			AuthorID: post.AuthorID,
+			PostID:   post.ID,
+		})
+	}
+}
+
+// syncCommentMentions stores the users mentioned in a comment, attaches them to the comment
+// and notifies the newly mentioned ones. Failures are logged so they never block the comment.
+func (s *postService) syncCommentMentions(ctx context.Context, comment *models.Comment) {
+	users := s.resolveMentions(ctx, comment.Content)
+	mentions := make([]*models.CommentMention, len(users))
+	for i, user := range users {
+		mentions[i] = &models.CommentMention{UserID: user.ID, Username: user.Username}
+	}
+
+	added, err := s.mentionRepo.SyncCommentMentions(ctx, comment.ID, mentions)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to sync comment mentions", err, "comment_id", comment.ID)
+		return
+	}
+	comment.Mentions = mentions
+
+	for _, mention := range added {
+		if mention.UserID == comment.AuthorID {
+			continue
+		}
+		s.notifier.NotifyMention(ctx, MentionEvent{
+			UserID:    mention.UserID,
+			Username:  mention.Username,
+			AuthorID:  comment.AuthorID,
+			PostID:    comment.PostID,
+			CommentID: comment.ID,
+		})
+	}
+}
+
+// attachPostMentions loads the mentions of several posts with a single query
+func (s *postService) attachPostMentions(ctx context.Context, posts []*models.Post) {
+	if len(posts) == 0 {
+		return
+	}
+
+	byID := make(map[string]*models.Post, len(posts))
+	postIDs := make([]string, len(posts))
+	for i, post := range posts {
+		byID[post.ID] = post
+		postIDs[i] = post.ID
+	}
+
+	mentions, err := s.mentionRepo.FindByPosts(ctx, postIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get post mentions", "error", err)
+		return
+	}
+	for _, mention := range mentions {
+		if post, ok := byID[mention.PostID]; ok {
+			post.Mentions = append(post.Mentions, mention)
+		}
+	}
+}
+
+// attachCommentMentions loads the mentions of several comments, including nested replies,
+// with a single query
+func (s *postService) attachCommentMentions(ctx context.Context, comments []*models.Comment) {
+	byID := make(map[string]*models.Comment)
+	var collect func([]*models.Comment)
+	collect = func(level []*models.Comment) {
+		for _, comment := range level {
+			byID[comment.ID] = comment
+			collect(comment.Replies)
+		}
+	}
+	collect(comments)
+	if len(byID) == 0 {
+		return
+	}
+
+	commentIDs := make([]string, 0, len(byID))
+	for id := range byID {
+		commentIDs = append(commentIDs, id)
+	}
+
+	mentions, err := s.mentionRepo.FindByComments(ctx, commentIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get comment mentions", "error", err)
+		return
+	}
+	for _, mention := range mentions {
+		if comment, ok := byID[mention.CommentID]; ok {
+			comment.Mentions = append(comment.Mentions, mention)
+		}
+	}
+}
@@ -2,25 +2,34 @@ package services
 
 import (
 	"context"
+	"errors"
+	"post-api/internal/clients"
 	"post-api/internal/models"
 	"post-api/internal/repository"
 	"post-api/internal/utils/logger"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 // PostService defines the interface for post-related operations
 type PostService interface {
-	// CreatePost creates a new post
-	CreatePost(ctx context.Context, userID, content, visibility, groupID string, media []string) (*models.Post, error)
+	// CreatePost creates a new post; a repeated idempotency key returns the post created first
+	CreatePost(ctx context.Context, userID, content, visibility, groupID string, media []string, idempotencyKey string) (*models.Post, error)
 
 	// GetPost retrieves a post by ID
 	GetPost(ctx context.Context, postID, userID string) (*models.Post, bool, error)
 
-	// GetPosts retrieves posts with pagination and filtering
-	GetPosts(ctx context.Context, userID, authorID, groupID, visibility string, page, limit int, friendIDs []string) ([]*models.Post, int64, int32, error)
+	// GetPosts retrieves posts with pagination and filtering. Feeds can be paged with an
+	// opaque cursor instead of a page number; the cursor for the next page is returned.
+	// The following feed only has the posts of users the user follows.
+	GetPosts(ctx context.Context, userID, authorID, groupID, visibility, feed, cursor string, page, limit int) ([]*models.Post, int64, int32, string, error)
+
+	// GetPostsByHashtag retrieves posts tagged with a hashtag with pagination
+	GetPostsByHashtag(ctx context.Context, userID, tag string, page, limit int) ([]*models.Post, int64, int32, error)
 
 	// UpdatePost updates a post
 	UpdatePost(ctx context.Context, postID, userID, content, visibility string, media []string) (*models.Post, error)
@@ -28,31 +37,122 @@ type PostService interface {
 	// DeletePost deletes a post
 	DeletePost(ctx context.Context, postID, userID string) error
 
-	// AddComment adds a comment to a post
-	AddComment(ctx context.Context, postID, userID, authorName, authorAvatar, content string) (*models.Comment, error)
+	// SharePost shares a post as a new post of the user's, with an optional comment
+	SharePost(ctx context.Context, originalPostID, userID, comment string) (*models.Post, error)
+
+	// RestorePost restores a deleted post within the restore window
+	RestorePost(ctx context.Context, postID, userID string) (*models.Post, error)
+
+	// AddComment adds a comment to a post, optionally as a reply to another comment; a repeated
+	// idempotency key returns the comment created first
+	AddComment(ctx context.Context, postID, userID, content, parentCommentID, idempotencyKey string) (*models.Comment, error)
+
+	// GetComments retrieves comments for a post, either flat or as a tree of replies.
+	// Comments by users the user has blocked, or been blocked by, are left out.
+	GetComments(ctx context.Context, postID, userID string, threaded bool, page, limit int) ([]*models.Comment, int64, int32, error)
 
-	// GetComments retrieves comments for a post
-	GetComments(ctx context.Context, postID string, page, limit int) ([]*models.Comment, int64, int32, error)
+	// EditComment updates the content of a comment
+	EditComment(ctx context.Context, commentID, postID, userID, content string) (*models.Comment, error)
 
 	// DeleteComment deletes a comment
 	DeleteComment(ctx context.Context, commentID, postID, userID string) error
 
-	// LikePost likes a post
-	LikePost(ctx context.Context, postID, userID string) (int32, error)
+	// React sets the user's reaction on a post, replacing any previous reaction
+	React(ctx context.Context, postID, userID, reaction string) (int32, error)
 
-	// UnlikePost unlikes a post
+	// UnlikePost removes the user's reaction from a post
 	UnlikePost(ctx context.Context, postID, userID string) (int32, error)
 
+	// BookmarkPost saves a post for the user to revisit later
+	BookmarkPost(ctx context.Context, postID, userID string) error
+
+	// UnbookmarkPost removes a post from the user's bookmarks
+	UnbookmarkPost(ctx context.Context, postID, userID string) error
+
+	// GetBookmarks retrieves the user's bookmarked posts that are still visible to them
+	GetBookmarks(ctx context.Context, userID string, page, limit int) ([]*models.Post, int64, int32, error)
+
+	// GetReactionCounts retrieves the number of each reaction type on a post
+	GetReactionCounts(ctx context.Context, postID string) (map[string]int32, error)
+
+	// CountPublicPosts counts the posts of an author that everyone can see
+	CountPublicPosts(ctx context.Context, authorID string) (int32, error)
+
+	// ReportPost reports a post the user can see for moderation
+	ReportPost(ctx context.Context, postID, reporterID, reason string) error
+
+	// GetReports retrieves the moderation queue
+	GetReports(ctx context.Context, status string, page, limit int) ([]*models.PostReport, int64, int32, error)
+
+	// ResolveReport resolves a report, optionally hiding the reported post
+	ResolveReport(ctx context.Context, reportID, userID string, hidePost bool) (*models.PostReport, error)
+
 	// IsLiked checks if a post is liked by a user
 	IsLiked(ctx context.Context, postID, userID string) (bool, error)
 }
 
+// GetReactionCounts retrieves the number of each reaction type on a post
+func (s *postService) GetReactionCounts(ctx context.Context, postID string) (map[string]int32, error) {
+	// Validate input
+	if postID == "" {
+		return nil, status.Error(codes.InvalidArgument, "post ID is required")
+	}
+
+	// Check if the post exists
+	if _, err := s.postRepo.FindByID(ctx, postID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+
+	// Count reactions grouped by type
+	counts, err := s.likeRepo.CountReactionsByPost(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count reactions", err)
+		return nil, status.Error(codes.Internal, "failed to get reaction counts")
+	}
+
+	reactionCounts := make(map[string]int32, len(counts))
+	for reaction, count := range counts {
+		reactionCounts[reaction] = int32(count)
+	}
+
+	return reactionCounts, nil
+}
+
+// defaultMaxCommentDepth is used when no maximum reply depth is configured
+const defaultMaxCommentDepth = 5
+
+// countReconcileInterval is how often stored likes and comments counts are checked against
+// the likes and comments tables
+const countReconcileInterval = time.Hour
+
+// defaultPostRestoreWindow is used when no restore window for deleted posts is configured
+const defaultPostRestoreWindow = 30 * 24 * time.Hour
+
+// postPurgeInterval is how often posts deleted longer ago than the restore window are purged
+const postPurgeInterval = time.Hour
+
 // postService implements the PostService interface
 type postService struct {
-	postRepo    repository.PostRepository
-	commentRepo repository.CommentRepository
-	likeRepo    repository.LikeRepository
-	logger      *logger.Logger
+	postRepo        repository.PostRepository
+	commentRepo     repository.CommentRepository
+	likeRepo        repository.LikeRepository
+	hashtagRepo     repository.HashtagRepository
+	mentionRepo     repository.MentionRepository
+	bookmarkRepo    repository.BookmarkRepository
+	reportRepo      repository.ReportRepository
+	idempotencyRepo repository.IdempotencyRepository
+	userClient      clients.UserClient
+	groupClient     clients.GroupClient
+	friendClient    clients.FriendClient
+	notifier        MentionNotifier
+	notifications   clients.NotificationClient
+	maxCommentDepth int
+	restoreWindow   time.Duration
+	idempotencyTTL  time.Duration
+	mediaLimits     MediaLimits
+	contentLimits   ContentLimits
+	logger          *logger.Logger
 }
 
 // NewPostService creates a new post service
@@ -60,38 +160,246 @@ func NewPostService(
 	postRepo repository.PostRepository,
 	commentRepo repository.CommentRepository,
 	likeRepo repository.LikeRepository,
+	hashtagRepo repository.HashtagRepository,
+	mentionRepo repository.MentionRepository,
+	bookmarkRepo repository.BookmarkRepository,
+	reportRepo repository.ReportRepository,
+	idempotencyRepo repository.IdempotencyRepository,
+	userClient clients.UserClient,
+	groupClient clients.GroupClient,
+	friendClient clients.FriendClient,
+	notifier MentionNotifier,
+	notifications clients.NotificationClient,
+	maxCommentDepth int,
+	restoreWindow time.Duration,
+	idempotencyTTL time.Duration,
+	mediaLimits MediaLimits,
+	contentLimits ContentLimits,
 	logger *logger.Logger,
 ) PostService {
-	return &postService{
-		postRepo:    postRepo,
-		commentRepo: commentRepo,
-		likeRepo:    likeRepo,
-		logger:      logger,
+	if maxCommentDepth <= 0 {
+		maxCommentDepth = defaultMaxCommentDepth
+	}
+	if restoreWindow <= 0 {
+		restoreWindow = defaultPostRestoreWindow
+	}
+	if idempotencyTTL <= 0 {
+		idempotencyTTL = defaultIdempotencyTTL
+	}
+
+	service := &postService{
+		postRepo:        postRepo,
+		commentRepo:     commentRepo,
+		likeRepo:        likeRepo,
+		hashtagRepo:     hashtagRepo,
+		mentionRepo:     mentionRepo,
+		bookmarkRepo:    bookmarkRepo,
+		reportRepo:      reportRepo,
+		idempotencyRepo: idempotencyRepo,
+		userClient:      userClient,
+		groupClient:     groupClient,
+		friendClient:    friendClient,
+		notifier:        notifier,
+		notifications:   notifications,
+		maxCommentDepth: maxCommentDepth,
+		restoreWindow:   restoreWindow,
+		idempotencyTTL:  idempotencyTTL,
+		mediaLimits:     mediaLimits.withDefaults(),
+		contentLimits:   contentLimits.withDefaults(),
+		logger:          logger,
+	}
+
+	// Periodically repair counts left wrong by writes that bypassed the counters
+	go service.reconcileCounts(countReconcileInterval)
+
+	// Periodically purge deleted posts that can no longer be restored
+	go service.purgeDeletedPosts(postPurgeInterval)
+
+	// Periodically forget idempotency keys whose TTL has passed
+	go service.purgeIdempotencyKeys(idempotencyPurgeInterval)
+
+	return service
+}
+
+// reconcileCounts recomputes drifted post counts on every tick
+func (s *postService) reconcileCounts(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		fixed, err := s.postRepo.RecountDrifted(context.Background())
+		if err != nil {
+			s.logger.Error("Failed to reconcile post counts", err)
+			continue
+		}
+		if fixed > 0 {
+			s.logger.Warn("Reconciled drifted post counts", "count", fixed)
+		}
 	}
 }
 
+// purgeDeletedPosts permanently removes posts deleted longer ago than the restore window on every tick
+func (s *postService) purgeDeletedPosts(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		purged, err := s.postRepo.PurgeDeleted(context.Background(), time.Now().Add(-s.restoreWindow))
+		if err != nil {
+			s.logger.Error("Failed to purge deleted posts", err)
+			continue
+		}
+		if purged > 0 {
+			s.logger.Debug("Purged deleted posts", "count", purged)
+		}
+	}
+}
+
+// lookupUsers resolves user info for the given IDs with a single call to the users service.
+// Lookup failures are logged and result in an empty map so callers can fall back to stored values.
+func (s *postService) lookupUsers(ctx context.Context, userIDs []string) map[string]*clients.UserInfo {
+	// Deduplicate IDs so each user is requested only once
+	seen := make(map[string]bool, len(userIDs))
+	uniqueIDs := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	users, err := s.userClient.GetUsersByIDs(ctx, uniqueIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to look up users", "error", err, "count", len(uniqueIDs))
+		return map[string]*clients.UserInfo{}
+	}
+
+	return users
+}
+
+// lookupGroupAccess determines which of the groups the posts belong to the user may read:
+// public groups are open to everyone and private groups only to their members. Each group
+// is checked once. Groups that cannot be checked are treated as inaccessible so that a
+// groups service outage never exposes private group posts.
+func (s *postService) lookupGroupAccess(ctx context.Context, posts []*models.Post, userID string) map[string]bool {
+	access := make(map[string]bool)
+	checked := make(map[string]bool)
+	for _, post := range posts {
+		if post.GroupID == "" || checked[post.GroupID] {
+			continue
+		}
+		checked[post.GroupID] = true
+
+		membership, err := s.groupClient.CheckMembership(ctx, post.GroupID, userID)
+		if err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to check group membership", "error", err, "group_id", post.GroupID)
+			continue
+		}
+		access[post.GroupID] = membership.IsMember || membership.GroupVisibility == "public"
+	}
+
+	return access
+}
+
+// requireGroupReadable fails with PermissionDenied unless the user may read the posts of a group,
+// which anyone may for a public group and only members for a private one
+func (s *postService) requireGroupReadable(ctx context.Context, groupID, userID string) error {
+	membership, err := s.groupClient.CheckMembership(ctx, groupID, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check group membership", err, "group_id", groupID)
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "group not found")
+		}
+		return status.Error(codes.Unavailable, "failed to verify group membership")
+	}
+	if !membership.IsMember && membership.GroupVisibility != "public" {
+		return status.Error(codes.PermissionDenied, "only group members can view the posts of this group")
+	}
+	return nil
+}
+
+// isFriendOfAuthor reports whether the user is a friend of the author of a friends-only post.
+// Other posts are visible without a friendship, so the friends service is not asked for them.
+func (s *postService) isFriendOfAuthor(ctx context.Context, post *models.Post, userID string) bool {
+	if userID == "" || userID == post.AuthorID || post.GroupID != "" || post.Visibility != "friends" {
+		return false
+	}
+
+	friendship, err := s.friendClient.CheckFriendship(ctx, userID, post.AuthorID)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to check friendship", "error", err, "author_id", post.AuthorID)
+		return false
+	}
+	return friendship.AreFriends
+}
+
+// requireCommentable fails with PermissionDenied unless the user may comment on a post: the
+// user must be able to see it, and neither the user nor its author may have blocked the other.
+// Unlike reads, a comment is refused when the friends service cannot be asked.
+func (s *postService) requireCommentable(ctx context.Context, post *models.Post, userID string) error {
+	if userID == post.AuthorID {
+		return nil
+	}
+
+	friendship, err := s.friendClient.CheckFriendship(ctx, userID, post.AuthorID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to check friendship", err, "author_id", post.AuthorID)
+		return status.Error(codes.Unavailable, "failed to verify the relationship with the author")
+	}
+	if friendship.Status == "blocked" {
+		return status.Error(codes.PermissionDenied, "you cannot comment on this post")
+	}
+
+	var friendIDs []string
+	if friendship.AreFriends {
+		friendIDs = []string{post.AuthorID}
+	}
+	groupAccess := s.lookupGroupAccess(ctx, []*models.Post{post}, userID)
+	if !s.isPostVisibleToUser(post, userID, friendIDs, groupAccess) {
+		return status.Error(codes.PermissionDenied, "you don't have permission to view this post")
+	}
+	return nil
+}
+
 // CreatePost creates a new post
-func (s *postService) CreatePost(ctx context.Context, userID, content, visibility, groupID string, media []string) (*models.Post, error) {
+func (s *postService) CreatePost(ctx context.Context, userID, content, visibility, groupID string, media []string, idempotencyKey string) (*models.Post, error) {
 	// Validate input
 	if userID == "" {
 		return nil, status.Error(codes.InvalidArgument, "user ID is required")
 	}
-	if content == "" {
-		return nil, status.Error(codes.InvalidArgument, "content is required")
+	content, err := validateContent(content, s.contentLimits.MaxPostLength, true)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidVisibility(visibility) {
+		return nil, status.Error(codes.InvalidArgument, "visibility must be 'public', 'friends' or 'private'")
 	}
-	if visibility != "public" && visibility != "private" {
-		return nil, status.Error(codes.InvalidArgument, "visibility must be 'public' or 'private'")
+	if err := validateMedia(media, s.mediaLimits); err != nil {
+		return nil, err
 	}
 
-	// TODO: Get user info from users service
-	authorName := "User " + userID // Placeholder
-	authorAvatar := ""             // Placeholder
+	// Get author info from the users service
+	var authorName, authorAvatar string
+	if author, ok := s.lookupUsers(ctx, []string{userID})[userID]; ok {
+		authorName = author.Name
+		authorAvatar = author.Avatar
+	}
 
-	// TODO: If groupID is provided, validate that the user is a member of the group
+	// Only members may post into a group
 	var groupName string
 	if groupID != "" {
-		// TODO: Get group info from groups service
-		groupName = "Group " + groupID // Placeholder
+		membership, err := s.groupClient.CheckMembership(ctx, groupID, userID)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to check group membership", err, "group_id", groupID)
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "group not found")
+			}
+			return nil, status.Error(codes.Unavailable, "failed to verify group membership")
+		}
+		if !membership.IsMember {
+			return nil, status.Error(codes.PermissionDenied, "only group members can post in this group")
+		}
+		groupName = membership.GroupName
 	}
 
 	// Create post
@@ -110,11 +418,26 @@ func (s *postService) CreatePost(ctx context.Context, userID, content, visibilit
 		UpdatedAt:     time.Now(),
 	}
 
+	// A retried request gets the post of the first attempt
+	createdID, err := s.reserveIdempotencyKey(ctx, userID, models.IdempotentCreatePost, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if createdID != "" {
+		return s.replayPost(ctx, createdID)
+	}
+
 	// Save post to database
 	if err := s.postRepo.Create(ctx, post); err != nil {
-		s.logger.Error("Failed to create post", err)
+		s.logger.WithContext(ctx).Error("Failed to create post", err)
+		s.releaseIdempotencyKey(ctx, userID, models.IdempotentCreatePost, idempotencyKey)
 		return nil, status.Error(codes.Internal, "failed to create post")
 	}
+	s.completeIdempotencyKey(ctx, userID, models.IdempotentCreatePost, idempotencyKey, post.ID)
+
+	// Index hashtags and mentions in the content
+	s.syncHashtags(ctx, post)
+	s.syncPostMentions(ctx, post)
 
 	return post, nil
 }
@@ -129,12 +452,23 @@ func (s *postService) GetPost(ctx context.Context, postID, userID string) (*mode
 	// Get post from database
 	post, err := s.postRepo.FindByID(ctx, postID)
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return nil, false, status.Error(codes.NotFound, "post not found")
 	}
 
-	// Check if the post is visible to the user
-	isVisible := s.isPostVisibleToUser(post, userID, nil)
+	// The posts of users the user has blocked, or been blocked by, are hidden as if they did not exist
+	if userID != "" && userID != post.AuthorID && s.getBlockedIDs(ctx, userID)[post.AuthorID] {
+		return nil, false, status.Error(codes.NotFound, "post not found")
+	}
+
+	// Check if the post is visible to the user. A single post is requested without the user's
+	// friend IDs, so the friends service is asked whether the user is a friend of the author.
+	var friendIDs []string
+	if s.isFriendOfAuthor(ctx, post, userID) {
+		friendIDs = []string{post.AuthorID}
+	}
+	groupAccess := s.lookupGroupAccess(ctx, []*models.Post{post}, userID)
+	isVisible := s.isPostVisibleToUser(post, userID, friendIDs, groupAccess)
 	if !isVisible {
 		return nil, false, status.Error(codes.PermissionDenied, "you don't have permission to view this post")
 	}
@@ -145,11 +479,15 @@ func (s *postService) GetPost(ctx context.Context, postID, userID string) (*mode
 		isLiked, _ = s.IsLiked(ctx, postID, userID)
 	}
 
+	// Load who is mentioned in the post and the post it shares
+	s.attachPostMentions(ctx, []*models.Post{post})
+	s.attachOriginalPosts(ctx, []*models.Post{post}, userID, nil)
+
 	return post, isLiked, nil
 }
 
 // GetPosts retrieves posts with pagination and filtering
-func (s *postService) GetPosts(ctx context.Context, userID, authorID, groupID, visibility string, page, limit int, friendIDs []string) ([]*models.Post, int64, int32, error) {
+func (s *postService) GetPosts(ctx context.Context, userID, authorID, groupID, visibility, feed, cursor string, page, limit int) ([]*models.Post, int64, int32, string, error) {
 	// Validate input
 	if page < 1 {
 		page = 1
@@ -158,16 +496,49 @@ func (s *postService) GetPosts(ctx context.Context, userID, authorID, groupID, v
 		limit = 10
 	}
 
+	isFeed := authorID == "" && groupID == ""
+	if feed != "" && feed != feedFollowing {
+		return nil, 0, 0, "", status.Error(codes.InvalidArgument, "feed must be 'following' or empty")
+	}
+	if feed != "" && !isFeed {
+		return nil, 0, 0, "", status.Error(codes.InvalidArgument, "feed is only supported for feeds")
+	}
+
+	// The following feed is limited to the posts of the users the user follows
+	var followeeIDs []string
+	if feed == feedFollowing {
+		var err error
+		if followeeIDs, err = s.getFollowingIDs(ctx, userID); err != nil {
+			return nil, 0, 0, "", err
+		}
+	}
+
+	// Friends-only posts are included for the friends of their author
+	friendIDs := s.getFriendIDs(ctx, userID)
+
+	if cursor != "" {
+		if !isFeed {
+			return nil, 0, 0, "", status.Error(codes.InvalidArgument, "cursor is only supported for feeds")
+		}
+		return s.getFeedAfter(ctx, userID, visibility, feed, cursor, limit, friendIDs, followeeIDs)
+	}
+
 	var posts []*models.Post
 	var count int64
 	var err error
 
 	// Get posts based on filters
-	if authorID != "" {
+	if feed == feedFollowing {
+		// Get posts of followed users
+		posts, count, err = s.postRepo.FindFollowed(ctx, followeeIDs, friendIDs, page, limit)
+	} else if authorID != "" {
 		// Get posts by author
 		posts, count, err = s.postRepo.FindByAuthor(ctx, authorID, page, limit)
 	} else if groupID != "" {
-		// Get posts by group
+		// Get posts by group, refusing the whole page rather than counting posts the user can't see
+		if err := s.requireGroupReadable(ctx, groupID, userID); err != nil {
+			return nil, 0, 0, "", err
+		}
 		posts, count, err = s.postRepo.FindByGroup(ctx, groupID, page, limit)
 	} else if userID == "" || visibility == "public" {
 		// Get public posts
@@ -178,30 +549,176 @@ func (s *postService) GetPosts(ctx context.Context, userID, authorID, groupID, v
 	}
 
 	if err != nil {
-		s.logger.Error("Failed to get posts", err)
+		s.logger.WithContext(ctx).Error("Failed to get posts", err)
+		return nil, 0, 0, "", status.Error(codes.Internal, "failed to get posts")
+	}
+
+	// Hand out a cursor so feed clients can switch to keyset paging after this page
+	var nextCursor string
+	if isFeed && len(posts) > 0 && int64(page*limit) < count {
+		nextCursor = encodeFeedCursor(posts[len(posts)-1])
+	}
+
+	visiblePosts := s.preparePosts(ctx, posts, userID, friendIDs)
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return visiblePosts, count, totalPages, nextCursor, nil
+}
+
+// getFeedAfter retrieves the page of a feed that follows the cursor. Totals are not
+// counted in cursor mode, so the returned count and page total are always zero.
+func (s *postService) getFeedAfter(ctx context.Context, userID, visibility, feed, cursor string, limit int, friendIDs, followeeIDs []string) ([]*models.Post, int64, int32, string, error) {
+	after, err := decodeFeedCursor(cursor)
+	if err != nil {
+		return nil, 0, 0, "", status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	// Fetch one extra post to find out whether another page follows
+	var posts []*models.Post
+	if feed == feedFollowing {
+		posts, err = s.postRepo.FindFollowedAfter(ctx, followeeIDs, friendIDs, after, limit+1)
+	} else if userID == "" || visibility == "public" {
+		posts, err = s.postRepo.FindPublicAfter(ctx, after, limit+1)
+	} else {
+		posts, err = s.postRepo.FindVisibleAfter(ctx, userID, friendIDs, after, limit+1)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get posts", err, "cursor", cursor)
+		return nil, 0, 0, "", status.Error(codes.Internal, "failed to get posts")
+	}
+
+	var nextCursor string
+	if len(posts) > limit {
+		posts = posts[:limit]
+		nextCursor = encodeFeedCursor(posts[len(posts)-1])
+	}
+
+	return s.preparePosts(ctx, posts, userID, friendIDs), 0, 0, nextCursor, nil
+}
+
+// feedFollowing is the feed of the posts of the users the user follows
+const feedFollowing = "following"
+
+// getFollowingIDs retrieves the IDs of the users a user follows from the friends service
+func (s *postService) getFollowingIDs(ctx context.Context, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see the posts of the users you follow")
+	}
+
+	followeeIDs, err := s.friendClient.GetFollowingIDs(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get followed users", err)
+		return nil, status.Error(codes.Unavailable, "failed to get followed users")
+	}
+
+	return followeeIDs, nil
+}
+
+// getFriendIDs retrieves the IDs of the friends of the user, who is the caller of the request,
+// from the friends service. Without them friends-only posts are left out, so a failure hides
+// them rather than failing the whole listing.
+func (s *postService) getFriendIDs(ctx context.Context, userID string) []string {
+	if userID == "" {
+		return nil
+	}
+
+	friendIDs, err := s.friendClient.GetFriendIDs(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get friends", "error", err)
+		return nil
+	}
+
+	return friendIDs
+}
+
+// GetPostsByHashtag retrieves posts tagged with a hashtag with pagination
+func (s *postService) GetPostsByHashtag(ctx context.Context, userID, tag string, page, limit int) ([]*models.Post, int64, int32, error) {
+	// Validate input
+	tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+	if !isValidHashtag(tag) {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "a valid hashtag is required")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	// Get tagged posts from database
+	friendIDs := s.getFriendIDs(ctx, userID)
+	posts, count, err := s.postRepo.FindByHashtag(ctx, tag, userID, friendIDs, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get posts by hashtag", err, "tag", tag)
 		return nil, 0, 0, status.Error(codes.Internal, "failed to get posts")
 	}
 
-	// Filter posts based on visibility
+	visiblePosts := s.preparePosts(ctx, posts, userID, friendIDs)
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return visiblePosts, count, totalPages, nil
+}
+
+// preparePosts resolves authors for a page of posts, drops the ones the user cannot see
+// and marks the ones the user has liked, using one lookup of each kind for the whole page
+func (s *postService) preparePosts(ctx context.Context, posts []*models.Post, userID string, friendIDs []string) []*models.Post {
+	// Resolve current author names and avatars
+	authorIDs := make([]string, len(posts))
+	for i, post := range posts {
+		authorIDs[i] = post.AuthorID
+	}
+	authors := s.lookupUsers(ctx, authorIDs)
+	for _, post := range posts {
+		if author, ok := authors[post.AuthorID]; ok {
+			post.AuthorName = author.Name
+			post.AuthorAvatar = author.Avatar
+		}
+	}
+
+	// Filter posts based on visibility, leaving out the posts of blocked users
+	groupAccess := s.lookupGroupAccess(ctx, posts, userID)
+	blocked := s.getBlockedIDs(ctx, userID)
 	visiblePosts := make([]*models.Post, 0, len(posts))
 	for _, post := range posts {
-		if s.isPostVisibleToUser(post, userID, friendIDs) {
-			// Check if the post is liked by the user
-			if userID != "" {
-				isLiked, _ := s.IsLiked(ctx, post.ID, userID)
-				if isLiked {
-					// Set isLiked flag (this is not stored in the database)
-					// We'll need to add this field to the Post struct or response
-				}
-			}
+		if !blocked[post.AuthorID] && s.isPostVisibleToUser(post, userID, friendIDs, groupAccess) {
 			visiblePosts = append(visiblePosts, post)
 		}
 	}
 
-	// Calculate total pages
-	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+	// Mark the posts liked by the user
+	if userID != "" && len(visiblePosts) > 0 {
+		postIDs := make([]string, len(visiblePosts))
+		for i, post := range visiblePosts {
+			postIDs[i] = post.ID
+		}
 
-	return visiblePosts, count, totalPages, nil
+		liked, err := s.likeRepo.WhichLiked(ctx, userID, postIDs)
+		if err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to get liked posts", "error", err)
+		} else {
+			for _, post := range visiblePosts {
+				post.IsLiked = liked[post.ID]
+			}
+		}
+	}
+
+	// Load who is mentioned in each post and the posts they share
+	s.attachPostMentions(ctx, visiblePosts)
+	s.attachOriginalPosts(ctx, visiblePosts, userID, friendIDs)
+
+	return visiblePosts
+}
+
+// syncHashtags indexes the hashtags in a post's content. Failures are logged rather than
+// returned so that a tagging problem never blocks saving the post itself.
+func (s *postService) syncHashtags(ctx context.Context, post *models.Post) {
+	if err := s.hashtagRepo.SyncPostTags(ctx, post.ID, extractHashtags(post.Content)); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to sync post hashtags", err, "post_id", post.ID)
+	}
 }
 
 // UpdatePost updates a post
@@ -213,17 +730,21 @@ func (s *postService) UpdatePost(ctx context.Context, postID, userID, content, v
 	if userID == "" {
 		return nil, status.Error(codes.InvalidArgument, "user ID is required")
 	}
-	if content == "" {
-		return nil, status.Error(codes.InvalidArgument, "content is required")
+	content, err := validateContent(content, s.contentLimits.MaxPostLength, true)
+	if err != nil {
+		return nil, err
+	}
+	if visibility != "" && !isValidVisibility(visibility) {
+		return nil, status.Error(codes.InvalidArgument, "visibility must be 'public', 'friends' or 'private'")
 	}
-	if visibility != "" && visibility != "public" && visibility != "private" {
-		return nil, status.Error(codes.InvalidArgument, "visibility must be 'public' or 'private'")
+	if err := validateMedia(media, s.mediaLimits); err != nil {
+		return nil, err
 	}
 
 	// Get post from database
 	post, err := s.postRepo.FindByID(ctx, postID)
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return nil, status.Error(codes.NotFound, "post not found")
 	}
 
@@ -244,10 +765,14 @@ func (s *postService) UpdatePost(ctx context.Context, postID, userID, content, v
 
 	// Save post to database
 	if err := s.postRepo.Update(ctx, post); err != nil {
-		s.logger.Error("Failed to update post", err)
+		s.logger.WithContext(ctx).Error("Failed to update post", err)
 		return nil, status.Error(codes.Internal, "failed to update post")
 	}
 
+	// Reconcile hashtags and mentions with the updated content
+	s.syncHashtags(ctx, post)
+	s.syncPostMentions(ctx, post)
+
 	return post, nil
 }
 
@@ -264,7 +789,7 @@ func (s *postService) DeletePost(ctx context.Context, postID, userID string) err
 	// Get post from database
 	post, err := s.postRepo.FindByID(ctx, postID)
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return status.Error(codes.NotFound, "post not found")
 	}
 
@@ -275,15 +800,58 @@ func (s *postService) DeletePost(ctx context.Context, postID, userID string) err
 
 	// Delete post from database
 	if err := s.postRepo.Delete(ctx, postID); err != nil {
-		s.logger.Error("Failed to delete post", err)
+		s.logger.WithContext(ctx).Error("Failed to delete post", err)
 		return status.Error(codes.Internal, "failed to delete post")
 	}
 
 	return nil
 }
 
+// RestorePost restores a deleted post, which is only possible within the restore window
+func (s *postService) RestorePost(ctx context.Context, postID, userID string) (*models.Post, error) {
+	// Validate input
+	if postID == "" {
+		return nil, status.Error(codes.InvalidArgument, "post ID is required")
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	// Get the deleted post from database
+	post, err := s.postRepo.FindDeletedByID(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get deleted post", err)
+		return nil, status.Error(codes.NotFound, "deleted post not found")
+	}
+
+	// Check if the user is the author of the post
+	if post.AuthorID != userID {
+		return nil, status.Error(codes.PermissionDenied, "you don't have permission to restore this post")
+	}
+
+	// The post may not have been purged yet, but it is past the window
+	if time.Since(post.DeletedAt.Time) > s.restoreWindow {
+		return nil, status.Error(codes.FailedPrecondition, "the post can no longer be restored")
+	}
+
+	// Restore post in database
+	if err := s.postRepo.Restore(ctx, postID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to restore post", err)
+		return nil, status.Error(codes.Internal, "failed to restore post")
+	}
+
+	// Get the restored post
+	restored, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get restored post", err)
+		return nil, status.Error(codes.Internal, "failed to restore post")
+	}
+
+	return restored, nil
+}
+
 // AddComment adds a comment to a post
-func (s *postService) AddComment(ctx context.Context, postID, userID, authorName, authorAvatar, content string) (*models.Comment, error) {
+func (s *postService) AddComment(ctx context.Context, postID, userID, content, parentCommentID, idempotencyKey string) (*models.Comment, error) {
 	// Validate input
 	if postID == "" {
 		return nil, status.Error(codes.InvalidArgument, "post ID is required")
@@ -291,47 +859,90 @@ func (s *postService) AddComment(ctx context.Context, postID, userID, authorName
 	if userID == "" {
 		return nil, status.Error(codes.InvalidArgument, "user ID is required")
 	}
-	if content == "" {
-		return nil, status.Error(codes.InvalidArgument, "content is required")
+	content, err := validateContent(content, s.contentLimits.MaxCommentLength, true)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get post from database
-	_, err := s.postRepo.FindByID(ctx, postID)
+	post, err := s.postRepo.FindByID(ctx, postID)
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return nil, status.Error(codes.NotFound, "post not found")
 	}
 
-	// TODO: Check if the user can comment on the post (e.g., is a friend or group member)
+	// Check if the user can comment on the post
+	if err := s.requireCommentable(ctx, post, userID); err != nil {
+		return nil, err
+	}
+
+	// Resolve the parent comment when replying
+	var parentID *string
+	depth := 0
+	if parentCommentID != "" {
+		parent, err := s.commentRepo.FindByID(ctx, parentCommentID)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to get parent comment", err)
+			return nil, status.Error(codes.NotFound, "parent comment not found")
+		}
+		if parent.PostID != postID {
+			return nil, status.Error(codes.InvalidArgument, "parent comment does not belong to the post")
+		}
+		depth = parent.Depth + 1
+		if depth > s.maxCommentDepth {
+			return nil, status.Errorf(codes.InvalidArgument, "replies cannot be nested more than %d levels deep", s.maxCommentDepth)
+		}
+		parentID = &parent.ID
+	}
+
+	// Get author info from the users service
+	var authorName, authorAvatar string
+	if author, ok := s.lookupUsers(ctx, []string{userID})[userID]; ok {
+		authorName = author.Name
+		authorAvatar = author.Avatar
+	}
 
 	// Create comment
 	comment := &models.Comment{
-		PostID:       postID,
-		AuthorID:     userID,
-		AuthorName:   authorName,
-		AuthorAvatar: authorAvatar,
-		Content:      content,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		PostID:          postID,
+		ParentCommentID: parentID,
+		Depth:           depth,
+		AuthorID:        userID,
+		AuthorName:      authorName,
+		AuthorAvatar:    authorAvatar,
+		Content:         content,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	// A retried request gets the comment of the first attempt
+	createdID, err := s.reserveIdempotencyKey(ctx, userID, models.IdempotentAddComment, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if createdID != "" {
+		return s.replayComment(ctx, postID, createdID)
 	}
 
-	// Save comment to database
+	// Save comment to database, counting it on the post
 	if err := s.commentRepo.Create(ctx, comment); err != nil {
-		s.logger.Error("Failed to create comment", err)
+		s.logger.WithContext(ctx).Error("Failed to create comment", err)
+		s.releaseIdempotencyKey(ctx, userID, models.IdempotentAddComment, idempotencyKey)
 		return nil, status.Error(codes.Internal, "failed to create comment")
 	}
+	s.completeIdempotencyKey(ctx, userID, models.IdempotentAddComment, idempotencyKey, comment.ID)
 
-	// Increment comments count for the post
-	if err := s.postRepo.IncrementCommentsCount(ctx, postID); err != nil {
-		s.logger.Error("Failed to increment comments count", err)
-		// Don't return an error here, just log it
-	}
+	// Record mentions in the content
+	s.syncCommentMentions(ctx, comment)
+
+	// Tell the post's author about the comment
+	s.notify(ctx, post.AuthorID, clients.NotificationComment, postID)
 
 	return comment, nil
 }
 
 // GetComments retrieves comments for a post
-func (s *postService) GetComments(ctx context.Context, postID string, page, limit int) ([]*models.Comment, int64, int32, error) {
+func (s *postService) GetComments(ctx context.Context, postID, userID string, threaded bool, page, limit int) ([]*models.Comment, int64, int32, error) {
 	// Validate input
 	if postID == "" {
 		return nil, 0, 0, status.Error(codes.InvalidArgument, "post ID is required")
@@ -343,19 +954,136 @@ func (s *postService) GetComments(ctx context.Context, postID string, page, limi
 		limit = 10
 	}
 
+	// Comments of deleted posts are hidden along with the post
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return nil, 0, 0, status.Error(codes.NotFound, "post not found")
+	}
+
+	// So are the comments of the posts of blocked users
+	blocked := s.getBlockedIDs(ctx, userID)
+	if blocked[post.AuthorID] {
+		return nil, 0, 0, status.Error(codes.NotFound, "post not found")
+	}
+
 	// Get comments from database
-	comments, count, err := s.commentRepo.FindByPost(ctx, postID, page, limit)
+	var comments []*models.Comment
+	var count int64
+	if threaded {
+		comments, count, err = s.commentRepo.FindTopLevelByPost(ctx, postID, page, limit)
+	} else {
+		comments, count, err = s.commentRepo.FindByPost(ctx, postID, page, limit)
+	}
 	if err != nil {
-		s.logger.Error("Failed to get comments", err)
+		s.logger.WithContext(ctx).Error("Failed to get comments", err)
 		return nil, 0, 0, status.Error(codes.Internal, "failed to get comments")
 	}
 
+	if threaded {
+		if err := s.attachReplies(ctx, postID, comments); err != nil {
+			s.logger.WithContext(ctx).Error("Failed to get comment replies", err)
+			return nil, 0, 0, status.Error(codes.Internal, "failed to get comments")
+		}
+	}
+
+	// Leave out the comments of blocked users
+	comments = withoutBlockedComments(comments, blocked)
+
 	// Calculate total pages
 	totalPages := int32((count + int64(limit) - 1) / int64(limit))
 
+	// Load who is mentioned in each comment
+	s.attachCommentMentions(ctx, comments)
+
 	return comments, count, totalPages, nil
 }
 
+// attachReplies loads the replies beneath the given top-level comments one nesting level
+// at a time, so the number of queries is bounded by the maximum reply depth
+func (s *postService) attachReplies(ctx context.Context, postID string, comments []*models.Comment) error {
+	level := comments
+	for depth := 0; depth < s.maxCommentDepth && len(level) > 0; depth++ {
+		parents := make(map[string]*models.Comment, len(level))
+		parentIDs := make([]string, len(level))
+		for i, comment := range level {
+			parents[comment.ID] = comment
+			parentIDs[i] = comment.ID
+		}
+
+		replies, err := s.commentRepo.FindReplies(ctx, postID, parentIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, reply := range replies {
+			if parent, ok := parents[*reply.ParentCommentID]; ok {
+				parent.Replies = append(parent.Replies, reply)
+			}
+		}
+		level = replies
+	}
+
+	return nil
+}
+
+// EditComment updates the content of a comment
+func (s *postService) EditComment(ctx context.Context, commentID, postID, userID, content string) (*models.Comment, error) {
+	// Validate input
+	if commentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "comment ID is required")
+	}
+	if postID == "" {
+		return nil, status.Error(codes.InvalidArgument, "post ID is required")
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	content, err := validateContent(content, s.contentLimits.MaxCommentLength, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get comment from database
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get comment", err)
+		return nil, status.Error(codes.NotFound, "comment not found")
+	}
+
+	// Check if the comment belongs to the post
+	if comment.PostID != postID {
+		return nil, status.Error(codes.InvalidArgument, "comment does not belong to the post")
+	}
+
+	// Make sure the post still exists
+	if _, err := s.postRepo.FindByID(ctx, postID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+
+	// Check if the user is the author of the comment
+	if comment.AuthorID != userID {
+		return nil, status.Error(codes.PermissionDenied, "you don't have permission to edit this comment")
+	}
+
+	// Update comment fields
+	comment.Content = content
+	comment.Edited = true
+	comment.UpdatedAt = time.Now()
+
+	// Save comment to database
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to update comment", err)
+		return nil, status.Error(codes.Internal, "failed to update comment")
+	}
+
+	// Reconcile mentions with the updated content
+	s.syncCommentMentions(ctx, comment)
+
+	return comment, nil
+}
+
 // DeleteComment deletes a comment
 func (s *postService) DeleteComment(ctx context.Context, commentID, postID, userID string) error {
 	// Validate input
@@ -372,7 +1100,7 @@ func (s *postService) DeleteComment(ctx context.Context, commentID, postID, user
 	// Get comment from database
 	comment, err := s.commentRepo.FindByID(ctx, commentID)
 	if err != nil {
-		s.logger.Error("Failed to get comment", err)
+		s.logger.WithContext(ctx).Error("Failed to get comment", err)
 		return status.Error(codes.NotFound, "comment not found")
 	}
 
@@ -384,7 +1112,7 @@ func (s *postService) DeleteComment(ctx context.Context, commentID, postID, user
 	// Get post from database
 	post, err := s.postRepo.FindByID(ctx, postID)
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return status.Error(codes.NotFound, "post not found")
 	}
 
@@ -393,23 +1121,17 @@ func (s *postService) DeleteComment(ctx context.Context, commentID, postID, user
 		return status.Error(codes.PermissionDenied, "you don't have permission to delete this comment")
 	}
 
-	// Delete comment from database
+	// Delete comment from database, uncounting it on the post
 	if err := s.commentRepo.Delete(ctx, commentID); err != nil {
-		s.logger.Error("Failed to delete comment", err)
+		s.logger.WithContext(ctx).Error("Failed to delete comment", err)
 		return status.Error(codes.Internal, "failed to delete comment")
 	}
 
-	// Decrement comments count for the post
-	if err := s.postRepo.DecrementCommentsCount(ctx, postID); err != nil {
-		s.logger.Error("Failed to decrement comments count", err)
-		// Don't return an error here, just log it
-	}
-
 	return nil
 }
 
-// LikePost likes a post
-func (s *postService) LikePost(ctx context.Context, postID, userID string) (int32, error) {
+// React sets the user's reaction on a post, replacing any previous reaction
+func (s *postService) React(ctx context.Context, postID, userID, reaction string) (int32, error) {
 	// Validate input
 	if postID == "" {
 		return 0, status.Error(codes.InvalidArgument, "post ID is required")
@@ -417,51 +1139,43 @@ func (s *postService) LikePost(ctx context.Context, postID, userID string) (int3
 	if userID == "" {
 		return 0, status.Error(codes.InvalidArgument, "user ID is required")
 	}
-
-	// Check if the post exists
-	post, err := s.postRepo.FindByID(ctx, postID)
-	if err != nil {
-		s.logger.Error("Failed to get post", err)
-		return 0, status.Error(codes.NotFound, "post not found")
+	if reaction == "" {
+		reaction = models.ReactionLike
 	}
-
-	// Check if the user has already liked the post
-	_, err = s.likeRepo.FindByPostAndUser(ctx, postID, userID)
-	if err == nil {
-		// User has already liked the post
-		return int32(post.LikesCount), status.Error(codes.AlreadyExists, "you have already liked this post")
+	if !models.IsValidReaction(reaction) {
+		return 0, status.Error(codes.InvalidArgument, "reaction must be one of 'like', 'love', 'laugh', 'angry' or 'sad'")
 	}
 
-	// Create like
-	like := &models.Like{
-		PostID:    postID,
-		UserID:    userID,
-		CreatedAt: time.Now(),
+	// Store the reaction and count the likes in one transaction
+	count, err := s.likeRepo.SetReaction(ctx, postID, userID, reaction)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, status.Error(codes.NotFound, "post not found")
 	}
-
-	// Save like to database
-	if err := s.likeRepo.Create(ctx, like); err != nil {
-		s.logger.Error("Failed to create like", err)
-		return 0, status.Error(codes.Internal, "failed to like post")
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to react to post", err)
+		return 0, status.Error(codes.Internal, "failed to react to post")
 	}
 
-	// Increment likes count for the post
-	if err := s.postRepo.IncrementLikesCount(ctx, postID); err != nil {
-		s.logger.Error("Failed to increment likes count", err)
-		// Don't return an error here, just log it
+	// Tell the post's author about the reaction
+	if post, err := s.postRepo.FindByID(ctx, postID); err == nil {
+		s.notify(ctx, post.AuthorID, clients.NotificationLike, postID)
 	}
 
-	// Get updated likes count
-	updatedPost, err := s.postRepo.FindByID(ctx, postID)
-	if err != nil {
-		s.logger.Error("Failed to get updated post", err)
-		return int32(post.LikesCount + 1), nil // Return estimated count
-	}
+	return int32(count), nil
+}
 
-	return int32(updatedPost.LikesCount), nil
+// notify tells a user about something the caller did to their post. Notifications are
+// best-effort, so a failure is logged without failing the request.
+func (s *postService) notify(ctx context.Context, userID, notificationType, targetID string) {
+	if err := s.notifications.Notify(ctx, userID, notificationType, targetID); err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to send notification",
+			"error", err,
+			"recipient_id", userID,
+			"type", notificationType)
+	}
 }
 
-// UnlikePost unlikes a post
+// UnlikePost removes the user's reaction from a post
 func (s *postService) UnlikePost(ctx context.Context, postID, userID string) (int32, error) {
 	// Validate input
 	if postID == "" {
@@ -474,37 +1188,116 @@ func (s *postService) UnlikePost(ctx context.Context, postID, userID string) (in
 	// Check if the post exists
 	post, err := s.postRepo.FindByID(ctx, postID)
 	if err != nil {
-		s.logger.Error("Failed to get post", err)
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
 		return 0, status.Error(codes.NotFound, "post not found")
 	}
 
-	// Check if the user has liked the post
-	like, err := s.likeRepo.FindByPostAndUser(ctx, postID, userID)
+	// Remove the reaction and count the likes in one transaction
+	count, err := s.likeRepo.RemoveReaction(ctx, postID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return int32(post.LikesCount), status.Error(codes.NotFound, "you have not reacted to this post")
+	}
 	if err != nil {
-		// User has not liked the post
-		return int32(post.LikesCount), status.Error(codes.NotFound, "you have not liked this post")
+		s.logger.WithContext(ctx).Error("Failed to remove reaction", err)
+		return 0, status.Error(codes.Internal, "failed to unlike post")
 	}
 
-	// Delete like from database
-	if err := s.likeRepo.Delete(ctx, like.ID); err != nil {
-		s.logger.Error("Failed to delete like", err)
-		return 0, status.Error(codes.Internal, "failed to unlike post")
+	return int32(count), nil
+}
+
+// BookmarkPost saves a post for the user to revisit later. Only posts the user can see can be
+// bookmarked, and bookmarking a post again has no effect.
+func (s *postService) BookmarkPost(ctx context.Context, postID, userID string) error {
+	// Validate input
+	if postID == "" {
+		return status.Error(codes.InvalidArgument, "post ID is required")
+	}
+	if userID == "" {
+		return status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	// Get post from database
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	// Check if the post is visible to the user
+	var friendIDs []string
+	if s.isFriendOfAuthor(ctx, post, userID) {
+		friendIDs = []string{post.AuthorID}
+	}
+	groupAccess := s.lookupGroupAccess(ctx, []*models.Post{post}, userID)
+	if !s.isPostVisibleToUser(post, userID, friendIDs, groupAccess) {
+		return status.Error(codes.PermissionDenied, "you don't have permission to view this post")
+	}
+
+	// Save bookmark to database
+	bookmark := &models.PostBookmark{
+		PostID:    postID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	}
+	if err := s.bookmarkRepo.Create(ctx, bookmark); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create bookmark", err)
+		return status.Error(codes.Internal, "failed to bookmark post")
+	}
+
+	return nil
+}
+
+// UnbookmarkPost removes a post from the user's bookmarks
+func (s *postService) UnbookmarkPost(ctx context.Context, postID, userID string) error {
+	// Validate input
+	if postID == "" {
+		return status.Error(codes.InvalidArgument, "post ID is required")
+	}
+	if userID == "" {
+		return status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	// Delete bookmark from database
+	err := s.bookmarkRepo.Delete(ctx, postID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Error(codes.NotFound, "you have not bookmarked this post")
 	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to delete bookmark", err)
+		return status.Error(codes.Internal, "failed to remove bookmark")
+	}
+
+	return nil
+}
 
-	// Decrement likes count for the post
-	if err := s.postRepo.DecrementLikesCount(ctx, postID); err != nil {
-		s.logger.Error("Failed to decrement likes count", err)
-		// Don't return an error here, just log it
+// GetBookmarks retrieves the user's bookmarked posts with pagination. Bookmarks stay in place
+// when a post becomes hidden from the user, so they reappear if it becomes visible again.
+func (s *postService) GetBookmarks(ctx context.Context, userID string, page, limit int) ([]*models.Post, int64, int32, error) {
+	// Validate input
+	if userID == "" {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
 	}
 
-	// Get updated likes count
-	updatedPost, err := s.postRepo.FindByID(ctx, postID)
+	// Get bookmarked posts from database
+	friendIDs := s.getFriendIDs(ctx, userID)
+	posts, count, err := s.bookmarkRepo.FindPostsByUser(ctx, userID, friendIDs, page, limit)
 	if err != nil {
-		s.logger.Error("Failed to get updated post", err)
-		return int32(post.LikesCount - 1), nil // Return estimated count
+		s.logger.WithContext(ctx).Error("Failed to get bookmarks", err)
+		return nil, 0, 0, status.Error(codes.Internal, "failed to get bookmarks")
 	}
 
-	return int32(updatedPost.LikesCount), nil
+	visiblePosts := s.preparePosts(ctx, posts, userID, friendIDs)
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return visiblePosts, count, totalPages, nil
 }
 
 // IsLiked checks if a post is liked by a user
@@ -527,20 +1320,51 @@ func (s *postService) IsLiked(ctx context.Context, postID, userID string) (bool,
 	return true, nil
 }
 
+// CountPublicPosts counts the posts of an author that everyone can see
+func (s *postService) CountPublicPosts(ctx context.Context, authorID string) (int32, error) {
+	if authorID == "" {
+		return 0, status.Error(codes.InvalidArgument, "author ID is required")
+	}
+
+	count, err := s.postRepo.CountPublicByAuthor(ctx, authorID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count public posts", err, "author_id", authorID)
+		return 0, status.Error(codes.Internal, "failed to count posts")
+	}
+
+	return int32(count), nil
+}
+
+// isValidVisibility reports whether visibility is one a post can be given: "public" for everyone,
+// "friends" for the author and the author's friends, or "private" for the author only
+func isValidVisibility(visibility string) bool {
+	return visibility == "public" || visibility == "friends" || visibility == "private"
+}
+
 // isPostVisibleToUser checks if a post is visible to a user
-func (s *postService) isPostVisibleToUser(post *models.Post, userID string, friendIDs []string) bool {
+func (s *postService) isPostVisibleToUser(post *models.Post, userID string, friendIDs []string, groupAccess map[string]bool) bool {
+	// Posts hidden by a moderator are visible to no one
+	if post.HiddenAt != nil {
+		return false
+	}
+
+	// Group posts follow the group's rules rather than the post's own visibility
+	if post.GroupID != "" {
+		return groupAccess[post.GroupID]
+	}
+
 	// Public posts are visible to everyone
 	if post.Visibility == "public" {
 		return true
 	}
 
-	// Private posts are visible to the author
+	// Every post is visible to its author, and private posts only to the author
 	if userID == post.AuthorID {
 		return true
 	}
 
-	// Private posts are visible to friends of the author
-	if post.Visibility == "private" && friendIDs != nil {
+	// Friends-only posts are visible to friends of the author
+	if post.Visibility == "friends" && friendIDs != nil {
 		for _, friendID := range friendIDs {
 			if friendID == post.AuthorID {
 				return true
@@ -548,12 +1372,5 @@ func (s *postService) isPostVisibleToUser(post *models.Post, userID string, frie
 		}
 	}
 
-	// Group posts are visible to group members
-	if post.GroupID != "" {
-		// TODO: Check if the user is a member of the group
-		// For now, assume all group posts are visible to everyone
-		return true
-	}
-
 	return false
 }
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"post-api/internal/clients"
+	"post-api/internal/testutil"
+)
+
+// countingUsers counts the calls that reach the users service
+type countingUsers struct {
+	fakeUsers
+	calls atomic.Int64
+}
+
+func (u *countingUsers) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*clients.UserInfo, error) {
+	u.calls.Add(1)
+	return u.fakeUsers.GetUsersByIDs(ctx, userIDs)
+}
+
+func TestGetPostsMarksLikedPosts(t *testing.T) {
+	s := newTestService(t)
+	posts := make([]string, 4)
+	for i := range posts {
+		posts[i] = s.createPost(t, "alice", "public", "").ID
+	}
+
+	// Bob reacts to two of the posts, and Carol to another one
+	ctx := asUser("bob")
+	if _, err := s.React(ctx, posts[0], "bob", "like"); err != nil {
+		t.Fatalf("React: %v", err)
+	}
+	if _, err := s.React(ctx, posts[2], "bob", "love"); err != nil {
+		t.Fatalf("React: %v", err)
+	}
+	if _, err := s.React(asUser("carol"), posts[1], "carol", "like"); err != nil {
+		t.Fatalf("React: %v", err)
+	}
+
+	feed, _, _, _, err := s.GetPosts(ctx, "bob", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if len(feed) != len(posts) {
+		t.Fatalf("got %d posts, want %d", len(feed), len(posts))
+	}
+	want := map[string]bool{posts[0]: true, posts[2]: true}
+	for _, post := range feed {
+		if post.IsLiked != want[post.ID] {
+			t.Errorf("post %s: IsLiked = %v, want %v", post.ID, post.IsLiked, want[post.ID])
+		}
+	}
+
+	// The flags are the caller's own, not anyone else's
+	feed, _, _, _, err = s.GetPosts(asUser("carol"), "carol", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	for _, post := range feed {
+		if post.IsLiked != (post.ID == posts[1]) {
+			t.Errorf("post %s for carol: IsLiked = %v, want %v", post.ID, post.IsLiked, post.ID == posts[1])
+		}
+	}
+}
+
+// BenchmarkGetPostsFeed reads the first page of a feed written by a handful of authors and
+// reports the database queries and users service calls it takes per page
+func BenchmarkGetPostsFeed(b *testing.B) {
+	for _, cacheSize := range []int{0, 1000} {
+		b.Run(fmt.Sprintf("cache=%d", cacheSize), func(b *testing.B) {
+			s := newTestService(b)
+			for i := 0; i < 100; i++ {
+				s.createPost(b, fmt.Sprintf("author-%d", i%10), "public", "")
+			}
+			users := &countingUsers{}
+			s.userClient = clients.NewCachedUserClient(users, cacheSize, time.Minute)
+			queries := testutil.CountQueries(b, s.db)
+			ctx := asUser("reader")
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				posts, _, _, _, err := s.GetPosts(ctx, "reader", "", "", "", "", "", 1, 20)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(posts) != 20 {
+					b.Fatalf("got %d posts, want 20", len(posts))
+				}
+			}
+			b.ReportMetric(float64(*queries)/float64(b.N), "queries/op")
+			b.ReportMetric(float64(users.calls.Load())/float64(b.N), "user-calls/op")
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"post-api/internal/models"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ReportPost reports a post for moderation. Users can only report posts they can see, and only
+// once per post.
+func (s *postService) ReportPost(ctx context.Context, postID, reporterID, reason string) error {
+	// Validate input
+	if postID == "" {
+		return status.Error(codes.InvalidArgument, "post ID is required")
+	}
+	if reporterID == "" {
+		return status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if !models.IsValidReportReason(reason) {
+		return status.Error(codes.InvalidArgument, "reason must be one of spam, harassment, hate_speech, violence, nudity, misinformation or other")
+	}
+
+	// Get post from database
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	// Check if the post is visible to the user
+	var friendIDs []string
+	if s.isFriendOfAuthor(ctx, post, reporterID) {
+		friendIDs = []string{post.AuthorID}
+	}
+	groupAccess := s.lookupGroupAccess(ctx, []*models.Post{post}, reporterID)
+	if !s.isPostVisibleToUser(post, reporterID, friendIDs, groupAccess) {
+		return status.Error(codes.PermissionDenied, "you don't have permission to view this post")
+	}
+	if post.AuthorID == reporterID {
+		return status.Error(codes.InvalidArgument, "you can't report your own post")
+	}
+
+	// Save report to database
+	report := &models.PostReport{
+		PostID:     postID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Status:     models.ReportStatusOpen,
+		CreatedAt:  time.Now(),
+	}
+	err = s.reportRepo.Create(ctx, report)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return status.Error(codes.AlreadyExists, "you have already reported this post")
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create report", err)
+		return status.Error(codes.Internal, "failed to report post")
+	}
+
+	return nil
+}
+
+// GetReports retrieves reports with the given status, open ones by default, together with the
+// reported posts and how often each post has been reported. The auth interceptor only lets
+// admins through.
+func (s *postService) GetReports(ctx context.Context, reportStatus string, page, limit int) ([]*models.PostReport, int64, int32, error) {
+	// Validate input
+	if reportStatus == "" {
+		reportStatus = models.ReportStatusOpen
+	}
+	if reportStatus != models.ReportStatusOpen && reportStatus != models.ReportStatusResolved {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "status must be 'open' or 'resolved'")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	// Get reports from database
+	reports, count, err := s.reportRepo.FindByStatus(ctx, reportStatus, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get reports", err)
+		return nil, 0, 0, status.Error(codes.Internal, "failed to get reports")
+	}
+
+	s.attachReportedPosts(ctx, reports)
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return reports, count, totalPages, nil
+}
+
+// ResolveReport resolves a report together with every other open report on the same post,
+// optionally hiding the post from everyone. The auth interceptor only lets admins through.
+func (s *postService) ResolveReport(ctx context.Context, reportID, userID string, hidePost bool) (*models.PostReport, error) {
+	// Validate input
+	if reportID == "" {
+		return nil, status.Error(codes.InvalidArgument, "report ID is required")
+	}
+
+	// Get report from database
+	report, err := s.reportRepo.FindByID(ctx, reportID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get report", err)
+		return nil, status.Error(codes.NotFound, "report not found")
+	}
+
+	// Resolve the post's reports
+	if err := s.reportRepo.Resolve(ctx, report.PostID, userID, hidePost); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to resolve report", err, "report_id", reportID)
+		return nil, status.Error(codes.Internal, "failed to resolve report")
+	}
+
+	// Get the resolved report
+	report, err = s.reportRepo.FindByID(ctx, reportID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get report", err)
+		return nil, status.Error(codes.Internal, "failed to get resolved report")
+	}
+
+	s.attachReportedPosts(ctx, []*models.PostReport{report})
+
+	return report, nil
+}
+
+// attachReportedPosts loads the post each report is about, including hidden posts, with its
+// current author info and report count. Reports on deleted posts are left without a post.
+func (s *postService) attachReportedPosts(ctx context.Context, reports []*models.PostReport) {
+	postIDs := make([]string, len(reports))
+	for i, report := range reports {
+		postIDs[i] = report.PostID
+	}
+
+	posts, err := s.postRepo.FindByIDs(ctx, postIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get reported posts", "error", err)
+		return
+	}
+
+	counts, err := s.reportRepo.CountByPosts(ctx, postIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to count reports", "error", err)
+	}
+
+	// Resolve current author names and avatars
+	authorIDs := make([]string, len(posts))
+	for i, post := range posts {
+		authorIDs[i] = post.AuthorID
+	}
+	authors := s.lookupUsers(ctx, authorIDs)
+
+	byID := make(map[string]*models.Post, len(posts))
+	for _, post := range posts {
+		if author, ok := authors[post.AuthorID]; ok {
+			post.AuthorName = author.Name
+			post.AuthorAvatar = author.Avatar
+		}
+		post.ReportsCount = counts[post.ID]
+		byID[post.ID] = post
+	}
+
+	for _, report := range reports {
+		report.Post = byID[report.PostID]
+	}
+}
\ No newline at end of file
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"post-api/internal/models"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SharePost shares a post the user can see as a new post of their own, with an optional comment.
+// Sharing a share shares the post it refers to, so shares never nest.
+func (s *postService) SharePost(ctx context.Context, originalPostID, userID, comment string) (*models.Post, error) {
+	// Validate input
+	if originalPostID == "" {
+		return nil, status.Error(codes.InvalidArgument, "post ID is required")
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	comment, err := validateContent(comment, s.contentLimits.MaxPostLength, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the post being shared from database
+	original, err := s.postRepo.FindByID(ctx, originalPostID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get post", err)
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if original.OriginalPostID != nil {
+		original, err = s.postRepo.FindByID(ctx, *original.OriginalPostID)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("Failed to get shared post", err)
+			return nil, status.Error(codes.NotFound, "the shared post is no longer available")
+		}
+	}
+
+	// Only posts the user can see can be shared
+	var friendIDs []string
+	if s.isFriendOfAuthor(ctx, original, userID) {
+		friendIDs = []string{original.AuthorID}
+	}
+	groupAccess := s.lookupGroupAccess(ctx, []*models.Post{original}, userID)
+	if !s.isPostVisibleToUser(original, userID, friendIDs, groupAccess) {
+		return nil, status.Error(codes.PermissionDenied, "you don't have permission to view this post")
+	}
+
+	// A share is never more visible than the post it shares. Group posts are shared with friends
+	// only; the original stays hidden from anyone outside the group.
+	visibility := original.Visibility
+	if original.GroupID != "" {
+		visibility = "friends"
+	}
+
+	// Get author info from the users service
+	var authorName, authorAvatar string
+	if author, ok := s.lookupUsers(ctx, []string{userID})[userID]; ok {
+		authorName = author.Name
+		authorAvatar = author.Avatar
+	}
+
+	// Create share
+	post := &models.Post{
+		AuthorID:       userID,
+		AuthorName:     authorName,
+		AuthorAvatar:   authorAvatar,
+		Content:        comment,
+		Visibility:     visibility,
+		OriginalPostID: &original.ID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	// Save share to database
+	if err := s.postRepo.CreateShare(ctx, post); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to share post", err, "post_id", original.ID)
+		return nil, status.Error(codes.Internal, "failed to share post")
+	}
+
+	// Index hashtags and mentions in the comment
+	s.syncHashtags(ctx, post)
+	s.syncPostMentions(ctx, post)
+
+	s.attachOriginalPosts(ctx, []*models.Post{post}, userID, friendIDs)
+
+	return post, nil
+}
+
+// attachOriginalPosts embeds the post each share refers to, with its current author info.
+// Originals that are deleted or hidden from the user are left out, so the share still shows
+// but without the content the user may not see.
+func (s *postService) attachOriginalPosts(ctx context.Context, posts []*models.Post, userID string, friendIDs []string) {
+	var originalIDs []string
+	for _, post := range posts {
+		if post.OriginalPostID != nil {
+			originalIDs = append(originalIDs, *post.OriginalPostID)
+		}
+	}
+	if len(originalIDs) == 0 {
+		return
+	}
+
+	originals, err := s.postRepo.FindByIDs(ctx, originalIDs)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get shared posts", "error", err)
+		return
+	}
+
+	// Resolve current author names and avatars
+	authorIDs := make([]string, len(originals))
+	for i, original := range originals {
+		authorIDs[i] = original.AuthorID
+	}
+	authors := s.lookupUsers(ctx, authorIDs)
+
+	groupAccess := s.lookupGroupAccess(ctx, originals, userID)
+	visible := make(map[string]*models.Post, len(originals))
+	for _, original := range originals {
+		if !s.isPostVisibleToUser(original, userID, friendIDs, groupAccess) {
+			continue
+		}
+		if author, ok := authors[original.AuthorID]; ok {
+			original.AuthorName = author.Name
+			original.AuthorAvatar = author.Avatar
+		}
+		visible[original.ID] = original
+	}
+
+	for _, post := range posts {
+		if post.OriginalPostID != nil {
+			post.OriginalPost = visible[*post.OriginalPostID]
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// withForgedFriendIDs adds friend IDs to the incoming metadata, as a direct caller could
+func withForgedFriendIDs(ctx context.Context, friendIDs ...string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(append([]string{"friend_ids"}, friendIDs...)...))
+}
+
+func TestForgedFriendIDsAreIgnored(t *testing.T) {
+	s := newTestService(t)
+	post := s.createPost(t, "alice", "friends", "")
+	s.friends.befriend("alice", "carol")
+
+	// Bob is not a friend of Alice's, whatever his metadata says
+	ctx := withForgedFriendIDs(asUser("bob"), "alice")
+
+	posts, _, _, _, err := s.GetPosts(ctx, "bob", "alice", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if containsID(posts, post.ID) {
+		t.Error("GetPosts returned a friends-only post to a non-friend with forged friend IDs")
+	}
+
+	posts, _, _, _, err = s.GetPosts(ctx, "bob", "", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts feed: %v", err)
+	}
+	if containsID(posts, post.ID) {
+		t.Error("the feed has a friends-only post of a non-friend with forged friend IDs")
+	}
+
+	if _, _, err := s.GetPost(ctx, post.ID, "bob"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetPost: got %v, want PermissionDenied", err)
+	}
+	if err := s.BookmarkPost(ctx, post.ID, "bob"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("BookmarkPost: got %v, want PermissionDenied", err)
+	}
+	if _, err := s.SharePost(ctx, post.ID, "bob", ""); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SharePost: got %v, want PermissionDenied", err)
+	}
+
+	// Carol is a friend according to the friends service, and needs no metadata for it
+	posts, _, _, _, err = s.GetPosts(asUser("carol"), "carol", "alice", "", "", "", "", 1, 10)
+	if err != nil {
+		t.Fatalf("GetPosts as friend: %v", err)
+	}
+	if !containsID(posts, post.ID) {
+		t.Error("GetPosts left out a friends-only post for a friend of the author")
+	}
+}
+
+func TestGetPostChecksFriendshipAndBlocks(t *testing.T) {
+	s := newTestService(t)
+	public := s.createPost(t, "alice", "public", "")
+	friendsOnly := s.createPost(t, "alice", "friends", "")
+	private := s.createPost(t, "alice", "private", "")
+	s.friends.befriend("alice", "carol")
+	s.friends.befriend("alice", "dave")
+	s.friends.block("alice", "dave")
+
+	tests := []struct {
+		name   string
+		postID string
+		userID string
+		want   codes.Code
+	}{
+		{"stranger reads a public post", public.ID, "bob", codes.OK},
+		{"anonymous user reads a public post", public.ID, "", codes.OK},
+		{"friend reads a friends-only post", friendsOnly.ID, "carol", codes.OK},
+		{"stranger reads a friends-only post", friendsOnly.ID, "bob", codes.PermissionDenied},
+		{"anonymous user reads a friends-only post", friendsOnly.ID, "", codes.PermissionDenied},
+		{"friend reads a private post", private.ID, "carol", codes.PermissionDenied},
+		{"author reads a private post", private.ID, "alice", codes.OK},
+		{"blocked user reads a public post", public.ID, "dave", codes.NotFound},
+		{"blocked friend reads a friends-only post", friendsOnly.ID, "dave", codes.NotFound},
+		{"missing post", "missing", "carol", codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _, err := s.GetPost(asUser(tt.userID), tt.postID, tt.userID)
+			if status.Code(err) != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if err == nil && post.ID != tt.postID {
+				t.Errorf("got post %q, want %q", post.ID, tt.postID)
+			}
+		})
+	}
+}
+
+func TestGetPostHidesFriendsOnlyPostsWhenFriendshipCannotBeChecked(t *testing.T) {
+	s := newTestService(t)
+	post := s.createPost(t, "alice", "friends", "")
+	s.friends.befriend("alice", "carol")
+	s.friends.err = status.Error(codes.Unavailable, "friends service unavailable")
+
+	if _, _, err := s.GetPost(asUser("carol"), post.ID, "carol"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("got %v, want PermissionDenied", err)
+	}
+}
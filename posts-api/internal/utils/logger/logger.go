@@ -1,69 +1,108 @@
 package logger
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+const (
+	// RequestIDKey is the context key the request ID of the current call is stored under
+	RequestIDKey = "requestID"
+
+	// ContextKey is the context key the request-scoped logger is stored under
+	ContextKey = "logger"
+)
+
+// defaultSamplingTick is the sampling window used when none is configured
+const defaultSamplingTick = time.Second
+
+// RotationConfig controls the rotation of the log file. Zero values keep the lumberjack
+// defaults: rotate at 100 megabytes and keep every rotated file.
+type RotationConfig struct {
+	MaxSize    int // Megabytes
+	MaxAge     int // Days
+	MaxBackups int
+	Compress   bool
+}
+
+// SamplingConfig throttles repeated entries. Of the entries with the same level and message
+// written within a tick, the first Initial are logged and then every Thereafter-th one.
+// Sampling is disabled when Initial is zero.
+type SamplingConfig struct {
+	Tick       time.Duration
+	Initial    int
+	Thereafter int
+}
+
 // Logger is a wrapper around zap.Logger
 type Logger struct {
 	Logger *zap.Logger
 }
 
 // NewLogger creates a new logger
-func NewLogger(level, format, output, file string) (*Logger, error) {
-	// Configure logger
-	var config zap.Config
+func NewLogger(level, format, output, file string, rotation RotationConfig, sampling SamplingConfig) (*Logger, error) {
+	// Configure encoder, JSON for production and console for development
+	var encoder zapcore.Encoder
+	options := []zap.Option{zap.AddCaller()}
 	if format == "json" {
-		config = zap.NewProductionConfig()
+		encoder = zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+		options = append(options, zap.AddStacktrace(zapcore.ErrorLevel))
 	} else {
-		config = zap.NewDevelopmentConfig()
+		encoder = zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig())
+		options = append(options, zap.Development(), zap.AddStacktrace(zapcore.WarnLevel))
 	}
 
 	// Set log level
+	var logLevel zapcore.Level
 	switch level {
 	case "debug":
-		config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+		logLevel = zapcore.DebugLevel
 	case "info":
-		config.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+		logLevel = zapcore.InfoLevel
 	case "warn":
-		config.Level = zap.NewAtomicLevelAt(zapcore.WarnLevel)
+		logLevel = zapcore.WarnLevel
 	case "error":
-		config.Level = zap.NewAtomicLevelAt(zapcore.ErrorLevel)
+		logLevel = zapcore.ErrorLevel
 	case "fatal":
-		config.Level = zap.NewAtomicLevelAt(zapcore.FatalLevel)
+		logLevel = zapcore.FatalLevel
 	case "panic":
-		config.Level = zap.NewAtomicLevelAt(zapcore.PanicLevel)
+		logLevel = zapcore.PanicLevel
 	default:
-		config.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+		logLevel = zapcore.InfoLevel
 	}
 
-	// Set output
+	// Set output, rotating the file as it grows
+	var writeSyncer zapcore.WriteSyncer
 	if output == "file" && file != "" {
-		config.OutputPaths = []string{file}
-		config.ErrorOutputPaths = []string{file}
-
-		// Create directory if it doesn't exist
-		dir := file[:len(file)-len("/post-api.log")]
-		if _, err := os.Stat(dir); os.IsNotExist(err) {
-			if err := os.MkdirAll(dir, 0755); err != nil {
-				return nil, err
-			}
-		}
+		writeSyncer = zapcore.AddSync(&lumberjack.Logger{
+			Filename:   file,
+			MaxSize:    rotation.MaxSize,
+			MaxAge:     rotation.MaxAge,
+			MaxBackups: rotation.MaxBackups,
+			Compress:   rotation.Compress,
+		})
+		options = append(options, zap.ErrorOutput(writeSyncer))
 	} else {
-		config.OutputPaths = []string{"stdout"}
-		config.ErrorOutputPaths = []string{"stderr"}
+		writeSyncer = zapcore.Lock(os.Stdout)
+		options = append(options, zap.ErrorOutput(zapcore.Lock(os.Stderr)))
 	}
 
 	// Build logger
-	zapLogger, err := config.Build()
-	if err != nil {
-		return nil, err
+	core := zapcore.NewCore(encoder, writeSyncer, logLevel)
+	if sampling.Initial > 0 {
+		tick := sampling.Tick
+		if tick <= 0 {
+			tick = defaultSamplingTick
+		}
+		core = zapcore.NewSamplerWithOptions(core, tick, sampling.Initial, sampling.Thereafter)
 	}
 
-	return &Logger{Logger: zapLogger}, nil
+	return &Logger{Logger: zap.New(core, options...)}, nil
 }
 
 // Debug logs a debug message
@@ -103,4 +142,29 @@ func (l *Logger) Panic(msg string, err error, fields ...interface{}) {
 		fields = append(fields, "error", err.Error())
 	}
 	l.Logger.Sugar().Panicw(msg, fields...)
+}
+
+// With returns a logger that adds the given key-value pairs to every entry
+func (l *Logger) With(fields ...interface{}) *Logger {
+	return &Logger{Logger: l.Logger.Sugar().With(fields...).Desugar()}
+}
+
+// NewContext returns a copy of ctx that carries l as the request-scoped logger
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, ContextKey, l)
+}
+
+// WithContext returns the request-scoped logger carried by ctx, which the server interceptors
+// tag with the request ID, RPC method and user ID. Outside of a request it falls back to l,
+// tagged with the request ID if ctx has one.
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	if scoped, ok := ctx.Value(ContextKey).(*Logger); ok {
+		return scoped
+	}
+
+	requestID, ok := ctx.Value(RequestIDKey).(string)
+	if !ok || requestID == "" {
+		return l
+	}
+	return l.With("request_id", requestID)
 }
\ No newline at end of file
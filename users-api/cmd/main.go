@@ -1,12 +1,16 @@
 package main
 
 import (
+	npb "common/pb/common/proto/notifications"
 	pb "common/pb/common/proto/users"
+	"context"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+	"users-api/internal/clients"
 	"users-api/internal/config"
 	"users-api/internal/controllers"
 	"users-api/internal/middleware"
@@ -15,6 +19,8 @@ import (
 	"users-api/internal/utils/logger"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -28,7 +34,8 @@ func main() {
 	}
 
 	// Initialize logger
-	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output, cfg.Logging.File)
+	log, err := logger.NewLogger(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output, cfg.Logging.File,
+		logger.RotationConfig(cfg.Logging.Rotation), logger.SamplingConfig(cfg.Logging.Sampling))
 	if err != nil {
 		fmt.Printf("Failed to initialize logger: %v\n", err)
 		os.Exit(1)
@@ -37,8 +44,8 @@ func main() {
 
 	log.Info("Starting Users API")
 
-	// Connect to database
-	db, err := gorm.Open(mysql.Open(cfg.Database.GetDSN()), &gorm.Config{})
+	// Connect to database, waiting for it to come up
+	db, err := connectDatabase(cfg.Database, log)
 	if err != nil {
 		log.Fatal("Failed to connect to database", err)
 	}
@@ -52,47 +59,125 @@ func main() {
 	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
 	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
 
+	// Create missing tables and columns
+	if cfg.Database.AutoMigrate {
+		tables, err := repository.AutoMigrate(db)
+		if err != nil {
+			log.Fatal("Failed to migrate database", err)
+		}
+		log.Info("Migrated database", logger.Field("tables", tables))
+	}
+
+	// Limit how long a statement may run, after the migrations so that they are not limited
+	if cfg.Database.QueryTimeout > 0 {
+		if err := db.Use(repository.NewQueryTimeout(cfg.Database.QueryTimeout)); err != nil {
+			log.Fatal("Failed to register query timeout", err)
+		}
+		log.Info("Query timeout enabled", logger.Field("timeout", cfg.Database.QueryTimeout.String()))
+	}
+
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
+	tokenRepo := repository.NewTokenRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+
+	// Initialize clients for other services
+	friendClient, err := clients.NewFriendClient(cfg.Services.FriendsServiceURL)
+	if err != nil {
+		log.Fatal("Failed to connect to friends service", err)
+	}
+
+	// Sign in with Apple settings shared by the user and auth services
+	appleConfig := services.AppleConfig{
+		ClientID:       cfg.OAuth.Apple.ClientID,
+		BundleID:       cfg.OAuth.Apple.BundleID,
+		TeamID:         cfg.OAuth.Apple.TeamID,
+		KeyID:          cfg.OAuth.Apple.KeyID,
+		PrivateKeyFile: cfg.OAuth.Apple.PrivateKeyFile,
+		RedirectURL:    cfg.OAuth.Apple.RedirectURL,
+	}
 
 	// Initialize services
 	userService := services.NewUserService(
 		userRepo,
+		friendClient,
 		log,
 		cfg.JWT.Secret,
 		cfg.JWT.Expiration,
 		cfg.OAuth.Google.ClientID,
 		cfg.OAuth.Google.ClientSecret,
+		cfg.OAuth.Google.Scopes,
 		cfg.OAuth.Microsoft.ClientID,
 		cfg.OAuth.Microsoft.ClientSecret,
+		cfg.OAuth.Microsoft.Scopes,
+		appleConfig,
+		cfg.OAuth.RequireVerifiedEmail,
+		cfg.Profile.EmailVisibility,
 	)
 
 	// Initialize auth service
 	authService := services.NewAuthService(
 		userRepo,
+		tokenRepo,
 		log,
 		cfg.JWT.Secret,
 		cfg.JWT.Expiration,
 		cfg.OAuth.Google.ClientID,
 		cfg.OAuth.Google.ClientSecret,
+		cfg.OAuth.Google.Scopes,
 		cfg.OAuth.Microsoft.ClientID,
 		cfg.OAuth.Microsoft.ClientSecret,
+		cfg.OAuth.Microsoft.Scopes,
+		cfg.OAuth.GitHub.ClientID,
+		cfg.OAuth.GitHub.ClientSecret,
+		cfg.OAuth.GitHub.Scopes,
+		appleConfig,
+		cfg.OAuth.RequireVerifiedEmail,
 	)
 
+	// Initialize notification service
+	notificationService := services.NewNotificationService(notificationRepo, userRepo, log)
+
+	// Initialize presence service, which writes last seen times in the background
+	presenceService := services.NewPresenceService(userRepo, log, cfg.Presence.OnlineWindow, cfg.Presence.FlushInterval)
+	presenceCtx, stopPresence := context.WithCancel(context.Background())
+	presenceDone := make(chan struct{})
+	go func() {
+		presenceService.Run(presenceCtx)
+		close(presenceDone)
+	}()
+
+	// Make the configured user an admin so that there is someone to grant the role to others
+	if cfg.Admin.BootstrapEmail != "" {
+		if err := userService.BootstrapAdmin(context.Background(), cfg.Admin.BootstrapEmail); err != nil {
+			log.Error("Failed to bootstrap admin; sign in with that email and restart", err)
+		}
+	}
+
 	// Initialize controllers
-	userController := controllers.NewUserController(userService, log)
+	userController := controllers.NewUserController(userService, presenceService, log)
 	authController := controllers.NewAuthController(authService, userController, log)
+	notificationController := controllers.NewNotificationController(notificationService, log)
 
-	// Initialize auth interceptor
-	authInterceptor := middleware.NewAuthInterceptor(cfg.JWT.Secret, log)
+	// Initialize interceptors
+	requestIDInterceptor := middleware.NewRequestIDInterceptor(log)
+	authInterceptor := middleware.NewAuthInterceptor(cfg.JWT.Secret, tokenRepo, userRepo, log)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainUnaryInterceptor(requestIDInterceptor.Unary(), authInterceptor.Unary()),
 	)
 
 	// Register services
 	pb.RegisterUserServiceServer(grpcServer, authController)
+	npb.RegisterNotificationServiceServer(grpcServer, notificationController)
+
+	// Register health service; the database is connected at this point
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("users.UserService", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("notifications.NotificationService", healthpb.HealthCheckResponse_SERVING)
 
 	// Start gRPC server
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port))
@@ -113,6 +198,59 @@ func main() {
 	<-quit
 
 	log.Info("Shutting down server...")
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
+
+	// Write the activity recorded since the last flush
+	stopPresence()
+	<-presenceDone
 	log.Info("Server exited properly")
 }
+
+const (
+	// defaultDBConnectAttempts is how many times the database connection is tried at startup
+	defaultDBConnectAttempts = 10
+
+	// defaultDBConnectMaxDelay caps the wait between two connection attempts
+	defaultDBConnectMaxDelay = 30 * time.Second
+
+	// dbConnectInitialDelay is the wait after the first failed attempt, doubled after each failure
+	dbConnectInitialDelay = time.Second
+)
+
+// connectDatabase connects to the database, retrying with exponential backoff since the
+// database may still be starting alongside the service
+func connectDatabase(cfg config.DatabaseConfig, log *logger.Logger) (*gorm.DB, error) {
+	attempts := cfg.ConnectAttempts
+	if attempts <= 0 {
+		attempts = defaultDBConnectAttempts
+	}
+	maxDelay := cfg.ConnectMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultDBConnectMaxDelay
+	}
+
+	delay := dbConnectInitialDelay
+	for attempt := 1; ; attempt++ {
+		// gorm.Open pings the database, so this fails until it accepts connections
+		db, err := gorm.Open(mysql.Open(cfg.GetDSN()), &gorm.Config{})
+		if err == nil {
+			log.Info("Connected to database", logger.Field("attempt", attempt))
+			return db, nil
+		}
+		if attempt >= attempts {
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempts, err)
+		}
+
+		log.Warn("Failed to connect to database",
+			logger.Field("attempt", attempt),
+			logger.Field("retry_in", delay.String()),
+			logger.Field("error", err.Error()))
+		time.Sleep(delay)
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
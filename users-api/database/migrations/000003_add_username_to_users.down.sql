@@ -0,0 +1,3 @@
+DROP INDEX idx_users_username ON users;
+
+ALTER TABLE users DROP COLUMN username;
\ No newline at end of file
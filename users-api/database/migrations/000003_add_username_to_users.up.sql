@@ -0,0 +1,21 @@
+ALTER TABLE users ADD COLUMN username VARCHAR(64) NULL AFTER name;
+
+-- Existing users take the local part of their email as username when nobody else shares it
+UPDATE users u
+JOIN (
+    SELECT REGEXP_REPLACE(LOWER(SUBSTRING_INDEX(email, '@', 1)), '[^a-z0-9_.]', '') AS handle
+    FROM users
+    GROUP BY handle
+    HAVING COUNT(*) = 1
+) h ON REGEXP_REPLACE(LOWER(SUBSTRING_INDEX(u.email, '@', 1)), '[^a-z0-9_.]', '') = h.handle
+SET u.username = h.handle
+WHERE h.handle <> '';
+
+-- Everyone else gets the handle suffixed with the end of their ID
+UPDATE users
+SET username = CONCAT(REGEXP_REPLACE(LOWER(SUBSTRING_INDEX(email, '@', 1)), '[^a-z0-9_.]', ''), '_', LOWER(RIGHT(id, 8)))
+WHERE username IS NULL;
+
+ALTER TABLE users MODIFY username VARCHAR(64) NOT NULL;
+
+CREATE UNIQUE INDEX idx_users_username ON users(username);
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- Inline photos do not fit the old column and are dropped
+UPDATE users SET avatar = NULL WHERE CHAR_LENGTH(avatar) > 255;
+
+ALTER TABLE users MODIFY avatar VARCHAR(255);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE users MODIFY avatar TEXT;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(36) NOT NULL,
+    target_id VARCHAR(36) NOT NULL DEFAULT '',
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_notifications_user_created_at ON notifications(user_id, created_at);
+CREATE INDEX idx_notifications_user_is_read ON notifications(user_id, is_read);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE users DROP COLUMN bio, DROP COLUMN location, DROP COLUMN website;
\ No newline at end of file
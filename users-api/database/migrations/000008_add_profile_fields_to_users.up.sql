@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN bio VARCHAR(300) NOT NULL DEFAULT '' AFTER avatar, ADD COLUMN location VARCHAR(100) NOT NULL DEFAULT '' AFTER bio, ADD COLUMN website VARCHAR(255) NOT NULL DEFAULT '' AFTER location;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE users DROP COLUMN last_seen_at;
\ No newline at end of file
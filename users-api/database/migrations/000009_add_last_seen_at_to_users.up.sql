@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN last_seen_at TIMESTAMP NULL AFTER role;
\ No newline at end of file
@@ -0,0 +1,88 @@
+package clients
+
+import (
+	pb "common/pb/common/proto/friends"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// FriendClient defines the interface for looking up relationships in the friends service
+type FriendClient interface {
+	// GetFriendshipStatus returns the relationship between two users (none, pending, friends, blocked)
+	GetFriendshipStatus(ctx context.Context, userID, otherUserID string) (string, error)
+
+	// GetFriendshipStatuses returns the relationship between a user and each of the other users, keyed by their ID
+	GetFriendshipStatuses(ctx context.Context, userID string, otherUserIDs []string) (map[string]string, error)
+
+	// GetFollowCounts returns how many users follow a user and how many they follow, and whether the viewer follows them
+	GetFollowCounts(ctx context.Context, userID, viewerID string) (*FollowCounts, error)
+}
+
+// FollowCounts is a user's follower and following counts as a viewer sees them
+type FollowCounts struct {
+	Followers   int32
+	Following   int32
+	IsFollowing bool
+}
+
+// friendClient implements the FriendClient interface over gRPC
+type friendClient struct {
+	client pb.FriendServiceClient
+}
+
+// NewFriendClient creates a new client for the friends service
+func NewFriendClient(friendsServiceURL string) (FriendClient, error) {
+	conn, err := grpc.Dial(friendsServiceURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(propagateRequestID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &friendClient{
+		client: pb.NewFriendServiceClient(conn),
+	}, nil
+}
+
+// GetFriendshipStatus returns the relationship between two users (none, pending, friends, blocked)
+func (c *friendClient) GetFriendshipStatus(ctx context.Context, userID, otherUserID string) (string, error) {
+	resp, err := c.client.CheckFriendship(ctx, &pb.CheckFriendshipRequest{
+		UserId:   userID,
+		FriendId: otherUserID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Status, nil
+}
+
+// GetFriendshipStatuses returns the relationship between a user and each of the other users, keyed by their ID
+func (c *friendClient) GetFriendshipStatuses(ctx context.Context, userID string, otherUserIDs []string) (map[string]string, error) {
+	resp, err := c.client.CheckFriendships(ctx, &pb.CheckFriendshipsRequest{
+		UserId:       userID,
+		OtherUserIds: otherUserIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Statuses, nil
+}
+
+// GetFollowCounts returns how many users follow a user and how many they follow, and whether the viewer follows them
+func (c *friendClient) GetFollowCounts(ctx context.Context, userID, viewerID string) (*FollowCounts, error) {
+	resp, err := c.client.GetFollowCounts(ctx, &pb.GetFollowCountsRequest{
+		UserId:   userID,
+		ViewerId: viewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &FollowCounts{
+		Followers:   resp.FollowersCount,
+		Following:   resp.FollowingCount,
+		IsFollowing: resp.IsFollowing,
+	}, nil
+}
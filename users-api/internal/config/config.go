@@ -13,6 +13,10 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	OAuth    OAuthConfig
+	Services ServicesConfig
+	Admin    AdminConfig
+	Profile  ProfileConfig
+	Presence PresenceConfig
 	Logging  LoggingConfig
 }
 
@@ -36,6 +40,20 @@ type DatabaseConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+
+	// ConnectAttempts is how many times the database connection is tried at startup
+	ConnectAttempts int
+
+	// ConnectMaxDelay caps the exponential backoff between connection attempts
+	ConnectMaxDelay time.Duration
+
+	// AutoMigrate creates missing tables and columns on startup; disable it where the
+	// SQL migrations are run instead
+	AutoMigrate bool
+
+	// QueryTimeout cancels a statement that runs longer, so that a slow query cannot hold a
+	// connection for as long as the request lives; statements are not limited when zero
+	QueryTimeout time.Duration
 }
 
 // JWTConfig holds JWT-related configuration
@@ -48,6 +66,11 @@ type JWTConfig struct {
 type OAuthConfig struct {
 	Google    OAuthProviderConfig
 	Microsoft OAuthProviderConfig
+	GitHub    OAuthProviderConfig
+	Apple     AppleOAuthConfig
+
+	// RequireVerifiedEmail blocks sign-in when the provider has not verified the user's email
+	RequireVerifiedEmail bool
 }
 
 // OAuthProviderConfig holds configuration for an OAuth provider
@@ -55,14 +78,86 @@ type OAuthProviderConfig struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
+	Scopes       []string // Scopes to request; the provider's defaults are used when empty
+}
+
+// AppleOAuthConfig holds configuration for Sign in with Apple
+type AppleOAuthConfig struct {
+	ClientID       string // Services ID used by the web flow
+	BundleID       string // iOS app bundle ID, the audience of tokens from native sign-in
+	TeamID         string
+	KeyID          string
+	PrivateKeyFile string // Path to the .p8 key used to sign client secrets
+	RedirectURL    string
+}
+
+// AdminConfig holds admin-related configuration
+type AdminConfig struct {
+	// BootstrapEmail is the email of a user who is made an admin on startup, so that there is an
+	// admin to grant the role to others
+	BootstrapEmail string
+}
+
+// ProfileConfig holds configuration for the profiles users see of each other
+type ProfileConfig struct {
+	// EmailVisibility is who sees a user's email on their public profile: everyone, friends
+	// or nobody; friends when empty
+	EmailVisibility string
+}
+
+// PresenceConfig holds configuration for tracking when users were last active
+type PresenceConfig struct {
+	// OnlineWindow is how recently a user must have been active to be shown as online;
+	// five minutes when zero
+	OnlineWindow time.Duration
+
+	// FlushInterval is how often recorded activity is written to the database, in one
+	// statement for all users; fifteen seconds when zero
+	FlushInterval time.Duration
+}
+
+// ServicesConfig holds URLs for other microservices
+type ServicesConfig struct {
+	FriendsServiceURL string
 }
 
 // LoggingConfig holds logging-related configuration
 type LoggingConfig struct {
-	Level  string
-	Format string
-	Output string
-	File   string
+	Level    string
+	Format   string
+	Output   string
+	File     string
+	Rotation LogRotationConfig
+	Sampling LogSamplingConfig
+}
+
+// LogRotationConfig controls the rotation of the log file when output is a file
+type LogRotationConfig struct {
+	// MaxSize is the size in megabytes the file may reach before it is rotated, 100 when zero
+	MaxSize int
+
+	// MaxAge is how many days rotated files are kept; they are never removed for age when zero
+	MaxAge int
+
+	// MaxBackups is how many rotated files are kept; all are kept when zero
+	MaxBackups int
+
+	// Compress gzips rotated files
+	Compress bool
+}
+
+// LogSamplingConfig throttles repeated log entries, so that an error repeated under load
+// does not flood the log
+type LogSamplingConfig struct {
+	// Tick is the window entries are counted in, one second when zero
+	Tick time.Duration
+
+	// Initial is how many entries with the same level and message are logged per tick before
+	// sampling starts; sampling is disabled when zero
+	Initial int
+
+	// Thereafter logs every Thereafter-th of the remaining entries; they are all dropped when zero
+	Thereafter int
 }
 
 // LoadConfig loads the configuration from the config file
@@ -2,11 +2,13 @@ package controllers
 
 import (
 	"context"
+	"strings"
 	"users-api/internal/services"
 	"users-api/internal/utils/logger"
 
 	pb "common/pb/common/proto/users"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -47,14 +49,76 @@ func (c *AuthController) UpdateProfile(ctx context.Context, req *pb.UpdateProfil
 	return c.userController.UpdateProfile(ctx, req)
 }
 
+// SetUserRole delegates to the user controller
+func (c *AuthController) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.ProfileResponse, error) {
+	return c.userController.SetUserRole(ctx, req)
+}
+
+// GetPublicProfile delegates to the user controller
+func (c *AuthController) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.PublicProfileResponse, error) {
+	return c.userController.GetPublicProfile(ctx, req)
+}
+
+// MarkSeen delegates to the user controller
+func (c *AuthController) MarkSeen(ctx context.Context, req *pb.MarkSeenRequest) (*pb.MarkSeenResponse, error) {
+	return c.userController.MarkSeen(ctx, req)
+}
+
+// GetUsersByIDs delegates to the user controller
+func (c *AuthController) GetUsersByIDs(ctx context.Context, req *pb.GetUsersByIDsRequest) (*pb.GetUsersByIDsResponse, error) {
+	return c.userController.GetUsersByIDs(ctx, req)
+}
+
+// GetUsersByUsernames delegates to the user controller
+func (c *AuthController) GetUsersByUsernames(ctx context.Context, req *pb.GetUsersByUsernamesRequest) (*pb.GetUsersByIDsResponse, error) {
+	return c.userController.GetUsersByUsernames(ctx, req)
+}
+
+// SearchUsers delegates to the user controller
+func (c *AuthController) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	return c.userController.SearchUsers(ctx, req)
+}
+
+// DeleteAccount delegates to the user controller, then signs out the token the account was
+// deleted with
+func (c *AuthController) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	resp, err := c.userController.DeleteAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The account is gone either way, and IsTokenRevoked refuses tokens of missing users, so a
+	// failure here is only logged
+	if token := bearerToken(ctx); token != "" {
+		if _, err := c.authService.Signout(ctx, token); err != nil {
+			c.logger.WithContext(ctx).Error("Failed to revoke the token of a deleted account", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// bearerToken returns the token of the call's authorization metadata, or an empty string
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return ""
+	}
+	return strings.TrimPrefix(values[0], "Bearer ")
+}
+
 // GoogleLogin generates a Google OAuth URL with state token
 func (c *AuthController) GoogleLogin(ctx context.Context, req *pb.GoogleLoginRequest) (*pb.OAuthURLResponse, error) {
-	c.logger.Info("GoogleLogin request received")
+	c.logger.WithContext(ctx).Info("GoogleLogin request received")
 
 	// Call service to generate Google OAuth URL
 	url, state, err := c.authService.GoogleLogin(ctx, req.RedirectUrl)
 	if err != nil {
-		c.logger.Error("Failed to generate Google OAuth URL", err)
+		c.logger.WithContext(ctx).Error("Failed to generate Google OAuth URL", err)
 		return nil, status.Errorf(codes.Internal, "failed to generate Google OAuth URL: %v", err)
 	}
 
@@ -66,12 +130,12 @@ func (c *AuthController) GoogleLogin(ctx context.Context, req *pb.GoogleLoginReq
 
 // MicrosoftLogin generates a Microsoft OAuth URL with state token
 func (c *AuthController) MicrosoftLogin(ctx context.Context, req *pb.MicrosoftLoginRequest) (*pb.OAuthURLResponse, error) {
-	c.logger.Info("MicrosoftLogin request received")
+	c.logger.WithContext(ctx).Info("MicrosoftLogin request received")
 
 	// Call service to generate Microsoft OAuth URL
 	url, state, err := c.authService.MicrosoftLogin(ctx, req.RedirectUrl)
 	if err != nil {
-		c.logger.Error("Failed to generate Microsoft OAuth URL", err)
+		c.logger.WithContext(ctx).Error("Failed to generate Microsoft OAuth URL", err)
 		return nil, status.Errorf(codes.Internal, "failed to generate Microsoft OAuth URL: %v", err)
 	}
 
@@ -83,7 +147,7 @@ func (c *AuthController) MicrosoftLogin(ctx context.Context, req *pb.MicrosoftLo
 
 // GoogleCallback handles the callback from Google OAuth
 func (c *AuthController) GoogleCallback(ctx context.Context, req *pb.OAuthCallbackRequest) (*pb.LoginResponse, error) {
-	c.logger.Info("GoogleCallback request received")
+	c.logger.WithContext(ctx).Info("GoogleCallback request received")
 
 	// Validate request
 	if req.State == "" || req.Code == "" {
@@ -93,7 +157,7 @@ func (c *AuthController) GoogleCallback(ctx context.Context, req *pb.OAuthCallba
 	// Call service to handle Google callback
 	userID, accessToken, err := c.authService.GoogleCallback(ctx, req.State, req.Code)
 	if err != nil {
-		c.logger.Error("Failed to handle Google callback", err)
+		c.logger.WithContext(ctx).Error("Failed to handle Google callback", err)
 		return nil, status.Errorf(codes.Internal, "failed to handle Google callback: %v", err)
 	}
 
@@ -105,7 +169,7 @@ func (c *AuthController) GoogleCallback(ctx context.Context, req *pb.OAuthCallba
 
 // MicrosoftCallback handles the callback from Microsoft OAuth
 func (c *AuthController) MicrosoftCallback(ctx context.Context, req *pb.OAuthCallbackRequest) (*pb.LoginResponse, error) {
-	c.logger.Info("MicrosoftCallback request received")
+	c.logger.WithContext(ctx).Info("MicrosoftCallback request received")
 
 	// Validate request
 	if req.State == "" || req.Code == "" {
@@ -115,7 +179,7 @@ func (c *AuthController) MicrosoftCallback(ctx context.Context, req *pb.OAuthCal
 	// Call service to handle Microsoft callback
 	userID, accessToken, err := c.authService.MicrosoftCallback(ctx, req.State, req.Code)
 	if err != nil {
-		c.logger.Error("Failed to handle Microsoft callback", err)
+		c.logger.WithContext(ctx).Error("Failed to handle Microsoft callback", err)
 		return nil, status.Errorf(codes.Internal, "failed to handle Microsoft callback: %v", err)
 	}
 
@@ -125,9 +189,87 @@ func (c *AuthController) MicrosoftCallback(ctx context.Context, req *pb.OAuthCal
 	}, nil
 }
 
+// GitHubLogin generates a GitHub OAuth URL with state token
+func (c *AuthController) GitHubLogin(ctx context.Context, req *pb.GitHubLoginRequest) (*pb.OAuthURLResponse, error) {
+	c.logger.WithContext(ctx).Info("GitHubLogin request received")
+
+	// Call service to generate GitHub OAuth URL
+	url, state, err := c.authService.GitHubLogin(ctx, req.RedirectUrl)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to generate GitHub OAuth URL", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate GitHub OAuth URL: %v", err)
+	}
+
+	return &pb.OAuthURLResponse{
+		Url:   url,
+		State: state,
+	}, nil
+}
+
+// GitHubCallback handles the callback from GitHub OAuth
+func (c *AuthController) GitHubCallback(ctx context.Context, req *pb.OAuthCallbackRequest) (*pb.LoginResponse, error) {
+	c.logger.WithContext(ctx).Info("GitHubCallback request received")
+
+	// Validate request
+	if req.State == "" || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "state and code are required")
+	}
+
+	// Call service to handle GitHub callback
+	userID, accessToken, err := c.authService.GitHubCallback(ctx, req.State, req.Code)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to handle GitHub callback", err)
+		return nil, status.Errorf(codes.Internal, "failed to handle GitHub callback: %v", err)
+	}
+
+	return &pb.LoginResponse{
+		UserId:      userID,
+		AccessToken: accessToken,
+	}, nil
+}
+
+// AppleLogin generates a Sign in with Apple URL with state token
+func (c *AuthController) AppleLogin(ctx context.Context, req *pb.AppleLoginRequest) (*pb.OAuthURLResponse, error) {
+	c.logger.WithContext(ctx).Info("AppleLogin request received")
+
+	// Call service to generate Apple login URL
+	url, state, err := c.authService.AppleLogin(ctx, req.RedirectUrl)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to generate Apple login URL", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate Apple login URL: %v", err)
+	}
+
+	return &pb.OAuthURLResponse{
+		Url:   url,
+		State: state,
+	}, nil
+}
+
+// AppleCallback handles the callback from Sign in with Apple
+func (c *AuthController) AppleCallback(ctx context.Context, req *pb.AppleCallbackRequest) (*pb.LoginResponse, error) {
+	c.logger.WithContext(ctx).Info("AppleCallback request received")
+
+	// Validate request
+	if req.State == "" || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "state and code are required")
+	}
+
+	// Call service to handle Apple callback
+	userID, accessToken, err := c.authService.AppleCallback(ctx, req.State, req.Code, req.Name)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to handle Apple callback", err)
+		return nil, status.Errorf(codes.Internal, "failed to handle Apple callback: %v", err)
+	}
+
+	return &pb.LoginResponse{
+		UserId:      userID,
+		AccessToken: accessToken,
+	}, nil
+}
+
 // ValidateStateToken validates the state token to prevent CSRF attacks
 func (c *AuthController) ValidateStateToken(ctx context.Context, req *pb.ValidateStateTokenRequest) (*pb.ValidateStateTokenResponse, error) {
-	c.logger.Info("ValidateStateToken request received")
+	c.logger.WithContext(ctx).Info("ValidateStateToken request received")
 
 	// Validate request
 	if req.State == "" {
@@ -144,7 +286,7 @@ func (c *AuthController) ValidateStateToken(ctx context.Context, req *pb.Validat
 
 // Signout signs out the user
 func (c *AuthController) Signout(ctx context.Context, req *pb.SignoutRequest) (*pb.SignoutResponse, error) {
-	c.logger.Info("Signout request received")
+	c.logger.WithContext(ctx).Info("Signout request received")
 
 	// Validate request
 	if req.Token == "" {
@@ -154,7 +296,7 @@ func (c *AuthController) Signout(ctx context.Context, req *pb.SignoutRequest) (*
 	// Call service to sign out user
 	success, err := c.authService.Signout(ctx, req.Token)
 	if err != nil {
-		c.logger.Error("Failed to sign out user", err)
+		c.logger.WithContext(ctx).Error("Failed to sign out user", err)
 		return nil, status.Errorf(codes.Internal, "failed to sign out user: %v", err)
 	}
 
@@ -162,3 +304,21 @@ func (c *AuthController) Signout(ctx context.Context, req *pb.SignoutRequest) (*
 		Success: success,
 	}, nil
 }
+
+// IsTokenRevoked checks whether a token has been revoked by signing out
+func (c *AuthController) IsTokenRevoked(ctx context.Context, req *pb.IsTokenRevokedRequest) (*pb.IsTokenRevokedResponse, error) {
+	// Validate request
+	if req.Jti == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token ID is required")
+	}
+
+	revoked, err := c.authService.IsTokenRevoked(ctx, req.Jti, req.UserId)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to check token revocation", err)
+		return nil, status.Errorf(codes.Internal, "failed to check token revocation")
+	}
+
+	return &pb.IsTokenRevokedResponse{
+		Revoked: revoked,
+	}, nil
+}
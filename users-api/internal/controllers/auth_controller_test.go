@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "common/pb/common/proto/users"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"users-api/internal/models"
+	"users-api/internal/repository"
+	"users-api/internal/services"
+	"users-api/internal/testutil"
+	"users-api/internal/utils/logger"
+)
+
+const testSecret = "test-secret"
+
+// signToken returns a token of the user with the given ID
+func signToken(t *testing.T, userID, jti string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": userID,
+		"jti": jti,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestDeleteAccountRevokesTheAccountsTokens(t *testing.T) {
+	db := testutil.NewDB(t)
+	if _, err := repository.AutoMigrate(db); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	log := &logger.Logger{Logger: zap.NewNop()}
+	userRepo := repository.NewUserRepository(db)
+	tokenRepo := repository.NewTokenRepository(db)
+	userService := services.NewUserService(userRepo, nil, log, testSecret, time.Hour, "", "", nil, "", "", nil, services.AppleConfig{}, false, "")
+	authService := services.NewAuthService(userRepo, tokenRepo, log, testSecret, time.Hour, "", "", nil, "", "", nil, "", "", nil, services.AppleConfig{}, false)
+	controller := NewAuthController(authService, NewUserController(userService, nil, log), log)
+
+	ctx := context.Background()
+	alice := &models.User{Name: "Alice", Email: "alice@example.com", Provider: "google"}
+	bob := &models.User{Name: "Bob", Email: "bob@example.com", Provider: "google"}
+	for _, user := range []*models.User{alice, bob} {
+		if err := userRepo.Create(ctx, user); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	isRevoked := func(jti, userID string) bool {
+		t.Helper()
+		resp, err := controller.IsTokenRevoked(ctx, &pb.IsTokenRevokedRequest{Jti: jti, UserId: userID})
+		if err != nil {
+			t.Fatalf("IsTokenRevoked: %v", err)
+		}
+		return resp.Revoked
+	}
+
+	if isRevoked("alice-phone", alice.ID) {
+		t.Fatal("token of an existing user reported as revoked")
+	}
+
+	// Alice deletes her account from her laptop, as the auth interceptor passes the call on
+	callCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+signToken(t, alice.ID, "alice-laptop")))
+	callCtx = context.WithValue(callCtx, "userID", alice.ID)
+	if _, err := controller.DeleteAccount(callCtx, &pb.DeleteAccountRequest{}); err != nil {
+		t.Fatalf("DeleteAccount: %v", err)
+	}
+
+	if revoked, err := tokenRepo.IsRevoked(ctx, "alice-laptop"); err != nil || !revoked {
+		t.Errorf("token the account was deleted with: revoked %v (%v), want it blacklisted", revoked, err)
+	}
+	if !isRevoked("alice-phone", alice.ID) {
+		t.Error("another token of the deleted account is not reported as revoked")
+	}
+	if isRevoked("bob-phone", bob.ID) {
+		t.Error("token of another user reported as revoked")
+	}
+	if isRevoked("bob-phone", "") {
+		t.Error("token without a user reported as revoked")
+	}
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"users-api/internal/services"
+	"users-api/internal/utils/logger"
+
+	npb "common/pb/common/proto/notifications"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NotificationController handles gRPC requests for the notification service
+type NotificationController struct {
+	npb.UnimplementedNotificationServiceServer
+	notificationService services.NotificationService
+	logger              *logger.Logger
+}
+
+// NewNotificationController creates a new notification controller
+func NewNotificationController(notificationService services.NotificationService, logger *logger.Logger) *NotificationController {
+	return &NotificationController{
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+// CreateNotification records that the authenticated user did something that concerns another user
+func (c *NotificationController) CreateNotification(ctx context.Context, req *npb.CreateNotificationRequest) (*npb.CreateNotificationResponse, error) {
+	// The actor is always the authenticated user, so services can only notify on behalf of their caller
+	actorID, ok := ctx.Value("userID").(string)
+	if !ok || actorID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	c.logger.WithContext(ctx).Debug("CreateNotification request received",
+		logger.Field("recipient_id", req.UserId),
+		logger.Field("type", req.Type))
+
+	notification, err := c.notificationService.CreateNotification(ctx, req.UserId, actorID, req.Type, req.TargetId)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+	}
+
+	response := &npb.CreateNotificationResponse{}
+	if notification != nil {
+		response.NotificationId = notification.ID
+	}
+	return response, nil
+}
+
+// GetNotifications retrieves the authenticated user's notifications, newest first
+func (c *NotificationController) GetNotifications(ctx context.Context, req *npb.GetNotificationsRequest) (*npb.GetNotificationsResponse, error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	results, totalCount, totalPages, err := c.notificationService.GetNotifications(ctx, userID, req.UnreadOnly, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notifications: %v", err)
+	}
+
+	unreadCount, err := c.notificationService.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count unread notifications: %v", err)
+	}
+
+	notifications := make([]*npb.NotificationResponse, len(results))
+	for i, result := range results {
+		notification := &npb.NotificationResponse{
+			NotificationId: result.Notification.ID,
+			Type:           result.Notification.Type,
+			ActorId:        result.Notification.ActorID,
+			TargetId:       result.Notification.TargetID,
+			Read:           result.Notification.IsRead,
+			CreatedAt:      result.Notification.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if result.Actor != nil {
+			notification.ActorName = result.Actor.Name
+			notification.ActorAvatar = result.Actor.Avatar
+		}
+		notifications[i] = notification
+	}
+
+	return &npb.GetNotificationsResponse{
+		Notifications: notifications,
+		TotalCount:    int32(totalCount),
+		Page:          req.Page,
+		TotalPages:    totalPages,
+		UnreadCount:   int32(unreadCount),
+	}, nil
+}
+
+// GetUnreadCount counts the authenticated user's unread notifications
+func (c *NotificationController) GetUnreadCount(ctx context.Context, req *npb.GetUnreadCountRequest) (*npb.UnreadCountResponse, error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	return c.unreadCount(ctx, userID)
+}
+
+// MarkRead marks one of the authenticated user's notifications as read
+func (c *NotificationController) MarkRead(ctx context.Context, req *npb.MarkReadRequest) (*npb.UnreadCountResponse, error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	if err := c.notificationService.MarkRead(ctx, userID, req.NotificationId); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to mark notification as read: %v", err)
+	}
+
+	return c.unreadCount(ctx, userID)
+}
+
+// MarkAllRead marks all of the authenticated user's notifications as read
+func (c *NotificationController) MarkAllRead(ctx context.Context, req *npb.MarkAllReadRequest) (*npb.UnreadCountResponse, error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	if err := c.notificationService.MarkAllRead(ctx, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark notifications as read: %v", err)
+	}
+
+	return c.unreadCount(ctx, userID)
+}
+
+// unreadCount responds with the number of unread notifications the user has left
+func (c *NotificationController) unreadCount(ctx context.Context, userID string) (*npb.UnreadCountResponse, error) {
+	count, err := c.notificationService.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count unread notifications: %v", err)
+	}
+
+	return &npb.UnreadCountResponse{
+		UnreadCount: int32(count),
+	}, nil
+}
\ No newline at end of file
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"users-api/internal/models"
 	"users-api/internal/services"
 	"users-api/internal/utils/logger"
 
@@ -14,20 +15,22 @@ import (
 type UserController struct {
 	pb.UnimplementedUserServiceServer
 	userService services.UserService
+	presence    services.PresenceService
 	logger      *logger.Logger
 }
 
 // NewUserController creates a new user controller
-func NewUserController(userService services.UserService, logger *logger.Logger) *UserController {
+func NewUserController(userService services.UserService, presence services.PresenceService, logger *logger.Logger) *UserController {
 	return &UserController{
 		userService: userService,
+		presence:    presence,
 		logger:      logger,
 	}
 }
 
 // Register registers a new user with OAuth provider
 func (c *UserController) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
-	c.logger.Info("Register request received", logger.Field("provider", req.Provider))
+	c.logger.WithContext(ctx).Info("Register request received", logger.Field("provider", req.Provider))
 
 	// Validate request
 	if req.Provider == "" || req.Token == "" {
@@ -35,9 +38,9 @@ func (c *UserController) Register(ctx context.Context, req *pb.RegisterRequest)
 	}
 
 	// Call service to register user
-	userID, accessToken, err := c.userService.Register(ctx, req.Provider, req.Token)
+	userID, accessToken, err := c.userService.Register(ctx, req.Provider, req.Token, req.Name)
 	if err != nil {
-		c.logger.Error("Failed to register user", err)
+		c.logger.WithContext(ctx).Error("Failed to register user", err)
 		return nil, status.Errorf(codes.Internal, "failed to register user: %v", err)
 	}
 
@@ -49,7 +52,7 @@ func (c *UserController) Register(ctx context.Context, req *pb.RegisterRequest)
 
 // Login authenticates a user with OAuth provider
 func (c *UserController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	c.logger.Info("Login request received", logger.Field("provider", req.Provider))
+	c.logger.WithContext(ctx).Info("Login request received", logger.Field("provider", req.Provider))
 
 	// Validate request
 	if req.Provider == "" || req.Token == "" {
@@ -57,9 +60,9 @@ func (c *UserController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 	}
 
 	// Call service to login user
-	userID, accessToken, err := c.userService.Login(ctx, req.Provider, req.Token)
+	userID, accessToken, err := c.userService.Login(ctx, req.Provider, req.Token, req.Name)
 	if err != nil {
-		c.logger.Error("Failed to login user", err)
+		c.logger.WithContext(ctx).Error("Failed to login user", err)
 		return nil, status.Errorf(codes.Internal, "failed to login user: %v", err)
 	}
 
@@ -71,68 +74,297 @@ func (c *UserController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 
 // GetProfile retrieves a user's profile
 func (c *UserController) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.ProfileResponse, error) {
-	c.logger.Info("GetProfile request received", logger.Field("user_id", req.UserId))
+	c.logger.WithContext(ctx).Info("GetProfile request received")
 
-	// Validate request
-	if req.UserId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "user ID is required")
-	}
-
-	// Get user ID from context if not provided in request
-	if req.UserId == "" {
-		userID, ok := ctx.Value("userID").(string)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
-		}
-		req.UserId = userID
+	// The profile is the authenticated user's own, whoever the request names
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
 	}
 
 	// Call service to get user profile
-	user, err := c.userService.GetProfile(ctx, req.UserId)
+	user, err := c.userService.GetProfile(ctx, userID)
 	if err != nil {
-		c.logger.Error("Failed to get user profile", err)
+		c.logger.WithContext(ctx).Error("Failed to get user profile", err)
 		return nil, status.Errorf(codes.Internal, "failed to get user profile: %v", err)
 	}
 
 	return &pb.ProfileResponse{
-		UserId:    user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Avatar:    user.Avatar,
-		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UserId:        user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		Avatar:        user.Avatar,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Username:      user.Username,
+		EmailVerified: user.EmailVerified,
+		Role:          user.Role,
+		Bio:           user.Bio,
+		Location:      user.Location,
+		Website:       user.Website,
 	}, nil
 }
 
 // UpdateProfile updates a user's profile
 func (c *UserController) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.ProfileResponse, error) {
-	c.logger.Info("UpdateProfile request received", logger.Field("user_id", req.UserId))
+	c.logger.WithContext(ctx).Info("UpdateProfile request received")
+
+	// Users can only update their own profile, whoever the request names
+	userID, ok := ctx.Value("userID").(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	// Call service to update user profile
+	user, err := c.userService.UpdateProfile(ctx, userID, services.ProfileUpdate{
+		Name:     req.Name,
+		Avatar:   req.Avatar,
+		Bio:      req.Bio,
+		Location: req.Location,
+		Website:  req.Website,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		c.logger.WithContext(ctx).Error("Failed to update user profile", err)
+		return nil, status.Errorf(codes.Internal, "failed to update user profile: %v", err)
+	}
+
+	return &pb.ProfileResponse{
+		UserId:        user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		Avatar:        user.Avatar,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Username:      user.Username,
+		EmailVerified: user.EmailVerified,
+		Role:          user.Role,
+		Bio:           user.Bio,
+		Location:      user.Location,
+		Website:       user.Website,
+	}, nil
+}
+
+// maxUsersPerLookup caps the number of IDs accepted by GetUsersByIDs
+const maxUsersPerLookup = 100
+
+// GetUsersByIDs retrieves the public info of several users at once
+func (c *UserController) GetUsersByIDs(ctx context.Context, req *pb.GetUsersByIDsRequest) (*pb.GetUsersByIDsResponse, error) {
+	c.logger.WithContext(ctx).Debug("GetUsersByIDs request received", logger.Field("count", len(req.UserIds)))
 
 	// Validate request
-	if req.UserId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "user ID is required")
+	if len(req.UserIds) > maxUsersPerLookup {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d user IDs can be requested at once", maxUsersPerLookup)
 	}
 
-	// Get user ID from context if not provided in request
-	if req.UserId == "" {
-		userID, ok := ctx.Value("userID").(string)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	// Call service to get users
+	users, err := c.userService.GetUsersByIDs(ctx, req.UserIds)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get users", err)
+		return nil, status.Errorf(codes.Internal, "failed to get users: %v", err)
+	}
+
+	return &pb.GetUsersByIDsResponse{
+		Users: c.toUserSummaries(users),
+	}, nil
+}
+
+// GetUsersByUsernames retrieves the public info of several users by username at once
+func (c *UserController) GetUsersByUsernames(ctx context.Context, req *pb.GetUsersByUsernamesRequest) (*pb.GetUsersByIDsResponse, error) {
+	c.logger.WithContext(ctx).Debug("GetUsersByUsernames request received", logger.Field("count", len(req.Usernames)))
+
+	// Validate request
+	if len(req.Usernames) > maxUsersPerLookup {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d usernames can be requested at once", maxUsersPerLookup)
+	}
+
+	// Call service to get users
+	users, err := c.userService.GetUsersByUsernames(ctx, req.Usernames)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("Failed to get users by username", err)
+		return nil, status.Errorf(codes.Internal, "failed to get users: %v", err)
+	}
+
+	return &pb.GetUsersByIDsResponse{
+		Users: c.toUserSummaries(users),
+	}, nil
+}
+
+// SearchUsers finds users by name or email for the authenticated user
+func (c *UserController) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	c.logger.WithContext(ctx).Debug("SearchUsers request received", logger.Field("query", req.Query))
+
+	// The searcher is always the authenticated user
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	// Call service to search users
+	results, totalCount, totalPages, err := c.userService.SearchUsers(ctx, userID, req.Query, int(req.Page), int(req.Limit))
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
 		}
-		req.UserId = userID
+		c.logger.WithContext(ctx).Error("Failed to search users", err)
+		return nil, status.Errorf(codes.Internal, "failed to search users: %v", err)
 	}
 
-	// Call service to update user profile
-	user, err := c.userService.UpdateProfile(ctx, req.UserId, req.Name, req.Avatar)
+	users := make([]*pb.UserSearchResult, len(results))
+	for i, result := range results {
+		users[i] = &pb.UserSearchResult{
+			User:             c.toUserSummary(result.User),
+			FriendshipStatus: result.FriendshipStatus,
+		}
+	}
+
+	return &pb.SearchUsersResponse{
+		Users:      users,
+		TotalCount: int32(totalCount),
+		Page:       req.Page,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetPublicProfile retrieves another user's public profile as the authenticated user sees it
+func (c *UserController) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.PublicProfileResponse, error) {
+	c.logger.WithContext(ctx).Info("GetPublicProfile request received", logger.Field("target_user_id", req.UserId))
+
+	// The viewer is always the authenticated user
+	viewerID, ok := ctx.Value("userID").(string)
+	if !ok || viewerID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	// Call service to get the profile
+	profile, err := c.userService.GetPublicProfile(ctx, viewerID, req.UserId)
 	if err != nil {
-		c.logger.Error("Failed to update user profile", err)
-		return nil, status.Errorf(codes.Internal, "failed to update user profile: %v", err)
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user profile: %v", err)
+	}
+
+	resp := &pb.PublicProfileResponse{
+		User:             c.toUserSummary(profile.User),
+		Email:            profile.Email,
+		CreatedAt:        profile.User.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		FriendshipStatus: profile.FriendshipStatus,
+		Bio:              profile.User.Bio,
+		Location:         profile.User.Location,
+		Website:          profile.User.Website,
+	}
+	if profile.Follows != nil {
+		resp.FollowersCount = &profile.Follows.Followers
+		resp.FollowingCount = &profile.Follows.Following
+		resp.IsFollowing = profile.Follows.IsFollowing
+	}
+
+	return resp, nil
+}
+
+// MarkSeen records that the authenticated user is active
+func (c *UserController) MarkSeen(ctx context.Context, req *pb.MarkSeenRequest) (*pb.MarkSeenResponse, error) {
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	c.presence.MarkSeen(userID)
+
+	return &pb.MarkSeenResponse{
+		Success: true,
+	}, nil
+}
+
+// DeleteAccount permanently deletes the authenticated user's account
+func (c *UserController) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	// Users can only delete their own account
+	userID, ok := ctx.Value("userID").(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	c.logger.WithContext(ctx).Info("DeleteAccount request received")
+
+	// Call service to delete the account
+	if err := c.userService.DeleteAccount(ctx, userID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, err
+		}
+		c.logger.WithContext(ctx).Error("Failed to delete account", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete account: %v", err)
+	}
+
+	return &pb.DeleteAccountResponse{
+		Success: true,
+	}, nil
+}
+
+// SetUserRole changes a user's role. The auth interceptor only lets admins through.
+func (c *UserController) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.ProfileResponse, error) {
+	adminID, ok := ctx.Value("userID").(string)
+	if !ok || adminID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	c.logger.WithContext(ctx).Info("SetUserRole request received",
+		logger.Field("target_user_id", req.UserId),
+		logger.Field("role", req.Role))
+
+	// Validate request
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user ID is required")
+	}
+
+	// Call service to change the role
+	user, err := c.userService.SetUserRole(ctx, adminID, req.UserId, req.Role)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		c.logger.WithContext(ctx).Error("Failed to set user role", err)
+		return nil, status.Errorf(codes.Internal, "failed to set user role: %v", err)
 	}
 
 	return &pb.ProfileResponse{
-		UserId:    user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Avatar:    user.Avatar,
-		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UserId:        user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		Avatar:        user.Avatar,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Username:      user.Username,
+		EmailVerified: user.EmailVerified,
+		Role:          user.Role,
+		Bio:           user.Bio,
+		Location:      user.Location,
+		Website:       user.Website,
 	}, nil
 }
+
+// toUserSummaries converts user models to their public summaries
+func (c *UserController) toUserSummaries(users []*models.User) []*pb.UserSummary {
+	summaries := make([]*pb.UserSummary, len(users))
+	for i, user := range users {
+		summaries[i] = c.toUserSummary(user)
+	}
+	return summaries
+}
+
+// toUserSummary converts a user model to its public summary
+func (c *UserController) toUserSummary(user *models.User) *pb.UserSummary {
+	summary := &pb.UserSummary{
+		UserId:   user.ID,
+		Name:     user.Name,
+		Avatar:   user.Avatar,
+		Username: user.Username,
+		IsOnline: c.presence.IsOnline(user),
+	}
+	if user.LastSeenAt != nil {
+		summary.LastSeenAt = user.LastSeenAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return summary
+}
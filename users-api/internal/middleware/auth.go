@@ -5,33 +5,54 @@ import (
 	"errors"
 	"strings"
 	"time"
+	"users-api/internal/models"
+	"users-api/internal/repository"
 	"users-api/internal/utils/logger"
 
 	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 // AuthInterceptor is a gRPC interceptor for authentication
 type AuthInterceptor struct {
 	jwtSecret     string
+	tokenRepo     repository.TokenRepository
+	userRepo      repository.UserRepository
 	logger        *logger.Logger
 	publicMethods map[string]bool
+
+	// methodRoles lists the methods that require a role beyond being signed in
+	methodRoles map[string]string
 }
 
 // NewAuthInterceptor creates a new auth interceptor
-func NewAuthInterceptor(jwtSecret string, logger *logger.Logger) *AuthInterceptor {
+func NewAuthInterceptor(jwtSecret string, tokenRepo repository.TokenRepository, userRepo repository.UserRepository, logger *logger.Logger) *AuthInterceptor {
 	return &AuthInterceptor{
 		jwtSecret: jwtSecret,
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
 		logger:    logger,
 		publicMethods: map[string]bool{
-			"/users.UserService/Register":          true,
-			"/users.UserService/Login":             true,
-			"/users.UserService/GoogleLogin":       true,
-			"/users.UserService/MicrosoftLogin":    true,
-			"/users.UserService/ValidateStateToken": true,
+			"/grpc.health.v1.Health/Check":           true,
+			"/users.UserService/Register":            true,
+			"/users.UserService/Login":               true,
+			"/users.UserService/GoogleLogin":         true,
+			"/users.UserService/MicrosoftLogin":      true,
+			"/users.UserService/GitHubLogin":         true,
+			"/users.UserService/AppleLogin":          true,
+			"/users.UserService/AppleCallback":       true, // Called by the gateway when Apple posts back to it
+			"/users.UserService/ValidateStateToken":  true,
+			"/users.UserService/GetUsersByIDs":       true, // Only exposes public profile fields to other services
+			"/users.UserService/GetUsersByUsernames": true, // Only exposes public profile fields to other services
+			"/users.UserService/IsTokenRevoked":      true, // Called by the gateway and other services to reject signed-out tokens
+		},
+		methodRoles: map[string]string{
+			"/users.UserService/SetUserRole": models.RoleAdmin,
 		},
 	}
 }
@@ -44,43 +65,84 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Skip authentication for public methods
+		// Skip authentication for public methods, which have no caller to claim in the metadata
 		if i.publicMethods[info.FullMethod] {
-			return handler(ctx, req)
+			return handler(withoutUserID(ctx), req)
 		}
 
 		// Authenticate the request
-		userID, err := i.authenticate(ctx)
+		userID, role, err := i.authenticate(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		// Add user ID to context
-		ctx = context.WithValue(ctx, "userID", userID)
+		// Check the role required by the method
+		if required, ok := i.methodRoles[info.FullMethod]; ok && role != required {
+			return nil, status.Errorf(codes.PermissionDenied, "%s role required", required)
+		}
+
+		// Add user ID and role to context
+		ctx, err = i.withUser(ctx, userID, role)
+		if err != nil {
+			return nil, err
+		}
 
 		// Proceed with the request
 		return handler(ctx, req)
 	}
 }
 
-// authenticate authenticates the request
-func (i *AuthInterceptor) authenticate(ctx context.Context) (string, error) {
+// withUser adds the authenticated user and their role to the context, and the user ID to the
+// entries of the request-scoped logger. The user_id metadata is set to the token's subject as
+// well, and a request naming another user there is refused.
+func (i *AuthInterceptor) withUser(ctx context.Context, userID, role string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(userIDMetadata); len(values) > 0 && values[0] != userID {
+		return nil, status.Error(codes.PermissionDenied, "user ID does not match the token")
+	}
+	md = md.Copy()
+	md.Set(userIDMetadata, userID)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	ctx = context.WithValue(ctx, "userID", userID)
+	ctx = context.WithValue(ctx, "role", role)
+	ctx = logger.NewContext(ctx, i.logger.WithContext(ctx).With(logger.Field("user_id", userID)))
+	return ctx, nil
+}
+
+// userIDMetadata is the metadata key the gateway sends the authenticated user's ID in
+const userIDMetadata = "user_id"
+
+// withoutUserID drops the user_id metadata of an unauthenticated call
+func withoutUserID(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(userIDMetadata)) == 0 {
+		return ctx
+	}
+	md = md.Copy()
+	md.Delete(userIDMetadata)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// authenticate authenticates the request and returns the user ID and role. The role is read
+// from the database rather than the token, so role changes apply here immediately.
+func (i *AuthInterceptor) authenticate(ctx context.Context) (string, string, error) {
 	// Get metadata from context
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
+		return "", "", unauthenticated(reasonTokenMissing, "metadata is not provided")
 	}
 
 	// Get authorization header
 	values := md["authorization"]
 	if len(values) == 0 {
-		return "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+		return "", "", unauthenticated(reasonTokenMissing, "authorization token is not provided")
 	}
 
 	// Extract token from authorization header
 	authHeader := values[0]
 	if !strings.HasPrefix(authHeader, "Bearer ") {
-		return "", status.Errorf(codes.Unauthenticated, "invalid authorization format")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid authorization format")
 	}
 	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
@@ -93,29 +155,78 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (string, error) {
 	})
 	if err != nil {
 		i.logger.Error("Failed to parse token", err)
-		return "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		return "", "", unauthenticated(tokenErrorReason(err), "invalid token: "+err.Error())
 	}
 
 	// Get claims from token
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid {
-		return "", status.Errorf(codes.Unauthenticated, "invalid token claims")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid token claims")
 	}
 
 	// Check token expiration
 	exp, ok := claims["exp"].(float64)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "invalid token expiration")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid token expiration")
 	}
 	if time.Now().Unix() > int64(exp) {
-		return "", status.Errorf(codes.Unauthenticated, "token expired")
+		return "", "", unauthenticated(reasonTokenExpired, "token expired")
+	}
+
+	// Reject tokens that have been revoked on signout
+	if jti, ok := claims["jti"].(string); ok && jti != "" {
+		revoked, err := i.tokenRepo.IsRevoked(ctx, jti)
+		if err != nil {
+			i.logger.Error("Failed to check token revocation", err)
+			return "", "", status.Errorf(codes.Internal, "failed to verify token")
+		}
+		if revoked {
+			return "", "", unauthenticated(reasonTokenInvalid, "token has been revoked")
+		}
 	}
 
 	// Get user ID from claims
 	userID, ok := claims["sub"].(string)
 	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "invalid user ID in token")
+		return "", "", unauthenticated(reasonTokenInvalid, "invalid user ID in token")
+	}
+
+	// Reject tokens of accounts that have since been deleted
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", "", unauthenticated(reasonTokenInvalid, "user no longer exists")
+		}
+		i.logger.Error("Failed to look up token user", err)
+		return "", "", status.Errorf(codes.Internal, "failed to verify token")
 	}
 
-	return userID, nil
+	return userID, user.Role, nil
+}
+
+// Reasons attached to authentication failures, so that clients can tell an expired token,
+// which signing in again fixes, from a missing or malformed one
+const (
+	authErrorDomain    = "auth"
+	reasonTokenMissing = "TOKEN_MISSING"
+	reasonTokenInvalid = "TOKEN_INVALID"
+	reasonTokenExpired = "TOKEN_EXPIRED"
+)
+
+// unauthenticated returns an Unauthenticated status carrying the reason in an ErrorInfo detail
+func unauthenticated(reason, msg string) error {
+	st := status.New(codes.Unauthenticated, msg)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: authErrorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// tokenErrorReason tells a token rejected for its exp claim apart from an otherwise invalid one
+func tokenErrorReason(err error) string {
+	var validationErr *jwt.ValidationError
+	if errors.As(err, &validationErr) && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+		return reasonTokenExpired
+	}
+	return reasonTokenInvalid
 }
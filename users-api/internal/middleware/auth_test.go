@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"users-api/internal/models"
+	"users-api/internal/repository"
+	"users-api/internal/services"
+	"users-api/internal/testutil"
+	"users-api/internal/utils/logger"
+)
+
+const testSecret = "test-secret"
+
+// callAs calls a method that needs authentication with a token of the user, through the interceptor
+func callAs(t *testing.T, interceptor *AuthInterceptor, userID string) error {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": userID,
+		"jti": "token-of-" + userID,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signed))
+	info := &grpc.UnaryServerInfo{FullMethod: "/users.UserService/GetProfile"}
+	_, err = interceptor.Unary()(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestDeletedUserCannotAuthenticate(t *testing.T) {
+	db := testutil.NewDB(t)
+	if err := db.AutoMigrate(&models.User{}, &models.RevokedToken{}); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	log := &logger.Logger{Logger: zap.NewNop()}
+	userRepo := repository.NewUserRepository(db)
+	interceptor := NewAuthInterceptor(testSecret, repository.NewTokenRepository(db), userRepo, log)
+	userService := services.NewUserService(userRepo, nil, log, testSecret, time.Hour, "", "", nil, "", "", nil, services.AppleConfig{}, false, "")
+
+	ctx := context.Background()
+	user := &models.User{Name: "Alice", Email: "alice@example.com", Provider: "google"}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	if err := callAs(t, interceptor, user.ID); err != nil {
+		t.Fatalf("token of an existing user: %v", err)
+	}
+
+	if err := userService.DeleteAccount(ctx, user.ID); err != nil {
+		t.Fatalf("DeleteAccount: %v", err)
+	}
+
+	if err := callAs(t, interceptor, user.ID); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("token of a deleted user: got %v, want Unauthenticated", err)
+	}
+	if err := userService.DeleteAccount(ctx, user.ID); status.Code(err) != codes.NotFound {
+		t.Errorf("second DeleteAccount: got %v, want NotFound", err)
+	}
+}
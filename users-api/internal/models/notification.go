@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Notification records an event caused by one user that concerns another
+type Notification struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	UserID    string    `gorm:"type:varchar(36);not null;index" json:"user_id"`
+	Type      string    `gorm:"type:varchar(32);not null" json:"type"`
+	ActorID   string    `gorm:"type:varchar(36);not null" json:"actor_id"`
+	TargetID  string    `gorm:"type:varchar(36);not null;default:''" json:"target_id"`
+	IsRead    bool      `gorm:"not null;default:false" json:"is_read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for the Notification model
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// BeforeCreate is a hook that is called before creating a notification
+func (n *Notification) BeforeCreate(tx *gorm.DB) error {
+	if n.ID == "" {
+		n.ID = generateUUID()
+	}
+	return nil
+}
+
+// Types of notification, named after the event that causes them
+const (
+	NotificationFriendRequest     = "friend_request"
+	NotificationFriendAccept      = "friend_accept"
+	NotificationLike              = "like"
+	NotificationComment           = "comment"
+	NotificationMention           = "mention"
+	NotificationGroupJoinApproved = "group_join_approved"
+)
+
+// IsValidNotificationType reports whether t is one of the supported notification types
+func IsValidNotificationType(t string) bool {
+	switch t {
+	case NotificationFriendRequest, NotificationFriendAccept, NotificationLike,
+		NotificationComment, NotificationMention, NotificationGroupJoinApproved:
+		return true
+	}
+	return false
+}
\ No newline at end of file
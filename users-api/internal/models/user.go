@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -8,14 +9,21 @@ import (
 
 // User represents a user in the system
 type User struct {
-	ID        string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
-	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
-	Email     string         `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
-	Avatar    string         `gorm:"type:varchar(255)" json:"avatar"`
-	Provider  string         `gorm:"type:varchar(50);not null" json:"provider"` // google or microsoft
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID            string         `gorm:"primaryKey;type:varchar(36)" json:"id"`
+	Name          string         `gorm:"type:varchar(255);not null" json:"name"`
+	Username      string         `gorm:"type:varchar(64);uniqueIndex;not null" json:"username"`
+	Email         string         `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
+	EmailVerified bool           `gorm:"not null;default:false" json:"email_verified"`
+	Avatar        string         `gorm:"type:text" json:"avatar"`
+	Bio           string         `gorm:"type:varchar(300);not null;default:''" json:"bio"`
+	Location      string         `gorm:"type:varchar(100);not null;default:''" json:"location"`
+	Website       string         `gorm:"type:varchar(255);not null;default:''" json:"website"`
+	Provider      string         `gorm:"type:varchar(50);not null" json:"provider"` // google or microsoft
+	Role          string         `gorm:"type:varchar(16);not null;default:'user'" json:"role"`
+	LastSeenAt    *time.Time     `json:"last_seen_at"` // nil until the user is first active
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName returns the table name for the User model
@@ -28,9 +36,57 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 	if u.ID == "" {
 		u.ID = generateUUID()
 	}
+	if u.Role == "" {
+		u.Role = RoleUser
+	}
 	return nil
 }
 
+// IsOnline reports whether the user was active within window of now
+func (u *User) IsOnline(window time.Duration) bool {
+	return u.LastSeenAt != nil && time.Since(*u.LastSeenAt) <= window
+}
+
+// Roles a user can have. The role is carried in the JWT so that other services can authorize
+// admin-only operations without calling back to the users service.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// IsValidRole reports whether role is one of the supported roles
+func IsValidRole(role string) bool {
+	return role == RoleUser || role == RoleAdmin
+}
+
+// maxUsernameBaseLength leaves room for a numeric suffix when a username is already taken
+const maxUsernameBaseLength = 30
+
+// UsernameFromEmail derives a username from the local part of an email address,
+// keeping only lowercase letters, digits, underscores and dots
+func UsernameFromEmail(email string) string {
+	local := strings.ToLower(email)
+	if i := strings.Index(local, "@"); i >= 0 {
+		local = local[:i]
+	}
+
+	var b strings.Builder
+	for _, r := range local {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '.' {
+			b.WriteRune(r)
+		}
+	}
+
+	username := strings.Trim(b.String(), ".")
+	if len(username) > maxUsernameBaseLength {
+		username = username[:maxUsernameBaseLength]
+	}
+	if username == "" {
+		username = "user"
+	}
+	return username
+}
+
 // generateUUID generates a UUID
 func generateUUID() string {
 	// This is a simple implementation for demonstration purposes
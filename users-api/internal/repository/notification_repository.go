@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"users-api/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// NotificationRepository defines the interface for notification repository operations
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *models.Notification) error
+	FindByUser(ctx context.Context, userID string, unreadOnly bool, page, limit int) ([]*models.Notification, int64, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	MarkRead(ctx context.Context, id, userID string) error
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+}
+
+// notificationRepository implements the NotificationRepository interface
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// Create stores a new notification
+func (r *notificationRepository) Create(ctx context.Context, notification *models.Notification) error {
+	return r.db.WithContext(ctx).Create(notification).Error
+}
+
+// FindByUser retrieves a user's notifications with pagination, newest first
+func (r *notificationRepository) FindByUser(ctx context.Context, userID string, unreadOnly bool, page, limit int) ([]*models.Notification, int64, error) {
+	var notifications []*models.Notification
+	var count int64
+
+	db := r.db.WithContext(ctx).Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		db = db.Where("is_read = ?", false)
+	}
+
+	if err := db.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := db.Order("created_at DESC").Order("id DESC").Offset(offset).Limit(limit).Find(&notifications).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, count, nil
+}
+
+// CountUnread counts a user's unread notifications
+func (r *notificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkRead marks one of a user's notifications as read. Notifications of other users are not found.
+func (r *notificationRepository) MarkRead(ctx context.Context, id, userID string) error {
+	var notification models.Notification
+	if err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&notification).Error; err != nil {
+		return err
+	}
+	if notification.IsRead {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&notification).Update("is_read", true).Error
+}
+
+// MarkAllRead marks all of a user's unread notifications as read and returns how many there were
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Update("is_read", true)
+	return result.RowsAffected, result.Error
+}
\ No newline at end of file
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 	"users-api/internal/models"
 
 	"gorm.io/gorm"
@@ -11,8 +14,12 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *models.User) error
 	FindByID(ctx context.Context, id string) (*models.User, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*models.User, error)
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByUsernames(ctx context.Context, usernames []string) ([]*models.User, error)
+	Search(ctx context.Context, query, excludeID string, page, limit int) ([]*models.User, int64, error)
 	Update(ctx context.Context, user *models.User) error
+	UpdateLastSeen(ctx context.Context, ids []string, seenAt time.Time) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -26,11 +33,40 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{db: db}
 }
 
-// Create creates a new user
+// Create creates a new user, assigning a unique username derived from the email if none is set
 func (r *userRepository) Create(ctx context.Context, user *models.User) error {
+	if user.Username == "" {
+		username, err := r.availableUsername(ctx, models.UsernameFromEmail(user.Email))
+		if err != nil {
+			return err
+		}
+		user.Username = username
+	}
 	return r.db.WithContext(ctx).Create(user).Error
 }
 
+// availableUsername returns base if it is free, otherwise base followed by the lowest free number
+func (r *userRepository) availableUsername(ctx context.Context, base string) (string, error) {
+	var taken []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.User{}).
+		Where("username = ? OR username LIKE ?", base, base+"%").
+		Pluck("username", &taken).Error
+	if err != nil {
+		return "", err
+	}
+
+	used := make(map[string]bool, len(taken))
+	for _, username := range taken {
+		used[username] = true
+	}
+
+	username := base
+	for i := 2; used[username]; i++ {
+		username = base + strconv.Itoa(i)
+	}
+	return username, nil
+}
+
 // FindByID finds a user by ID
 func (r *userRepository) FindByID(ctx context.Context, id string) (*models.User, error) {
 	var user models.User
@@ -41,6 +77,19 @@ func (r *userRepository) FindByID(ctx context.Context, id string) (*models.User,
 	return &user, nil
 }
 
+// FindByIDs finds all users whose ID is in the given list
+func (r *userRepository) FindByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	var users []*models.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 // FindByEmail finds a user by email
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
@@ -51,12 +100,71 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models
 	return &user, nil
 }
 
+// FindByUsernames finds all users whose username is in the given list
+func (r *userRepository) FindByUsernames(ctx context.Context, usernames []string) ([]*models.User, error) {
+	var users []*models.User
+	if len(usernames) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("username IN ?", usernames).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Search finds users whose name or email contains query, leaving out excludeID
+func (r *userRepository) Search(ctx context.Context, query, excludeID string, page, limit int) ([]*models.User, int64, error) {
+	var users []*models.User
+	var count int64
+
+	pattern := "%" + escapeLike(query) + "%"
+	db := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("name LIKE ? OR email LIKE ?", pattern, pattern).
+		Where("id <> ?", excludeID)
+
+	if err := db.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := db.Order("name ASC").Order("id ASC").Offset(offset).Limit(limit).Find(&users).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, count, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so they match literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // Update updates a user
 func (r *userRepository) Update(ctx context.Context, user *models.User) error {
 	return r.db.WithContext(ctx).Save(user).Error
 }
 
-// Delete deletes a user
+// UpdateLastSeen sets the last seen time of several users in one statement. It leaves
+// updated_at alone, as being active is not a change to the profile.
+func (r *userRepository) UpdateLastSeen(ctx context.Context, ids []string, seenAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&models.User{}).
+		Where("id IN ?", ids).
+		UpdateColumn("last_seen_at", seenAt).Error
+}
+
+// Delete permanently removes a user. The row is hard-deleted rather than soft-deleted so that
+// no personal data is retained and the email and username become available again.
 func (r *userRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&models.User{}, "id = ?", id).Error
+	result := r.db.WithContext(ctx).Unscoped().Delete(&models.User{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
\ No newline at end of file
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// appleIssuer is the issuer of Apple identity tokens
+	appleIssuer = "https://appleid.apple.com"
+
+	// appleKeysURL serves the public keys Apple signs identity tokens with
+	appleKeysURL = "https://appleid.apple.com/auth/keys"
+
+	// appleKeysTTL is how long the fetched Apple public keys are trusted before refreshing
+	appleKeysTTL = 24 * time.Hour
+
+	// appleClientSecretTTL is the lifetime of the client secret sent with a code exchange
+	appleClientSecretTTL = 5 * time.Minute
+)
+
+// appleEndpoint is the OAuth2 endpoint of Sign in with Apple
+var appleEndpoint = oauth2.Endpoint{
+	AuthURL:   "https://appleid.apple.com/auth/authorize",
+	TokenURL:  "https://appleid.apple.com/auth/token",
+	AuthStyle: oauth2.AuthStyleInParams,
+}
+
+// AppleConfig holds the Sign in with Apple settings
+type AppleConfig struct {
+	// ClientID is the Services ID used by the web sign-in flow
+	ClientID string
+
+	// BundleID is the iOS app's bundle ID, the audience of identity tokens issued to the app
+	BundleID string
+
+	// TeamID is the Apple developer team that owns the sign-in key
+	TeamID string
+
+	// KeyID identifies the sign-in key
+	KeyID string
+
+	// PrivateKeyFile is the path to the sign-in key (.p8) used to sign client secrets
+	PrivateKeyFile string
+
+	// RedirectURL is where Apple posts the result of the web sign-in flow
+	RedirectURL string
+}
+
+// newAppleConfig creates the OAuth2 configuration for the Sign in with Apple web flow.
+// The client secret is left empty because it is a short-lived JWT generated per exchange.
+func newAppleConfig(cfg AppleConfig) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:    cfg.ClientID,
+		RedirectURL: cfg.RedirectURL,
+		Endpoint:    appleEndpoint,
+		Scopes:      []string{"name", "email"},
+	}
+}
+
+// appleClaims are the claims of an Apple identity token
+type appleClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+
+	// EmailVerified is sent as either a boolean or the string "true"
+	EmailVerified interface{} `json:"email_verified"`
+}
+
+// emailVerified reports whether Apple has verified the email claim
+func (c *appleClaims) emailVerified() bool {
+	switch v := c.EmailVerified.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// appleKeySet caches Apple's public signing keys by key ID
+type appleKeySet struct {
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+	client    *http.Client
+}
+
+// newAppleKeySet creates an empty key set that is filled on first use
+func newAppleKeySet() *appleKeySet {
+	return &appleKeySet{
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// key returns the public key with the given ID. The keys are refetched when they are stale
+// or the ID is unknown, since Apple rotates its keys without notice.
+func (k *appleKeySet) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if key, ok := k.keys[kid]; ok && time.Since(k.fetchedAt) < appleKeysTTL {
+		return key, nil
+	}
+
+	keys, err := k.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	k.keys = keys
+	k.fetchedAt = time.Now()
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown Apple signing key %q", kid)
+	}
+	return key, nil
+}
+
+// fetch downloads Apple's JSON Web Key Set
+func (k *appleKeySet) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", appleKeysURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Apple public keys: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Apple API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse Apple public keys: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus of Apple key %q: %w", jwk.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent of Apple key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+// verifyAppleIDToken checks the signature, issuer, audience and expiry of an Apple identity token
+// and returns the user it identifies. Apple never puts the user's name in the token.
+func verifyAppleIDToken(ctx context.Context, keys *appleKeySet, idToken string, audiences ...string) (*UserInfo, error) {
+	claims := &appleClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		kid, _ := token.Header["kid"].(string)
+		return keys.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid Apple identity token: %w", err)
+	}
+
+	if !claims.VerifyIssuer(appleIssuer, true) {
+		return nil, errors.New("invalid Apple identity token issuer")
+	}
+
+	validAudience := false
+	for _, aud := range audiences {
+		if aud != "" && claims.VerifyAudience(aud, true) {
+			validAudience = true
+			break
+		}
+	}
+	if !validAudience {
+		return nil, errors.New("invalid Apple identity token audience")
+	}
+
+	// Validate required fields
+	if claims.Subject == "" || claims.Email == "" || !claims.emailVerified() {
+		return nil, errors.New("incomplete user info from Apple")
+	}
+
+	return &UserInfo{
+		ID:            claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: true,
+	}, nil
+}
+
+// appleClientSecret generates the client secret for a code exchange: a JWT signed with the
+// team's sign-in key, as Apple does not issue static client secrets
+func appleClientSecret(cfg AppleConfig) (string, error) {
+	pem, err := os.ReadFile(cfg.PrivateKeyFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Apple private key: %w", err)
+	}
+
+	key, err := jwt.ParseECPrivateKeyFromPEM(pem)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse Apple private key: %w", err)
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    cfg.TeamID,
+		Subject:   cfg.ClientID,
+		Audience:  jwt.ClaimStrings{appleIssuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appleClientSecretTTL)),
+	})
+	token.Header["kid"] = cfg.KeyID
+
+	return token.SignedString(key)
+}
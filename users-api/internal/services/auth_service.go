@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"gorm.io/gorm"
 )
 
 // AuthService defines the interface for authentication-related operations
@@ -33,41 +35,84 @@ type AuthService interface {
 	// MicrosoftCallback handles the callback from Microsoft OAuth
 	MicrosoftCallback(ctx context.Context, state, code string) (string, string, error)
 
+	// GitHubLogin generates a GitHub OAuth URL with state token
+	GitHubLogin(ctx context.Context, redirectURL string) (string, string, error)
+
+	// GitHubCallback handles the callback from GitHub OAuth
+	GitHubCallback(ctx context.Context, state, code string) (string, string, error)
+
+	// AppleLogin generates a Sign in with Apple URL with state token
+	AppleLogin(ctx context.Context, redirectURL string) (string, string, error)
+
+	// AppleCallback handles the callback from Sign in with Apple
+	AppleCallback(ctx context.Context, state, code, name string) (string, string, error)
+
 	// ValidateStateToken validates the state token to prevent CSRF attacks
 	ValidateStateToken(state string) bool
 
 	// Signout signs out the user
 	Signout(ctx context.Context, token string) (bool, error)
+
+	// IsTokenRevoked checks whether a token ID has been revoked by signing out, or the user the
+	// token was issued to no longer exists
+	IsTokenRevoked(ctx context.Context, jti, userID string) (bool, error)
 }
 
+const (
+	// stateTokenTTL is how long an OAuth state token stays valid
+	stateTokenTTL = 10 * time.Minute
+
+	// stateCleanupInterval is how often abandoned state tokens are swept
+	stateCleanupInterval = time.Minute
+
+	// revokedTokenCleanupInterval is how often expired blacklist entries are purged
+	revokedTokenCleanupInterval = time.Hour
+)
+
 // authService implements the AuthService interface
 type authService struct {
 	userRepo        repository.UserRepository
+	tokenRepo       repository.TokenRepository
 	logger          *logger.Logger
 	jwtSecret       string
 	jwtExpiration   time.Duration
 	googleConfig    *oauth2.Config
 	microsoftConfig *oauth2.Config
-	stateStore      map[string]time.Time // Store state tokens for CSRF protection
+	githubConfig    *oauth2.Config
+	appleConfig     *oauth2.Config
+	apple           AppleConfig
+	appleKeys       *appleKeySet
+	stateStore      *stateStore // Store state tokens for CSRF protection
+
+	// requireVerifiedEmail rejects sign-ins whose email the provider has not verified
+	requireVerifiedEmail bool
 }
 
 // NewAuthService creates a new auth service
 func NewAuthService(
 	userRepo repository.UserRepository,
+	tokenRepo repository.TokenRepository,
 	logger *logger.Logger,
 	jwtSecret string,
 	jwtExpiration time.Duration,
 	googleClientID string,
 	googleClientSecret string,
+	googleScopes []string,
 	microsoftClientID string,
 	microsoftClientSecret string,
+	microsoftScopes []string,
+	githubClientID string,
+	githubClientSecret string,
+	githubScopes []string,
+	apple AppleConfig,
+	requireVerifiedEmail bool,
 ) AuthService {
 	// Configure Google OAuth2
 	googleConfig := &oauth2.Config{
 		ClientID:     googleClientID,
 		ClientSecret: googleClientSecret,
 		Endpoint:     google.Endpoint,
-		Scopes:       []string{"User.Read", "profile", "email", "openid"},
+		Scopes:       oauthScopes(googleScopes, defaultGoogleScopes),
 	}
 
 	// Configure Microsoft OAuth2
@@ -78,17 +123,49 @@ func NewAuthService(
 			AuthURL:  "https://login.microsoftonline.com/consumers/oauth2/v2.0/authorize",
 			TokenURL: "https://login.microsoftonline.com/consumers/oauth2/v2.0/token",
 		},
-		Scopes: []string{"User.Read", "profile", "email", "openid"},
+		Scopes: oauthScopes(microsoftScopes, defaultMicrosoftScopes),
 	}
 
-	return &authService{
+	service := &authService{
 		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
 		logger:          logger,
 		jwtSecret:       jwtSecret,
 		jwtExpiration:   jwtExpiration,
 		googleConfig:    googleConfig,
 		microsoftConfig: microsoftConfig,
-		stateStore:      make(map[string]time.Time),
+		githubConfig:    newGitHubConfig(githubClientID, githubClientSecret, githubScopes),
+		appleConfig:     newAppleConfig(apple),
+		apple:           apple,
+		appleKeys:       newAppleKeySet(),
+		stateStore:      newStateStore(stateTokenTTL),
+
+		requireVerifiedEmail: requireVerifiedEmail,
+	}
+
+	// Periodically sweep state tokens from logins that were never completed
+	go service.stateStore.runCleanup(stateCleanupInterval)
+
+	// Periodically purge blacklist entries for tokens that have expired anyway
+	go service.cleanupRevokedTokens(revokedTokenCleanupInterval)
+
+	return service
+}
+
+// cleanupRevokedTokens removes expired entries from the token blacklist on every tick
+func (s *authService) cleanupRevokedTokens(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := s.tokenRepo.DeleteExpired(context.Background(), time.Now())
+		if err != nil {
+			s.logger.Error("Failed to clean up revoked tokens", err)
+			continue
+		}
+		if deleted > 0 {
+			s.logger.Debug("Cleaned up revoked tokens", logger.Field("count", deleted))
+		}
 	}
 }
 
@@ -101,6 +178,15 @@ func generateStateToken() (string, error) {
 	return base64.URLEncoding.EncodeToString(b), nil
 }
 
+// generateTokenID generates a random identifier for the jti claim of a JWT
+func generateTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 // GoogleLogin generates a Google OAuth URL with state token
 func (s *authService) GoogleLogin(ctx context.Context, redirectURL string) (string, string, error) {
 	// Set redirect URL if provided
@@ -111,12 +197,12 @@ func (s *authService) GoogleLogin(ctx context.Context, redirectURL string) (stri
 	// Generate a state token for CSRF protection
 	state, err := generateStateToken()
 	if err != nil {
-		s.logger.Error("Failed to generate state token", err)
+		s.logger.WithContext(ctx).Error("Failed to generate state token", err)
 		return "", "", err
 	}
 
 	// Store the state token with timestamp
-	s.stateStore[state] = time.Now()
+	s.stateStore.Save(state)
 
 	// Generate Google's OAuth login URL
 	url := s.googleConfig.AuthCodeURL(state)
@@ -133,12 +219,12 @@ func (s *authService) MicrosoftLogin(ctx context.Context, redirectURL string) (s
 	// Generate a state token for CSRF protection
 	state, err := generateStateToken()
 	if err != nil {
-		s.logger.Error("Failed to generate state token", err)
+		s.logger.WithContext(ctx).Error("Failed to generate state token", err)
 		return "", "", err
 	}
 
 	// Store the state token with timestamp
-	s.stateStore[state] = time.Now()
+	s.stateStore.Save(state)
 
 	// Generate Microsoft's OAuth login URL
 	url := s.microsoftConfig.AuthCodeURL(state)
@@ -155,32 +241,37 @@ func (s *authService) GoogleCallback(ctx context.Context, state, code string) (s
 	// Exchange authorization code for token
 	token, err := s.googleConfig.Exchange(context.Background(), code)
 	if err != nil {
-		s.logger.Error("Failed to exchange code for token", err)
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
 		return "", "", err
 	}
 
 	// Get user info from Google
 	userInfo, err := s.getUserInfoFromOAuth(ctx, "google", token.AccessToken)
 	if err != nil {
-		s.logger.Error("Failed to get user info from Google", err)
+		s.logger.WithContext(ctx).Error("Failed to get user info from Google", err)
 		return "", "", err
 	}
+	if s.requireVerifiedEmail && !userInfo.EmailVerified {
+		return "", "", errEmailNotVerified
+	}
 
 	// Find user by email
 	existingUser, err := s.userRepo.FindByEmail(ctx, userInfo.Email)
 	if err != nil {
 		// User not found, create new user
 		if err := s.userRepo.Create(ctx, userInfo); err != nil {
-			s.logger.Error("Failed to create user", err)
+			s.logger.WithContext(ctx).Error("Failed to create user", err)
 			return "", "", err
 		}
 		existingUser = userInfo
+	} else if err := markEmailVerified(ctx, s.userRepo, existingUser, userInfo.EmailVerified); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to save email verification", err)
 	}
 
 	// Generate JWT token
-	accessToken, err := s.generateJWT(existingUser.ID)
+	accessToken, err := s.generateJWT(existingUser)
 	if err != nil {
-		s.logger.Error("Failed to generate JWT", err)
+		s.logger.WithContext(ctx).Error("Failed to generate JWT", err)
 		return "", "", err
 	}
 
@@ -189,124 +280,332 @@ func (s *authService) GoogleCallback(ctx context.Context, state, code string) (s
 
 // MicrosoftCallback handles the callback from Microsoft OAuth
 func (s *authService) MicrosoftCallback(ctx context.Context, state, code string) (string, string, error) {
-	s.logger.Info("Microsoft callback received",
-		logger.Field("state_length", len(state)),
-		logger.Field("code_length", len(code)))
+	// The step-by-step entries below are debug level, since they are only useful while
+	// troubleshooting a sign-in and would otherwise be written on every login
+	log := s.logger.WithContext(ctx).With(logger.Field("provider", "microsoft"))
+	log.Debug("Microsoft callback received")
 
 	// Validate state token
 	if !s.ValidateStateToken(state) {
-		s.logger.Error("Invalid state token", nil)
+		log.Error("Invalid state token", nil)
 		return "", "", errors.New("invalid state token")
 	}
 
 	// Exchange authorization code for token
-	s.logger.Info("Exchanging code for token",
-		logger.Field("code_length", len(code)),
+	log.Debug("Exchanging code for token",
 		logger.Field("redirect_url", s.microsoftConfig.RedirectURL),
-		logger.Field("client_id", s.microsoftConfig.ClientID),
 		logger.Field("scopes", s.microsoftConfig.Scopes),
-		logger.Field("auth_url", s.microsoftConfig.Endpoint.AuthURL),
 		logger.Field("token_url", s.microsoftConfig.Endpoint.TokenURL))
 
 	token, err := s.microsoftConfig.Exchange(context.Background(), code)
 	if err != nil {
-		s.logger.Error("Failed to exchange code for token", err)
+		log.Error("Failed to exchange code for token", err)
 		return "", "", fmt.Errorf("failed to exchange code for token: %w", err)
 	}
 
-	s.logger.Info("Token exchange successful",
+	log.Debug("Token exchange successful",
 		logger.Field("token_type", token.TokenType),
-		logger.Field("expiry", token.Expiry.String()),
-		logger.Field("access_token_length", len(token.AccessToken)))
+		logger.Field("expiry", token.Expiry.String()))
 
 	// Get user info from Microsoft
-	s.logger.Info("Getting user info from Microsoft")
 	userInfo, err := s.getUserInfoFromOAuth(ctx, "microsoft", token.AccessToken)
 	if err != nil {
-		s.logger.Error("Failed to get user info from Microsoft", err)
+		log.Error("Failed to get user info from Microsoft", err)
 		return "", "", fmt.Errorf("failed to get user info from Microsoft: %w", err)
 	}
+	if s.requireVerifiedEmail && !userInfo.EmailVerified {
+		return "", "", errEmailNotVerified
+	}
 
-	s.logger.Info("User info retrieved successfully",
-		logger.Field("email", userInfo.Email),
-		logger.Field("name", userInfo.Name))
+	// Every further entry is about this account
+	log = log.With(logger.Field("email", userInfo.Email))
+	log.Debug("User info retrieved successfully")
 
 	// Find user by email
-	s.logger.Info("Finding user by email", logger.Field("email", userInfo.Email))
 	existingUser, err := s.userRepo.FindByEmail(ctx, userInfo.Email)
 	if err != nil {
-		s.logger.Info("User not found, creating new user", logger.Field("email", userInfo.Email))
+		log.Info("User not found, creating new user")
 		// User not found, create new user
 		if err := s.userRepo.Create(ctx, userInfo); err != nil {
-			s.logger.Error("Failed to create user", err)
+			log.Error("Failed to create user", err)
 			return "", "", fmt.Errorf("failed to create user: %w", err)
 		}
 		existingUser = userInfo
-		s.logger.Info("New user created", logger.Field("id", existingUser.ID))
+		log.Info("New user created", logger.Field("id", existingUser.ID))
 	} else {
-		s.logger.Info("User found", logger.Field("id", existingUser.ID))
+		log.Debug("User found", logger.Field("id", existingUser.ID))
+		if err := markEmailVerified(ctx, s.userRepo, existingUser, userInfo.EmailVerified); err != nil {
+			log.Error("Failed to save email verification", err)
+		}
 	}
 
 	// Generate JWT token
-	s.logger.Info("Generating JWT token")
-	accessToken, err := s.generateJWT(existingUser.ID)
+	accessToken, err := s.generateJWT(existingUser)
 	if err != nil {
-		s.logger.Error("Failed to generate JWT", err)
+		log.Error("Failed to generate JWT", err)
 		return "", "", fmt.Errorf("failed to generate JWT: %w", err)
 	}
 
-	s.logger.Info("JWT token generated successfully",
-		logger.Field("token_length", len(accessToken)))
+	return existingUser.ID, accessToken, nil
+}
+
+// GitHubLogin generates a GitHub OAuth URL with state token
+func (s *authService) GitHubLogin(ctx context.Context, redirectURL string) (string, string, error) {
+	// Set redirect URL if provided
+	if redirectURL != "" {
+		s.githubConfig.RedirectURL = redirectURL
+	}
+
+	// Generate a state token for CSRF protection
+	state, err := generateStateToken()
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate state token", err)
+		return "", "", err
+	}
+
+	// Store the state token with timestamp
+	s.stateStore.Save(state)
+
+	// Generate GitHub's OAuth login URL
+	url := s.githubConfig.AuthCodeURL(state)
+	return url, state, nil
+}
+
+// GitHubCallback handles the callback from GitHub OAuth
+func (s *authService) GitHubCallback(ctx context.Context, state, code string) (string, string, error) {
+	// Validate state token
+	if !s.ValidateStateToken(state) {
+		return "", "", errors.New("invalid state token")
+	}
+
+	// Exchange authorization code for token
+	token, err := s.githubConfig.Exchange(ctx, code)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
+		return "", "", fmt.Errorf("failed to exchange code for token: %w", err)
+	}
+
+	// Get user info from GitHub
+	userInfo, err := s.getUserInfoFromOAuth(ctx, "github", token.AccessToken)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get user info from GitHub", err)
+		return "", "", fmt.Errorf("failed to get user info from GitHub: %w", err)
+	}
+	if s.requireVerifiedEmail && !userInfo.EmailVerified {
+		return "", "", errEmailNotVerified
+	}
+
+	// Find user by email
+	existingUser, err := s.userRepo.FindByEmail(ctx, userInfo.Email)
+	if err != nil {
+		// User not found, create new user
+		if err := s.userRepo.Create(ctx, userInfo); err != nil {
+			s.logger.WithContext(ctx).Error("Failed to create user", err)
+			return "", "", fmt.Errorf("failed to create user: %w", err)
+		}
+		existingUser = userInfo
+	} else if err := markEmailVerified(ctx, s.userRepo, existingUser, userInfo.EmailVerified); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to save email verification", err)
+	}
+
+	// Generate JWT token
+	accessToken, err := s.generateJWT(existingUser)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate JWT", err)
+		return "", "", fmt.Errorf("failed to generate JWT: %w", err)
+	}
 
 	return existingUser.ID, accessToken, nil
 }
 
-// ValidateStateToken validates the state token to prevent CSRF attacks
-func (s *authService) ValidateStateToken(state string) bool {
-	timestamp, exists := s.stateStore[state]
-	if !exists {
-		return false
+// AppleLogin generates a Sign in with Apple URL with state token
+func (s *authService) AppleLogin(ctx context.Context, redirectURL string) (string, string, error) {
+	// Set redirect URL if provided
+	if redirectURL != "" {
+		s.appleConfig.RedirectURL = redirectURL
+	}
+
+	// Generate a state token for CSRF protection
+	state, err := generateStateToken()
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate state token", err)
+		return "", "", err
+	}
+
+	// Store the state token with timestamp
+	s.stateStore.Save(state)
+
+	// Generate Apple's login URL; Apple requires the callback to be a form post
+	// whenever the name or email scope is requested
+	url := s.appleConfig.AuthCodeURL(state, oauth2.SetAuthURLParam("response_mode", "form_post"))
+	return url, state, nil
+}
+
+// AppleCallback handles the callback from Sign in with Apple.
+// Apple only posts the user's name on the first sign-in, so it is saved when the account is
+// created, or when an existing account has no name yet.
+func (s *authService) AppleCallback(ctx context.Context, state, code, name string) (string, string, error) {
+	// Validate state token
+	if !s.ValidateStateToken(state) {
+		return "", "", errors.New("invalid state token")
+	}
+
+	// Exchange authorization code for token
+	clientSecret, err := appleClientSecret(s.apple)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate Apple client secret", err)
+		return "", "", err
+	}
+	token, err := s.appleConfig.Exchange(ctx, code, oauth2.SetAuthURLParam("client_secret", clientSecret))
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to exchange code for token", err)
+		return "", "", fmt.Errorf("failed to exchange code for token: %w", err)
+	}
+
+	// Apple has no userinfo endpoint; the user is described by the identity token
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return "", "", errors.New("no identity token from Apple")
+	}
+	userInfo, err := verifyAppleIDToken(ctx, s.appleKeys, idToken, s.apple.ClientID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to verify Apple identity token", err)
+		return "", "", err
+	}
+	if s.requireVerifiedEmail && !userInfo.EmailVerified {
+		return "", "", errEmailNotVerified
+	}
+
+	// Find user by email
+	existingUser, err := s.userRepo.FindByEmail(ctx, userInfo.Email)
+	if err != nil {
+		// User not found, create new user
+		existingUser = &models.User{
+			ID:            userInfo.ID,
+			Name:          name,
+			Email:         userInfo.Email,
+			EmailVerified: userInfo.EmailVerified,
+			Provider:      "apple",
+		}
+		if err := s.userRepo.Create(ctx, existingUser); err != nil {
+			s.logger.WithContext(ctx).Error("Failed to create user", err)
+			return "", "", fmt.Errorf("failed to create user: %w", err)
+		}
+	} else {
+		if err := markEmailVerified(ctx, s.userRepo, existingUser, userInfo.EmailVerified); err != nil {
+			s.logger.WithContext(ctx).Error("Failed to save email verification", err)
+		}
+		if existingUser.Name == "" && name != "" {
+			existingUser.Name = name
+			if err := s.userRepo.Update(ctx, existingUser); err != nil {
+				s.logger.WithContext(ctx).Error("Failed to save user name", err)
+			}
+		}
 	}
 
-	// Check if the token is expired (10 minutes)
-	if time.Since(timestamp) > 10*time.Minute {
-		delete(s.stateStore, state)
-		return false
+	// Generate JWT token
+	accessToken, err := s.generateJWT(existingUser)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to generate JWT", err)
+		return "", "", fmt.Errorf("failed to generate JWT: %w", err)
 	}
 
-	// Remove the token after use
-	delete(s.stateStore, state)
-	return true
+	return existingUser.ID, accessToken, nil
+}
+
+// ValidateStateToken validates the state token to prevent CSRF attacks
+func (s *authService) ValidateStateToken(state string) bool {
+	return s.stateStore.Validate(state)
 }
 
 // Signout signs out the user
 func (s *authService) Signout(ctx context.Context, token string) (bool, error) {
-	// Parse the token to get the user ID
+	// Parse the token to get the token ID and expiration
 	claims := jwt.MapClaims{}
 	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
 		return []byte(s.jwtSecret), nil
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to parse token", err)
+		s.logger.WithContext(ctx).Error("Failed to parse token", err)
 		return false, err
 	}
 
-	// In a real application, you would add the token to a blacklist here
+	jti, ok := claims["jti"].(string)
+	if !ok || jti == "" {
+		return false, errors.New("token has no ID and cannot be revoked")
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return false, errors.New("invalid token expiration")
+	}
+
+	userID, _ := claims["sub"].(string)
+
+	// Add the token to the blacklist until it would have expired
+	if err := s.tokenRepo.Revoke(ctx, &models.RevokedToken{
+		JTI:       jti,
+		UserID:    userID,
+		ExpiresAt: time.Unix(int64(exp), 0),
+	}); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to revoke token", err)
+		return false, err
+	}
 
 	return true, nil
 }
 
+// IsTokenRevoked checks whether a token ID has been revoked by signing out. Tokens of deleted
+// accounts count as revoked too, since only the token the account was deleted with is
+// blacklisted. An empty user ID skips that check.
+func (s *authService) IsTokenRevoked(ctx context.Context, jti, userID string) (bool, error) {
+	revoked, err := s.tokenRepo.IsRevoked(ctx, jti)
+	if err != nil || revoked || userID == "" {
+		return revoked, err
+	}
+
+	if _, err := s.userRepo.FindByID(ctx, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
 // getUserInfoFromOAuth gets user information from OAuth provider
 func (s *authService) getUserInfoFromOAuth(ctx context.Context, provider, token string) (*models.User, error) {
 	if provider == "google" {
 		return s.getGoogleUserInfo(ctx, token)
 	} else if provider == "microsoft" {
 		return s.getMicrosoftUserInfo(ctx, token)
+	} else if provider == "github" {
+		return s.getGitHubUserInfo(ctx, token)
 	}
 	return nil, errors.New("invalid provider")
 }
 
+// getGitHubUserInfo retrieves user information from GitHub OAuth
+func (s *authService) getGitHubUserInfo(ctx context.Context, accessToken string) (*models.User, error) {
+	userInfo, err := getGitHubUserInfo(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.User{
+		ID:            userInfo.ID,
+		Name:          userInfo.Name,
+		Email:         userInfo.Email,
+		EmailVerified: userInfo.EmailVerified,
+		Avatar:        userInfo.Avatar,
+		Provider:      "github",
+	}, nil
+}
+
 // getGoogleUserInfo retrieves user information from Google OAuth
 func (s *authService) getGoogleUserInfo(ctx context.Context, accessToken string) (*models.User, error) {
 	// Google's userinfo endpoint
@@ -358,6 +657,10 @@ func (s *authService) getGoogleUserInfo(ctx context.Context, accessToken string)
 		user.Email = email
 	}
 
+	if emailVerified, ok := result["email_verified"].(bool); ok {
+		user.EmailVerified = emailVerified
+	}
+
 	if picture, ok := result["picture"].(string); ok {
 		user.Avatar = picture
 	}
@@ -375,8 +678,7 @@ func (s *authService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 	// Microsoft Graph API endpoint for user info
 	userInfoURL := "https://graph.microsoft.com/beta/me"
 
-	s.logger.Info("Getting Microsoft user info", logger.Field("url", userInfoURL))
-	s.logger.Info("Access token", logger.Field("token_length", len(accessToken)))
+	s.logger.WithContext(ctx).Debug("Getting Microsoft user info", logger.Field("url", userInfoURL))
 
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, "GET", userInfoURL, nil)
@@ -395,7 +697,7 @@ func (s *authService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		s.logger.Error("Failed to make request to Microsoft Graph API", err,
+		s.logger.WithContext(ctx).Error("Failed to make request to Microsoft Graph API", err,
 			logger.Field("url", userInfoURL))
 		return nil, fmt.Errorf("failed to get user info from Microsoft: %w", err)
 	}
@@ -404,7 +706,7 @@ func (s *authService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 	// Check response status
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		s.logger.Error("Microsoft API error", nil,
+		s.logger.WithContext(ctx).Error("Microsoft API error", nil,
 			logger.Field("status", resp.StatusCode),
 			logger.Field("body", string(body)))
 		return nil, fmt.Errorf("Microsoft API error (status %d): %s", resp.StatusCode, string(body))
@@ -429,44 +731,23 @@ func (s *authService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 		user.Name = displayName
 	}
 
+	// Microsoft accounts must confirm their address before they can sign in, so mail is trusted;
+	// the userPrincipalName is a sign-in name that need not be a working address
 	if mail, ok := result["mail"].(string); ok {
 		user.Email = mail
+		user.EmailVerified = true
 	} else if userPrincipalName, ok := result["userPrincipalName"].(string); ok {
 		// Fallback to userPrincipalName if mail is not available
 		user.Email = userPrincipalName
 	}
 
 	// Get photo (requires a separate API call)
-	photoURL := "https://graph.microsoft.com/beta/me/photo/$value"
-	s.logger.Info("Getting Microsoft user photo", logger.Field("url", photoURL))
-
-	photoReq, err := http.NewRequestWithContext(ctx, "GET", photoURL, nil)
+	avatar, err := getMicrosoftPhoto(ctx, client, accessToken)
 	if err != nil {
-		s.logger.Error("Failed to create photo request", err)
 		// Continue without photo, not a critical error
+		s.logger.WithContext(ctx).Warn("Failed to get user photo", logger.Field("error", err.Error()))
 	} else {
-		photoReq.Header.Add("Authorization", "Bearer "+accessToken)
-		photoReq.Header.Add("Accept", "application/json")
-
-		photoResp, err := client.Do(photoReq)
-		if err != nil {
-			s.logger.Error("Failed to get user photo", err)
-			// Continue without photo, not a critical error
-		} else {
-			defer photoResp.Body.Close()
-
-			if photoResp.StatusCode == http.StatusOK {
-				s.logger.Info("Photo request successful")
-				// If photo is available, construct a data URL
-				// In a real app, you might want to save this to a CDN or file storage
-				user.Avatar = fmt.Sprintf("https://graph.microsoft.com/beta/me/photo/$value")
-			} else {
-				body, _ := io.ReadAll(photoResp.Body)
-				s.logger.Error("Failed to get user photo", nil,
-					logger.Field("status", photoResp.StatusCode),
-					logger.Field("body", string(body)))
-			}
-		}
+		user.Avatar = avatar
 	}
 
 	// Validate required fields
@@ -477,13 +758,22 @@ func (s *authService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 	return user, nil
 }
 
-// generateJWT generates a JWT token for the user
-func (s *authService) generateJWT(userID string) (string, error) {
+// generateJWT generates a JWT token for the user. The role claim lets the gateway and the
+// other services authorize admin-only operations; it is fixed until the token expires.
+func (s *authService) generateJWT(user *models.User) (string, error) {
+	// Generate a unique token ID so the token can be revoked
+	jti, err := generateTokenID()
+	if err != nil {
+		return "", err
+	}
+
 	// Create token
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub": userID,                                 // Subject (user ID)
-		"iat": time.Now().Unix(),                      // Issued at
-		"exp": time.Now().Add(s.jwtExpiration).Unix(), // Expiration
+		"jti":  jti,                                    // Token ID
+		"sub":  user.ID,                                // Subject (user ID)
+		"role": user.Role,                              // Role (user or admin)
+		"iat":  time.Now().Unix(),                      // Issued at
+		"exp":  time.Now().Add(s.jwtExpiration).Unix(), // Expiration
 	})
 
 	// Sign token
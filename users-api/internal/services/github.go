@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+const (
+	// gitHubUserURL returns the profile of the authenticated GitHub user
+	gitHubUserURL = "https://api.github.com/user"
+
+	// gitHubEmailsURL lists the email addresses of the authenticated GitHub user,
+	// including private ones that are missing from the profile
+	gitHubEmailsURL = "https://api.github.com/user/emails"
+)
+
+// newGitHubConfig creates the OAuth2 configuration for GitHub
+func newGitHubConfig(clientID, clientSecret string, scopes []string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint:     github.Endpoint,
+		Scopes:       oauthScopes(scopes, defaultGitHubScopes),
+	}
+}
+
+// gitHubUser is the subset of the GitHub user profile that we use
+type gitHubUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// gitHubEmail is an entry of the GitHub user emails list
+type gitHubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// getGitHubUserInfo retrieves user information from GitHub OAuth.
+// The email is always the primary verified address from the emails list, since the
+// profile email is optional, may be unverified and is empty when the user keeps it private.
+func getGitHubUserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	// Get the user's profile
+	var user gitHubUser
+	if err := getGitHubJSON(ctx, client, gitHubUserURL, accessToken, &user); err != nil {
+		return nil, err
+	}
+
+	// Get the user's email addresses
+	var emails []gitHubEmail
+	if err := getGitHubJSON(ctx, client, gitHubEmailsURL, accessToken, &emails); err != nil {
+		return nil, err
+	}
+
+	// Extract user info
+	userInfo := &UserInfo{
+		Name:   user.Name,
+		Avatar: user.AvatarURL,
+	}
+
+	if user.ID != 0 {
+		userInfo.ID = strconv.FormatInt(user.ID, 10)
+	}
+
+	// Not every GitHub user sets a display name
+	if userInfo.Name == "" {
+		userInfo.Name = user.Login
+	}
+
+	for _, email := range emails {
+		if email.Primary && email.Verified {
+			userInfo.Email = email.Email
+			userInfo.EmailVerified = true
+			break
+		}
+	}
+
+	// Validate required fields
+	if userInfo.ID == "" || userInfo.Email == "" {
+		return nil, errors.New("incomplete user info from GitHub")
+	}
+
+	return userInfo, nil
+}
+
+// getGitHubJSON calls a GitHub API endpoint on behalf of the user and decodes the response into v
+func getGitHubJSON(ctx context.Context, client *http.Client, url, accessToken string, v interface{}) error {
+	// Create request
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Add authorization header
+	req.Header.Add("Authorization", "Bearer "+accessToken)
+	req.Header.Add("Accept", "application/vnd.github+json")
+
+	// Make the request
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get user info from GitHub: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("GitHub API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse response
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse GitHub user info: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"users-api/internal/repository"
+	"users-api/internal/testutil"
+	"users-api/internal/utils/logger"
+)
+
+// testService is a user service over a fresh database
+type testService struct {
+	*userService
+	db *gorm.DB
+}
+
+// newTestService returns a user service over a fresh, migrated database
+func newTestService(tb testing.TB) *testService {
+	tb.Helper()
+
+	db := testutil.NewDB(tb)
+	if _, err := repository.AutoMigrate(db); err != nil {
+		tb.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	service := NewUserService(
+		repository.NewUserRepository(db),
+		nil,
+		&logger.Logger{Logger: zap.NewNop()},
+		"secret", time.Hour,
+		"", "", nil,
+		"", "", nil,
+		AppleConfig{},
+		false,
+		"",
+	)
+
+	return &testService{userService: service.(*userService), db: db}
+}
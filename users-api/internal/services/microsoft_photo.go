@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// microsoftPhotoURL serves the signed-in user's profile photo in a size that is small
+	// enough to keep inline. The bare photo endpoint returns the largest available size.
+	microsoftPhotoURL = "https://graph.microsoft.com/v1.0/me/photos/120x120/$value"
+
+	// maxAvatarPhotoBytes caps the size of a photo stored inline as a data URL
+	maxAvatarPhotoBytes = 32 << 10
+)
+
+// getMicrosoftPhoto downloads the user's Microsoft profile photo and returns it as a data URL.
+// There is no media storage for avatars, and the Graph URL itself only works with the user's
+// access token, so the image has to be stored inline. Any failure yields an empty avatar
+// rather than a link that cannot be loaded.
+func getMicrosoftPhoto(ctx context.Context, client *http.Client, accessToken string) (string, error) {
+	// Create request
+	req, err := http.NewRequestWithContext(ctx, "GET", microsoftPhotoURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Add authorization header
+	req.Header.Add("Authorization", "Bearer "+accessToken)
+
+	// Make the request
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user photo from Microsoft: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Users without a photo get a 404
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Microsoft API error (status %d)", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", fmt.Errorf("unexpected photo content type %q", contentType)
+	}
+
+	// Read one byte past the limit to detect oversized photos
+	photo, err := io.ReadAll(io.LimitReader(resp.Body, maxAvatarPhotoBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read user photo: %w", err)
+	}
+	if len(photo) > maxAvatarPhotoBytes {
+		return "", fmt.Errorf("user photo exceeds %d bytes", maxAvatarPhotoBytes)
+	}
+
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(photo), nil
+}
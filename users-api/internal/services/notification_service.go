@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"users-api/internal/models"
+	"users-api/internal/repository"
+	"users-api/internal/utils/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// NotificationService defines the interface for notification service operations
+type NotificationService interface {
+	CreateNotification(ctx context.Context, userID, actorID, notificationType, targetID string) (*models.Notification, error)
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, page, limit int) ([]*NotificationResult, int64, int32, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	MarkRead(ctx context.Context, userID, notificationID string) error
+	MarkAllRead(ctx context.Context, userID string) error
+}
+
+// NotificationResult is a notification along with the user who caused it, if they still exist
+type NotificationResult struct {
+	Notification *models.Notification
+	Actor        *models.User
+}
+
+// notificationService implements the NotificationService interface
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	userRepo         repository.UserRepository
+	logger           *logger.Logger
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(notificationRepo repository.NotificationRepository, userRepo repository.UserRepository, logger *logger.Logger) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		logger:           logger,
+	}
+}
+
+// CreateNotification notifies a user of something the actor did. Users are not notified of
+// their own actions, in which case no notification is created and nil is returned.
+func (s *notificationService) CreateNotification(ctx context.Context, userID, actorID, notificationType, targetID string) (*models.Notification, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if !models.IsValidNotificationType(notificationType) {
+		return nil, status.Error(codes.InvalidArgument, "unsupported notification type")
+	}
+	if userID == actorID {
+		return nil, nil
+	}
+
+	if _, err := s.userRepo.FindByID(ctx, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to get user", err)
+		return nil, err
+	}
+
+	notification := &models.Notification{
+		UserID:   userID,
+		Type:     notificationType,
+		ActorID:  actorID,
+		TargetID: targetID,
+	}
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to create notification", err)
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+// GetNotifications retrieves a user's notifications with the users who caused them, newest first
+func (s *notificationService) GetNotifications(ctx context.Context, userID string, unreadOnly bool, page, limit int) ([]*NotificationResult, int64, int32, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 20
+	}
+
+	notifications, count, err := s.notificationRepo.FindByUser(ctx, userID, unreadOnly, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get notifications", err)
+		return nil, 0, 0, err
+	}
+
+	// Look up all actors at once
+	actorIDs := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		actorIDs = append(actorIDs, notification.ActorID)
+	}
+	actors := make(map[string]*models.User, len(actorIDs))
+	if len(actorIDs) > 0 {
+		users, err := s.userRepo.FindByIDs(ctx, actorIDs)
+		if err != nil {
+			// The notifications are still useful without the actors' names
+			s.logger.WithContext(ctx).Warn("Failed to look up notification actors", logger.Field("error", err.Error()))
+		}
+		for _, user := range users {
+			actors[user.ID] = user
+		}
+	}
+
+	results := make([]*NotificationResult, len(notifications))
+	for i, notification := range notifications {
+		results[i] = &NotificationResult{
+			Notification: notification,
+			Actor:        actors[notification.ActorID],
+		}
+	}
+
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return results, count, totalPages, nil
+}
+
+// CountUnread counts a user's unread notifications
+func (s *notificationService) CountUnread(ctx context.Context, userID string) (int64, error) {
+	count, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count unread notifications", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// MarkRead marks one of a user's notifications as read
+func (s *notificationService) MarkRead(ctx context.Context, userID, notificationID string) error {
+	if notificationID == "" {
+		return status.Error(codes.InvalidArgument, "notification ID is required")
+	}
+
+	if err := s.notificationRepo.MarkRead(ctx, notificationID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "notification not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to mark notification as read", err)
+		return err
+	}
+	return nil
+}
+
+// MarkAllRead marks all of a user's notifications as read
+func (s *notificationService) MarkAllRead(ctx context.Context, userID string) error {
+	marked, err := s.notificationRepo.MarkAllRead(ctx, userID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to mark notifications as read", err)
+		return err
+	}
+
+	s.logger.WithContext(ctx).Debug("Notifications marked as read", logger.Field("count", marked))
+	return nil
+}
\ No newline at end of file
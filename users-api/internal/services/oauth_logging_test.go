@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"users-api/internal/utils/logger"
+)
+
+// debugOnlyFields are the details of a Microsoft sign-in that are only logged at debug level
+var debugOnlyFields = []string{"redirect_url", "scopes", "token_url", "token_type", "expiry"}
+
+// logMicrosoftCallback runs a Microsoft callback logged at the given level, against a token
+// endpoint that hands out accessToken, and returns the entries written
+func logMicrosoftCallback(t *testing.T, level zapcore.Level, accessToken string) []observer.LoggedEntry {
+	t.Helper()
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, accessToken)
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	core, logs := observer.New(level)
+	s := newScopedAuthService(nil, nil, nil)
+	s.logger = &logger.Logger{Logger: zap.New(core)}
+	s.microsoftConfig.Endpoint.TokenURL = tokenServer.URL
+
+	_, state, err := s.MicrosoftLogin(context.Background(), "https://app.example.com/callback")
+	if err != nil {
+		t.Fatalf("MicrosoftLogin: %v", err)
+	}
+
+	// The user info request is cancelled, so the callback stops after the token exchange
+	// instead of calling Microsoft Graph
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := s.MicrosoftCallback(ctx, state, "code"); err == nil {
+		t.Fatal("MicrosoftCallback succeeded without user info")
+	}
+
+	return logs.All()
+}
+
+func TestMicrosoftCallbackLogsDetailsOnlyAtDebugLevel(t *testing.T) {
+	for _, level := range []zapcore.Level{zapcore.InfoLevel, zapcore.DebugLevel} {
+		t.Run(level.String(), func(t *testing.T) {
+			const accessToken = "access-token-value"
+			entries := logMicrosoftCallback(t, level, accessToken)
+
+			logged := make(map[string]bool)
+			for _, entry := range entries {
+				for key, value := range entry.ContextMap() {
+					logged[key] = true
+
+					// The client ID and the token itself are never logged, nor are token lengths
+					text := fmt.Sprint(value)
+					if strings.Contains(text, "microsoft-id") || strings.Contains(text, accessToken) {
+						t.Errorf("%q logged field %s=%q", entry.Message, key, text)
+					}
+					if strings.Contains(key, "client_id") || strings.Contains(key, "length") {
+						t.Errorf("%q logged field %s", entry.Message, key)
+					}
+				}
+			}
+
+			for _, field := range debugOnlyFields {
+				if want := level == zapcore.DebugLevel; logged[field] != want {
+					t.Errorf("field %s logged: %v, want %v", field, logged[field], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package services
+
+var (
+	// defaultGoogleScopes are the OpenID Connect scopes needed to read a Google user's profile and email
+	defaultGoogleScopes = []string{"openid", "profile", "email"}
+
+	// defaultMicrosoftScopes add the Graph User.Read scope, which the profile and photo calls need
+	defaultMicrosoftScopes = []string{"openid", "profile", "email", "User.Read"}
+
+	// defaultGitHubScopes allow reading the GitHub profile and the private email addresses
+	defaultGitHubScopes = []string{"read:user", "user:email"}
+)
+
+// oauthScopes returns the configured scopes of a provider, or its defaults when none are configured
+func oauthScopes(configured, defaults []string) []string {
+	if len(configured) == 0 {
+		return defaults
+	}
+	return configured
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"users-api/internal/utils/logger"
+)
+
+// newScopedAuthService returns an auth service with the given scopes configured for Google,
+// Microsoft and GitHub
+func newScopedAuthService(google, microsoft, github []string) *authService {
+	service := NewAuthService(nil, nil, &logger.Logger{Logger: zap.NewNop()}, "secret", time.Hour,
+		"google-id", "", google,
+		"microsoft-id", "", microsoft,
+		"github-id", "", github,
+		AppleConfig{}, false)
+	return service.(*authService)
+}
+
+// scopeOf returns the scope parameter of an auth URL
+func scopeOf(t *testing.T, authURL string) string {
+	t.Helper()
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("failed to parse auth URL %q: %v", authURL, err)
+	}
+	return parsed.Query().Get("scope")
+}
+
+func TestAuthURLsRequestProviderScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		google    []string
+		microsoft []string
+		github    []string
+		want      map[string]string
+	}{
+		{
+			name: "defaults",
+			want: map[string]string{
+				"google":    "openid profile email",
+				"microsoft": "openid profile email User.Read",
+				"github":    "read:user user:email",
+			},
+		},
+		{
+			name:      "configured",
+			google:    []string{"openid", "email", "https://www.googleapis.com/auth/calendar.readonly"},
+			microsoft: []string{"openid", "User.Read", "Calendars.Read"},
+			github:    []string{"read:user"},
+			want: map[string]string{
+				"google":    "openid email https://www.googleapis.com/auth/calendar.readonly",
+				"microsoft": "openid User.Read Calendars.Read",
+				"github":    "read:user",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newScopedAuthService(tt.google, tt.microsoft, tt.github)
+			ctx := context.Background()
+			logins := map[string]func(ctx context.Context, redirectURL string) (string, string, error){
+				"google":    s.GoogleLogin,
+				"microsoft": s.MicrosoftLogin,
+				"github":    s.GitHubLogin,
+			}
+			for provider, login := range logins {
+				authURL, _, err := login(ctx, "https://app.example.com/callback")
+				if err != nil {
+					t.Fatalf("%s login: %v", provider, err)
+				}
+				if got := scopeOf(t, authURL); got != tt.want[provider] {
+					t.Errorf("%s: got scope %q, want %q", provider, got, tt.want[provider])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+	"users-api/internal/models"
+	"users-api/internal/repository"
+	"users-api/internal/utils/logger"
+)
+
+// Defaults for when the presence settings are not configured
+const (
+	defaultOnlineWindow       = 5 * time.Minute
+	defaultPresenceFlushDelay = 15 * time.Second
+)
+
+// PresenceService defines the interface for tracking when users were last active
+type PresenceService interface {
+	// MarkSeen records that a user is active. The last seen time is written with the next flush.
+	MarkSeen(userID string)
+	// IsOnline reports whether a user was active within the online window
+	IsOnline(user *models.User) bool
+	// Run flushes the recorded activity periodically until ctx is done, then flushes once more
+	Run(ctx context.Context)
+}
+
+// presenceService implements the PresenceService interface. Activity is buffered in memory and
+// written in one statement per flush, so a user making many requests costs at most one write
+// per flush interval.
+type presenceService struct {
+	userRepo      repository.UserRepository
+	logger        *logger.Logger
+	onlineWindow  time.Duration
+	flushInterval time.Duration
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+}
+
+// NewPresenceService creates a new presence service; zero durations fall back to the defaults
+func NewPresenceService(userRepo repository.UserRepository, logger *logger.Logger, onlineWindow, flushInterval time.Duration) PresenceService {
+	if onlineWindow <= 0 {
+		onlineWindow = defaultOnlineWindow
+	}
+	if flushInterval <= 0 {
+		flushInterval = defaultPresenceFlushDelay
+	}
+
+	return &presenceService{
+		userRepo:      userRepo,
+		logger:        logger,
+		onlineWindow:  onlineWindow,
+		flushInterval: flushInterval,
+		pending:       make(map[string]struct{}),
+	}
+}
+
+// MarkSeen records that a user is active
+func (s *presenceService) MarkSeen(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending[userID] = struct{}{}
+}
+
+// IsOnline reports whether a user was active within the online window
+func (s *presenceService) IsOnline(user *models.User) bool {
+	return user.IsOnline(s.onlineWindow)
+}
+
+// Run flushes the recorded activity periodically until ctx is done, then flushes once more
+func (s *presenceService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flush(ctx)
+		case <-ctx.Done():
+			// Write what was recorded since the last flush before shutting down
+			s.flush(context.Background())
+			return
+		}
+	}
+}
+
+// flush writes the last seen time of the users recorded since the previous flush. The flush
+// time is used for all of them, which is accurate to within the flush interval.
+func (s *presenceService) flush(ctx context.Context) {
+	s.mu.Lock()
+	if len(s.pending) == 0 {
+		s.mu.Unlock()
+		return
+	}
+	userIDs := make([]string, 0, len(s.pending))
+	for userID := range s.pending {
+		userIDs = append(userIDs, userID)
+	}
+	s.pending = make(map[string]struct{})
+	s.mu.Unlock()
+
+	if err := s.userRepo.UpdateLastSeen(ctx, userIDs, time.Now()); err != nil {
+		// Presence is best effort; the users are recorded again on their next request
+		s.logger.Error("Failed to update last seen times", err, logger.Field("users", len(userIDs)))
+	}
+}
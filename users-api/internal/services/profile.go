@@ -0,0 +1,121 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maximum lengths, in characters, of the free-form profile fields
+const (
+	maxBioLength      = 300
+	maxLocationLength = 100
+	maxWebsiteLength  = 255
+)
+
+// ProfileUpdate holds the fields of a profile update. Name and Avatar are left unchanged when
+// empty; the other fields are left unchanged when nil and cleared when empty.
+type ProfileUpdate struct {
+	Name     string
+	Avatar   string
+	Bio      *string
+	Location *string
+	Website  *string
+}
+
+// validate sanitizes the free-form fields of the update and checks their lengths and formats
+func (u *ProfileUpdate) validate() error {
+	if u.Bio != nil {
+		bio := sanitizeContent(*u.Bio)
+		if utf8.RuneCountInString(bio) > maxBioLength {
+			return status.Errorf(codes.InvalidArgument, "bio must be at most %d characters", maxBioLength)
+		}
+		u.Bio = &bio
+	}
+	if u.Location != nil {
+		location := sanitizeContent(*u.Location)
+		if utf8.RuneCountInString(location) > maxLocationLength {
+			return status.Errorf(codes.InvalidArgument, "location must be at most %d characters", maxLocationLength)
+		}
+		u.Location = &location
+	}
+	if u.Website != nil {
+		website := strings.TrimSpace(*u.Website)
+		if website != "" && !isWebsiteURL(website) {
+			return status.Error(codes.InvalidArgument, "website must be a valid http or https URL")
+		}
+		if utf8.RuneCountInString(website) > maxWebsiteLength {
+			return status.Errorf(codes.InvalidArgument, "website must be at most %d characters", maxWebsiteLength)
+		}
+		u.Website = &website
+	}
+	return nil
+}
+
+// isWebsiteURL reports whether s is an absolute http or https URL, so that a profile can never
+// link to a javascript: or data: URL
+func isWebsiteURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// strippedElements are removed together with their content, which is code rather than text
+var strippedElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"iframe":   true,
+	"object":   true,
+	"embed":    true,
+	"noscript": true,
+	"template": true,
+	"svg":      true,
+	"math":     true,
+}
+
+// sanitizeContent strips HTML from user-written text, which is stored and rendered as plain text.
+// Tags, comments and doctypes are dropped, as is the content of elements such as script. The
+// remaining text is kept as written, entities included, so nothing is unescaped into markup.
+func sanitizeContent(content string) string {
+	// Skip the tokenizer for the common case of text without markup
+	if !strings.Contains(content, "<") {
+		return strings.TrimSpace(content)
+	}
+
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(content))
+	skipping, depth := "", 0
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// The reader never fails, so this is the end of the content
+			return strings.TrimSpace(b.String())
+		case html.TextToken:
+			if skipping == "" {
+				b.Write(z.Raw())
+			}
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if skipping == "" && strippedElements[tag] {
+				skipping, depth = tag, 1
+			} else if tag == skipping {
+				depth++
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if string(name) == skipping {
+				depth--
+				if depth == 0 {
+					skipping = ""
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"users-api/internal/models"
+)
+
+func TestProfileUpdateValidation(t *testing.T) {
+	text := func(s string) *string { return &s }
+
+	tests := []struct {
+		name   string
+		update ProfileUpdate
+		want   codes.Code
+	}{
+		{"bio at the limit", ProfileUpdate{Bio: text(strings.Repeat("é", maxBioLength))}, codes.OK},
+		{"over-length bio", ProfileUpdate{Bio: text(strings.Repeat("b", maxBioLength+1))}, codes.InvalidArgument},
+		{"over-length location", ProfileUpdate{Location: text(strings.Repeat("l", maxLocationLength+1))}, codes.InvalidArgument},
+		{"https website", ProfileUpdate{Website: text("https://alice.example.com/about")}, codes.OK},
+		{"cleared website", ProfileUpdate{Website: text("")}, codes.OK},
+		{"website without a scheme", ProfileUpdate{Website: text("alice.example.com")}, codes.InvalidArgument},
+		{"javascript website", ProfileUpdate{Website: text("javascript:alert(1)")}, codes.InvalidArgument},
+		{"ftp website", ProfileUpdate{Website: text("ftp://alice.example.com")}, codes.InvalidArgument},
+		{"website without a host", ProfileUpdate{Website: text("https://")}, codes.InvalidArgument},
+		{"over-length website", ProfileUpdate{Website: text("https://example.com/" + strings.Repeat("p", maxWebsiteLength))}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.update.validate(); status.Code(err) != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileUpdateSanitizesBio(t *testing.T) {
+	bio := "  Hello <b>there</b><script>alert(1)</script>  "
+	update := ProfileUpdate{Bio: &bio}
+	if err := update.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if want := "Hello there"; *update.Bio != want {
+		t.Errorf("got bio %q, want %q", *update.Bio, want)
+	}
+
+	// Markup does not count towards the limit, only the text that is kept
+	long := "<p>" + strings.Repeat("b", maxBioLength) + "</p>"
+	update = ProfileUpdate{Bio: &long}
+	if err := update.validate(); err != nil {
+		t.Errorf("bio at the limit once its markup is stripped: %v", err)
+	}
+}
+
+func TestUpdateProfileRejectsInvalidFieldsWithoutSaving(t *testing.T) {
+	s := newTestService(t)
+
+	ctx := context.Background()
+	user := &models.User{Name: "Alice", Email: "alice@example.com", Provider: "google", Bio: "old bio", Website: "https://old.example.com"}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	bio := strings.Repeat("b", maxBioLength+1)
+	if _, err := s.UpdateProfile(ctx, user.ID, ProfileUpdate{Name: "Alicia", Bio: &bio}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("over-length bio: got %v, want InvalidArgument", err)
+	}
+	website := "not a url"
+	if _, err := s.UpdateProfile(ctx, user.ID, ProfileUpdate{Name: "Alicia", Website: &website}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid website: got %v, want InvalidArgument", err)
+	}
+
+	stored, err := s.userRepo.FindByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if stored.Name != "Alice" || stored.Bio != "old bio" || stored.Website != "https://old.example.com" {
+		t.Errorf("a rejected update changed the profile to %q, %q, %q", stored.Name, stored.Bio, stored.Website)
+	}
+
+	// A valid update of the same fields is saved
+	bio, website = "new bio", "https://new.example.com"
+	updated, err := s.UpdateProfile(ctx, user.ID, ProfileUpdate{Bio: &bio, Website: &website})
+	if err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+	if updated.Bio != bio || updated.Website != website {
+		t.Errorf("got bio %q and website %q, want %q and %q", updated.Bio, updated.Website, bio, website)
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// stateStore keeps OAuth state tokens for CSRF protection and is safe for concurrent use
+type stateStore struct {
+	mu     sync.RWMutex
+	ttl    time.Duration
+	states map[string]time.Time
+}
+
+// newStateStore creates a state store whose tokens expire after ttl
+func newStateStore(ttl time.Duration) *stateStore {
+	return &stateStore{
+		ttl:    ttl,
+		states: make(map[string]time.Time),
+	}
+}
+
+// Save stores a state token with the current timestamp
+func (s *stateStore) Save(state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[state] = time.Now()
+}
+
+// Validate reports whether the state token exists and has not expired.
+// A token can only be validated once; it is removed on first use.
+func (s *stateStore) Validate(state string) bool {
+	// Reject unknown tokens without taking the write lock
+	s.mu.RLock()
+	_, exists := s.states[state]
+	s.mu.RUnlock()
+	if !exists {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another request may have consumed the token in the meantime
+	timestamp, exists := s.states[state]
+	if !exists {
+		return false
+	}
+
+	// Remove the token after use
+	delete(s.states, state)
+
+	return time.Since(timestamp) <= s.ttl
+}
+
+// Cleanup removes all tokens older than the validity window
+func (s *stateStore) Cleanup() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for state, timestamp := range s.states {
+		if time.Since(timestamp) > s.ttl {
+			delete(s.states, state)
+		}
+	}
+}
+
+// runCleanup calls Cleanup on every tick of the given interval
+func (s *stateStore) runCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.Cleanup()
+	}
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Run with -race: the store is used from every login and callback handler at once
+func TestStateStoreConcurrentUse(t *testing.T) {
+	store := newStateStore(time.Minute)
+
+	const workers, perWorker = 16, 200
+	var validated atomic.Int32
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				state := fmt.Sprintf("state-%d-%d", w, i)
+				store.Save(state)
+				if store.Validate(state) {
+					validated.Add(1)
+				}
+				if store.Validate(state) {
+					t.Errorf("state %s validated twice", state)
+				}
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				store.Cleanup()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := validated.Load(); got != workers*perWorker {
+		t.Errorf("validated %d states, want %d", got, workers*perWorker)
+	}
+}
+
+func TestStateStoreValidatesStateOnce(t *testing.T) {
+	store := newStateStore(time.Minute)
+	store.Save("state")
+
+	// Of many callbacks racing with the same state, exactly one gets through
+	var validated atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if store.Validate("state") {
+				validated.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := validated.Load(); got != 1 {
+		t.Errorf("state validated %d times, want 1", got)
+	}
+}
+
+func TestStateStoreExpiresStates(t *testing.T) {
+	store := newStateStore(time.Millisecond)
+	store.Save("expired")
+	store.Save("cleaned")
+	time.Sleep(5 * time.Millisecond)
+
+	if store.Validate("expired") {
+		t.Error("expired state validated")
+	}
+
+	store.Cleanup()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	if len(store.states) != 0 {
+		t.Errorf("cleanup left %d expired states", len(store.states))
+	}
+}
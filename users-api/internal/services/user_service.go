@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
+	"users-api/internal/clients"
 	"users-api/internal/models"
 	"users-api/internal/repository"
 	"users-api/internal/utils/logger"
@@ -15,43 +17,93 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 // UserService defines the interface for user service operations
 type UserService interface {
-	Register(ctx context.Context, provider, token string) (string, string, error)
-	Login(ctx context.Context, provider, token string) (string, string, error)
+	Register(ctx context.Context, provider, token, name string) (string, string, error)
+	Login(ctx context.Context, provider, token, name string) (string, string, error)
 	GetProfile(ctx context.Context, userID string) (*models.User, error)
-	UpdateProfile(ctx context.Context, userID, name, avatar string) (*models.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]*models.User, error)
+	GetUsersByUsernames(ctx context.Context, usernames []string) ([]*models.User, error)
+	UpdateProfile(ctx context.Context, userID string, update ProfileUpdate) (*models.User, error)
+	SearchUsers(ctx context.Context, userID, query string, page, limit int) ([]*UserSearchResult, int64, int32, error)
+	GetPublicProfile(ctx context.Context, viewerID, userID string) (*PublicProfile, error)
+	DeleteAccount(ctx context.Context, userID string) error
+	SetUserRole(ctx context.Context, adminID, userID, role string) (*models.User, error)
+	BootstrapAdmin(ctx context.Context, email string) error
 }
 
+// UserSearchResult is a user found by SearchUsers along with their relationship to the searcher
+type UserSearchResult struct {
+	User             *models.User
+	FriendshipStatus string
+}
+
+// PublicProfile is a user as another user sees them. Email is empty unless the viewer may see it.
+type PublicProfile struct {
+	User             *models.User
+	Email            string
+	FriendshipStatus string
+	Follows          *clients.FollowCounts // nil if the friends service could not be reached
+}
+
+// Who sees a user's email on their public profile
+const (
+	EmailVisibleToEveryone = "everyone"
+	EmailVisibleToFriends  = "friends"
+	EmailVisibleToNobody   = "nobody"
+)
+
 // userService implements the UserService interface
 type userService struct {
 	userRepo        repository.UserRepository
+	friendClient    clients.FriendClient
 	logger          *logger.Logger
 	jwtSecret       string
 	jwtExpiration   time.Duration
 	googleConfig    *oauth2.Config
 	microsoftConfig *oauth2.Config
+	apple           AppleConfig
+	appleKeys       *appleKeySet
+
+	// requireVerifiedEmail rejects sign-ins whose email the provider has not verified
+	requireVerifiedEmail bool
+
+	// emailVisibility is who sees a user's email on their public profile
+	emailVisibility string
 }
 
 // NewUserService creates a new user service
 func NewUserService(
 	userRepo repository.UserRepository,
+	friendClient clients.FriendClient,
 	logger *logger.Logger,
 	jwtSecret string,
 	jwtExpiration time.Duration,
 	googleClientID string,
 	googleClientSecret string,
+	googleScopes []string,
 	microsoftClientID string,
 	microsoftClientSecret string,
+	microsoftScopes []string,
+	apple AppleConfig,
+	requireVerifiedEmail bool,
+	emailVisibility string,
 ) UserService {
+	if emailVisibility == "" {
+		emailVisibility = EmailVisibleToFriends
+	}
+
 	// Configure Google OAuth2
 	googleConfig := &oauth2.Config{
 		ClientID:     googleClientID,
 		ClientSecret: googleClientSecret,
 		Endpoint:     google.Endpoint,
-		Scopes:       []string{"User.Read", "profile", "email", "openid"},
+		Scopes:       oauthScopes(googleScopes, defaultGoogleScopes),
 	}
 
 	// Configure Microsoft OAuth2
@@ -62,32 +114,51 @@ func NewUserService(
 			AuthURL:  "https://login.microsoftonline.com/consumers/oauth2/v2.0/authorize",
 			TokenURL: "https://login.microsoftonline.com/consumers/oauth2/v2.0/token",
 		},
-		Scopes: []string{"User.Read", "profile", "email", "openid"},
+		Scopes: oauthScopes(microsoftScopes, defaultMicrosoftScopes),
 	}
 
 	return &userService{
 		userRepo:        userRepo,
+		friendClient:    friendClient,
 		logger:          logger,
 		jwtSecret:       jwtSecret,
 		jwtExpiration:   jwtExpiration,
 		googleConfig:    googleConfig,
 		microsoftConfig: microsoftConfig,
+		apple:           apple,
+		appleKeys:       newAppleKeySet(),
+
+		requireVerifiedEmail: requireVerifiedEmail,
+		emailVisibility:      emailVisibility,
 	}
 }
 
-// Register registers a new user with OAuth provider
-func (s *userService) Register(ctx context.Context, provider, token string) (string, string, error) {
+// isSupportedProvider reports whether users can register and log in with the given OAuth provider
+func isSupportedProvider(provider string) bool {
+	switch provider {
+	case "google", "microsoft", "github", "apple":
+		return true
+	}
+	return false
+}
+
+// Register registers a new user with OAuth provider.
+// The name is only used when the provider does not return one, as with Apple.
+func (s *userService) Register(ctx context.Context, provider, token, name string) (string, string, error) {
 	// Validate provider
-	if provider != "google" && provider != "microsoft" {
+	if !isSupportedProvider(provider) {
 		return "", "", errors.New("invalid provider")
 	}
 
 	// Get user info from OAuth provider
 	userInfo, err := s.getUserInfoFromOAuth(ctx, provider, token)
 	if err != nil {
-		s.logger.Error("Failed to get user info from OAuth", err)
+		s.logger.WithContext(ctx).Error("Failed to get user info from OAuth", err)
 		return "", "", err
 	}
+	if s.requireVerifiedEmail && !userInfo.EmailVerified {
+		return "", "", errEmailNotVerified
+	}
 
 	// Check if user already exists
 	_, err = s.userRepo.FindByEmail(ctx, userInfo.Email)
@@ -97,23 +168,27 @@ func (s *userService) Register(ctx context.Context, provider, token string) (str
 	}
 
 	// Create new user
+	if userInfo.Name == "" {
+		userInfo.Name = name
+	}
 	user := &models.User{
-		Name:     userInfo.Name,
-		Email:    userInfo.Email,
-		Avatar:   userInfo.Avatar,
-		Provider: provider,
+		Name:          userInfo.Name,
+		Email:         userInfo.Email,
+		EmailVerified: userInfo.EmailVerified,
+		Avatar:        userInfo.Avatar,
+		Provider:      provider,
 	}
 
 	// Save user to database
 	if err := s.userRepo.Create(ctx, user); err != nil {
-		s.logger.Error("Failed to create user", err)
+		s.logger.WithContext(ctx).Error("Failed to create user", err)
 		return "", "", err
 	}
 
 	// Generate JWT token
-	accessToken, err := s.generateJWT(user.ID)
+	accessToken, err := s.generateJWT(user)
 	if err != nil {
-		s.logger.Error("Failed to generate JWT", err)
+		s.logger.WithContext(ctx).Error("Failed to generate JWT", err)
 		return "", "", err
 	}
 
@@ -121,30 +196,45 @@ func (s *userService) Register(ctx context.Context, provider, token string) (str
 }
 
 // Login authenticates a user with OAuth provider
-func (s *userService) Login(ctx context.Context, provider, token string) (string, string, error) {
+func (s *userService) Login(ctx context.Context, provider, token, name string) (string, string, error) {
 	// Validate provider
-	if provider != "google" && provider != "microsoft" {
+	if !isSupportedProvider(provider) {
 		return "", "", errors.New("invalid provider")
 	}
 
 	// Get user info from OAuth provider
 	userInfo, err := s.getUserInfoFromOAuth(ctx, provider, token)
 	if err != nil {
-		s.logger.Error("Failed to get user info from OAuth", err)
+		s.logger.WithContext(ctx).Error("Failed to get user info from OAuth", err)
 		return "", "", err
 	}
+	if s.requireVerifiedEmail && !userInfo.EmailVerified {
+		return "", "", errEmailNotVerified
+	}
 
 	// Find user by email
 	user, err := s.userRepo.FindByEmail(ctx, userInfo.Email)
 	if err != nil {
 		// User not found, register new user
-		return s.Register(ctx, provider, token)
+		return s.Register(ctx, provider, token, name)
+	}
+
+	if err := markEmailVerified(ctx, s.userRepo, user, userInfo.EmailVerified); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to save email verification", err)
+	}
+
+	// Apple only shares the name on the first sign-in; keep it if the account has none yet
+	if user.Name == "" && name != "" {
+		user.Name = name
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			s.logger.WithContext(ctx).Error("Failed to save user name", err)
+		}
 	}
 
 	// Generate JWT token
-	accessToken, err := s.generateJWT(user.ID)
+	accessToken, err := s.generateJWT(user)
 	if err != nil {
-		s.logger.Error("Failed to generate JWT", err)
+		s.logger.WithContext(ctx).Error("Failed to generate JWT", err)
 		return "", "", err
 	}
 
@@ -156,8 +246,22 @@ func (s *userService) GetProfile(ctx context.Context, userID string) (*models.Us
 	return s.userRepo.FindByID(ctx, userID)
 }
 
+// GetUsersByIDs retrieves several users in a single query
+func (s *userService) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*models.User, error) {
+	return s.userRepo.FindByIDs(ctx, userIDs)
+}
+
+// GetUsersByUsernames retrieves several users by username in a single query
+func (s *userService) GetUsersByUsernames(ctx context.Context, usernames []string) ([]*models.User, error) {
+	return s.userRepo.FindByUsernames(ctx, usernames)
+}
+
 // UpdateProfile updates a user's profile
-func (s *userService) UpdateProfile(ctx context.Context, userID, name, avatar string) (*models.User, error) {
+func (s *userService) UpdateProfile(ctx context.Context, userID string, update ProfileUpdate) (*models.User, error) {
+	if err := update.validate(); err != nil {
+		return nil, err
+	}
+
 	// Find user by ID
 	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -165,28 +269,234 @@ func (s *userService) UpdateProfile(ctx context.Context, userID, name, avatar st
 	}
 
 	// Update user fields
-	if name != "" {
-		user.Name = name
+	if update.Name != "" {
+		user.Name = update.Name
+	}
+	if update.Avatar != "" {
+		user.Avatar = update.Avatar
+	}
+	if update.Bio != nil {
+		user.Bio = *update.Bio
+	}
+	if update.Location != nil {
+		user.Location = *update.Location
+	}
+	if update.Website != nil {
+		user.Website = *update.Website
+	}
+
+	// Save user to database
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to update user", err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// SetUserRole changes a user's role on behalf of an admin. Admins cannot change their own role,
+// so demoting admins never leaves the system without one. The new role reaches other services
+// with the user's next token.
+func (s *userService) SetUserRole(ctx context.Context, adminID, userID, role string) (*models.User, error) {
+	if !models.IsValidRole(role) {
+		return nil, status.Error(codes.InvalidArgument, "role must be 'user' or 'admin'")
+	}
+	if userID == adminID {
+		return nil, status.Error(codes.FailedPrecondition, "admins cannot change their own role")
 	}
-	if avatar != "" {
-		user.Avatar = avatar
+
+	// Find user by ID
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, err
 	}
 
+	if user.Role == role {
+		return user, nil
+	}
+	user.Role = role
+
 	// Save user to database
 	if err := s.userRepo.Update(ctx, user); err != nil {
-		s.logger.Error("Failed to update user", err)
+		s.logger.WithContext(ctx).Error("Failed to update user role", err)
 		return nil, err
 	}
 
+	s.logger.WithContext(ctx).Info("User role changed",
+		logger.Field("target_user_id", userID),
+		logger.Field("role", role))
 	return user, nil
 }
 
+// BootstrapAdmin makes the user with the given email an admin, so that the first admin can be
+// set up through configuration. The user must have signed in at least once.
+func (s *userService) BootstrapAdmin(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user.Role == models.RoleAdmin {
+		return nil
+	}
+
+	user.Role = models.RoleAdmin
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	s.logger.Info("Bootstrapped admin", logger.Field("user_id", user.ID))
+	return nil
+}
+
+// DeleteAccount permanently deletes a user's account.
+//
+// Only the users database is cleaned up. The user's posts, comments and likes (posts-api),
+// friendships, friend requests and blocks (friends-api), and group memberships and group posts
+// (groups-api) are not removed yet. Tokens already issued are refused once the account is gone:
+// here by the auth interceptor, and at the gateway because IsTokenRevoked reports tokens of
+// missing users as revoked.
+func (s *userService) DeleteAccount(ctx context.Context, userID string) error {
+	if err := s.userRepo.Delete(ctx, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to delete user", err)
+		return err
+	}
+
+	s.logger.WithContext(ctx).Info("User account deleted")
+	return nil
+}
+
+// SearchUsers finds users by name or email, excluding the searcher, and reports whether the
+// searcher is already friends with or has a pending request with each of them
+func (s *userService) SearchUsers(ctx context.Context, userID, query string, page, limit int) ([]*UserSearchResult, int64, int32, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "search query is required")
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	users, count, err := s.userRepo.Search(ctx, query, userID, page, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to search users", err)
+		return nil, 0, 0, err
+	}
+
+	// Check the relationship with the whole page of users in one call
+	userIDs := make([]string, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+	statuses, err := s.friendClient.GetFriendshipStatuses(ctx, userID, userIDs)
+	if err != nil {
+		// The search itself succeeded; report the relationships as unknown rather than failing
+		s.logger.WithContext(ctx).Warn("Failed to check friendships", logger.Field("users", len(userIDs)), logger.Field("error", err.Error()))
+		statuses = nil
+	}
+
+	results := make([]*UserSearchResult, len(users))
+	for i, user := range users {
+		friendshipStatus, ok := statuses[user.ID]
+		if !ok {
+			friendshipStatus = "unknown"
+		}
+		results[i] = &UserSearchResult{
+			User:             user,
+			FriendshipStatus: friendshipStatus,
+		}
+	}
+
+	// Calculate total pages
+	totalPages := int32((count + int64(limit) - 1) / int64(limit))
+
+	return results, count, totalPages, nil
+}
+
+// GetPublicProfile retrieves a user's profile as the viewer sees it, with the viewer's
+// relationship to the user and the email only if the configured visibility allows it
+func (s *userService) GetPublicProfile(ctx context.Context, viewerID, userID string) (*PublicProfile, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to get user", err)
+		return nil, err
+	}
+
+	profile := &PublicProfile{User: user}
+	if viewerID == userID {
+		profile.Email = user.Email
+		profile.FriendshipStatus = "self"
+		profile.Follows = s.getFollowCounts(ctx, userID, viewerID)
+		return profile, nil
+	}
+
+	friendshipStatus, err := s.friendClient.GetFriendshipStatus(ctx, viewerID, userID)
+	if err != nil {
+		// Show the profile without the relationship, and without anything only friends may see
+		s.logger.WithContext(ctx).Warn("Failed to check friendship", logger.Field("target_user_id", userID), logger.Field("error", err.Error()))
+		friendshipStatus = "unknown"
+	}
+	profile.FriendshipStatus = friendshipStatus
+	profile.Follows = s.getFollowCounts(ctx, userID, viewerID)
+
+	switch s.emailVisibility {
+	case EmailVisibleToEveryone:
+		profile.Email = user.Email
+	case EmailVisibleToFriends:
+		if friendshipStatus == "friends" {
+			profile.Email = user.Email
+		}
+	}
+
+	return profile, nil
+}
+
+// getFollowCounts retrieves a user's follow counts, or nil if the friends service fails
+func (s *userService) getFollowCounts(ctx context.Context, userID, viewerID string) *clients.FollowCounts {
+	counts, err := s.friendClient.GetFollowCounts(ctx, userID, viewerID)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("Failed to get follow counts", logger.Field("target_user_id", userID), logger.Field("error", err.Error()))
+		return nil
+	}
+	return counts
+}
+
 // UserInfo represents user information from OAuth provider
 type UserInfo struct {
-	ID     string
-	Name   string
-	Email  string
-	Avatar string
+	ID            string
+	Name          string
+	Email         string
+	EmailVerified bool
+	Avatar        string
+}
+
+// errEmailNotVerified rejects sign-ins with an unverified email when verification is required
+var errEmailNotVerified = status.Error(codes.PermissionDenied, "email address is not verified")
+
+// markEmailVerified records that a provider has verified the user's email. The flag is never
+// cleared, so a later sign-in through a provider that does not vouch for the address keeps it.
+func markEmailVerified(ctx context.Context, userRepo repository.UserRepository, user *models.User, verified bool) error {
+	if !verified || user.EmailVerified {
+		return nil
+	}
+	user.EmailVerified = true
+	return userRepo.Update(ctx, user)
 }
 
 // getUserInfoFromOAuth gets user information from OAuth provider
@@ -195,6 +505,11 @@ func (s *userService) getUserInfoFromOAuth(ctx context.Context, provider, token
 		return s.getGoogleUserInfo(ctx, token)
 	} else if provider == "microsoft" {
 		return s.getMicrosoftUserInfo(ctx, token)
+	} else if provider == "github" {
+		return getGitHubUserInfo(ctx, token)
+	} else if provider == "apple" {
+		// The app signs in natively, so the token is issued for its bundle ID
+		return verifyAppleIDToken(ctx, s.appleKeys, token, s.apple.BundleID, s.apple.ClientID)
 	}
 
 	return nil, errors.New("invalid provider")
@@ -249,6 +564,10 @@ func (s *userService) getGoogleUserInfo(ctx context.Context, accessToken string)
 		userInfo.Email = email
 	}
 
+	if emailVerified, ok := result["email_verified"].(bool); ok {
+		userInfo.EmailVerified = emailVerified
+	}
+
 	if picture, ok := result["picture"].(string); ok {
 		userInfo.Avatar = picture
 	}
@@ -306,25 +625,19 @@ func (s *userService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 		userInfo.Name = displayName
 	}
 
+	// Microsoft accounts must confirm their address before they can sign in, so mail is trusted;
+	// the userPrincipalName is a sign-in name that need not be a working address
 	if mail, ok := result["mail"].(string); ok {
 		userInfo.Email = mail
+		userInfo.EmailVerified = true
 	} else if userPrincipalName, ok := result["userPrincipalName"].(string); ok {
 		// Fallback to userPrincipalName if mail is not available
 		userInfo.Email = userPrincipalName
 	}
 
-	// Get photo (requires a separate API call)
-	photoURL := "https://graph.microsoft.com/v1.0/me/photo/$value"
-	photoReq, err := http.NewRequestWithContext(ctx, "GET", photoURL, nil)
-	if err == nil {
-		photoReq.Header.Add("Authorization", "Bearer "+accessToken)
-		photoResp, err := client.Do(photoReq)
-		if err == nil && photoResp.StatusCode == http.StatusOK {
-			// If photo is available, construct a data URL
-			// In a real app, you might want to save this to a CDN or file storage
-			userInfo.Avatar = fmt.Sprintf("https://graph.microsoft.com/v1.0/me/photo/$value")
-			photoResp.Body.Close()
-		}
+	// Get photo (requires a separate API call); the user simply has no avatar if it fails
+	if avatar, err := getMicrosoftPhoto(ctx, client, accessToken); err == nil {
+		userInfo.Avatar = avatar
 	}
 
 	// Validate required fields
@@ -335,13 +648,22 @@ func (s *userService) getMicrosoftUserInfo(ctx context.Context, accessToken stri
 	return userInfo, nil
 }
 
-// generateJWT generates a JWT token for the user
-func (s *userService) generateJWT(userID string) (string, error) {
+// generateJWT generates a JWT token for the user. The role claim lets the gateway and the
+// other services authorize admin-only operations; it is fixed until the token expires.
+func (s *userService) generateJWT(user *models.User) (string, error) {
+	// Generate a unique token ID so the token can be revoked
+	jti, err := generateTokenID()
+	if err != nil {
+		return "", err
+	}
+
 	// Create token
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub": userID,                                 // Subject (user ID)
-		"iat": time.Now().Unix(),                      // Issued at
-		"exp": time.Now().Add(s.jwtExpiration).Unix(), // Expiration
+		"jti":  jti,                                    // Token ID
+		"sub":  user.ID,                                // Subject (user ID)
+		"role": user.Role,                              // Role (user or admin)
+		"iat":  time.Now().Unix(),                      // Issued at
+		"exp":  time.Now().Add(s.jwtExpiration).Unix(), // Expiration
 	})
 
 	// Sign token